@@ -0,0 +1,61 @@
+package main
+
+import (
+	"log"
+	"net"
+	"os"
+
+	handler "github.com/athandoan/youtube/analytics-service/internal/delivery/grpc"
+	"github.com/athandoan/youtube/analytics-service/internal/infrastructure/rpc"
+	"github.com/athandoan/youtube/analytics-service/internal/repository"
+	"github.com/athandoan/youtube/analytics-service/internal/usecase"
+	pb "github.com/athandoan/youtube/proto/analytics"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	// 1. Init SQLite DB
+	dbPath := os.Getenv("SQLITE_DB_PATH")
+	if dbPath == "" {
+		dbPath = "analytics.db"
+	}
+
+	repo, err := repository.NewSQLiteRepository(dbPath)
+	if err != nil {
+		log.Fatalf("failed to init repository: %v", err)
+	}
+
+	// 2. Init Metadata Client
+	metaAddr := os.Getenv("METADATA_SERVICE_ADDR")
+	if metaAddr == "" {
+		metaAddr = "metadata-service:50051"
+	}
+	metadataService, err := rpc.NewMetadataClient(metaAddr)
+	if err != nil {
+		log.Fatalf("failed to create metadata client: %v", err)
+	}
+
+	// 3. Init Usecase
+	uc := usecase.NewAnalyticsUsecase(repo, metadataService)
+
+	// 4. Init Handler
+	h := handler.NewAnalyticsHandler(uc)
+
+	// 5. Start gRPC Server
+	port := os.Getenv("GRPC_PORT")
+	if port == "" {
+		port = "50054"
+	}
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(handler.AuthInterceptor))
+	pb.RegisterAnalyticsServiceServer(s, h)
+
+	log.Printf("Analytics Service (gRPC) running on :%s", port)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
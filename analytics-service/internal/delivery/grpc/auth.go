@@ -0,0 +1,42 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/analytics-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/analytics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The gateway sends the signed-in user it calls for in these metadata keys.
+const (
+	userIDKey    = "x-user-id"
+	userRolesKey = "x-user-roles"
+)
+
+type callerKey struct{}
+
+// AuthInterceptor identifies the caller. Players report beacons signed in or not; stats are
+// for signed-in users, and the usecase decides whose.
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	caller := &domain.Caller{Roles: md.Get(userRolesKey)}
+	if ids := md.Get(userIDKey); len(ids) > 0 {
+		caller.UserID = ids[0]
+	}
+	if info.FullMethod == pb.AnalyticsService_GetPlaybackStats_FullMethodName && caller.UserID == "" {
+		return nil, status.Error(codes.Unauthenticated, "sign in first")
+	}
+	return handler(context.WithValue(ctx, callerKey{}, caller), req)
+}
+
+// callerFrom returns the user AuthInterceptor identified.
+func callerFrom(ctx context.Context) *domain.Caller {
+	if caller, ok := ctx.Value(callerKey{}).(*domain.Caller); ok {
+		return caller
+	}
+	return &domain.Caller{}
+}
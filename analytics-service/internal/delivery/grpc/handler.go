@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/athandoan/youtube/analytics-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/analytics"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultWindow is used when a stats request leaves out the start of the window.
+const defaultWindow = time.Hour * 24
+
+type AnalyticsHandler struct {
+	pb.UnimplementedAnalyticsServiceServer
+	Usecase domain.AnalyticsUsecase
+}
+
+func NewAnalyticsHandler(u domain.AnalyticsUsecase) *AnalyticsHandler {
+	return &AnalyticsHandler{Usecase: u}
+}
+
+func (h *AnalyticsHandler) IngestBeacons(ctx context.Context, req *pb.IngestBeaconsRequest) (*pb.IngestBeaconsResponse, error) {
+	beacons := make([]*domain.Beacon, 0, len(req.Beacons))
+	for _, b := range req.Beacons {
+		beacons = append(beacons, &domain.Beacon{
+			VideoID:      b.VideoId,
+			SessionID:    b.SessionId,
+			Type:         b.Type,
+			Rendition:    b.Rendition,
+			ValueMs:      b.ValueMs,
+			Bitrate:      b.Bitrate,
+			ErrorCode:    b.ErrorCode,
+			Message:      b.Message,
+			ClientTimeMs: b.ClientTimeMs,
+		})
+	}
+
+	accepted, err := h.Usecase.Ingest(ctx, callerFrom(ctx), beacons)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.IngestBeaconsResponse{
+		Accepted: int32(accepted),
+		Rejected: int32(len(beacons) - accepted),
+	}, nil
+}
+
+func (h *AnalyticsHandler) GetPlaybackStats(ctx context.Context, req *pb.GetPlaybackStatsRequest) (*pb.GetPlaybackStatsResponse, error) {
+	to := time.Now()
+	if req.To != "" {
+		t, err := time.Parse(time.RFC3339, req.To)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid 'to': %v", err)
+		}
+		to = t
+	}
+	from := to.Add(-defaultWindow)
+	if req.From != "" {
+		t, err := time.Parse(time.RFC3339, req.From)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid 'from': %v", err)
+		}
+		from = t
+	}
+
+	stats, err := h.Usecase.GetPlaybackStats(ctx, callerFrom(ctx), req.VideoId, from, to)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidQuery):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrVideoNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, err
+	}
+
+	resp := &pb.GetPlaybackStatsResponse{
+		VideoId: stats.VideoID,
+		From:    stats.From.Format(time.RFC3339),
+		To:      stats.To.Format(time.RFC3339),
+		Overall: toProtoStats(stats.Overall),
+	}
+	for _, s := range stats.Renditions {
+		resp.Renditions = append(resp.Renditions, toProtoStats(s))
+	}
+	return resp, nil
+}
+
+func toProtoStats(s domain.PlaybackStats) *pb.PlaybackStats {
+	return &pb.PlaybackStats{
+		Rendition:       s.Rendition,
+		Sessions:        s.Sessions,
+		StartupMs:       toProtoPercentiles(s.StartupMs),
+		RebufferMs:      toProtoPercentiles(s.RebufferMs),
+		RebufferEvents:  s.RebufferEvents,
+		BitrateSwitches: s.BitrateSwitches,
+		Errors:          s.Errors,
+		FatalSessions:   s.FatalSessions,
+	}
+}
+
+func toProtoPercentiles(p domain.Percentiles) *pb.Percentiles {
+	return &pb.Percentiles{P50: p.P50, P90: p.P90, P99: p.P99}
+}
@@ -0,0 +1,119 @@
+package domain
+
+//go:generate mockgen -source=analytics.go -destination=../mocks/mock_repository.go -package=mocks
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidQuery  = errors.New("invalid stats query")
+	ErrForbidden     = errors.New("only the video's owner or an admin can see its stats")
+	ErrVideoNotFound = errors.New("video not found")
+)
+
+// RoleAdmin may see the stats of any video.
+const RoleAdmin = "admin"
+
+// Bounds on the player-chosen fields of a beacon. Beacons with longer session IDs or
+// renditions are dropped; longer error messages are cut short.
+const (
+	MaxSessionIDLength = 64
+	MaxRenditionLength = 32
+	MaxMessageLength   = 500
+)
+
+// Caller is the user the gateway calls on behalf of; UserID is empty for anonymous viewers.
+type Caller struct {
+	UserID string
+	Roles  []string
+}
+
+func (c *Caller) IsAdmin() bool {
+	return slices.Contains(c.Roles, RoleAdmin)
+}
+
+// Beacon types reported by the player.
+const (
+	BeaconStartup       = "startup"
+	BeaconRebuffer      = "rebuffer"
+	BeaconBitrateSwitch = "bitrate_switch"
+	BeaconError         = "error"
+	BeaconFatal         = "fatal"
+)
+
+type Beacon struct {
+	VideoID      string
+	SessionID    string
+	Type         string
+	Rendition    string
+	ValueMs      float64
+	Bitrate      int64
+	ErrorCode    string
+	Message      string
+	ClientTimeMs int64
+	ReceivedAt   time.Time
+}
+
+type Percentiles struct {
+	P50 float64
+	P90 float64
+	P99 float64
+}
+
+type PlaybackStats struct {
+	Rendition       string
+	Sessions        int64
+	StartupMs       Percentiles
+	RebufferMs      Percentiles
+	RebufferEvents  int64
+	BitrateSwitches int64
+	Errors          int64
+	FatalSessions   int64
+}
+
+type VideoStats struct {
+	VideoID    string
+	From       time.Time
+	To         time.Time
+	Overall    PlaybackStats
+	Renditions []PlaybackStats
+}
+
+// Video is what analytics needs to know about a video from the metadata service.
+type Video struct {
+	ID         string
+	OwnerID    string
+	Status     string // pending, processing, ready, failed
+	LiveStatus string // empty for uploads, live, ended
+}
+
+// Playable reports whether players can be watching the video, and so sending beacons for it.
+func (v *Video) Playable() bool {
+	return v.Status == "ready" || v.LiveStatus != ""
+}
+
+type BeaconRepository interface {
+	Insert(ctx context.Context, beacons []*Beacon) error
+	// Stats aggregates the beacons of a video received in [from, to): the stats across all
+	// renditions come first, with an empty Rendition, then one entry per rendition by name.
+	Stats(ctx context.Context, videoID string, from, to time.Time) ([]PlaybackStats, error)
+}
+
+type MetadataService interface {
+	// GetVideo returns any video, or ErrVideoNotFound.
+	GetVideo(ctx context.Context, id string) (*Video, error)
+	// GetVideoForViewer returns the video if viewerID, empty for anonymous viewers, may watch
+	// it, and ErrVideoNotFound if it does not exist or is hidden from them.
+	GetVideoForViewer(ctx context.Context, id, viewerID string) (*Video, error)
+}
+
+type AnalyticsUsecase interface {
+	// Ingest stores the valid beacons the caller sent for videos they can watch, and returns
+	// how many were accepted.
+	Ingest(ctx context.Context, caller *Caller, beacons []*Beacon) (int, error)
+	GetPlaybackStats(ctx context.Context, caller *Caller, videoID string, from, to time.Time) (*VideoStats, error)
+}
@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/analytics-service/internal/domain"
+	"github.com/athandoan/youtube/proto/common"
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type metadataClient struct {
+	client pb.MetadataServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewMetadataClient(addr string) (domain.MetadataService, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(identify))
+	if err != nil {
+		return nil, err
+	}
+	client := pb.NewMetadataServiceClient(conn)
+	return &metadataClient{client: client, conn: conn}, nil
+}
+
+// identify calls the metadata service as this service, which may ask on behalf of any viewer.
+func identify(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(metadata.AppendToOutgoingContext(ctx, "x-service", "analytics-service"), method, req, reply, cc, opts...)
+}
+
+func (m *metadataClient) GetVideo(ctx context.Context, id string) (*domain.Video, error) {
+	return m.getVideo(ctx, &pb.GetVideoRequest{Id: id, Unrestricted: true})
+}
+
+func (m *metadataClient) GetVideoForViewer(ctx context.Context, id, viewerID string) (*domain.Video, error) {
+	return m.getVideo(ctx, &pb.GetVideoRequest{Id: id, Viewer: &pb.Viewer{UserId: viewerID}})
+}
+
+func (m *metadataClient) getVideo(ctx context.Context, req *pb.GetVideoRequest) (*domain.Video, error) {
+	resp, err := m.client.GetVideo(ctx, req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound, codes.PermissionDenied:
+			return nil, domain.ErrVideoNotFound
+		}
+		return nil, err
+	}
+	return toVideo(resp), nil
+}
+
+func toVideo(v *common.Video) *domain.Video {
+	return &domain.Video{
+		ID:         v.Id,
+		OwnerID:    v.OwnerId,
+		Status:     v.Status,
+		LiveStatus: v.LiveStatus,
+	}
+}
@@ -0,0 +1,180 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: analytics.go
+//
+// Generated by this command:
+//
+//	mockgen -source=analytics.go -destination=../mocks/mock_repository.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/athandoan/youtube/analytics-service/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBeaconRepository is a mock of BeaconRepository interface.
+type MockBeaconRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBeaconRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockBeaconRepositoryMockRecorder is the mock recorder for MockBeaconRepository.
+type MockBeaconRepositoryMockRecorder struct {
+	mock *MockBeaconRepository
+}
+
+// NewMockBeaconRepository creates a new mock instance.
+func NewMockBeaconRepository(ctrl *gomock.Controller) *MockBeaconRepository {
+	mock := &MockBeaconRepository{ctrl: ctrl}
+	mock.recorder = &MockBeaconRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeaconRepository) EXPECT() *MockBeaconRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Insert mocks base method.
+func (m *MockBeaconRepository) Insert(ctx context.Context, beacons []*domain.Beacon) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Insert", ctx, beacons)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Insert indicates an expected call of Insert.
+func (mr *MockBeaconRepositoryMockRecorder) Insert(ctx, beacons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Insert", reflect.TypeOf((*MockBeaconRepository)(nil).Insert), ctx, beacons)
+}
+
+// Stats mocks base method.
+func (m *MockBeaconRepository) Stats(ctx context.Context, videoID string, from, to time.Time) ([]domain.PlaybackStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, videoID, from, to)
+	ret0, _ := ret[0].([]domain.PlaybackStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockBeaconRepositoryMockRecorder) Stats(ctx, videoID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockBeaconRepository)(nil).Stats), ctx, videoID, from, to)
+}
+
+// MockMetadataService is a mock of MetadataService interface.
+type MockMetadataService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetadataServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMetadataServiceMockRecorder is the mock recorder for MockMetadataService.
+type MockMetadataServiceMockRecorder struct {
+	mock *MockMetadataService
+}
+
+// NewMockMetadataService creates a new mock instance.
+func NewMockMetadataService(ctrl *gomock.Controller) *MockMetadataService {
+	mock := &MockMetadataService{ctrl: ctrl}
+	mock.recorder = &MockMetadataServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetadataService) EXPECT() *MockMetadataServiceMockRecorder {
+	return m.recorder
+}
+
+// GetVideo mocks base method.
+func (m *MockMetadataService) GetVideo(ctx context.Context, id string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideo", ctx, id)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideo indicates an expected call of GetVideo.
+func (mr *MockMetadataServiceMockRecorder) GetVideo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockMetadataService)(nil).GetVideo), ctx, id)
+}
+
+// GetVideoForViewer mocks base method.
+func (m *MockMetadataService) GetVideoForViewer(ctx context.Context, id, viewerID string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoForViewer", ctx, id, viewerID)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoForViewer indicates an expected call of GetVideoForViewer.
+func (mr *MockMetadataServiceMockRecorder) GetVideoForViewer(ctx, id, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoForViewer", reflect.TypeOf((*MockMetadataService)(nil).GetVideoForViewer), ctx, id, viewerID)
+}
+
+// MockAnalyticsUsecase is a mock of AnalyticsUsecase interface.
+type MockAnalyticsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockAnalyticsUsecaseMockRecorder is the mock recorder for MockAnalyticsUsecase.
+type MockAnalyticsUsecaseMockRecorder struct {
+	mock *MockAnalyticsUsecase
+}
+
+// NewMockAnalyticsUsecase creates a new mock instance.
+func NewMockAnalyticsUsecase(ctrl *gomock.Controller) *MockAnalyticsUsecase {
+	mock := &MockAnalyticsUsecase{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsUsecase) EXPECT() *MockAnalyticsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetPlaybackStats mocks base method.
+func (m *MockAnalyticsUsecase) GetPlaybackStats(ctx context.Context, caller *domain.Caller, videoID string, from, to time.Time) (*domain.VideoStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaybackStats", ctx, caller, videoID, from, to)
+	ret0, _ := ret[0].(*domain.VideoStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaybackStats indicates an expected call of GetPlaybackStats.
+func (mr *MockAnalyticsUsecaseMockRecorder) GetPlaybackStats(ctx, caller, videoID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaybackStats", reflect.TypeOf((*MockAnalyticsUsecase)(nil).GetPlaybackStats), ctx, caller, videoID, from, to)
+}
+
+// Ingest mocks base method.
+func (m *MockAnalyticsUsecase) Ingest(ctx context.Context, caller *domain.Caller, beacons []*domain.Beacon) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ingest", ctx, caller, beacons)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ingest indicates an expected call of Ingest.
+func (mr *MockAnalyticsUsecaseMockRecorder) Ingest(ctx, caller, beacons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ingest", reflect.TypeOf((*MockAnalyticsUsecase)(nil).Ingest), ctx, caller, beacons)
+}
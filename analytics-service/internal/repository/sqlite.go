@@ -0,0 +1,147 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/athandoan/youtube/analytics-service/internal/domain"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type sqliteRepo struct {
+	DB *sql.DB
+}
+
+func NewSQLiteRepository(dbPath string) (domain.BeaconRepository, error) {
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Init Schema
+	schema := `
+	CREATE TABLE IF NOT EXISTS beacons (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		video_id TEXT NOT NULL,
+		session_id TEXT NOT NULL,
+		type TEXT NOT NULL,
+		rendition TEXT NOT NULL DEFAULT '',
+		value_ms REAL NOT NULL DEFAULT 0,
+		bitrate INTEGER NOT NULL DEFAULT 0,
+		error_code TEXT NOT NULL DEFAULT '',
+		message TEXT NOT NULL DEFAULT '',
+		client_time_ms INTEGER NOT NULL DEFAULT 0,
+		received_at INTEGER NOT NULL -- unix milliseconds, server clock
+	);
+
+	CREATE INDEX IF NOT EXISTS beacons_video_received ON beacons(video_id, received_at);
+	`
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	return &sqliteRepo{DB: db}, nil
+}
+
+func (r *sqliteRepo) Insert(ctx context.Context, beacons []*domain.Beacon) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	stmt, err := tx.PrepareContext(ctx, `
+		INSERT INTO beacons (video_id, session_id, type, rendition, value_ms, bitrate, error_code, message, client_time_ms, received_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stmt.Close() }()
+
+	for _, b := range beacons {
+		if _, err := stmt.ExecContext(ctx, b.VideoID, b.SessionID, b.Type, b.Rendition, b.ValueMs, b.Bitrate,
+			b.ErrorCode, b.Message, b.ClientTimeMs, b.ReceivedAt.UnixMilli()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// statsQuery aggregates a video's beacons in the database, so a popular video's stats never
+// load its beacons into memory. Every beacon counts towards the overall stats, grouped under
+// the empty rendition, and those with a rendition towards its own as well. Percentiles use
+// the nearest-rank method: the smallest value ranked at or above p% of the samples.
+const statsQuery = `
+	WITH scoped AS (
+		SELECT session_id, type, rendition, value_ms
+		FROM beacons
+		WHERE video_id = ? AND received_at >= ? AND received_at < ?
+	),
+	grouped AS (
+		SELECT '' AS grp, session_id, type, value_ms FROM scoped
+		UNION ALL
+		SELECT rendition, session_id, type, value_ms FROM scoped WHERE rendition != ''
+	),
+	counts AS (
+		SELECT grp,
+			COUNT(DISTINCT session_id) AS sessions,
+			SUM(type = 'rebuffer') AS rebuffer_events,
+			SUM(type = 'bitrate_switch') AS bitrate_switches,
+			SUM(type IN ('error', 'fatal')) AS errors,
+			COUNT(DISTINCT CASE WHEN type = 'fatal' THEN session_id END) AS fatal_sessions
+		FROM grouped
+		GROUP BY grp
+	),
+	samples AS (
+		SELECT 'startup' AS metric, grp, value_ms FROM grouped WHERE type = 'startup'
+		UNION ALL
+		-- Total rebuffering per session; sessions that never stalled count as zero
+		SELECT 'rebuffer', grp, SUM(CASE WHEN type = 'rebuffer' THEN value_ms ELSE 0 END)
+		FROM grouped
+		GROUP BY grp, session_id
+	),
+	ranked AS (
+		SELECT metric, grp, value_ms,
+			ROW_NUMBER() OVER (PARTITION BY metric, grp ORDER BY value_ms) AS rn,
+			COUNT(*) OVER (PARTITION BY metric, grp) AS n
+		FROM samples
+	),
+	percentiles AS (
+		SELECT metric, grp,
+			MIN(CASE WHEN rn * 100 >= 50 * n THEN value_ms END) AS p50,
+			MIN(CASE WHEN rn * 100 >= 90 * n THEN value_ms END) AS p90,
+			MIN(CASE WHEN rn * 100 >= 99 * n THEN value_ms END) AS p99
+		FROM ranked
+		GROUP BY metric, grp
+	)
+	SELECT c.grp, c.sessions,
+		COALESCE(s.p50, 0), COALESCE(s.p90, 0), COALESCE(s.p99, 0),
+		COALESCE(rb.p50, 0), COALESCE(rb.p90, 0), COALESCE(rb.p99, 0),
+		c.rebuffer_events, c.bitrate_switches, c.errors, c.fatal_sessions
+	FROM counts c
+	LEFT JOIN percentiles s ON s.metric = 'startup' AND s.grp = c.grp
+	LEFT JOIN percentiles rb ON rb.metric = 'rebuffer' AND rb.grp = c.grp
+	ORDER BY c.grp`
+
+func (r *sqliteRepo) Stats(ctx context.Context, videoID string, from, to time.Time) ([]domain.PlaybackStats, error) {
+	rows, err := r.DB.QueryContext(ctx, statsQuery, videoID, from.UnixMilli(), to.UnixMilli())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var stats []domain.PlaybackStats
+	for rows.Next() {
+		var s domain.PlaybackStats
+		if err := rows.Scan(&s.Rendition, &s.Sessions,
+			&s.StartupMs.P50, &s.StartupMs.P90, &s.StartupMs.P99,
+			&s.RebufferMs.P50, &s.RebufferMs.P90, &s.RebufferMs.P99,
+			&s.RebufferEvents, &s.BitrateSwitches, &s.Errors, &s.FatalSessions); err != nil {
+			return nil, err
+		}
+		stats = append(stats, s)
+	}
+	return stats, rows.Err()
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/analytics-service/internal/domain"
+)
+
+func newTestRepo(t *testing.T) domain.BeaconRepository {
+	t.Helper()
+	repo, err := NewSQLiteRepository(filepath.Join(t.TempDir(), "analytics.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteRepository() error = %v", err)
+	}
+	return repo
+}
+
+func TestSQLiteRepo_Stats(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepo(t)
+	at := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	beacons := []*domain.Beacon{
+		{SessionID: "s1", Type: domain.BeaconStartup, ValueMs: 400},
+		{SessionID: "s2", Type: domain.BeaconStartup, ValueMs: 1200},
+		{SessionID: "s3", Type: domain.BeaconStartup, ValueMs: 800},
+		{SessionID: "s1", Type: domain.BeaconRebuffer, Rendition: "720p", ValueMs: 300},
+		{SessionID: "s1", Type: domain.BeaconRebuffer, Rendition: "720p", ValueMs: 200},
+		{SessionID: "s2", Type: domain.BeaconBitrateSwitch, Rendition: "480p", Bitrate: 1_200_000},
+		{SessionID: "s3", Type: domain.BeaconFatal, Rendition: "480p", ErrorCode: "MEDIA_ERR_NETWORK"},
+		// Outside the window, or for another video
+		{SessionID: "s4", Type: domain.BeaconStartup, ValueMs: 9000, ReceivedAt: at.Add(-2 * time.Hour)},
+		{VideoID: "video-2", SessionID: "s5", Type: domain.BeaconFatal, Rendition: "720p"},
+	}
+	for _, b := range beacons {
+		if b.VideoID == "" {
+			b.VideoID = "video-1"
+		}
+		if b.ReceivedAt.IsZero() {
+			b.ReceivedAt = at
+		}
+	}
+	if err := repo.Insert(ctx, beacons); err != nil {
+		t.Fatalf("Insert() error = %v", err)
+	}
+
+	stats, err := repo.Stats(ctx, "video-1", at.Add(-time.Hour), at.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("Stats() returned %d rows, want overall, 480p and 720p: %+v", len(stats), stats)
+	}
+
+	overall, r480, r720 := stats[0], stats[1], stats[2]
+	if overall.Rendition != "" || overall.Sessions != 3 {
+		t.Errorf("overall = %+v, want 3 sessions", overall)
+	}
+	if want := (domain.Percentiles{P50: 800, P90: 1200, P99: 1200}); overall.StartupMs != want {
+		t.Errorf("overall startup = %+v, want %+v", overall.StartupMs, want)
+	}
+	// s1 stalled for 500ms in total, s2 and s3 never stalled.
+	if want := (domain.Percentiles{P50: 0, P90: 500, P99: 500}); overall.RebufferMs != want {
+		t.Errorf("overall rebuffer = %+v, want %+v", overall.RebufferMs, want)
+	}
+	if overall.FatalSessions != 1 || overall.Errors != 1 || overall.RebufferEvents != 2 || overall.BitrateSwitches != 1 {
+		t.Errorf("overall counts = %+v", overall)
+	}
+
+	if r480.Rendition != "480p" || r480.Sessions != 2 || r480.BitrateSwitches != 1 || r480.FatalSessions != 1 {
+		t.Errorf("unexpected 480p stats: %+v", r480)
+	}
+	if r480.StartupMs != (domain.Percentiles{}) {
+		t.Errorf("480p startup = %+v, want none", r480.StartupMs)
+	}
+	if r720.Rendition != "720p" || r720.Sessions != 1 || r720.RebufferEvents != 2 || r720.RebufferMs.P50 != 500 {
+		t.Errorf("unexpected 720p stats: %+v", r720)
+	}
+}
+
+func TestSQLiteRepo_StatsWithoutBeacons(t *testing.T) {
+	stats, err := newTestRepo(t).Stats(context.Background(), "video-1", time.Unix(0, 0), time.Now())
+	if err != nil {
+		t.Fatalf("Stats() error = %v", err)
+	}
+	if len(stats) != 0 {
+		t.Errorf("Stats() = %+v, want no rows", stats)
+	}
+}
@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/athandoan/youtube/analytics-service/internal/domain"
+)
+
+var beaconTypes = map[string]bool{
+	domain.BeaconStartup:       true,
+	domain.BeaconRebuffer:      true,
+	domain.BeaconBitrateSwitch: true,
+	domain.BeaconError:         true,
+	domain.BeaconFatal:         true,
+}
+
+type analyticsUsecase struct {
+	repo     domain.BeaconRepository
+	metadata domain.MetadataService
+	now      func() time.Time
+}
+
+func NewAnalyticsUsecase(repo domain.BeaconRepository, metadata domain.MetadataService) domain.AnalyticsUsecase {
+	return &analyticsUsecase{repo: repo, metadata: metadata, now: time.Now}
+}
+
+func (u *analyticsUsecase) Ingest(ctx context.Context, caller *domain.Caller, beacons []*domain.Beacon) (int, error) {
+	receivedAt := u.now()
+
+	// Drop malformed beacons instead of failing the whole batch; players retry poorly.
+	// Beacons only count for videos the caller can be watching, each looked up once.
+	playable := make(map[string]bool)
+	valid := make([]*domain.Beacon, 0, len(beacons))
+	for _, b := range beacons {
+		if b.VideoID == "" || b.SessionID == "" || len(b.SessionID) > domain.MaxSessionIDLength ||
+			len(b.Rendition) > domain.MaxRenditionLength || !beaconTypes[b.Type] || b.ValueMs < 0 {
+			continue
+		}
+		ok, seen := playable[b.VideoID]
+		if !seen {
+			v, err := u.metadata.GetVideoForViewer(ctx, b.VideoID, caller.UserID)
+			switch {
+			case errors.Is(err, domain.ErrVideoNotFound):
+			case err != nil:
+				return 0, fmt.Errorf("failed to get video: %w", err)
+			default:
+				ok = v.Playable()
+			}
+			playable[b.VideoID] = ok
+		}
+		if !ok {
+			continue
+		}
+		b.Message = truncate(b.Message, domain.MaxMessageLength)
+		b.ReceivedAt = receivedAt
+		valid = append(valid, b)
+	}
+	if len(valid) == 0 {
+		return 0, nil
+	}
+
+	if err := u.repo.Insert(ctx, valid); err != nil {
+		return 0, fmt.Errorf("failed to store beacons: %w", err)
+	}
+	return len(valid), nil
+}
+
+func (u *analyticsUsecase) GetPlaybackStats(ctx context.Context, caller *domain.Caller, videoID string, from, to time.Time) (*domain.VideoStats, error) {
+	if videoID == "" {
+		return nil, fmt.Errorf("%w: video id is required", domain.ErrInvalidQuery)
+	}
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from must be before to", domain.ErrInvalidQuery)
+	}
+
+	if !caller.IsAdmin() {
+		v, err := u.metadata.GetVideo(ctx, videoID)
+		if err != nil {
+			return nil, err
+		}
+		if caller.UserID == "" || v.OwnerID != caller.UserID {
+			return nil, domain.ErrForbidden
+		}
+	}
+
+	rows, err := u.repo.Stats(ctx, videoID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &domain.VideoStats{
+		VideoID:    videoID,
+		From:       from,
+		To:         to,
+		Renditions: make([]domain.PlaybackStats, 0, len(rows)),
+	}
+	for _, s := range rows {
+		if s.Rendition == "" {
+			stats.Overall = s
+			continue
+		}
+		stats.Renditions = append(stats.Renditions, s)
+	}
+	return stats, nil
+}
+
+// truncate cuts s to at most n bytes without splitting a character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
@@ -0,0 +1,232 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/athandoan/youtube/analytics-service/internal/domain"
+	"github.com/athandoan/youtube/analytics-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestAnalyticsUsecase_Ingest(t *testing.T) {
+	viewer := &domain.Caller{UserID: "viewer-1"}
+	tests := []struct {
+		name         string
+		beacons      []*domain.Beacon
+		setupMock    func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService)
+		wantAccepted int
+		wantErr      bool
+	}{
+		{
+			name: "success - stores valid beacons and drops malformed ones",
+			beacons: []*domain.Beacon{
+				{VideoID: "video-1", SessionID: "s1", Type: domain.BeaconStartup, ValueMs: 850},
+				{VideoID: "video-1", SessionID: "s1", Type: "unknown"},
+				{VideoID: "", SessionID: "s1", Type: domain.BeaconError},
+				{VideoID: "video-1", SessionID: "s1", Type: domain.BeaconRebuffer, ValueMs: -5},
+				{VideoID: "video-1", SessionID: string(make([]byte, domain.MaxSessionIDLength+1)), Type: domain.BeaconStartup},
+				{VideoID: "video-1", SessionID: "s1", Type: domain.BeaconBitrateSwitch, Rendition: strings.Repeat("p", domain.MaxRenditionLength+1)},
+				{VideoID: "video-1", SessionID: "s1", Type: domain.BeaconFatal, ErrorCode: "MEDIA_ERR_DECODE"},
+			},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				meta.EXPECT().GetVideoForViewer(gomock.Any(), "video-1", "viewer-1").Return(&domain.Video{ID: "video-1", Status: "ready"}, nil)
+				m.EXPECT().
+					Insert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, beacons []*domain.Beacon) error {
+						if len(beacons) != 2 {
+							t.Errorf("expected 2 beacons to be stored, got %d", len(beacons))
+						}
+						for _, b := range beacons {
+							if b.ReceivedAt.IsZero() {
+								t.Errorf("expected ReceivedAt to be set")
+							}
+						}
+						return nil
+					})
+			},
+			wantAccepted: 2,
+		},
+		{
+			name: "success - drops beacons for videos the viewer cannot be watching",
+			beacons: []*domain.Beacon{
+				{VideoID: "video-1", SessionID: "s1", Type: domain.BeaconStartup, ValueMs: 850},
+				{VideoID: "private", SessionID: "s1", Type: domain.BeaconStartup, ValueMs: 850},
+				{VideoID: "private", SessionID: "s1", Type: domain.BeaconRebuffer, ValueMs: 100},
+				{VideoID: "pending", SessionID: "s1", Type: domain.BeaconStartup, ValueMs: 850},
+				{VideoID: "live", SessionID: "s1", Type: domain.BeaconStartup, ValueMs: 850},
+			},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				meta.EXPECT().GetVideoForViewer(gomock.Any(), "video-1", "viewer-1").Return(&domain.Video{ID: "video-1", Status: "ready"}, nil)
+				meta.EXPECT().GetVideoForViewer(gomock.Any(), "private", "viewer-1").Return(nil, domain.ErrVideoNotFound)
+				meta.EXPECT().GetVideoForViewer(gomock.Any(), "pending", "viewer-1").Return(&domain.Video{ID: "pending", Status: "pending"}, nil)
+				meta.EXPECT().GetVideoForViewer(gomock.Any(), "live", "viewer-1").Return(&domain.Video{ID: "live", Status: "pending", LiveStatus: "live"}, nil)
+				m.EXPECT().Insert(gomock.Any(), gomock.Len(2)).Return(nil)
+			},
+			wantAccepted: 2,
+		},
+		{
+			name: "success - cuts long error messages short",
+			beacons: []*domain.Beacon{
+				{VideoID: "video-1", SessionID: "s1", Type: domain.BeaconError, Message: strings.Repeat("é", domain.MaxMessageLength)},
+			},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				meta.EXPECT().GetVideoForViewer(gomock.Any(), "video-1", "viewer-1").Return(&domain.Video{ID: "video-1", Status: "ready"}, nil)
+				m.EXPECT().
+					Insert(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, beacons []*domain.Beacon) error {
+						if msg := beacons[0].Message; len(msg) > domain.MaxMessageLength || !utf8.ValidString(msg) {
+							t.Errorf("expected the message cut to %d bytes of valid UTF-8, got %d bytes", domain.MaxMessageLength, len(msg))
+						}
+						return nil
+					})
+			},
+			wantAccepted: 1,
+		},
+		{
+			name: "success - nothing stored when every beacon is invalid",
+			beacons: []*domain.Beacon{
+				{VideoID: "video-1", Type: domain.BeaconStartup},
+			},
+			setupMock:    func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {},
+			wantAccepted: 0,
+		},
+		{
+			name: "error - metadata service fails",
+			beacons: []*domain.Beacon{
+				{VideoID: "video-1", SessionID: "s1", Type: domain.BeaconStartup, ValueMs: 850},
+			},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				meta.EXPECT().GetVideoForViewer(gomock.Any(), "video-1", "viewer-1").Return(nil, errors.New("unavailable"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "error - repository fails",
+			beacons: []*domain.Beacon{
+				{VideoID: "video-1", SessionID: "s1", Type: domain.BeaconStartup, ValueMs: 850},
+			},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				meta.EXPECT().GetVideoForViewer(gomock.Any(), "video-1", "viewer-1").Return(&domain.Video{ID: "video-1", Status: "ready"}, nil)
+				m.EXPECT().Insert(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockBeaconRepository(ctrl)
+			mockMeta := mocks.NewMockMetadataService(ctrl)
+			tt.setupMock(mockRepo, mockMeta)
+
+			uc := NewAnalyticsUsecase(mockRepo, mockMeta)
+			accepted, err := uc.Ingest(context.Background(), viewer, tt.beacons)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Ingest() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && accepted != tt.wantAccepted {
+				t.Errorf("Ingest() accepted = %d, want %d", accepted, tt.wantAccepted)
+			}
+		})
+	}
+}
+
+func TestAnalyticsUsecase_GetPlaybackStats(t *testing.T) {
+	to := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	from := to.Add(-time.Hour)
+	rows := []domain.PlaybackStats{
+		{Sessions: 3},
+		{Rendition: "480p", Sessions: 2},
+		{Rendition: "720p", Sessions: 1},
+	}
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		setupMock func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService)
+		wantErr   error
+	}{
+		{
+			name:   "success - owner",
+			caller: &domain.Caller{UserID: "owner-1"},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				meta.EXPECT().GetVideo(gomock.Any(), "video-1").Return(&domain.Video{ID: "video-1", OwnerID: "owner-1"}, nil)
+				m.EXPECT().Stats(gomock.Any(), "video-1", from, to).Return(rows, nil)
+			},
+		},
+		{
+			name:   "success - admin",
+			caller: &domain.Caller{UserID: "admin-1", Roles: []string{domain.RoleAdmin}},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				m.EXPECT().Stats(gomock.Any(), "video-1", from, to).Return(rows, nil)
+			},
+		},
+		{
+			name:   "error - someone else's video",
+			caller: &domain.Caller{UserID: "viewer-1"},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				meta.EXPECT().GetVideo(gomock.Any(), "video-1").Return(&domain.Video{ID: "video-1", OwnerID: "owner-1"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "error - video not found",
+			caller: &domain.Caller{UserID: "owner-1"},
+			setupMock: func(m *mocks.MockBeaconRepository, meta *mocks.MockMetadataService) {
+				meta.EXPECT().GetVideo(gomock.Any(), "video-1").Return(nil, domain.ErrVideoNotFound)
+			},
+			wantErr: domain.ErrVideoNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockBeaconRepository(ctrl)
+			mockMeta := mocks.NewMockMetadataService(ctrl)
+			tt.setupMock(mockRepo, mockMeta)
+
+			uc := NewAnalyticsUsecase(mockRepo, mockMeta)
+			stats, err := uc.GetPlaybackStats(context.Background(), tt.caller, "video-1", from, to)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("GetPlaybackStats() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if stats.Overall.Sessions != 3 {
+				t.Errorf("overall sessions = %d, want 3", stats.Overall.Sessions)
+			}
+			if len(stats.Renditions) != 2 || stats.Renditions[0].Rendition != "480p" || stats.Renditions[1].Rendition != "720p" {
+				t.Errorf("renditions = %+v, want 480p and 720p", stats.Renditions)
+			}
+		})
+	}
+}
+
+func TestAnalyticsUsecase_GetPlaybackStats_InvalidQuery(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc := NewAnalyticsUsecase(mocks.NewMockBeaconRepository(ctrl), mocks.NewMockMetadataService(ctrl))
+	caller := &domain.Caller{UserID: "owner-1"}
+	now := time.Now()
+
+	if _, err := uc.GetPlaybackStats(context.Background(), caller, "", now.Add(-time.Hour), now); !errors.Is(err, domain.ErrInvalidQuery) {
+		t.Errorf("GetPlaybackStats() without video id error = %v, want ErrInvalidQuery", err)
+	}
+	if _, err := uc.GetPlaybackStats(context.Background(), caller, "video-1", now, now.Add(-time.Hour)); !errors.Is(err, domain.ErrInvalidQuery) {
+		t.Errorf("GetPlaybackStats() with inverted window error = %v, want ErrInvalidQuery", err)
+	}
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"time"
+
+	handler "github.com/athandoan/youtube/chat-service/internal/delivery/grpc"
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+	"github.com/athandoan/youtube/chat-service/internal/infrastructure/broker"
+	"github.com/athandoan/youtube/chat-service/internal/infrastructure/limiter"
+	"github.com/athandoan/youtube/chat-service/internal/infrastructure/rpc"
+	"github.com/athandoan/youtube/chat-service/internal/repository"
+	"github.com/athandoan/youtube/chat-service/internal/usecase"
+	pb "github.com/athandoan/youtube/proto/chat"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	// 1. Init SQLite DB
+	dbPath := os.Getenv("SQLITE_DB_PATH")
+	if dbPath == "" {
+		dbPath = "chat.db"
+	}
+
+	repo, err := repository.NewSQLiteRepository(dbPath)
+	if err != nil {
+		log.Fatalf("failed to init repository: %v", err)
+	}
+
+	// 2. Init Metadata Client (gRPC)
+	metaAddr := os.Getenv("METADATA_SERVICE_ADDR")
+	if metaAddr == "" {
+		metaAddr = "metadata-service:50051"
+	}
+	videos, err := rpc.NewMetadataClient(metaAddr)
+	if err != nil {
+		log.Fatalf("failed to create metadata client: %v", err)
+	}
+
+	// 3. Init Usecase
+	// Each user may send CHAT_RATE_BURST messages back to back, then one every CHAT_RATE_INTERVAL_SECONDS
+	limits := domain.Limits{MaxLength: 500, Burst: 5, Refill: 2 * time.Second}
+	if v := os.Getenv("CHAT_MAX_MESSAGE_LENGTH"); v != "" {
+		if limits.MaxLength, err = strconv.Atoi(v); err != nil || limits.MaxLength <= 0 {
+			log.Fatalf("invalid CHAT_MAX_MESSAGE_LENGTH: %q", v)
+		}
+	}
+	if v := os.Getenv("CHAT_RATE_BURST"); v != "" {
+		if limits.Burst, err = strconv.Atoi(v); err != nil || limits.Burst <= 0 {
+			log.Fatalf("invalid CHAT_RATE_BURST: %q", v)
+		}
+	}
+	if v := os.Getenv("CHAT_RATE_INTERVAL_SECONDS"); v != "" {
+		seconds, err := strconv.ParseFloat(v, 64)
+		if err != nil || seconds <= 0 {
+			log.Fatalf("invalid CHAT_RATE_INTERVAL_SECONDS: %q", v)
+		}
+		limits.Refill = time.Duration(seconds * float64(time.Second))
+	}
+	uc := usecase.NewChatUsecase(repo, videos, broker.NewMemoryBroker(), limiter.NewTokenBucketLimiter(), limits)
+
+	// 4. Init Handler
+	h := handler.NewChatHandler(uc)
+
+	// 5. Start gRPC Server
+	port := os.Getenv("GRPC_PORT")
+	if port == "" {
+		port = "50055"
+	}
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	s := grpc.NewServer(grpc.UnaryInterceptor(handler.AuthInterceptor), grpc.StreamInterceptor(handler.StreamAuthInterceptor))
+	pb.RegisterChatServiceServer(s, h)
+
+	log.Printf("Chat Service (gRPC) running on :%s", port)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
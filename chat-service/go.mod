@@ -0,0 +1,20 @@
+module github.com/athandoan/youtube/chat-service
+
+go 1.25.5
+
+require (
+	github.com/athandoan/youtube/proto v0.0.0-00010101000000-000000000000
+	github.com/mattn/go-sqlite3 v1.14.22
+	go.uber.org/mock v0.6.0
+	google.golang.org/grpc v1.78.0
+)
+
+require (
+	golang.org/x/net v0.47.0 // indirect
+	golang.org/x/sys v0.38.0 // indirect
+	golang.org/x/text v0.31.0 // indirect
+	google.golang.org/genproto/googleapis/rpc v0.0.0-20251029180050-ab9386a59fda // indirect
+	google.golang.org/protobuf v1.36.11 // indirect
+)
+
+replace github.com/athandoan/youtube/proto => ../proto
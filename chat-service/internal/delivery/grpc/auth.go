@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/chat"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys callers identify themselves with. The gateway sends the signed-in user it
+// calls for; other services send their name. Services trust them, so they must only be
+// reachable by the gateway and each other.
+const (
+	userIDKey    = "x-user-id"
+	userRolesKey = "x-user-roles"
+	serviceKey   = "x-service"
+)
+
+// access says who may call an RPC.
+type access int
+
+const (
+	servicesOnly access = iota
+	anyone
+	signedIn
+)
+
+// methodAccess lists the RPCs open to users. Reading a room is open to anyone who may watch
+// its video, which the usecase checks; system messages are for services only.
+var methodAccess = map[string]access{
+	pb.ChatService_PostMessage_FullMethodName:   signedIn,
+	pb.ChatService_ListMessages_FullMethodName:  anyone,
+	pb.ChatService_Subscribe_FullMethodName:     anyone,
+	pb.ChatService_DeleteMessage_FullMethodName: signedIn,
+	pb.ChatService_BanUser_FullMethodName:       signedIn,
+	pb.ChatService_SetSlowMode_FullMethodName:   signedIn,
+	pb.ChatService_AddModerator_FullMethodName:  signedIn,
+}
+
+type callerKey struct{}
+
+// AuthInterceptor identifies the caller of every RPC and rejects calls it may not make.
+// Whether a caller may moderate a particular room is left to the usecase, which knows the owner.
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	caller := callerFromMetadata(ctx)
+	if err := checkCaller(caller, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(context.WithValue(ctx, callerKey{}, caller), req)
+}
+
+// StreamAuthInterceptor does the same for Subscribe, checking the request as it is received.
+func StreamAuthInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	caller := callerFromMetadata(ss.Context())
+	if err := checkCaller(caller, info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, &authStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), callerKey{}, caller), caller: caller, method: info.FullMethod})
+}
+
+type authStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	caller *domain.Caller
+	method string
+}
+
+func (s *authStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *authStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return checkCaller(s.caller, s.method, m)
+}
+
+// checkCaller rejects users calling RPCs they may not, and requests made on behalf of anyone
+// but the caller. req is nil when only the method is checked.
+func checkCaller(caller *domain.Caller, method string, req any) error {
+	if caller.Service != "" {
+		return nil
+	}
+	switch methodAccess[method] {
+	case servicesOnly:
+		return status.Error(codes.PermissionDenied, "only for internal services")
+	case signedIn:
+		if caller.UserID == "" {
+			return status.Error(codes.Unauthenticated, "sign in first")
+		}
+	}
+	// Users can only act as themselves, or as nobody, which never grants more
+	if id := claimedUser(req); id != "" && id != caller.UserID {
+		return status.Error(codes.PermissionDenied, "requests must be made as the caller")
+	}
+	return nil
+}
+
+// claimedUser returns who a request says it is made by. The user_id of bans and new
+// moderators names who they apply to instead.
+func claimedUser(req any) string {
+	switch r := req.(type) {
+	case *pb.PostMessageRequest:
+		return r.UserId
+	case interface{ GetViewerId() string }:
+		return r.GetViewerId()
+	case interface{ GetActorId() string }:
+		return r.GetActorId()
+	}
+	return ""
+}
+
+func callerFromMetadata(ctx context.Context) *domain.Caller {
+	md, _ := metadata.FromIncomingContext(ctx)
+	caller := &domain.Caller{Roles: md.Get(userRolesKey)}
+	if v := md.Get(userIDKey); len(v) > 0 {
+		caller.UserID = v[0]
+	}
+	if v := md.Get(serviceKey); len(v) > 0 {
+		caller.Service = v[0]
+	}
+	return caller
+}
+
+// callerFrom returns the caller the interceptors identified; without them, the call is anonymous.
+func callerFrom(ctx context.Context) *domain.Caller {
+	if caller, ok := ctx.Value(callerKey{}).(*domain.Caller); ok {
+		return caller
+	}
+	return &domain.Caller{}
+}
+
+// actorFrom returns who a moderation request acts as. Users act as themselves, which the
+// interceptor matched against actor_id; services act as the actor_id they name.
+func actorFrom(ctx context.Context, actorID string) *domain.Caller {
+	caller := callerFrom(ctx)
+	if caller.Service != "" {
+		return &domain.Caller{UserID: actorID}
+	}
+	return caller
+}
@@ -0,0 +1,135 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/athandoan/youtube/proto/chat"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		method   string
+		req      any
+		wantCode codes.Code
+	}{
+		{name: "anonymous replays a room", method: pb.ChatService_ListMessages_FullMethodName, req: &pb.ListMessagesRequest{VideoId: "v1"}},
+		{name: "user posts as themselves", md: metadata.Pairs(userIDKey, "user-1"), method: pb.ChatService_PostMessage_FullMethodName,
+			req: &pb.PostMessageRequest{VideoId: "v1", UserId: "user-1", Text: "hi"}},
+		{name: "anonymous posts", method: pb.ChatService_PostMessage_FullMethodName,
+			req: &pb.PostMessageRequest{VideoId: "v1", UserId: "user-1", Text: "hi"}, wantCode: codes.Unauthenticated},
+		{name: "user posts as someone else", md: metadata.Pairs(userIDKey, "user-1"), method: pb.ChatService_PostMessage_FullMethodName,
+			req: &pb.PostMessageRequest{VideoId: "v1", UserId: "user-2", Text: "hi"}, wantCode: codes.PermissionDenied},
+		{name: "user replays as someone else", md: metadata.Pairs(userIDKey, "user-1"), method: pb.ChatService_ListMessages_FullMethodName,
+			req: &pb.ListMessagesRequest{VideoId: "v1", ViewerId: "user-2"}, wantCode: codes.PermissionDenied},
+		{name: "user moderates as someone else", md: metadata.Pairs(userIDKey, "user-1"), method: pb.ChatService_DeleteMessage_FullMethodName,
+			req: &pb.DeleteMessageRequest{VideoId: "v1", ActorId: "owner", MessageId: 7}, wantCode: codes.PermissionDenied},
+		{name: "user bans someone else", md: metadata.Pairs(userIDKey, "user-1"), method: pb.ChatService_BanUser_FullMethodName,
+			req: &pb.BanUserRequest{VideoId: "v1", ActorId: "user-1", UserId: "troll"}},
+		{name: "anonymous bans", method: pb.ChatService_BanUser_FullMethodName,
+			req: &pb.BanUserRequest{VideoId: "v1", UserId: "troll"}, wantCode: codes.Unauthenticated},
+		{name: "admin posts a system message", md: metadata.Pairs(userIDKey, "user-1", userRolesKey, "admin"), method: pb.ChatService_PostSystemMessage_FullMethodName,
+			req: &pb.PostSystemMessageRequest{VideoId: "v1", Text: "hi"}, wantCode: codes.PermissionDenied},
+		{name: "service posts a system message", md: metadata.Pairs(serviceKey, "live-service"), method: pb.ChatService_PostSystemMessage_FullMethodName,
+			req: &pb.PostSystemMessageRequest{VideoId: "v1", Text: "hi"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := AuthInterceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("AuthInterceptor() code = %v, want %v (%v)", got, tt.wantCode, err)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCode == codes.OK)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptor_Actor(t *testing.T) {
+	tests := []struct {
+		name      string
+		md        metadata.MD
+		actorID   string
+		wantUser  string
+		wantAdmin bool
+	}{
+		{name: "admin acts as themselves with their roles", md: metadata.Pairs(userIDKey, "user-1", userRolesKey, "admin"), actorID: "user-1", wantUser: "user-1", wantAdmin: true},
+		{name: "user without actor_id acts as themselves", md: metadata.Pairs(userIDKey, "user-1"), wantUser: "user-1"},
+		{name: "service acts as the actor it names", md: metadata.Pairs(serviceKey, "live-service", userRolesKey, "admin"), actorID: "owner", wantUser: "owner"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			handler := func(ctx context.Context, req any) (any, error) {
+				actor := actorFrom(ctx, tt.actorID)
+				if actor.UserID != tt.wantUser || actor.IsAdmin() != tt.wantAdmin {
+					t.Errorf("actorFrom() = %+v, want user %q admin %v", actor, tt.wantUser, tt.wantAdmin)
+				}
+				return nil, nil
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: pb.ChatService_SetSlowMode_FullMethodName}
+			if _, err := AuthInterceptor(ctx, &pb.SetSlowModeRequest{VideoId: "v1", ActorId: tt.actorID}, info, handler); err != nil {
+				t.Fatalf("AuthInterceptor() error = %v", err)
+			}
+		})
+	}
+}
+
+// fakeStream receives one request.
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *pb.SubscribeRequest
+}
+
+func (s *fakeStream) Context() context.Context { return s.ctx }
+
+func (s *fakeStream) RecvMsg(m any) error {
+	req := m.(*pb.SubscribeRequest)
+	req.VideoId, req.ViewerId = s.req.VideoId, s.req.ViewerId
+	return nil
+}
+
+func TestStreamAuthInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		req      *pb.SubscribeRequest
+		wantCode codes.Code
+	}{
+		{name: "anonymous follows a room", req: &pb.SubscribeRequest{VideoId: "v1"}},
+		{name: "user follows as themselves", md: metadata.Pairs(userIDKey, "user-1"), req: &pb.SubscribeRequest{VideoId: "v1", ViewerId: "user-1"}},
+		{name: "user follows as someone else", md: metadata.Pairs(userIDKey, "user-1"), req: &pb.SubscribeRequest{VideoId: "v1", ViewerId: "user-2"}, wantCode: codes.PermissionDenied},
+		{name: "anonymous follows as someone", req: &pb.SubscribeRequest{VideoId: "v1", ViewerId: "user-2"}, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := &fakeStream{ctx: metadata.NewIncomingContext(context.Background(), tt.md), req: tt.req}
+			handler := func(srv any, stream grpc.ServerStream) error {
+				return stream.RecvMsg(&pb.SubscribeRequest{})
+			}
+
+			err := StreamAuthInterceptor(nil, ss, &grpc.StreamServerInfo{FullMethod: pb.ChatService_Subscribe_FullMethodName}, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("StreamAuthInterceptor() code = %v, want %v (%v)", got, tt.wantCode, err)
+			}
+		})
+	}
+}
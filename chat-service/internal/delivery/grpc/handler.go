@@ -0,0 +1,154 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/chat"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type ChatHandler struct {
+	pb.UnimplementedChatServiceServer
+	Usecase domain.ChatUsecase
+}
+
+func NewChatHandler(u domain.ChatUsecase) *ChatHandler {
+	return &ChatHandler{Usecase: u}
+}
+
+func (h *ChatHandler) PostMessage(ctx context.Context, req *pb.PostMessageRequest) (*pb.ChatMessage, error) {
+	m, err := h.Usecase.PostMessage(ctx, req.VideoId, req.UserId, req.Text)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoMessage(m), nil
+}
+
+func (h *ChatHandler) PostSystemMessage(ctx context.Context, req *pb.PostSystemMessageRequest) (*pb.ChatMessage, error) {
+	m, err := h.Usecase.PostSystemMessage(ctx, req.VideoId, req.Text)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoMessage(m), nil
+}
+
+func (h *ChatHandler) ListMessages(ctx context.Context, req *pb.ListMessagesRequest) (*pb.ListMessagesResponse, error) {
+	messages, err := h.Usecase.ListMessages(ctx, req.VideoId, req.ViewerId,
+		time.Duration(req.FromOffsetMs)*time.Millisecond, time.Duration(req.ToOffsetMs)*time.Millisecond, int(req.Limit))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListMessagesResponse{}
+	for _, m := range messages {
+		resp.Messages = append(resp.Messages, toProtoMessage(m))
+	}
+	return resp, nil
+}
+
+func (h *ChatHandler) Subscribe(req *pb.SubscribeRequest, stream pb.ChatService_SubscribeServer) error {
+	events, cancel, err := h.Usecase.Subscribe(stream.Context(), req.VideoId, req.ViewerId)
+	if err != nil {
+		return toStatus(err)
+	}
+	defer cancel()
+	// Let the client know the viewer is in before the first event, which may be a while
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case e, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toProtoEvent(e)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (h *ChatHandler) DeleteMessage(ctx context.Context, req *pb.DeleteMessageRequest) (*pb.ModerationResponse, error) {
+	if err := h.Usecase.DeleteMessage(ctx, req.VideoId, actorFrom(ctx, req.ActorId), req.MessageId); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ModerationResponse{Status: "success"}, nil
+}
+
+func (h *ChatHandler) BanUser(ctx context.Context, req *pb.BanUserRequest) (*pb.ModerationResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := h.Usecase.BanUser(ctx, req.VideoId, actorFrom(ctx, req.ActorId), req.UserId, time.Duration(req.DurationSeconds)*time.Second); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ModerationResponse{Status: "success"}, nil
+}
+
+func (h *ChatHandler) SetSlowMode(ctx context.Context, req *pb.SetSlowModeRequest) (*pb.ModerationResponse, error) {
+	if err := h.Usecase.SetSlowMode(ctx, req.VideoId, actorFrom(ctx, req.ActorId), time.Duration(req.Seconds)*time.Second); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ModerationResponse{Status: "success"}, nil
+}
+
+func (h *ChatHandler) AddModerator(ctx context.Context, req *pb.AddModeratorRequest) (*pb.ModerationResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := h.Usecase.AddModerator(ctx, req.VideoId, actorFrom(ctx, req.ActorId), req.UserId); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ModerationResponse{Status: "success"}, nil
+}
+
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAnonymous):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrEmptyMessage), errors.Is(err, domain.ErrMessageTooLong):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrChatUnavailable):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, domain.ErrBanned), errors.Is(err, domain.ErrNotModerator), errors.Is(err, domain.ErrNotOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrRateLimited), errors.Is(err, domain.ErrSlowMode):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, domain.ErrMessageNotFound), errors.Is(err, domain.ErrVideoNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func toProtoMessage(m *domain.Message) *pb.ChatMessage {
+	return &pb.ChatMessage{
+		Id:          m.ID,
+		VideoId:     m.VideoID,
+		UserId:      m.UserID,
+		Text:        m.Text,
+		System:      m.System,
+		CreatedAtMs: m.CreatedAt.UnixMilli(),
+		OffsetMs:    m.Offset.Milliseconds(),
+	}
+}
+
+func toProtoEvent(e *domain.Event) *pb.ChatEvent {
+	out := &pb.ChatEvent{
+		Type:            e.Type,
+		MessageId:       e.MessageID,
+		UserId:          e.UserID,
+		SlowModeSeconds: int32(e.SlowMode / time.Second),
+	}
+	if e.Message != nil {
+		out.Message = toProtoMessage(e.Message)
+	}
+	return out
+}
@@ -0,0 +1,156 @@
+package domain
+
+//go:generate mockgen -source=chat.go -destination=../mocks/mock_services.go -package=mocks
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrAnonymous       = errors.New("sign in to chat")
+	ErrEmptyMessage    = errors.New("message is empty")
+	ErrMessageTooLong  = errors.New("message is too long")
+	ErrChatUnavailable = errors.New("chat is only open during live streams and premieres")
+	ErrBanned          = errors.New("you are banned from this chat")
+	ErrRateLimited     = errors.New("you are sending messages too fast")
+	ErrSlowMode        = errors.New("slow mode is on, wait before sending another message")
+	ErrNotModerator    = errors.New("only the owner, moderators and admins can do that")
+	ErrNotOwner        = errors.New("only the owner and admins can add moderators")
+	ErrMessageNotFound = errors.New("message not found")
+	// ErrVideoNotFound also covers videos the viewer may not watch, so their chat stays hidden.
+	ErrVideoNotFound = errors.New("video not found")
+)
+
+// RoleAdmin may moderate every room.
+const RoleAdmin = "admin"
+
+// Caller is who an RPC is made by: a signed-in user with their roles, identified by the
+// gateway, or another service.
+type Caller struct {
+	UserID  string
+	Roles   []string
+	Service string
+}
+
+func (c *Caller) IsAdmin() bool {
+	return slices.Contains(c.Roles, RoleAdmin)
+}
+
+// Room event types.
+const (
+	EventMessage  = "message"
+	EventDelete   = "delete"
+	EventBan      = "ban"
+	EventSlowMode = "slow_mode"
+)
+
+type Message struct {
+	ID        int64
+	VideoID   string
+	UserID    string // empty for system messages
+	Text      string
+	System    bool
+	CreatedAt time.Time
+	// Offset is the stream position when the message was posted, for replay against the VOD
+	Offset time.Duration
+}
+
+// Event is something that happened in a room, fanned out to its subscribers.
+type Event struct {
+	Type      string
+	Message   *Message      // EventMessage
+	MessageID int64         // EventDelete
+	UserID    string        // EventBan
+	SlowMode  time.Duration // EventSlowMode
+}
+
+// Video is what chat needs to know about the video a room belongs to.
+type Video struct {
+	ID       string
+	OwnerID  string
+	Live     bool
+	Premiere bool
+	// StartedAt is when the stream or premiere started; message offsets count from it
+	StartedAt time.Time
+	Duration  time.Duration // of a premiere; zero while unknown
+}
+
+// ClosesAt is when the room of a premiere closes, once it has played through; zero for rooms
+// that don't close on their own.
+func (v *Video) ClosesAt() time.Time {
+	if !v.Premiere || v.Duration <= 0 || v.StartedAt.IsZero() {
+		return time.Time{}
+	}
+	return v.StartedAt.Add(v.Duration)
+}
+
+// ChatOpen reports whether the room takes messages at now: during a live stream, and from
+// before a premiere until it has played through.
+func (v *Video) ChatOpen(now time.Time) bool {
+	if v.Live {
+		return true
+	}
+	closesAt := v.ClosesAt()
+	return v.Premiere && (closesAt.IsZero() || now.Before(closesAt))
+}
+
+type Limits struct {
+	MaxLength int // characters per message
+	// Burst messages may be sent back to back; after that one more every Refill
+	Burst  int
+	Refill time.Duration
+}
+
+type ChatRepository interface {
+	// InsertMessage stores m and sets its ID.
+	InsertMessage(ctx context.Context, m *Message) error
+	GetMessage(ctx context.Context, id int64) (*Message, error)
+	DeleteMessage(ctx context.Context, id int64) error
+	// ListMessages returns a room's messages between two offsets, oldest first; to 0 means no bound.
+	ListMessages(ctx context.Context, videoID string, from, to time.Duration, limit int) ([]*Message, error)
+	// Ban bans a user from a room until the given time; zero bans for good.
+	Ban(ctx context.Context, videoID, userID string, until time.Time) error
+	IsBanned(ctx context.Context, videoID, userID string, now time.Time) (bool, error)
+	SlowMode(ctx context.Context, videoID string) (time.Duration, error)
+	SetSlowMode(ctx context.Context, videoID string, d time.Duration) error
+	AddModerator(ctx context.Context, videoID, userID string) error
+	IsModerator(ctx context.Context, videoID, userID string) (bool, error)
+}
+
+type VideoService interface {
+	// GetVideo looks a video up whatever its visibility, for moderation and system messages.
+	GetVideo(ctx context.Context, id string) (*Video, error)
+	// GetVideoForViewer returns ErrVideoNotFound unless viewerID, empty for anonymous viewers,
+	// may watch the video.
+	GetVideoForViewer(ctx context.Context, id, viewerID string) (*Video, error)
+}
+
+// RateLimiter is a set of token buckets keyed by caller.
+type RateLimiter interface {
+	// Allow takes a token from key's bucket, which holds burst tokens and regains one every interval.
+	Allow(key string, burst int, every time.Duration) bool
+}
+
+// Broker fans room events out to subscribers on this instance.
+type Broker interface {
+	Publish(videoID string, e *Event)
+	// Subscribe returns the room's events and a func that ends the subscription.
+	Subscribe(videoID string) (<-chan *Event, func())
+}
+
+type ChatUsecase interface {
+	PostMessage(ctx context.Context, videoID, userID, text string) (*Message, error)
+	PostSystemMessage(ctx context.Context, videoID, text string) (*Message, error)
+	ListMessages(ctx context.Context, videoID, viewerID string, from, to time.Duration, limit int) ([]*Message, error)
+	// Subscribe lets viewerID follow an open room. A premiere's subscription ends with it.
+	Subscribe(ctx context.Context, videoID, viewerID string) (<-chan *Event, func(), error)
+	// The moderation calls act as actor, who must own the video, moderate its room or be an admin.
+	DeleteMessage(ctx context.Context, videoID string, actor *Caller, messageID int64) error
+	BanUser(ctx context.Context, videoID string, actor *Caller, userID string, d time.Duration) error
+	SetSlowMode(ctx context.Context, videoID string, actor *Caller, d time.Duration) error
+	// AddModerator is for the owner and admins only.
+	AddModerator(ctx context.Context, videoID string, actor *Caller, userID string) error
+}
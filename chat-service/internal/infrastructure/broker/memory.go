@@ -0,0 +1,56 @@
+package broker
+
+import (
+	"sync"
+
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+)
+
+// subscriberBuffer is how many events a subscriber may fall behind before it misses some.
+const subscriberBuffer = 64
+
+type memoryBroker struct {
+	mu    sync.RWMutex
+	rooms map[string]map[chan *domain.Event]struct{}
+}
+
+// NewMemoryBroker fans events out in process, so every gateway must subscribe to the same instance.
+func NewMemoryBroker() domain.Broker {
+	return &memoryBroker{rooms: make(map[string]map[chan *domain.Event]struct{})}
+}
+
+// Publish never blocks; a subscriber that is not keeping up drops the event.
+func (b *memoryBroker) Publish(videoID string, e *domain.Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for ch := range b.rooms[videoID] {
+		select {
+		case ch <- e:
+		default:
+		}
+	}
+}
+
+func (b *memoryBroker) Subscribe(videoID string) (<-chan *domain.Event, func()) {
+	ch := make(chan *domain.Event, subscriberBuffer)
+
+	b.mu.Lock()
+	if b.rooms[videoID] == nil {
+		b.rooms[videoID] = make(map[chan *domain.Event]struct{})
+	}
+	b.rooms[videoID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			delete(b.rooms[videoID], ch)
+			if len(b.rooms[videoID]) == 0 {
+				delete(b.rooms, videoID)
+			}
+			close(ch)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package limiter
+
+import (
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+)
+
+// sweepInterval is how often buckets that have refilled are dropped.
+const sweepInterval = time.Minute * 5
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+	full   time.Duration // time for an empty bucket to refill
+}
+
+type tokenBucketLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewTokenBucketLimiter() domain.RateLimiter {
+	return &tokenBucketLimiter{buckets: make(map[string]*bucket), now: time.Now}
+}
+
+func (l *tokenBucketLimiter) Allow(key string, burst int, every time.Duration) bool {
+	if burst <= 0 || every <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if now.Sub(l.lastSweep) >= sweepInterval {
+		// A full bucket is the same as no bucket
+		for k, b := range l.buckets {
+			if now.Sub(b.last) >= b.full {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(burst), last: now, full: every * time.Duration(burst)}
+		l.buckets[key] = b
+	}
+	b.tokens += float64(now.Sub(b.last)) / float64(every)
+	if b.tokens > float64(burst) {
+		b.tokens = float64(burst)
+	}
+	b.last, b.full = now, every*time.Duration(burst)
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
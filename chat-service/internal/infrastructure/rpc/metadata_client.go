@@ -0,0 +1,82 @@
+package rpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+	"github.com/athandoan/youtube/proto/common"
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// createdAtLayout is how the metadata service formats created_at, in UTC.
+const createdAtLayout = "2006-01-02 15:04:05"
+
+type metadataClient struct {
+	client pb.MetadataServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewMetadataClient(addr string) (domain.VideoService, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(identify))
+	if err != nil {
+		return nil, err
+	}
+	client := pb.NewMetadataServiceClient(conn)
+	return &metadataClient{client: client, conn: conn}, nil
+}
+
+// identify sends every call as this service, which the metadata service only lets look up
+// videos regardless of who may watch them when it is a service.
+func identify(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(metadata.AppendToOutgoingContext(ctx, "x-service", "chat-service"), method, req, reply, cc, opts...)
+}
+
+func (m *metadataClient) GetVideo(ctx context.Context, id string) (*domain.Video, error) {
+	resp, err := m.client.GetVideo(ctx, &pb.GetVideoRequest{Id: id, Unrestricted: true})
+	if err != nil {
+		return nil, err
+	}
+	return toVideo(resp)
+}
+
+func (m *metadataClient) GetVideoForViewer(ctx context.Context, id, viewerID string) (*domain.Video, error) {
+	resp, err := m.client.GetVideo(ctx, &pb.GetVideoRequest{Id: id, Viewer: &pb.Viewer{UserId: viewerID}})
+	switch status.Code(err) {
+	case codes.OK:
+		return toVideo(resp)
+	case codes.NotFound, codes.PermissionDenied:
+		return nil, domain.ErrVideoNotFound
+	}
+	return nil, err
+}
+
+func toVideo(resp *common.Video) (*domain.Video, error) {
+	var err error
+
+	// A premiere starts at its publish time; a live stream when it was created
+	var startedAt time.Time
+	if resp.Premiere && resp.PublishAt != "" {
+		if startedAt, err = time.Parse(time.RFC3339, resp.PublishAt); err != nil {
+			return nil, err
+		}
+	} else if resp.LiveStatus != "" && resp.CreatedAt != "" {
+		if startedAt, err = time.Parse(createdAtLayout, resp.CreatedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	return &domain.Video{
+		ID:        resp.Id,
+		OwnerID:   resp.OwnerId,
+		Live:      resp.LiveStatus == "live",
+		Premiere:  resp.Premiere,
+		StartedAt: startedAt,
+		Duration:  time.Duration(resp.DurationSeconds * float64(time.Second)),
+	}, nil
+}
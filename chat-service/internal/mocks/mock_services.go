@@ -0,0 +1,472 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chat.go
+//
+// Generated by this command:
+//
+//	mockgen -source=chat.go -destination=../mocks/mock_services.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/athandoan/youtube/chat-service/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChatRepository is a mock of ChatRepository interface.
+type MockChatRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockChatRepositoryMockRecorder is the mock recorder for MockChatRepository.
+type MockChatRepositoryMockRecorder struct {
+	mock *MockChatRepository
+}
+
+// NewMockChatRepository creates a new mock instance.
+func NewMockChatRepository(ctrl *gomock.Controller) *MockChatRepository {
+	mock := &MockChatRepository{ctrl: ctrl}
+	mock.recorder = &MockChatRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatRepository) EXPECT() *MockChatRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddModerator mocks base method.
+func (m *MockChatRepository) AddModerator(ctx context.Context, videoID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddModerator", ctx, videoID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddModerator indicates an expected call of AddModerator.
+func (mr *MockChatRepositoryMockRecorder) AddModerator(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddModerator", reflect.TypeOf((*MockChatRepository)(nil).AddModerator), ctx, videoID, userID)
+}
+
+// Ban mocks base method.
+func (m *MockChatRepository) Ban(ctx context.Context, videoID, userID string, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ban", ctx, videoID, userID, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ban indicates an expected call of Ban.
+func (mr *MockChatRepositoryMockRecorder) Ban(ctx, videoID, userID, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ban", reflect.TypeOf((*MockChatRepository)(nil).Ban), ctx, videoID, userID, until)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockChatRepository) DeleteMessage(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockChatRepositoryMockRecorder) DeleteMessage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockChatRepository)(nil).DeleteMessage), ctx, id)
+}
+
+// GetMessage mocks base method.
+func (m *MockChatRepository) GetMessage(ctx context.Context, id int64) (*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessage", ctx, id)
+	ret0, _ := ret[0].(*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessage indicates an expected call of GetMessage.
+func (mr *MockChatRepositoryMockRecorder) GetMessage(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessage", reflect.TypeOf((*MockChatRepository)(nil).GetMessage), ctx, id)
+}
+
+// InsertMessage mocks base method.
+func (m_2 *MockChatRepository) InsertMessage(ctx context.Context, m *domain.Message) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "InsertMessage", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertMessage indicates an expected call of InsertMessage.
+func (mr *MockChatRepositoryMockRecorder) InsertMessage(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertMessage", reflect.TypeOf((*MockChatRepository)(nil).InsertMessage), ctx, m)
+}
+
+// IsBanned mocks base method.
+func (m *MockChatRepository) IsBanned(ctx context.Context, videoID, userID string, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBanned", ctx, videoID, userID, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBanned indicates an expected call of IsBanned.
+func (mr *MockChatRepositoryMockRecorder) IsBanned(ctx, videoID, userID, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBanned", reflect.TypeOf((*MockChatRepository)(nil).IsBanned), ctx, videoID, userID, now)
+}
+
+// IsModerator mocks base method.
+func (m *MockChatRepository) IsModerator(ctx context.Context, videoID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsModerator", ctx, videoID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsModerator indicates an expected call of IsModerator.
+func (mr *MockChatRepositoryMockRecorder) IsModerator(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsModerator", reflect.TypeOf((*MockChatRepository)(nil).IsModerator), ctx, videoID, userID)
+}
+
+// ListMessages mocks base method.
+func (m *MockChatRepository) ListMessages(ctx context.Context, videoID string, from, to time.Duration, limit int) ([]*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMessages", ctx, videoID, from, to, limit)
+	ret0, _ := ret[0].([]*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMessages indicates an expected call of ListMessages.
+func (mr *MockChatRepositoryMockRecorder) ListMessages(ctx, videoID, from, to, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockChatRepository)(nil).ListMessages), ctx, videoID, from, to, limit)
+}
+
+// SetSlowMode mocks base method.
+func (m *MockChatRepository) SetSlowMode(ctx context.Context, videoID string, d time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSlowMode", ctx, videoID, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSlowMode indicates an expected call of SetSlowMode.
+func (mr *MockChatRepositoryMockRecorder) SetSlowMode(ctx, videoID, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSlowMode", reflect.TypeOf((*MockChatRepository)(nil).SetSlowMode), ctx, videoID, d)
+}
+
+// SlowMode mocks base method.
+func (m *MockChatRepository) SlowMode(ctx context.Context, videoID string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SlowMode", ctx, videoID)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SlowMode indicates an expected call of SlowMode.
+func (mr *MockChatRepositoryMockRecorder) SlowMode(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SlowMode", reflect.TypeOf((*MockChatRepository)(nil).SlowMode), ctx, videoID)
+}
+
+// MockVideoService is a mock of VideoService interface.
+type MockVideoService struct {
+	ctrl     *gomock.Controller
+	recorder *MockVideoServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockVideoServiceMockRecorder is the mock recorder for MockVideoService.
+type MockVideoServiceMockRecorder struct {
+	mock *MockVideoService
+}
+
+// NewMockVideoService creates a new mock instance.
+func NewMockVideoService(ctrl *gomock.Controller) *MockVideoService {
+	mock := &MockVideoService{ctrl: ctrl}
+	mock.recorder = &MockVideoServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVideoService) EXPECT() *MockVideoServiceMockRecorder {
+	return m.recorder
+}
+
+// GetVideo mocks base method.
+func (m *MockVideoService) GetVideo(ctx context.Context, id string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideo", ctx, id)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideo indicates an expected call of GetVideo.
+func (mr *MockVideoServiceMockRecorder) GetVideo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockVideoService)(nil).GetVideo), ctx, id)
+}
+
+// GetVideoForViewer mocks base method.
+func (m *MockVideoService) GetVideoForViewer(ctx context.Context, id, viewerID string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoForViewer", ctx, id, viewerID)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoForViewer indicates an expected call of GetVideoForViewer.
+func (mr *MockVideoServiceMockRecorder) GetVideoForViewer(ctx, id, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoForViewer", reflect.TypeOf((*MockVideoService)(nil).GetVideoForViewer), ctx, id, viewerID)
+}
+
+// MockRateLimiter is a mock of RateLimiter interface.
+type MockRateLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockRateLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockRateLimiterMockRecorder is the mock recorder for MockRateLimiter.
+type MockRateLimiterMockRecorder struct {
+	mock *MockRateLimiter
+}
+
+// NewMockRateLimiter creates a new mock instance.
+func NewMockRateLimiter(ctrl *gomock.Controller) *MockRateLimiter {
+	mock := &MockRateLimiter{ctrl: ctrl}
+	mock.recorder = &MockRateLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRateLimiter) EXPECT() *MockRateLimiterMockRecorder {
+	return m.recorder
+}
+
+// Allow mocks base method.
+func (m *MockRateLimiter) Allow(key string, burst int, every time.Duration) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Allow", key, burst, every)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Allow indicates an expected call of Allow.
+func (mr *MockRateLimiterMockRecorder) Allow(key, burst, every any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Allow", reflect.TypeOf((*MockRateLimiter)(nil).Allow), key, burst, every)
+}
+
+// MockBroker is a mock of Broker interface.
+type MockBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrokerMockRecorder
+	isgomock struct{}
+}
+
+// MockBrokerMockRecorder is the mock recorder for MockBroker.
+type MockBrokerMockRecorder struct {
+	mock *MockBroker
+}
+
+// NewMockBroker creates a new mock instance.
+func NewMockBroker(ctrl *gomock.Controller) *MockBroker {
+	mock := &MockBroker{ctrl: ctrl}
+	mock.recorder = &MockBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroker) EXPECT() *MockBrokerMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockBroker) Publish(videoID string, e *domain.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Publish", videoID, e)
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockBrokerMockRecorder) Publish(videoID, e any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBroker)(nil).Publish), videoID, e)
+}
+
+// Subscribe mocks base method.
+func (m *MockBroker) Subscribe(videoID string) (<-chan *domain.Event, func()) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", videoID)
+	ret0, _ := ret[0].(<-chan *domain.Event)
+	ret1, _ := ret[1].(func())
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockBrokerMockRecorder) Subscribe(videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBroker)(nil).Subscribe), videoID)
+}
+
+// MockChatUsecase is a mock of ChatUsecase interface.
+type MockChatUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChatUsecaseMockRecorder is the mock recorder for MockChatUsecase.
+type MockChatUsecaseMockRecorder struct {
+	mock *MockChatUsecase
+}
+
+// NewMockChatUsecase creates a new mock instance.
+func NewMockChatUsecase(ctrl *gomock.Controller) *MockChatUsecase {
+	mock := &MockChatUsecase{ctrl: ctrl}
+	mock.recorder = &MockChatUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatUsecase) EXPECT() *MockChatUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddModerator mocks base method.
+func (m *MockChatUsecase) AddModerator(ctx context.Context, videoID string, actor *domain.Caller, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddModerator", ctx, videoID, actor, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddModerator indicates an expected call of AddModerator.
+func (mr *MockChatUsecaseMockRecorder) AddModerator(ctx, videoID, actor, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddModerator", reflect.TypeOf((*MockChatUsecase)(nil).AddModerator), ctx, videoID, actor, userID)
+}
+
+// BanUser mocks base method.
+func (m *MockChatUsecase) BanUser(ctx context.Context, videoID string, actor *domain.Caller, userID string, d time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanUser", ctx, videoID, actor, userID, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanUser indicates an expected call of BanUser.
+func (mr *MockChatUsecaseMockRecorder) BanUser(ctx, videoID, actor, userID, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockChatUsecase)(nil).BanUser), ctx, videoID, actor, userID, d)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockChatUsecase) DeleteMessage(ctx context.Context, videoID string, actor *domain.Caller, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, videoID, actor, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockChatUsecaseMockRecorder) DeleteMessage(ctx, videoID, actor, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockChatUsecase)(nil).DeleteMessage), ctx, videoID, actor, messageID)
+}
+
+// ListMessages mocks base method.
+func (m *MockChatUsecase) ListMessages(ctx context.Context, videoID, viewerID string, from, to time.Duration, limit int) ([]*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMessages", ctx, videoID, viewerID, from, to, limit)
+	ret0, _ := ret[0].([]*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMessages indicates an expected call of ListMessages.
+func (mr *MockChatUsecaseMockRecorder) ListMessages(ctx, videoID, viewerID, from, to, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockChatUsecase)(nil).ListMessages), ctx, videoID, viewerID, from, to, limit)
+}
+
+// PostMessage mocks base method.
+func (m *MockChatUsecase) PostMessage(ctx context.Context, videoID, userID, text string) (*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostMessage", ctx, videoID, userID, text)
+	ret0, _ := ret[0].(*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostMessage indicates an expected call of PostMessage.
+func (mr *MockChatUsecaseMockRecorder) PostMessage(ctx, videoID, userID, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMessage", reflect.TypeOf((*MockChatUsecase)(nil).PostMessage), ctx, videoID, userID, text)
+}
+
+// PostSystemMessage mocks base method.
+func (m *MockChatUsecase) PostSystemMessage(ctx context.Context, videoID, text string) (*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostSystemMessage", ctx, videoID, text)
+	ret0, _ := ret[0].(*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostSystemMessage indicates an expected call of PostSystemMessage.
+func (mr *MockChatUsecaseMockRecorder) PostSystemMessage(ctx, videoID, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostSystemMessage", reflect.TypeOf((*MockChatUsecase)(nil).PostSystemMessage), ctx, videoID, text)
+}
+
+// SetSlowMode mocks base method.
+func (m *MockChatUsecase) SetSlowMode(ctx context.Context, videoID string, actor *domain.Caller, d time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSlowMode", ctx, videoID, actor, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSlowMode indicates an expected call of SetSlowMode.
+func (mr *MockChatUsecaseMockRecorder) SetSlowMode(ctx, videoID, actor, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSlowMode", reflect.TypeOf((*MockChatUsecase)(nil).SetSlowMode), ctx, videoID, actor, d)
+}
+
+// Subscribe mocks base method.
+func (m *MockChatUsecase) Subscribe(ctx context.Context, videoID, viewerID string) (<-chan *domain.Event, func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, videoID, viewerID)
+	ret0, _ := ret[0].(<-chan *domain.Event)
+	ret1, _ := ret[1].(func())
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockChatUsecaseMockRecorder) Subscribe(ctx, videoID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockChatUsecase)(nil).Subscribe), ctx, videoID, viewerID)
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+	_ "github.com/mattn/go-sqlite3"
+)
+
+type sqliteRepo struct {
+	DB *sql.DB
+}
+
+func NewSQLiteRepository(dbPath string) (domain.ChatRepository, error) {
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Init Schema
+	schema := `
+	CREATE TABLE IF NOT EXISTS messages (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		video_id TEXT NOT NULL,
+		user_id TEXT NOT NULL DEFAULT '',
+		text TEXT NOT NULL,
+		system INTEGER NOT NULL DEFAULT 0,
+		created_at INTEGER NOT NULL, -- unix milliseconds
+		offset_ms INTEGER NOT NULL DEFAULT 0,
+		deleted INTEGER NOT NULL DEFAULT 0
+	);
+
+	CREATE INDEX IF NOT EXISTS messages_video_offset ON messages(video_id, offset_ms);
+
+	CREATE TABLE IF NOT EXISTS bans (
+		video_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		until INTEGER NOT NULL DEFAULT 0, -- unix milliseconds, 0 for good
+		PRIMARY KEY (video_id, user_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS rooms (
+		video_id TEXT PRIMARY KEY,
+		slow_mode_ms INTEGER NOT NULL DEFAULT 0
+	);
+
+	CREATE TABLE IF NOT EXISTS moderators (
+		video_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		PRIMARY KEY (video_id, user_id)
+	);
+	`
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	return &sqliteRepo{DB: db}, nil
+}
+
+func (r *sqliteRepo) InsertMessage(ctx context.Context, m *domain.Message) error {
+	res, err := r.DB.ExecContext(ctx, "INSERT INTO messages (video_id, user_id, text, system, created_at, offset_ms) VALUES (?, ?, ?, ?, ?, ?)",
+		m.VideoID, m.UserID, m.Text, m.System, m.CreatedAt.UnixMilli(), m.Offset.Milliseconds())
+	if err != nil {
+		return err
+	}
+	m.ID, err = res.LastInsertId()
+	return err
+}
+
+func (r *sqliteRepo) GetMessage(ctx context.Context, id int64) (*domain.Message, error) {
+	row := r.DB.QueryRowContext(ctx, "SELECT id, video_id, user_id, text, system, created_at, offset_ms FROM messages WHERE id = ? AND deleted = 0", id)
+	m, err := scanMessage(row)
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrMessageNotFound
+	}
+	return m, err
+}
+
+func (r *sqliteRepo) DeleteMessage(ctx context.Context, id int64) error {
+	_, err := r.DB.ExecContext(ctx, "UPDATE messages SET deleted = 1 WHERE id = ?", id)
+	return err
+}
+
+func (r *sqliteRepo) ListMessages(ctx context.Context, videoID string, from, to time.Duration, limit int) ([]*domain.Message, error) {
+	query := "SELECT id, video_id, user_id, text, system, created_at, offset_ms FROM messages WHERE video_id = ? AND deleted = 0 AND offset_ms >= ?"
+	args := []any{videoID, from.Milliseconds()}
+	if to > 0 {
+		query += " AND offset_ms < ?"
+		args = append(args, to.Milliseconds())
+	}
+	query += " ORDER BY offset_ms, id LIMIT ?"
+	args = append(args, limit)
+
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var messages []*domain.Message
+	for rows.Next() {
+		m, err := scanMessage(rows)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, m)
+	}
+	return messages, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanMessage(row scanner) (*domain.Message, error) {
+	var m domain.Message
+	var createdAt, offset int64
+	if err := row.Scan(&m.ID, &m.VideoID, &m.UserID, &m.Text, &m.System, &createdAt, &offset); err != nil {
+		return nil, err
+	}
+	m.CreatedAt = time.UnixMilli(createdAt)
+	m.Offset = time.Duration(offset) * time.Millisecond
+	return &m, nil
+}
+
+func (r *sqliteRepo) Ban(ctx context.Context, videoID, userID string, until time.Time) error {
+	var untilMs int64
+	if !until.IsZero() {
+		untilMs = until.UnixMilli()
+	}
+	_, err := r.DB.ExecContext(ctx, `
+		INSERT INTO bans (video_id, user_id, until) VALUES (?, ?, ?)
+		ON CONFLICT (video_id, user_id) DO UPDATE SET until = excluded.until`,
+		videoID, userID, untilMs)
+	return err
+}
+
+func (r *sqliteRepo) IsBanned(ctx context.Context, videoID, userID string, now time.Time) (bool, error) {
+	var n int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM bans WHERE video_id = ? AND user_id = ? AND (until = 0 OR until > ?)",
+		videoID, userID, now.UnixMilli()).Scan(&n)
+	return n > 0, err
+}
+
+func (r *sqliteRepo) SlowMode(ctx context.Context, videoID string) (time.Duration, error) {
+	var ms int64
+	err := r.DB.QueryRowContext(ctx, "SELECT slow_mode_ms FROM rooms WHERE video_id = ?", videoID).Scan(&ms)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+	return time.Duration(ms) * time.Millisecond, err
+}
+
+func (r *sqliteRepo) SetSlowMode(ctx context.Context, videoID string, d time.Duration) error {
+	_, err := r.DB.ExecContext(ctx, `
+		INSERT INTO rooms (video_id, slow_mode_ms) VALUES (?, ?)
+		ON CONFLICT (video_id) DO UPDATE SET slow_mode_ms = excluded.slow_mode_ms`,
+		videoID, d.Milliseconds())
+	return err
+}
+
+func (r *sqliteRepo) AddModerator(ctx context.Context, videoID, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT OR IGNORE INTO moderators (video_id, user_id) VALUES (?, ?)", videoID, userID)
+	return err
+}
+
+func (r *sqliteRepo) IsModerator(ctx context.Context, videoID, userID string) (bool, error) {
+	var n int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM moderators WHERE video_id = ? AND user_id = ?", videoID, userID).Scan(&n)
+	return n > 0, err
+}
@@ -0,0 +1,230 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+)
+
+const (
+	defaultListLimit = 100
+	maxListLimit     = 500
+)
+
+type chatUsecase struct {
+	repo    domain.ChatRepository
+	videos  domain.VideoService
+	broker  domain.Broker
+	limiter domain.RateLimiter
+	limits  domain.Limits
+	now     func() time.Time
+}
+
+func NewChatUsecase(repo domain.ChatRepository, videos domain.VideoService, broker domain.Broker, limiter domain.RateLimiter, limits domain.Limits) domain.ChatUsecase {
+	return &chatUsecase{repo: repo, videos: videos, broker: broker, limiter: limiter, limits: limits, now: time.Now}
+}
+
+func (u *chatUsecase) PostMessage(ctx context.Context, videoID, userID, text string) (*domain.Message, error) {
+	if userID == "" {
+		return nil, domain.ErrAnonymous
+	}
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, domain.ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(text) > u.limits.MaxLength {
+		return nil, domain.ErrMessageTooLong
+	}
+
+	video, err := u.videos.GetVideoForViewer(ctx, videoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	now := u.now()
+	if !video.ChatOpen(now) {
+		return nil, domain.ErrChatUnavailable
+	}
+
+	banned, err := u.repo.IsBanned(ctx, videoID, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	if banned {
+		return nil, domain.ErrBanned
+	}
+
+	moderator, err := u.isModerator(ctx, video, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !moderator {
+		slowMode, err := u.repo.SlowMode(ctx, videoID)
+		if err != nil {
+			return nil, err
+		}
+		if slowMode > 0 && !u.limiter.Allow("slow:"+videoID+":"+userID, 1, slowMode) {
+			return nil, domain.ErrSlowMode
+		}
+	}
+	if !u.limiter.Allow("user:"+userID, u.limits.Burst, u.limits.Refill) {
+		return nil, domain.ErrRateLimited
+	}
+
+	return u.post(ctx, video, &domain.Message{VideoID: videoID, UserID: userID, Text: text, CreatedAt: now})
+}
+
+func (u *chatUsecase) PostSystemMessage(ctx context.Context, videoID, text string) (*domain.Message, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, domain.ErrEmptyMessage
+	}
+	video, err := u.videos.GetVideo(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	return u.post(ctx, video, &domain.Message{VideoID: videoID, Text: text, System: true, CreatedAt: u.now()})
+}
+
+// post stores m at its offset into the stream and hands it to the room's subscribers.
+func (u *chatUsecase) post(ctx context.Context, video *domain.Video, m *domain.Message) (*domain.Message, error) {
+	// Messages sent before a premiere starts replay at its first frame
+	if !video.StartedAt.IsZero() && m.CreatedAt.After(video.StartedAt) {
+		m.Offset = m.CreatedAt.Sub(video.StartedAt).Truncate(time.Millisecond)
+	}
+	if err := u.repo.InsertMessage(ctx, m); err != nil {
+		return nil, err
+	}
+	u.broker.Publish(m.VideoID, &domain.Event{Type: domain.EventMessage, Message: m})
+	return m, nil
+}
+
+// ListMessages replays rooms that have closed too, but only to viewers who may watch the video.
+func (u *chatUsecase) ListMessages(ctx context.Context, videoID, viewerID string, from, to time.Duration, limit int) ([]*domain.Message, error) {
+	if _, err := u.videos.GetVideoForViewer(ctx, videoID, viewerID); err != nil {
+		return nil, err
+	}
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	if limit > maxListLimit {
+		limit = maxListLimit
+	}
+	if from < 0 {
+		from = 0
+	}
+	return u.repo.ListMessages(ctx, videoID, from, to, limit)
+}
+
+func (u *chatUsecase) Subscribe(ctx context.Context, videoID, viewerID string) (<-chan *domain.Event, func(), error) {
+	video, err := u.videos.GetVideoForViewer(ctx, videoID, viewerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := u.now()
+	if !video.ChatOpen(now) {
+		return nil, nil, domain.ErrChatUnavailable
+	}
+
+	events, cancel := u.broker.Subscribe(videoID)
+	closesAt := video.ClosesAt()
+	if closesAt.IsZero() {
+		return events, cancel, nil
+	}
+	// Ending the subscription closes events, which ends the stream to the viewer
+	timer := time.AfterFunc(closesAt.Sub(now), cancel)
+	return events, func() {
+		timer.Stop()
+		cancel()
+	}, nil
+}
+
+func (u *chatUsecase) DeleteMessage(ctx context.Context, videoID string, actor *domain.Caller, messageID int64) error {
+	if err := u.authorize(ctx, videoID, actor); err != nil {
+		return err
+	}
+	m, err := u.repo.GetMessage(ctx, messageID)
+	if err != nil {
+		return err
+	}
+	if m.VideoID != videoID {
+		return domain.ErrMessageNotFound
+	}
+	if err := u.repo.DeleteMessage(ctx, messageID); err != nil {
+		return err
+	}
+	u.broker.Publish(videoID, &domain.Event{Type: domain.EventDelete, MessageID: messageID})
+	return nil
+}
+
+func (u *chatUsecase) BanUser(ctx context.Context, videoID string, actor *domain.Caller, userID string, d time.Duration) error {
+	if err := u.authorize(ctx, videoID, actor); err != nil {
+		return err
+	}
+	var until time.Time
+	if d > 0 {
+		until = u.now().Add(d)
+	}
+	if err := u.repo.Ban(ctx, videoID, userID, until); err != nil {
+		return err
+	}
+	u.broker.Publish(videoID, &domain.Event{Type: domain.EventBan, UserID: userID})
+	return nil
+}
+
+func (u *chatUsecase) SetSlowMode(ctx context.Context, videoID string, actor *domain.Caller, d time.Duration) error {
+	if err := u.authorize(ctx, videoID, actor); err != nil {
+		return err
+	}
+	if d < 0 {
+		d = 0
+	}
+	if err := u.repo.SetSlowMode(ctx, videoID, d); err != nil {
+		return err
+	}
+	u.broker.Publish(videoID, &domain.Event{Type: domain.EventSlowMode, SlowMode: d})
+	return nil
+}
+
+func (u *chatUsecase) AddModerator(ctx context.Context, videoID string, actor *domain.Caller, userID string) error {
+	video, err := u.videos.GetVideo(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	isOwner := actor.UserID != "" && actor.UserID == video.OwnerID
+	if !isOwner && !actor.IsAdmin() {
+		return domain.ErrNotOwner
+	}
+	return u.repo.AddModerator(ctx, videoID, userID)
+}
+
+// authorize checks that actor may moderate the room of videoID. Admins may moderate any room.
+func (u *chatUsecase) authorize(ctx context.Context, videoID string, actor *domain.Caller) error {
+	if actor.UserID == "" {
+		return domain.ErrNotModerator
+	}
+	video, err := u.videos.GetVideo(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if actor.IsAdmin() {
+		return nil
+	}
+	moderator, err := u.isModerator(ctx, video, actor.UserID)
+	if err != nil {
+		return err
+	}
+	if !moderator {
+		return domain.ErrNotModerator
+	}
+	return nil
+}
+
+func (u *chatUsecase) isModerator(ctx context.Context, video *domain.Video, userID string) (bool, error) {
+	if video.OwnerID != "" && userID == video.OwnerID {
+		return true, nil
+	}
+	return u.repo.IsModerator(ctx, video.ID, userID)
+}
@@ -0,0 +1,462 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/chat-service/internal/domain"
+	"github.com/athandoan/youtube/chat-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	testNow    = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	testLimits = domain.Limits{MaxLength: 20, Burst: 5, Refill: 2 * time.Second}
+	liveVideo  = &domain.Video{ID: "video-123", OwnerID: "owner", Live: true, StartedAt: testNow.Add(-90 * time.Second)}
+	// endedPremiere played through an hour ago
+	endedPremiere = &domain.Video{ID: "video-123", OwnerID: "owner", Premiere: true, StartedAt: testNow.Add(-2 * time.Hour), Duration: time.Hour}
+)
+
+type chatMocks struct {
+	repo    *mocks.MockChatRepository
+	videos  *mocks.MockVideoService
+	broker  *mocks.MockBroker
+	limiter *mocks.MockRateLimiter
+}
+
+func newTestUsecase(ctrl *gomock.Controller) (*chatUsecase, chatMocks) {
+	m := chatMocks{
+		repo:    mocks.NewMockChatRepository(ctrl),
+		videos:  mocks.NewMockVideoService(ctrl),
+		broker:  mocks.NewMockBroker(ctrl),
+		limiter: mocks.NewMockRateLimiter(ctrl),
+	}
+	uc := NewChatUsecase(m.repo, m.videos, m.broker, m.limiter, testLimits).(*chatUsecase)
+	uc.now = func() time.Time { return testNow }
+	return uc, m
+}
+
+func TestChatUsecase_PostMessage(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     string
+		text       string
+		setupMock  func(m chatMocks)
+		wantOffset time.Duration
+		wantErr    error
+	}{
+		{
+			name:   "success - stores the message at its stream offset and publishes it",
+			userID: "viewer",
+			text:   "  hello  ",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(liveVideo, nil)
+				m.repo.EXPECT().IsBanned(gomock.Any(), "video-123", "viewer", testNow).Return(false, nil)
+				m.repo.EXPECT().IsModerator(gomock.Any(), "video-123", "viewer").Return(false, nil)
+				m.repo.EXPECT().SlowMode(gomock.Any(), "video-123").Return(time.Duration(0), nil)
+				m.limiter.EXPECT().Allow("user:viewer", 5, 2*time.Second).Return(true)
+				m.repo.EXPECT().InsertMessage(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, msg *domain.Message) error {
+					if msg.Text != "hello" {
+						t.Errorf("expected trimmed text, got %q", msg.Text)
+					}
+					msg.ID = 7
+					return nil
+				})
+				m.broker.EXPECT().Publish("video-123", gomock.Any()).Do(func(videoID string, e *domain.Event) {
+					if e.Type != domain.EventMessage || e.Message.ID != 7 {
+						t.Errorf("unexpected event %+v", e)
+					}
+				})
+			},
+			wantOffset: 90 * time.Second,
+		},
+		{
+			name:   "success - messages before a premiere starts replay at its beginning",
+			userID: "viewer",
+			text:   "soon!",
+			setupMock: func(m chatMocks) {
+				premiere := &domain.Video{ID: "video-123", OwnerID: "owner", Premiere: true, StartedAt: testNow.Add(time.Hour)}
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(premiere, nil)
+				m.repo.EXPECT().IsBanned(gomock.Any(), "video-123", "viewer", testNow).Return(false, nil)
+				m.repo.EXPECT().IsModerator(gomock.Any(), "video-123", "viewer").Return(false, nil)
+				m.repo.EXPECT().SlowMode(gomock.Any(), "video-123").Return(time.Duration(0), nil)
+				m.limiter.EXPECT().Allow("user:viewer", 5, 2*time.Second).Return(true)
+				m.repo.EXPECT().InsertMessage(gomock.Any(), gomock.Any()).Return(nil)
+				m.broker.EXPECT().Publish("video-123", gomock.Any())
+			},
+			wantOffset: 0,
+		},
+		{
+			name:   "success - the owner is not held to slow mode",
+			userID: "owner",
+			text:   "welcome",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "owner").Return(liveVideo, nil)
+				m.repo.EXPECT().IsBanned(gomock.Any(), "video-123", "owner", testNow).Return(false, nil)
+				m.limiter.EXPECT().Allow("user:owner", 5, 2*time.Second).Return(true)
+				m.repo.EXPECT().InsertMessage(gomock.Any(), gomock.Any()).Return(nil)
+				m.broker.EXPECT().Publish("video-123", gomock.Any())
+			},
+			wantOffset: 90 * time.Second,
+		},
+		{
+			name:      "error - anonymous users cannot chat",
+			userID:    "",
+			text:      "hello",
+			setupMock: func(m chatMocks) {},
+			wantErr:   domain.ErrAnonymous,
+		},
+		{
+			name:      "error - empty message",
+			userID:    "viewer",
+			text:      "   ",
+			setupMock: func(m chatMocks) {},
+			wantErr:   domain.ErrEmptyMessage,
+		},
+		{
+			name:      "error - message too long",
+			userID:    "viewer",
+			text:      strings.Repeat("a", 21),
+			setupMock: func(m chatMocks) {},
+			wantErr:   domain.ErrMessageTooLong,
+		},
+		{
+			name:   "error - chat is closed once the stream ends",
+			userID: "viewer",
+			text:   "hello",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(&domain.Video{ID: "video-123"}, nil)
+			},
+			wantErr: domain.ErrChatUnavailable,
+		},
+		{
+			name:   "error - chat is closed once the premiere has played through",
+			userID: "viewer",
+			text:   "hello",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(endedPremiere, nil)
+			},
+			wantErr: domain.ErrChatUnavailable,
+		},
+		{
+			name:   "error - a video the user may not watch",
+			userID: "viewer",
+			text:   "hello",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(nil, domain.ErrVideoNotFound)
+			},
+			wantErr: domain.ErrVideoNotFound,
+		},
+		{
+			name:   "error - banned user",
+			userID: "troll",
+			text:   "hello",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "troll").Return(liveVideo, nil)
+				m.repo.EXPECT().IsBanned(gomock.Any(), "video-123", "troll", testNow).Return(true, nil)
+			},
+			wantErr: domain.ErrBanned,
+		},
+		{
+			name:   "error - slow mode",
+			userID: "viewer",
+			text:   "hello again",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(liveVideo, nil)
+				m.repo.EXPECT().IsBanned(gomock.Any(), "video-123", "viewer", testNow).Return(false, nil)
+				m.repo.EXPECT().IsModerator(gomock.Any(), "video-123", "viewer").Return(false, nil)
+				m.repo.EXPECT().SlowMode(gomock.Any(), "video-123").Return(30*time.Second, nil)
+				m.limiter.EXPECT().Allow("slow:video-123:viewer", 1, 30*time.Second).Return(false)
+			},
+			wantErr: domain.ErrSlowMode,
+		},
+		{
+			name:   "error - rate limited",
+			userID: "viewer",
+			text:   "spam",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(liveVideo, nil)
+				m.repo.EXPECT().IsBanned(gomock.Any(), "video-123", "viewer", testNow).Return(false, nil)
+				m.repo.EXPECT().IsModerator(gomock.Any(), "video-123", "viewer").Return(false, nil)
+				m.repo.EXPECT().SlowMode(gomock.Any(), "video-123").Return(time.Duration(0), nil)
+				m.limiter.EXPECT().Allow("user:viewer", 5, 2*time.Second).Return(false)
+			},
+			wantErr: domain.ErrRateLimited,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			msg, err := uc.PostMessage(context.Background(), "video-123", tt.userID, tt.text)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PostMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && msg.Offset != tt.wantOffset {
+				t.Errorf("PostMessage() offset = %v, want %v", msg.Offset, tt.wantOffset)
+			}
+		})
+	}
+}
+
+func TestChatUsecase_ListMessages(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(m chatMocks)
+		wantErr   error
+	}{
+		{
+			name: "success - replays a closed room",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(endedPremiere, nil)
+				m.repo.EXPECT().ListMessages(gomock.Any(), "video-123", time.Duration(0), time.Minute, defaultListLimit).Return([]*domain.Message{{ID: 1}}, nil)
+			},
+		},
+		{
+			name: "error - a video the viewer may not watch",
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(nil, domain.ErrVideoNotFound)
+			},
+			wantErr: domain.ErrVideoNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			if _, err := uc.ListMessages(context.Background(), "video-123", "viewer", 0, time.Minute, 0); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ListMessages() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChatUsecase_Subscribe(t *testing.T) {
+	tests := []struct {
+		name    string
+		video   *domain.Video
+		err     error
+		wantErr error
+	}{
+		{
+			name:  "success - a live stream",
+			video: liveVideo,
+		},
+		{
+			name:    "error - a video the viewer may not watch",
+			err:     domain.ErrVideoNotFound,
+			wantErr: domain.ErrVideoNotFound,
+		},
+		{
+			name:    "error - a premiere that has played through",
+			video:   endedPremiere,
+			wantErr: domain.ErrChatUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "viewer").Return(tt.video, tt.err)
+			if tt.wantErr == nil {
+				m.broker.EXPECT().Subscribe("video-123").Return(make(chan *domain.Event), func() {})
+			}
+
+			_, cancel, err := uc.Subscribe(context.Background(), "video-123", "viewer")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Subscribe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil {
+				cancel()
+			}
+		})
+	}
+}
+
+func TestChatUsecase_Subscribe_ClosesWithThePremiere(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc, m := newTestUsecase(ctrl)
+	premiere := &domain.Video{ID: "video-123", Premiere: true, StartedAt: testNow.Add(-time.Hour), Duration: time.Hour + 10*time.Millisecond}
+	m.videos.EXPECT().GetVideoForViewer(gomock.Any(), "video-123", "").Return(premiere, nil)
+	ended := make(chan struct{})
+	m.broker.EXPECT().Subscribe("video-123").Return(make(chan *domain.Event), func() { close(ended) })
+
+	if _, _, err := uc.Subscribe(context.Background(), "video-123", ""); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	select {
+	case <-ended:
+	case <-time.After(time.Second):
+		t.Fatal("subscription still open after the premiere ended")
+	}
+}
+
+func TestChatUsecase_PostSystemMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc, m := newTestUsecase(ctrl)
+	m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+	m.repo.EXPECT().InsertMessage(gomock.Any(), gomock.Any()).Return(nil)
+	m.broker.EXPECT().Publish("video-123", gomock.Any())
+
+	// System messages skip chat limits and may be longer than MaxLength
+	msg, err := uc.PostSystemMessage(context.Background(), "video-123", "The stream will end in five minutes")
+	if err != nil {
+		t.Fatalf("PostSystemMessage() unexpected error: %v", err)
+	}
+	if !msg.System || msg.UserID != "" {
+		t.Errorf("expected a system message, got %+v", msg)
+	}
+}
+
+func TestChatUsecase_DeleteMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		actor     *domain.Caller
+		setupMock func(m chatMocks)
+		wantErr   error
+	}{
+		{
+			name:  "success - moderator deletes a message",
+			actor: &domain.Caller{UserID: "mod"},
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+				m.repo.EXPECT().IsModerator(gomock.Any(), "video-123", "mod").Return(true, nil)
+				m.repo.EXPECT().GetMessage(gomock.Any(), int64(7)).Return(&domain.Message{ID: 7, VideoID: "video-123"}, nil)
+				m.repo.EXPECT().DeleteMessage(gomock.Any(), int64(7)).Return(nil)
+				m.broker.EXPECT().Publish("video-123", &domain.Event{Type: domain.EventDelete, MessageID: 7})
+			},
+		},
+		{
+			name:  "error - viewers cannot delete messages",
+			actor: &domain.Caller{UserID: "viewer"},
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+				m.repo.EXPECT().IsModerator(gomock.Any(), "video-123", "viewer").Return(false, nil)
+			},
+			wantErr: domain.ErrNotModerator,
+		},
+		{
+			name:  "error - message belongs to another room",
+			actor: &domain.Caller{UserID: "owner"},
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+				m.repo.EXPECT().GetMessage(gomock.Any(), int64(7)).Return(&domain.Message{ID: 7, VideoID: "video-456"}, nil)
+			},
+			wantErr: domain.ErrMessageNotFound,
+		},
+		{
+			name:  "success - admins moderate any room",
+			actor: &domain.Caller{UserID: "admin-1", Roles: []string{domain.RoleAdmin}},
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+				m.repo.EXPECT().GetMessage(gomock.Any(), int64(7)).Return(&domain.Message{ID: 7, VideoID: "video-123"}, nil)
+				m.repo.EXPECT().DeleteMessage(gomock.Any(), int64(7)).Return(nil)
+				m.broker.EXPECT().Publish("video-123", &domain.Event{Type: domain.EventDelete, MessageID: 7})
+			},
+		},
+		{
+			name:      "error - anonymous callers cannot moderate",
+			actor:     &domain.Caller{},
+			setupMock: func(m chatMocks) {},
+			wantErr:   domain.ErrNotModerator,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			err := uc.DeleteMessage(context.Background(), "video-123", tt.actor, 7)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("DeleteMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChatUsecase_BanUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	uc, m := newTestUsecase(ctrl)
+	m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+	m.repo.EXPECT().Ban(gomock.Any(), "video-123", "troll", testNow.Add(10*time.Minute)).Return(nil)
+	m.broker.EXPECT().Publish("video-123", &domain.Event{Type: domain.EventBan, UserID: "troll"})
+
+	if err := uc.BanUser(context.Background(), "video-123", &domain.Caller{UserID: "owner"}, "troll", 10*time.Minute); err != nil {
+		t.Errorf("BanUser() unexpected error: %v", err)
+	}
+}
+
+func TestChatUsecase_AddModerator(t *testing.T) {
+	tests := []struct {
+		name      string
+		actor     *domain.Caller
+		setupMock func(m chatMocks)
+		wantErr   error
+	}{
+		{
+			name:  "success - owner adds a moderator",
+			actor: &domain.Caller{UserID: "owner"},
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+				m.repo.EXPECT().AddModerator(gomock.Any(), "video-123", "mod").Return(nil)
+			},
+		},
+		{
+			name:  "success - admin adds a moderator",
+			actor: &domain.Caller{UserID: "admin-1", Roles: []string{domain.RoleAdmin}},
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+				m.repo.EXPECT().AddModerator(gomock.Any(), "video-123", "mod").Return(nil)
+			},
+		},
+		{
+			name:  "error - moderators cannot add moderators",
+			actor: &domain.Caller{UserID: "mod"},
+			setupMock: func(m chatMocks) {
+				m.videos.EXPECT().GetVideo(gomock.Any(), "video-123").Return(liveVideo, nil)
+			},
+			wantErr: domain.ErrNotOwner,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			err := uc.AddModerator(context.Background(), "video-123", tt.actor, "mod")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AddModerator() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
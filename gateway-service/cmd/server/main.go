@@ -3,9 +3,16 @@ package main
 import (
 	"log"
 	"net/http"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	handler "github.com/athandoan/youtube/gateway-service/internal/delivery/http"
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	"github.com/athandoan/youtube/gateway-service/internal/infrastructure/oidc"
+	"github.com/athandoan/youtube/gateway-service/internal/infrastructure/party"
 	"github.com/athandoan/youtube/gateway-service/internal/infrastructure/rpc"
 	"github.com/athandoan/youtube/gateway-service/internal/usecase"
 )
@@ -41,20 +48,143 @@ func main() {
 		log.Fatalf("did not connect to streaming: %v", err)
 	}
 
-	// 4. Init Usecase
-	uc := usecase.NewGatewayUsecase(metadataClient, uploadClient, streamingClient)
+	// 4. Connect to Analytics Service
+	analyticsAddr := os.Getenv("ANALYTICS_SERVICE_ADDR")
+	if analyticsAddr == "" {
+		analyticsAddr = "analytics-service:50054"
+	}
+	analyticsClient, err := rpc.NewAnalyticsClient(analyticsAddr)
+	if err != nil {
+		log.Fatalf("did not connect to analytics: %v", err)
+	}
+
+	// 5. Connect to Chat Service
+	chatAddr := os.Getenv("CHAT_SERVICE_ADDR")
+	if chatAddr == "" {
+		chatAddr = "chat-service:50055"
+	}
+	chatClient, err := rpc.NewChatClient(chatAddr)
+	if err != nil {
+		log.Fatalf("did not connect to chat: %v", err)
+	}
+
+	// 6. Connect to User Service
+	userAddr := os.Getenv("USER_SERVICE_ADDR")
+	if userAddr == "" {
+		userAddr = "user-service:50056"
+	}
+	userClient, err := rpc.NewUserClient(userAddr)
+	if err != nil {
+		log.Fatalf("did not connect to user: %v", err)
+	}
+
+	// 7. Init Single Sign-On, when an OpenID Connect provider is configured
+	var sso domain.IdentityProvider
+	if issuer := os.Getenv("OIDC_ISSUER"); issuer != "" {
+		roleMap, err := oidc.ParseRoleMap(os.Getenv("OIDC_ROLE_MAP"))
+		if err != nil {
+			log.Fatalf("invalid OIDC_ROLE_MAP: %v", err)
+		}
+		redirectURL := os.Getenv("OIDC_REDIRECT_URL")
+		if redirectURL == "" {
+			redirectURL = "http://localhost:8080/api/auth/oidc/callback"
+		}
+		scopes := "email profile"
+		if v, ok := os.LookupEnv("OIDC_SCOPES"); ok {
+			scopes = v
+		}
+		rolesClaim := os.Getenv("OIDC_ROLES_CLAIM")
+		if rolesClaim == "" {
+			rolesClaim = "groups"
+		}
+		sso = oidc.NewProvider(oidc.Config{
+			Issuer:       issuer,
+			ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+			ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+			RedirectURL:  redirectURL,
+			Scopes:       strings.Fields(scopes),
+			RolesClaim:   rolesClaim,
+			RoleMap:      roleMap,
+		}, &http.Client{Timeout: 10 * time.Second})
+	}
+
+	// 8. Init Watch Party Hub
+	partyTTL := 4 * time.Hour
+	if v := os.Getenv("WATCH_PARTY_TTL_SECONDS"); v != "" {
+		seconds, err := strconv.Atoi(v)
+		if err != nil || seconds <= 0 {
+			log.Fatalf("invalid WATCH_PARTY_TTL_SECONDS: %q", v)
+		}
+		partyTTL = time.Duration(seconds) * time.Second
+	}
+	parties := party.NewMemoryHub(partyTTL)
 
-	// 5. Init Handler
+	// 9. Init Usecase
+	uc := usecase.NewGatewayUsecase(metadataClient, uploadClient, streamingClient, analyticsClient, chatClient, parties, userClient, sso)
+
+	// 10. Init Handler
 	h := handler.NewHandler(uc)
 
+	// Proxied playback is streamed by the streaming service's HTTP server
+	contentAddr := os.Getenv("STREAMING_CONTENT_URL")
+	if contentAddr == "" {
+		contentAddr = "http://streaming-service:8082"
+	}
+	contentURL, err := url.Parse(contentAddr)
+	if err != nil {
+		log.Fatalf("invalid STREAMING_CONTENT_URL: %v", err)
+	}
+
+	// Live HLS is served by the live service, which checks the viewer can watch the stream
+	liveAddr := os.Getenv("LIVE_SERVICE_URL")
+	if liveAddr == "" {
+		liveAddr = "http://live-service:8083"
+	}
+	liveURL, err := url.Parse(liveAddr)
+	if err != nil {
+		log.Fatalf("invalid LIVE_SERVICE_URL: %v", err)
+	}
+
 	mux := http.NewServeMux()
+	mux.HandleFunc("/api/auth/signup", h.HandleSignup)
+	mux.HandleFunc("/api/auth/login", h.HandleLogin)
+	mux.HandleFunc("/api/auth/logout", h.HandleLogout)
+	mux.HandleFunc("/api/auth/me", h.HandleMe)
+	mux.HandleFunc("/api/auth/password-reset", h.HandleRequestPasswordReset)
+	mux.HandleFunc("/api/auth/password-reset/confirm", h.HandleResetPassword)
+	mux.HandleFunc("/api/auth/oidc/login", h.HandleSSOLogin)
+	mux.HandleFunc("/api/auth/oidc/callback", h.HandleSSOCallback)
+	mux.HandleFunc("/api/keys", h.HandleAPIKeys)
+	mux.HandleFunc("/api/keys/", h.HandleAPIKey)
+	mux.HandleFunc("/api/admin/keys", h.HandleAdminAPIKeys)
+	mux.HandleFunc("/api/admin/keys/", h.HandleAdminAPIKey)
 	mux.HandleFunc("/api/upload/init", h.HandleInitUpload)
 	mux.HandleFunc("/api/upload/complete", h.HandleCompleteUpload)
 	mux.HandleFunc("/api/videos", h.HandleListVideos)
+	mux.HandleFunc("/api/videos/", h.HandleVideo)
+	mux.HandleFunc("/api/trash", h.HandleListTrash)
+	mux.HandleFunc("/api/channels", h.HandleCreateChannel)
+	mux.HandleFunc("/api/channels/", h.HandleChannel)
+	mux.HandleFunc("/api/subscriptions", h.HandleListSubscriptions)
+	mux.HandleFunc("/api/feed/subscriptions", h.HandleSubscriptionFeed)
+	mux.HandleFunc("/api/playlists", h.HandlePlaylists)
+	mux.HandleFunc("/api/playlists/", h.HandlePlaylist)
+	mux.HandleFunc("/api/series", h.HandleSeriesList)
+	mux.HandleFunc("/api/series/", h.HandleSeries)
+	mux.HandleFunc("/api/share/", h.HandleShare)
 	mux.HandleFunc("/api/stream/videos/", h.HandleStreamVideo)
+	mux.HandleFunc("/api/download/videos/", h.HandleDownloadVideo)
+	mux.Handle("/api/content/", handler.NewContentProxy(contentURL))
+	mux.Handle("/api/live/videos/", handler.NewContentProxy(liveURL))
+	mux.HandleFunc("/api/analytics/beacons", h.HandleIngestBeacons)
+	mux.HandleFunc("/api/analytics/videos/", h.HandlePlaybackStats)
+	mux.HandleFunc("/api/live/keys", h.HandleCreateStreamKey)
+	mux.HandleFunc("/api/chat/", h.HandleChat)
+	mux.HandleFunc("/api/parties", h.HandleCreateParty)
+	mux.HandleFunc("/api/parties/", h.HandleParty)
 
-	// CORS middleware
-	hMux := handler.CorsMiddleware(mux)
+	// CORS and session middleware
+	hMux := handler.CorsMiddleware(h.AuthMiddleware(mux))
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -7,8 +7,12 @@ replace github.com/athandoan/youtube/proto => ../proto
 require (
 	github.com/athandoan/youtube/proto v0.0.0-00010101000000-000000000000
 	github.com/google/jsonapi v1.0.0
+	github.com/google/uuid v1.6.0
+	github.com/gorilla/websocket v1.5.3
 	go.uber.org/mock v0.6.0
+	golang.org/x/oauth2 v0.32.0
 	google.golang.org/grpc v1.78.0
+	google.golang.org/protobuf v1.36.11
 )
 
 require (
@@ -16,5 +20,4 @@ require (
 	golang.org/x/sys v0.38.0 // indirect
 	golang.org/x/text v0.31.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20251029180050-ab9386a59fda // indirect
-	google.golang.org/protobuf v1.36.11 // indirect
 )
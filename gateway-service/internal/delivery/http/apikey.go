@@ -0,0 +1,247 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"google.golang.org/grpc/status"
+)
+
+type CreateAPIKeyRequest struct {
+	Name   string   `json:"name"`
+	Scopes []string `json:"scopes"`
+}
+
+// APIKeyResponse describes a key; the key itself is only returned once, when it's created.
+type APIKeyResponse struct {
+	ID         string   `jsonapi:"primary,api-key"`
+	UserID     string   `jsonapi:"attr,user_id"`
+	Name       string   `jsonapi:"attr,name"`
+	Prefix     string   `jsonapi:"attr,prefix"`
+	Scopes     []string `jsonapi:"attr,scopes"`
+	CreatedAt  string   `jsonapi:"attr,created_at"`
+	LastUsedAt string   `jsonapi:"attr,last_used_at,omitempty"`
+	RevokedAt  string   `jsonapi:"attr,revoked_at,omitempty"`
+	Key        string   `jsonapi:"attr,key,omitempty"`
+}
+
+func toAPIKeyResponse(k *userpb.APIKey) *APIKeyResponse {
+	return &APIKeyResponse{
+		ID:         k.Id,
+		UserID:     k.UserId,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		CreatedAt:  k.CreatedAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+	}
+}
+
+func toAPIKeyResponses(keys []*userpb.APIKey) []interface{} {
+	data := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		data = append(data, toAPIKeyResponse(k))
+	}
+	return data
+}
+
+// APIKeyFromContext returns the API key a request was authenticated with, if any.
+func APIKeyFromContext(ctx context.Context) (*userpb.APIKey, bool) {
+	key, ok := ctx.Value(apiKeyKey).(*userpb.APIKey)
+	return key, ok
+}
+
+// serveAPIKey serves a request made with an API key as the key's user, if the key's scopes
+// cover the endpoint.
+func (h *Handler) serveAPIKey(w http.ResponseWriter, r *http.Request, next http.Handler, key string) {
+	resp, err := h.usecase.AuthenticateAPIKey(r.Context(), key)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	scope, ok := requiredScope(r)
+	if !ok {
+		writeJsonApiError(w, http.StatusForbidden, "Forbidden", "API keys can't be used for this endpoint")
+		return
+	}
+	if !hasScope(resp.ApiKey.Scopes, scope) {
+		writeJsonApiError(w, http.StatusForbidden, "Forbidden", "API key lacks the "+scope+" scope")
+		return
+	}
+
+	ctx := context.WithValue(withUser(r.Context(), resp.User), apiKeyKey, resp.ApiKey)
+	next.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// requiredScope returns the scope an API key needs for a request, or false for endpoints
+// keys can't use at all, such as managing accounts and keys.
+func requiredScope(r *http.Request) (string, bool) {
+	path := r.URL.Path
+	switch {
+	case strings.HasPrefix(path, "/api/upload/"):
+		return domain.ScopeUploadWrite, true
+	case path == "/api/videos", path == "/api/trash",
+		path == "/api/subscriptions", path == "/api/feed/subscriptions",
+		strings.HasPrefix(path, "/api/stream/videos/"),
+		strings.HasPrefix(path, "/api/download/videos/"),
+		strings.HasPrefix(path, "/api/content/"),
+		strings.HasPrefix(path, "/api/live/videos/"),
+		strings.HasPrefix(path, "/api/analytics/videos/"),
+		strings.HasPrefix(path, "/api/channels/"):
+		if r.Method != "GET" {
+			return "", false
+		}
+		return domain.ScopeVideosRead, true
+	case path == "/api/playlists", strings.HasPrefix(path, "/api/playlists/"),
+		path == "/api/series", strings.HasPrefix(path, "/api/series/"):
+		if r.Method == "GET" {
+			return domain.ScopeVideosRead, true
+		}
+		return domain.ScopeVideosAdmin, true
+	case strings.HasPrefix(path, "/api/videos/"):
+		// Reading a video needs read access; changing it, its access list or share links
+		// needs admin
+		if r.Method == "GET" && len(strings.Split(path, "/")) == 4 {
+			return domain.ScopeVideosRead, true
+		}
+		return domain.ScopeVideosAdmin, true
+	default:
+		return "", false
+	}
+}
+
+// hasScope reports whether scopes grant scope; videos:admin implies videos:read.
+func hasScope(scopes []string, scope string) bool {
+	if slices.Contains(scopes, scope) {
+		return true
+	}
+	return scope == domain.ScopeVideosRead && slices.Contains(scopes, domain.ScopeVideosAdmin)
+}
+
+// HandleAPIKeys lists the caller's API keys at GET /api/keys and creates one at POST /api/keys.
+func (h *Handler) HandleAPIKeys(w http.ResponseWriter, r *http.Request) {
+	user, ok := UserFromContext(r.Context())
+	if !ok {
+		writeJsonApiError(w, http.StatusUnauthorized, "Unauthorized", "not signed in")
+		return
+	}
+
+	switch r.Method {
+	case "GET":
+		keys, err := h.usecase.ListAPIKeys(r.Context(), user.Id)
+		if err != nil {
+			code := httpStatusFromRPC(err)
+			writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+			return
+		}
+		writeJsonApi(w, toAPIKeyResponses(keys))
+	case "POST":
+		var req CreateAPIKeyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+			return
+		}
+		resp, err := h.usecase.CreateAPIKey(r.Context(), user.Id, req.Name, req.Scopes)
+		if err != nil {
+			code := httpStatusFromRPC(err)
+			writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+			return
+		}
+		key := toAPIKeyResponse(resp.ApiKey)
+		key.Key = resp.Key
+		writeJsonApi(w, key)
+	default:
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET and POST are allowed")
+	}
+}
+
+// HandleAPIKey revokes one of the caller's API keys at DELETE /api/keys/{id}.
+func (h *Handler) HandleAPIKey(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "DELETE" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only DELETE is allowed")
+		return
+	}
+	user, ok := UserFromContext(r.Context())
+	if !ok {
+		writeJsonApiError(w, http.StatusUnauthorized, "Unauthorized", "not signed in")
+		return
+	}
+	// Extract key ID from path: /api/keys/{id}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) != 4 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid API key ID")
+		return
+	}
+
+	if err := h.usecase.RevokeAPIKey(r.Context(), pathParts[3], user.Id); err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleAdminAPIKeys lists every user's API keys, revoked ones included, at
+// GET /api/admin/keys. Admins only.
+func (h *Handler) HandleAdminAPIKeys(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+	if !requireAdmin(w, r) {
+		return
+	}
+
+	keys, err := h.usecase.ListAllAPIKeys(r.Context())
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toAPIKeyResponses(keys))
+}
+
+// HandleAdminAPIKey revokes any user's API key at DELETE /api/admin/keys/{id}. Admins only.
+func (h *Handler) HandleAdminAPIKey(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "DELETE" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only DELETE is allowed")
+		return
+	}
+	if !requireAdmin(w, r) {
+		return
+	}
+	// Extract key ID from path: /api/admin/keys/{id}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) != 5 || pathParts[4] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid API key ID")
+		return
+	}
+
+	if err := h.usecase.RevokeAnyAPIKey(r.Context(), pathParts[4]); err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// requireAdmin writes an error and returns false unless the caller is signed in as an admin.
+func requireAdmin(w http.ResponseWriter, r *http.Request) bool {
+	user, ok := UserFromContext(r.Context())
+	if !ok {
+		writeJsonApiError(w, http.StatusUnauthorized, "Unauthorized", "not signed in")
+		return false
+	}
+	if !slices.Contains(user.Roles, domain.RoleAdmin) {
+		writeJsonApiError(w, http.StatusForbidden, "Forbidden", "admins only")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/athandoan/youtube/gateway-service/internal/mocks"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthMiddleware_APIKeyScopes(t *testing.T) {
+	tests := []struct {
+		name       string
+		scopes     []string
+		method     string
+		path       string
+		wantStatus int
+	}{
+		{name: "upload with upload:write", scopes: []string{"upload:write"}, method: "POST", path: "/api/upload/init", wantStatus: http.StatusOK},
+		{name: "upload without upload:write", scopes: []string{"videos:read"}, method: "POST", path: "/api/upload/init", wantStatus: http.StatusForbidden},
+		{name: "list videos with videos:read", scopes: []string{"videos:read"}, method: "GET", path: "/api/videos", wantStatus: http.StatusOK},
+		{name: "videos:admin implies videos:read", scopes: []string{"videos:admin"}, method: "GET", path: "/api/stream/videos/v1", wantStatus: http.StatusOK},
+		{name: "delete with videos:read", scopes: []string{"videos:read"}, method: "DELETE", path: "/api/videos/v1", wantStatus: http.StatusForbidden},
+		{name: "delete with videos:admin", scopes: []string{"videos:admin"}, method: "DELETE", path: "/api/videos/v1", wantStatus: http.StatusOK},
+		{name: "share links need videos:admin", scopes: []string{"videos:read"}, method: "GET", path: "/api/videos/v1/shares", wantStatus: http.StatusForbidden},
+		{name: "read a channel with videos:read", scopes: []string{"videos:read"}, method: "GET", path: "/api/channels/cooking/videos", wantStatus: http.StatusOK},
+		{name: "read the subscription feed with videos:read", scopes: []string{"videos:read"}, method: "GET", path: "/api/feed/subscriptions", wantStatus: http.StatusOK},
+		{name: "keys can't subscribe", scopes: []string{"videos:admin"}, method: "PUT", path: "/api/channels/cooking/subscription", wantStatus: http.StatusForbidden},
+		{name: "keys can't manage channels", scopes: []string{"videos:admin"}, method: "DELETE", path: "/api/channels/cooking", wantStatus: http.StatusForbidden},
+		{name: "playlists need videos:admin to change", scopes: []string{"videos:read"}, method: "POST", path: "/api/playlists/p1/items", wantStatus: http.StatusForbidden},
+		{name: "read a series with videos:read", scopes: []string{"videos:read"}, method: "GET", path: "/api/series/s1/next", wantStatus: http.StatusOK},
+		{name: "series need videos:admin to change", scopes: []string{"videos:read"}, method: "PUT", path: "/api/series/s1/episodes/v1", wantStatus: http.StatusForbidden},
+		{name: "keys can't mint keys", scopes: []string{"upload:write", "videos:read", "videos:admin"}, method: "POST", path: "/api/keys", wantStatus: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u := mocks.NewMockGatewayUsecase(ctrl)
+			u.EXPECT().AuthenticateAPIKey(gomock.Any(), "gtk_secret").Return(&userpb.AuthenticateAPIKeyResponse{
+				User:   &userpb.User{Id: "user-1"},
+				ApiKey: &userpb.APIKey{Id: "key-1", UserId: "user-1", Scopes: tt.scopes},
+			}, nil)
+
+			var gotUser string
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotUser = userIDFromRequest(r)
+				if key, ok := APIKeyFromContext(r.Context()); !ok || key.Id != "key-1" {
+					t.Errorf("APIKeyFromContext() = %v, %v, want key-1", key, ok)
+				}
+			})
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer gtk_secret")
+			rec := httptest.NewRecorder()
+			NewHandler(u).AuthMiddleware(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && gotUser != "user-1" {
+				t.Errorf("request ran as %q, want the key's user", gotUser)
+			}
+		})
+	}
+}
+
+func TestAuthMiddleware_InvalidAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mocks.NewMockGatewayUsecase(ctrl)
+	u.EXPECT().AuthenticateAPIKey(gomock.Any(), "gtk_revoked").Return(nil, status.Error(codes.Unauthenticated, "invalid API key"))
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request with a revoked key reached the handler")
+	})
+	req := httptest.NewRequest("GET", "/api/videos", nil)
+	req.Header.Set("Authorization", "Bearer gtk_revoked")
+	rec := httptest.NewRecorder()
+	NewHandler(u).AuthMiddleware(next).ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("status = %d, want 401", rec.Code)
+	}
+}
@@ -0,0 +1,288 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// sessionCookie carries the session token for browsers; API clients send it as a bearer token.
+const sessionCookie = "session"
+
+type contextKey int
+
+const (
+	userKey contextKey = iota
+	apiKeyKey
+)
+
+// Backends identify the user a call is made for by these gRPC metadata keys.
+const (
+	userIDMetadataKey    = "x-user-id"
+	userRolesMetadataKey = "x-user-roles"
+)
+
+// withUser attaches the signed-in user to a request's context, including the gRPC metadata
+// that identifies them to backends for every call made with it.
+func withUser(ctx context.Context, user *userpb.User) context.Context {
+	kv := []string{userIDMetadataKey, user.Id}
+	for _, role := range user.Roles {
+		kv = append(kv, userRolesMetadataKey, role)
+	}
+	return metadata.AppendToOutgoingContext(context.WithValue(ctx, userKey, user), kv...)
+}
+
+// UserFromContext returns the signed-in user AuthMiddleware attached to a request, if any.
+func UserFromContext(ctx context.Context) (*userpb.User, bool) {
+	user, ok := ctx.Value(userKey).(*userpb.User)
+	return user, ok
+}
+
+type SignupRequest struct {
+	Email       string `json:"email"`
+	Password    string `json:"password"`
+	DisplayName string `json:"display_name"`
+}
+
+type LoginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type PasswordResetRequest struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token"`
+	Password string `json:"password"`
+}
+
+// SessionResponse is identified by the session token, which API clients send back as
+// "Authorization: Bearer <token>".
+type SessionResponse struct {
+	ID        string        `jsonapi:"primary,session"`
+	ExpiresAt string        `jsonapi:"attr,expires_at"`
+	User      *UserResponse `jsonapi:"relation,user"`
+}
+
+func toUserResponse(u *userpb.User) *UserResponse {
+	return &UserResponse{ID: u.Id, Email: u.Email, DisplayName: u.DisplayName, CreatedAt: u.CreatedAt, Roles: u.Roles}
+}
+
+// AuthMiddleware resolves the caller's session from a bearer token or the session cookie and
+// attaches their user to the request. Bearer tokens may also be API keys, which only reach
+// the endpoints their scopes allow. Requests without a session go through anonymously; an
+// invalid bearer token is rejected, while a stale cookie is cleared.
+func (h *Handler) AuthMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, bearer := sessionToken(r)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+		if bearer && strings.HasPrefix(token, domain.APIKeyPrefix) {
+			h.serveAPIKey(w, r, next, token)
+			return
+		}
+
+		user, err := h.usecase.Authenticate(r.Context(), token)
+		if err != nil {
+			if bearer {
+				code := httpStatusFromRPC(err)
+				writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+				return
+			}
+			// Keep the cookie through outages so a hiccup doesn't sign everyone out
+			if status.Code(err) == codes.Unauthenticated {
+				clearSessionCookie(w, r)
+			} else {
+				log.Printf("failed to authenticate session: %v", err)
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(withUser(r.Context(), user)))
+	})
+}
+
+// sessionToken returns the request's session token and whether it came as a bearer token.
+func sessionToken(r *http.Request) (string, bool) {
+	if auth := r.Header.Get("Authorization"); auth != "" {
+		if token, ok := strings.CutPrefix(auth, "Bearer "); ok {
+			return strings.TrimSpace(token), true
+		}
+	}
+	if c, err := r.Cookie(sessionCookie); err == nil {
+		return c.Value, false
+	}
+	return "", false
+}
+
+func setSessionCookie(w http.ResponseWriter, r *http.Request, s *userpb.Session) {
+	c := &http.Cookie{
+		Name:     sessionCookie,
+		Value:    s.Token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   isHTTPS(r),
+		SameSite: http.SameSiteLaxMode,
+	}
+	if expires, err := time.Parse(time.RFC3339, s.ExpiresAt); err == nil {
+		c.Expires = expires
+	}
+	http.SetCookie(w, c)
+}
+
+func clearSessionCookie(w http.ResponseWriter, r *http.Request) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     sessionCookie,
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   isHTTPS(r),
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// isHTTPS reports whether the client reached us over TLS, directly or through the edge proxy.
+func isHTTPS(r *http.Request) bool {
+	return r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https"
+}
+
+// writeSession signs the client in: browsers get the session cookie, API clients the token.
+func writeSession(w http.ResponseWriter, r *http.Request, s *userpb.Session) {
+	setSessionCookie(w, r, s)
+	writeJsonApi(w, &SessionResponse{ID: s.Token, ExpiresAt: s.ExpiresAt, User: toUserResponse(s.User)})
+}
+
+// HandleSignup creates an account at POST /api/auth/signup and signs it in.
+func (h *Handler) HandleSignup(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	var req SignupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	session, err := h.usecase.Signup(r.Context(), req.Email, req.Password, req.DisplayName)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeSession(w, r, session)
+}
+
+// HandleLogin signs in with email and password at POST /api/auth/login.
+func (h *Handler) HandleLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	var req LoginRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	session, err := h.usecase.Login(r.Context(), req.Email, req.Password)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeSession(w, r, session)
+}
+
+// HandleLogout ends the caller's session at POST /api/auth/logout.
+func (h *Handler) HandleLogout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	if token, _ := sessionToken(r); token != "" {
+		if err := h.usecase.Logout(r.Context(), token); err != nil {
+			code := httpStatusFromRPC(err)
+			writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+			return
+		}
+	}
+	clearSessionCookie(w, r)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// HandleMe returns the signed-in user at GET /api/auth/me.
+func (h *Handler) HandleMe(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	user, ok := UserFromContext(r.Context())
+	if !ok {
+		writeJsonApiError(w, http.StatusUnauthorized, "Unauthorized", "not signed in")
+		return
+	}
+	writeJsonApi(w, toUserResponse(user))
+}
+
+// HandleRequestPasswordReset mails a reset link at POST /api/auth/password-reset. It answers
+// 202 whether or not the address has an account.
+func (h *Handler) HandleRequestPasswordReset(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	var req PasswordResetRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	if err := h.usecase.RequestPasswordReset(r.Context(), req.Email); err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// HandleResetPassword sets a new password with a reset token at
+// POST /api/auth/password-reset/confirm, signing out every session of the account.
+func (h *Handler) HandleResetPassword(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	var req ResetPasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	if err := h.usecase.ResetPassword(r.Context(), req.Token, req.Password); err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	clearSessionCookie(w, r)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,45 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/athandoan/youtube/gateway-service/internal/mocks"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestAuthMiddleware_ForwardsUserToBackends(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	u := mocks.NewMockGatewayUsecase(ctrl)
+	u.EXPECT().Authenticate(gomock.Any(), "session-token").
+		Return(&userpb.User{Id: "user-1", Roles: []string{"admin", "moderator"}}, nil)
+
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md, _ := metadata.FromOutgoingContext(r.Context())
+		if got := md.Get(userIDMetadataKey); !slices.Equal(got, []string{"user-1"}) {
+			t.Errorf("%s = %v, want [user-1]", userIDMetadataKey, got)
+		}
+		if got := md.Get(userRolesMetadataKey); !slices.Equal(got, []string{"admin", "moderator"}) {
+			t.Errorf("%s = %v, want [admin moderator]", userRolesMetadataKey, got)
+		}
+	})
+	req := httptest.NewRequest("GET", "/api/videos", nil)
+	req.Header.Set("Authorization", "Bearer session-token")
+	NewHandler(u).AuthMiddleware(next).ServeHTTP(httptest.NewRecorder(), req)
+}
+
+func TestAuthMiddleware_AnonymousSendsNoUser(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if md, ok := metadata.FromOutgoingContext(r.Context()); ok && len(md.Get(userIDMetadataKey)) > 0 {
+			t.Errorf("anonymous request carries %s = %v", userIDMetadataKey, md.Get(userIDMetadataKey))
+		}
+	})
+	req := httptest.NewRequest("GET", "/api/videos", nil)
+	NewHandler(nil).AuthMiddleware(next).ServeHTTP(httptest.NewRecorder(), req)
+}
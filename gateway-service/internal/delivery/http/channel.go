@@ -0,0 +1,225 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type CreateChannelRequest struct {
+	Handle      string `json:"handle"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+}
+
+type ChannelImageUploadRequest struct {
+	Filename string `json:"filename"`
+}
+
+// ChannelImageUploadData is identified by the image's kind, avatar or banner.
+type ChannelImageUploadData struct {
+	ID           string `jsonapi:"primary,channel-image-upload"`
+	PresignedUrl string `jsonapi:"attr,presigned_url"`
+}
+
+func toChannelResponse(c *metadatapb.Channel) *ChannelResponse {
+	data := &ChannelResponse{
+		ID:          c.Id,
+		Handle:      c.Handle,
+		DisplayName: c.DisplayName,
+		Description: c.Description,
+		OwnerID:     c.OwnerId,
+		MemberIDs:   c.MemberIds,
+		CreatedAt:   c.CreatedAt,
+
+		SubscriberCount: c.SubscriberCount,
+	}
+	if c.Avatar != nil {
+		data.AvatarURL = "/api/channels/" + c.Handle + "/avatar"
+	}
+	if c.Banner != nil {
+		data.BannerURL = "/api/channels/" + c.Handle + "/banner"
+	}
+	return data
+}
+
+// HandleCreateChannel creates a channel owned by the caller at POST /api/channels.
+func (h *Handler) HandleCreateChannel(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	var req CreateChannelRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	channel, err := h.usecase.CreateChannel(r.Context(), req.Handle, req.DisplayName, req.Description)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toChannelResponse(channel))
+}
+
+// HandleChannel serves a channel by its handle. Anyone may read channels and their videos;
+// only the owner or an admin may change them, and images are uploaded through the URL that
+// POSTing to them returns. Signed-in viewers PUT and DELETE its subscription to follow it.
+func (h *Handler) HandleChannel(w http.ResponseWriter, r *http.Request) {
+	// Extract handle from path: /api/channels/{handle}[/videos|/avatar|/banner|/subscription|/members/{userID}]
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 4 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid channel handle")
+		return
+	}
+	handle := pathParts[3]
+	image := len(pathParts) == 5 && (pathParts[4] == "avatar" || pathParts[4] == "banner")
+	subscription := len(pathParts) == 5 && pathParts[4] == "subscription"
+
+	var err error
+	switch {
+	case len(pathParts) == 4 && r.Method == "GET":
+		h.getChannel(w, r, handle)
+		return
+	case len(pathParts) == 4 && r.Method == "PATCH":
+		h.updateChannel(w, r, handle)
+		return
+	case len(pathParts) == 4 && r.Method == "DELETE":
+		err = h.usecase.DeleteChannel(r.Context(), handle)
+	case len(pathParts) == 5 && pathParts[4] == "videos" && r.Method == "GET":
+		h.listChannelVideos(w, r, handle)
+		return
+	case image && r.Method == "GET":
+		h.getChannelImage(w, r, handle, pathParts[4])
+		return
+	case image && r.Method == "POST":
+		h.initChannelImageUpload(w, r, handle, pathParts[4])
+		return
+	case subscription && r.Method == "PUT":
+		err = h.usecase.Subscribe(r.Context(), handle)
+	case subscription && r.Method == "DELETE":
+		err = h.usecase.Unsubscribe(r.Context(), handle)
+	case len(pathParts) == 6 && pathParts[4] == "members" && pathParts[5] != "" && r.Method == "PUT":
+		err = h.usecase.AddChannelMember(r.Context(), handle, pathParts[5])
+	case len(pathParts) == 6 && pathParts[4] == "members" && pathParts[5] != "" && r.Method == "DELETE":
+		err = h.usecase.RemoveChannelMember(r.Context(), handle, pathParts[5])
+	case len(pathParts) == 4, image, subscription, len(pathParts) == 5 && pathParts[4] == "videos",
+		len(pathParts) == 6 && pathParts[4] == "members" && pathParts[5] != "":
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Method not allowed on this resource")
+		return
+	default:
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Unknown channel resource")
+		return
+	}
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getChannel(w http.ResponseWriter, r *http.Request, handle string) {
+	channel, err := h.usecase.GetChannel(r.Context(), handle)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toChannelResponse(channel))
+}
+
+// updateChannel takes a JSON:API update document; its id may be left out, as the URL names
+// the channel by handle.
+func (h *Handler) updateChannel(w http.ResponseWriter, r *http.Request, handle string) {
+	var patch resourcePatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	if patch.Data.Type != "channel" {
+		writeJsonApiError(w, http.StatusConflict, "Conflict", "The resource type must be channel")
+		return
+	}
+
+	req := &metadatapb.UpdateChannelRequest{UpdateMask: &fieldmaskpb.FieldMask{}}
+	for name, raw := range patch.Data.Attributes {
+		var err error
+		switch name {
+		case "display_name":
+			err = json.Unmarshal(raw, &req.DisplayName)
+		case "description":
+			err = json.Unmarshal(raw, &req.Description)
+		default:
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Attribute %q cannot be updated", name))
+			return
+		}
+		if err != nil {
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Invalid %s: %v", name, err))
+			return
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, name)
+	}
+	if len(req.UpdateMask.Paths) == 0 {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "No attributes to update")
+		return
+	}
+
+	channel, err := h.usecase.UpdateChannel(r.Context(), handle, req)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toChannelResponse(channel))
+}
+
+func (h *Handler) listChannelVideos(w http.ResponseWriter, r *http.Request, handle string) {
+	videos, err := h.usecase.ListChannelVideos(r.Context(), handle)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*VideoResponse, 0, len(videos))
+	for _, v := range videos {
+		data = append(data, toVideoResponse(v))
+	}
+	writeJsonApi(w, data)
+}
+
+// getChannelImage redirects to a short-lived URL of the channel's avatar or banner.
+func (h *Handler) getChannelImage(w http.ResponseWriter, r *http.Request, handle, kind string) {
+	url, err := h.usecase.GetChannelImageURL(r.Context(), handle, kind)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	http.Redirect(w, r, url, http.StatusFound)
+}
+
+func (h *Handler) initChannelImageUpload(w http.ResponseWriter, r *http.Request, handle, kind string) {
+	var req ChannelImageUploadRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	url, err := h.usecase.InitChannelImageUpload(r.Context(), handle, kind, req.Filename)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, &ChannelImageUploadData{ID: kind, PresignedUrl: url})
+}
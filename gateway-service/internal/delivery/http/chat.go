@@ -0,0 +1,264 @@
+package http
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	chatpb "github.com/athandoan/youtube/proto/chat"
+	"github.com/gorilla/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// wsPingInterval keeps idle sockets open through proxies; a client that
+	// misses wsPongWait worth of pings is dropped.
+	wsPingInterval = 30 * time.Second
+	wsPongWait     = 60 * time.Second
+	wsWriteWait    = 10 * time.Second
+	maxChatFrame   = 4 << 10
+)
+
+var upgrader = websocket.Upgrader{ReadBufferSize: 1024, WriteBufferSize: 1024}
+
+type ChatMessageResponse struct {
+	ID        string `jsonapi:"primary,chat-message"`
+	UserID    string `jsonapi:"attr,user_id,omitempty"`
+	Text      string `jsonapi:"attr,text"`
+	System    bool   `jsonapi:"attr,system,omitempty"`
+	CreatedAt int64  `jsonapi:"attr,created_at_ms"`
+	Offset    int64  `jsonapi:"attr,offset_ms"`
+}
+
+// ChatFrame is a WebSocket frame in either direction. Clients send message, delete, ban,
+// slow_mode and add_moderator commands; the server sends room events and errors.
+type ChatFrame struct {
+	Type            string           `json:"type"`
+	Text            string           `json:"text,omitempty"`
+	Message         *ChatMessageJSON `json:"message,omitempty"`
+	MessageID       int64            `json:"message_id,omitempty"`
+	UserID          string           `json:"user_id,omitempty"`
+	DurationSeconds int32            `json:"duration_seconds,omitempty"`
+	Seconds         int32            `json:"seconds,omitempty"`
+	SlowModeSeconds int32            `json:"slow_mode_seconds,omitempty"`
+	Error           string           `json:"error,omitempty"`
+}
+
+type ChatMessageJSON struct {
+	ID        int64  `json:"id"`
+	UserID    string `json:"user_id,omitempty"`
+	Text      string `json:"text"`
+	System    bool   `json:"system,omitempty"`
+	CreatedAt int64  `json:"created_at_ms"`
+	Offset    int64  `json:"offset_ms"`
+}
+
+// HandleChat serves /api/chat/{videoID}/ws and /api/chat/{videoID}/messages.
+func (h *Handler) HandleChat(w http.ResponseWriter, r *http.Request) {
+	// Extract video ID from path: /api/chat/{id}/{resource}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) != 5 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Unknown chat resource")
+		return
+	}
+	videoID := pathParts[3]
+
+	switch pathParts[4] {
+	case "ws":
+		h.serveChatSocket(w, r, videoID)
+	case "messages":
+		h.listChatMessages(w, r, videoID)
+	default:
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Unknown chat resource")
+	}
+}
+
+// listChatMessages replays a room between two stream offsets, for chat alongside a VOD.
+func (h *Handler) listChatMessages(w http.ResponseWriter, r *http.Request, videoID string) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	var bounds [3]int64
+	for i, name := range []string{"from_ms", "to_ms", "limit"} {
+		if v := query.Get(name); v != "" {
+			n, err := strconv.ParseInt(v, 10, 32)
+			if err != nil || n < 0 {
+				writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid "+name)
+				return
+			}
+			bounds[i] = n
+		}
+	}
+
+	messages, err := h.usecase.ListChatMessages(r.Context(), videoID, userIDFromRequest(r), bounds[0], bounds[1], int32(bounds[2]))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*ChatMessageResponse, 0, len(messages))
+	for _, m := range messages {
+		data = append(data, &ChatMessageResponse{
+			ID:        strconv.FormatInt(m.Id, 10),
+			UserID:    m.UserId,
+			Text:      m.Text,
+			System:    m.System,
+			CreatedAt: m.CreatedAtMs,
+			Offset:    m.OffsetMs,
+		})
+	}
+	writeJsonApi(w, data)
+}
+
+// serveChatSocket relays a room's events to the client and its commands to the chat service.
+// Anyone who may watch the video may follow its chat; sending and moderating need a signed-in user.
+func (h *Handler) serveChatSocket(w http.ResponseWriter, r *http.Request, videoID string) {
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	userID := userIDFromRequest(r)
+	events, err := h.usecase.SubscribeChat(ctx, videoID, userID)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied
+		log.Printf("chat %s: upgrade failed: %v", videoID, err)
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	s := &socket{conn: conn}
+	go relayChat(ctx, cancel, s, events)
+
+	s.keepAlive(maxChatFrame)
+	for {
+		var cmd ChatFrame
+		if err := conn.ReadJSON(&cmd); err != nil {
+			return
+		}
+		if err := h.runChatCommand(ctx, videoID, userID, &cmd); err != nil {
+			s.write(&ChatFrame{Type: "error", Error: status.Convert(err).Message()})
+		}
+	}
+}
+
+func (h *Handler) runChatCommand(ctx context.Context, videoID, userID string, cmd *ChatFrame) error {
+	switch cmd.Type {
+	case "message":
+		_, err := h.usecase.PostChatMessage(ctx, videoID, userID, cmd.Text)
+		return err
+	case "delete":
+		return h.usecase.DeleteChatMessage(ctx, videoID, userID, cmd.MessageID)
+	case "ban":
+		return h.usecase.BanChatUser(ctx, videoID, userID, cmd.UserID, cmd.DurationSeconds)
+	case "slow_mode":
+		return h.usecase.SetChatSlowMode(ctx, videoID, userID, cmd.Seconds)
+	case "add_moderator":
+		return h.usecase.AddChatModerator(ctx, videoID, userID, cmd.UserID)
+	}
+	return status.Errorf(codes.InvalidArgument, "unknown command %q", cmd.Type)
+}
+
+// socket serialises writes; gorilla connections allow one writer at a time.
+type socket struct {
+	mu   sync.Mutex
+	conn *websocket.Conn
+}
+
+func (s *socket) write(v any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_ = s.conn.SetWriteDeadline(time.Now().Add(wsWriteWait))
+	if err := s.conn.WriteJSON(v); err != nil {
+		_ = s.conn.Close()
+	}
+}
+
+func (s *socket) ping() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteWait))
+}
+
+// keepAlive makes reads fail once the client stops answering pings.
+func (s *socket) keepAlive(maxFrame int64) {
+	s.conn.SetReadLimit(maxFrame)
+	_ = s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(wsPongWait))
+	})
+}
+
+// relayChat forwards room events until the subscription ends, then closes the socket.
+func relayChat(ctx context.Context, cancel context.CancelFunc, s *socket, events chatpb.ChatService_SubscribeClient) {
+	defer cancel()
+	defer func() { _ = s.conn.Close() }()
+
+	received := make(chan *chatpb.ChatEvent)
+	go func() {
+		defer close(received)
+		for {
+			e, err := events.Recv()
+			if err != nil {
+				return
+			}
+			select {
+			case received <- e:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case e, ok := <-received:
+			if !ok {
+				return
+			}
+			s.write(toChatFrame(e))
+		case <-ticker.C:
+			if err := s.ping(); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func toChatFrame(e *chatpb.ChatEvent) *ChatFrame {
+	f := &ChatFrame{
+		Type:            e.Type,
+		MessageID:       e.MessageId,
+		UserID:          e.UserId,
+		SlowModeSeconds: e.SlowModeSeconds,
+	}
+	if m := e.Message; m != nil {
+		f.Message = &ChatMessageJSON{
+			ID:        m.Id,
+			UserID:    m.UserId,
+			Text:      m.Text,
+			System:    m.System,
+			CreatedAt: m.CreatedAtMs,
+			Offset:    m.OffsetMs,
+		}
+	}
+	return f
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+)
+
+// Headers the streaming service identifies viewers and their tier by. Clients' own values
+// are dropped, so only the gateway's word on who is watching reaches it.
+const (
+	userIDHeader    = "X-User-ID"
+	userRolesHeader = "X-User-Roles"
+	realIPHeader    = "X-Real-IP"
+)
+
+// NewContentProxy proxies GET /api/content/videos/{id} to the streaming service at target,
+// which streams the bytes itself and throttles them per viewer. It must sit behind
+// AuthMiddleware: the viewer is the signed-in user, or the client's address when signed out.
+func NewContentProxy(target *url.URL) http.Handler {
+	return &httputil.ReverseProxy{
+		Rewrite: func(pr *httputil.ProxyRequest) {
+			pr.SetURL(target)
+			pr.Out.URL.Path = strings.TrimSuffix(target.Path, "/") + strings.TrimPrefix(pr.In.URL.Path, "/api")
+			pr.Out.URL.RawPath = ""
+
+			pr.Out.Header.Del(userIDHeader)
+			pr.Out.Header.Del(userRolesHeader)
+			pr.Out.Header.Set(realIPHeader, clientIP(pr.In))
+			if user, ok := UserFromContext(pr.In.Context()); ok {
+				pr.Out.Header.Set(userIDHeader, user.Id)
+				for _, role := range user.Roles {
+					pr.Out.Header.Add(userRolesHeader, role)
+				}
+			}
+		},
+		// The gateway answers CORS itself
+		ModifyResponse: func(resp *http.Response) error {
+			for name := range resp.Header {
+				if strings.HasPrefix(name, "Access-Control-") {
+					resp.Header.Del(name)
+				}
+			}
+			return nil
+		},
+		// Stream bytes to the player as they arrive
+		FlushInterval: -1,
+	}
+}
+
+// clientIP returns the address of the client, as reported by the reverse proxy in front of
+// the gateway, which overwrites X-Real-IP, or the peer's address without one.
+func clientIP(r *http.Request) string {
+	if ip := r.Header.Get(realIPHeader); ip != "" {
+		return ip
+	}
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return ip
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+
+	"github.com/athandoan/youtube/gateway-service/internal/mocks"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"go.uber.org/mock/gomock"
+)
+
+func TestContentProxy_IdentifiesViewer(t *testing.T) {
+	tests := []struct {
+		name       string
+		session    bool
+		wantUserID string
+		wantRoles  []string
+	}{
+		{name: "signed in - the session's user and roles", session: true, wantUserID: "user-1", wantRoles: []string{"premium"}},
+		{name: "signed out - a spoofed user and roles are dropped", wantUserID: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var gotPath, gotUserID, gotIP string
+			var gotRoles []string
+			upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath, gotUserID, gotIP = r.URL.Path, r.Header.Get("X-User-ID"), r.Header.Get("X-Real-IP")
+				gotRoles = r.Header.Values("X-User-Roles")
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+				_, _ = w.Write([]byte("video-bytes"))
+			}))
+			defer upstream.Close()
+			target, _ := url.Parse(upstream.URL)
+
+			u := mocks.NewMockGatewayUsecase(ctrl)
+			req := httptest.NewRequest("GET", "/api/content/videos/video-123", nil)
+			req.Header.Set("X-User-ID", "owner-1")
+			req.Header.Set("X-User-Roles", "admin")
+			req.Header.Set("X-Real-IP", "203.0.113.7")
+			if tt.session {
+				u.EXPECT().Authenticate(gomock.Any(), "token").Return(&userpb.User{Id: "user-1", Roles: []string{"premium"}}, nil)
+				req.Header.Set("Authorization", "Bearer token")
+			}
+			rec := httptest.NewRecorder()
+			NewHandler(u).AuthMiddleware(NewContentProxy(target)).ServeHTTP(rec, req)
+
+			if rec.Code != http.StatusOK || rec.Body.String() != "video-bytes" {
+				t.Fatalf("proxy returned %d %q, want 200 video-bytes", rec.Code, rec.Body.String())
+			}
+			if gotPath != "/content/videos/video-123" {
+				t.Errorf("upstream path = %q, want /content/videos/video-123", gotPath)
+			}
+			if gotUserID != tt.wantUserID {
+				t.Errorf("upstream X-User-ID = %q, want %q", gotUserID, tt.wantUserID)
+			}
+			if !slices.Equal(gotRoles, tt.wantRoles) {
+				t.Errorf("upstream X-User-Roles = %v, want %v", gotRoles, tt.wantRoles)
+			}
+			if gotIP != "203.0.113.7" {
+				t.Errorf("upstream X-Real-IP = %q, want 203.0.113.7", gotIP)
+			}
+			if got := rec.Header().Values("Access-Control-Allow-Origin"); len(got) != 0 {
+				t.Errorf("upstream CORS headers passed through: %v", got)
+			}
+		})
+	}
+}
@@ -2,14 +2,22 @@ package http
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	analyticspb "github.com/athandoan/youtube/proto/analytics"
+	"github.com/athandoan/youtube/proto/common"
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
 	uploadpb "github.com/athandoan/youtube/proto/upload"
 	"github.com/google/jsonapi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 type Handler struct {
@@ -32,12 +40,91 @@ type CompleteUploadData struct {
 }
 
 type VideoResponse struct {
-	ID         string `jsonapi:"primary,video"`
-	Title      string `jsonapi:"attr,title"`
-	Status     string `jsonapi:"attr,status"`
-	CreatedAt  string `jsonapi:"attr,created_at"`
-	BucketName string `jsonapi:"attr,bucket_name"`
-	ObjectKey  string `jsonapi:"attr,object_key"`
+	ID            string   `jsonapi:"primary,video"`
+	Title         string   `jsonapi:"attr,title"`
+	Status        string   `jsonapi:"attr,status"`
+	CreatedAt     string   `jsonapi:"attr,created_at"`
+	BucketName    string   `jsonapi:"attr,bucket_name"`
+	ObjectKey     string   `jsonapi:"attr,object_key"`
+	AllowDownload bool     `jsonapi:"attr,allow_download"`
+	LiveStatus    string   `jsonapi:"attr,live_status,omitempty"`
+	Duration      float64  `jsonapi:"attr,duration_seconds,omitempty"`
+	PublishAt     string   `jsonapi:"attr,publish_at,omitempty"`
+	Premiere      bool     `jsonapi:"attr,premiere,omitempty"`
+	DeletedAt     string   `jsonapi:"attr,deleted_at,omitempty"`
+	Description   string   `jsonapi:"attr,description,omitempty"`
+	Tags          []string `jsonapi:"attr,tags,omitempty"`
+	Category      string   `jsonapi:"attr,category,omitempty"`
+	Visibility    string   `jsonapi:"attr,visibility,omitempty"`
+	SeriesID      string   `jsonapi:"attr,series_id,omitempty"`
+	Season        int32    `jsonapi:"attr,season,omitempty"`
+	Episode       int32    `jsonapi:"attr,episode,omitempty"`
+	ThumbnailURL  string   `jsonapi:"attr,thumbnail_url,omitempty"`
+	Renditions    []string `jsonapi:"attr,renditions,omitempty"`
+
+	// Relationships, set on the detail endpoint only
+	Uploader *UserResponse          `jsonapi:"relation,uploader,omitempty"`
+	Channel  *ChannelResponse       `jsonapi:"relation,channel,omitempty"`
+	Stats    *PlaybackStatsResponse `jsonapi:"relation,stats,omitempty"`
+}
+
+// UserResponse and ChannelResponse identify who owns a video. The uploader is the owner_id the
+// video was created with, and the channel the one it was posted to, if any. Included
+// uploaders carry their public profile only; email and roles are only shown to the user
+// themselves, through /api/auth/me.
+type UserResponse struct {
+	ID          string   `jsonapi:"primary,user"`
+	Email       string   `jsonapi:"attr,email,omitempty"`
+	DisplayName string   `jsonapi:"attr,display_name,omitempty"`
+	CreatedAt   string   `jsonapi:"attr,created_at,omitempty"`
+	Roles       []string `jsonapi:"attr,roles,omitempty"`
+}
+
+// ChannelResponse carries attributes when a channel is fetched itself; its images are served
+// from /api/channels/{handle}/avatar and /banner.
+type ChannelResponse struct {
+	ID          string   `jsonapi:"primary,channel"`
+	Handle      string   `jsonapi:"attr,handle,omitempty"`
+	DisplayName string   `jsonapi:"attr,display_name,omitempty"`
+	Description string   `jsonapi:"attr,description,omitempty"`
+	OwnerID     string   `jsonapi:"attr,owner_id,omitempty"`
+	MemberIDs   []string `jsonapi:"attr,member_ids,omitempty"`
+	AvatarURL   string   `jsonapi:"attr,avatar_url,omitempty"`
+	BannerURL   string   `jsonapi:"attr,banner_url,omitempty"`
+	CreatedAt   string   `jsonapi:"attr,created_at,omitempty"`
+
+	SubscriberCount int64 `jsonapi:"attr,subscriber_count"`
+}
+
+// videoIncludes maps the relationships ?include= can embed to their resource types.
+var videoIncludes = map[string]string{
+	"uploader": "user",
+	"channel":  "channel",
+	"stats":    "playback-stats",
+}
+
+func toVideoResponse(v *common.Video) *VideoResponse {
+	return &VideoResponse{
+		ID:            v.Id,
+		Title:         v.Title,
+		Status:        v.Status,
+		CreatedAt:     v.CreatedAt,
+		BucketName:    v.BucketName,
+		ObjectKey:     v.ObjectKey,
+		AllowDownload: v.AllowDownload,
+		LiveStatus:    v.LiveStatus,
+		Duration:      v.DurationSeconds,
+		PublishAt:     v.PublishAt,
+		Premiere:      v.Premiere,
+		DeletedAt:     v.DeletedAt,
+		Description:   v.Description,
+		Tags:          v.Tags,
+		Category:      v.Category,
+		Visibility:    v.Visibility,
+		SeriesID:      v.SeriesId,
+		Season:        v.Season,
+		Episode:       v.Episode,
+	}
 }
 
 func writeJsonApi(w http.ResponseWriter, data interface{}) {
@@ -77,7 +164,18 @@ func (h *Handler) HandleInitUpload(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp, err := h.usecase.InitUpload(r.Context(), req.Title, req.Filename)
+	if req.PublishAt != "" {
+		if _, err := time.Parse(time.RFC3339, req.PublishAt); err != nil {
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "publish_at must be an RFC 3339 timestamp")
+			return
+		}
+	}
+	if req.Premiere && req.PublishAt == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "A premiere needs a publish_at time")
+		return
+	}
+
+	resp, err := h.usecase.InitUpload(r.Context(), req.Title, req.Filename, req.ChannelId, req.AllowDownload, req.PublishAt, req.Premiere)
 	if err != nil {
 		writeJsonApiError(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
 		return
@@ -122,8 +220,8 @@ func (h *Handler) HandleListVideos(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	query := r.URL.Query().Get("q")
-	videos, err := h.usecase.ListVideos(r.Context(), query)
+	q := r.URL.Query()
+	videos, err := h.usecase.ListVideos(r.Context(), q.Get("q"), q.Get("tag"), q.Get("category"))
 	if err != nil {
 		writeJsonApiError(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
 		return
@@ -131,14 +229,7 @@ func (h *Handler) HandleListVideos(w http.ResponseWriter, r *http.Request) {
 
 	data := make([]*VideoResponse, 0)
 	for _, v := range videos {
-		data = append(data, &VideoResponse{
-			ID:         v.Id,
-			Title:      v.Title,
-			Status:     v.Status,
-			CreatedAt:  v.CreatedAt,
-			BucketName: v.BucketName,
-			ObjectKey:  v.ObjectKey,
-		})
+		data = append(data, toVideoResponse(v))
 	}
 
 	// jsonapi.MarshalPayload creates an empty data array for nil/empty slice
@@ -147,9 +238,252 @@ func (h *Handler) HandleListVideos(w http.ResponseWriter, r *http.Request) {
 	writeJsonApi(w, data)
 }
 
+// HandleVideo serves /api/videos/{id}, /api/videos/{id}/restore, /api/videos/{id}/unpublish,
+// the access list of a private video at /api/videos/{id}/access[/{userID}] and its share
+// links at /api/videos/{id}/shares[/{token}].
+func (h *Handler) HandleVideo(w http.ResponseWriter, r *http.Request) {
+	// Extract video ID from path: /api/videos/{id}[/restore|/unpublish|/access[/{userID}]|/shares[/{token}]]
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 4 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid video ID")
+		return
+	}
+	videoID := pathParts[3]
+
+	var err error
+	switch {
+	case len(pathParts) == 4 && r.Method == "GET":
+		h.getVideo(w, r, videoID)
+		return
+	case len(pathParts) == 4 && r.Method == "PATCH":
+		h.updateVideo(w, r, videoID)
+		return
+	case len(pathParts) == 4 && r.Method == "DELETE":
+		err = h.usecase.DeleteVideo(r.Context(), videoID, userIDFromRequest(r))
+	case len(pathParts) == 5 && pathParts[4] == "restore" && r.Method == "POST":
+		err = h.usecase.RestoreVideo(r.Context(), videoID, userIDFromRequest(r))
+	case len(pathParts) == 5 && pathParts[4] == "unpublish" && r.Method == "POST":
+		err = h.usecase.UnpublishVideo(r.Context(), videoID, userIDFromRequest(r))
+	case len(pathParts) == 5 && pathParts[4] == "access" && r.Method == "GET":
+		h.listVideoAccess(w, r, videoID)
+		return
+	case len(pathParts) == 6 && pathParts[4] == "access" && pathParts[5] != "" && r.Method == "PUT":
+		err = h.usecase.GrantVideoAccess(r.Context(), videoID, userIDFromRequest(r), pathParts[5])
+	case len(pathParts) == 6 && pathParts[4] == "access" && pathParts[5] != "" && r.Method == "DELETE":
+		err = h.usecase.RevokeVideoAccess(r.Context(), videoID, userIDFromRequest(r), pathParts[5])
+	case len(pathParts) == 5 && pathParts[4] == "shares" && r.Method == "POST":
+		h.createShareLink(w, r, videoID)
+		return
+	case len(pathParts) == 5 && pathParts[4] == "shares" && r.Method == "GET":
+		h.listShareLinks(w, r, videoID)
+		return
+	case len(pathParts) == 6 && pathParts[4] == "shares" && pathParts[5] != "" && r.Method == "DELETE":
+		err = h.usecase.RevokeShareLink(r.Context(), videoID, userIDFromRequest(r), pathParts[5])
+	case len(pathParts) == 4, len(pathParts) == 5 && (pathParts[4] == "restore" || pathParts[4] == "unpublish" || pathParts[4] == "access" || pathParts[4] == "shares"),
+		len(pathParts) == 6 && (pathParts[4] == "access" || pathParts[4] == "shares") && pathParts[5] != "":
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Method not allowed on this resource")
+		return
+	default:
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Unknown video resource")
+		return
+	}
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getVideo writes a video with relationships to its owner and playback stats. Related
+// resources are only fetched and embedded when named in ?include=.
+func (h *Handler) getVideo(w http.ResponseWriter, r *http.Request, videoID string) {
+	include := make(map[string]bool)
+	if v := r.URL.Query().Get("include"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			if _, ok := videoIncludes[name]; !ok {
+				writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Unsupported include %q", name))
+				return
+			}
+			include[name] = true
+		}
+	}
+
+	v, err := h.usecase.GetVideo(r.Context(), videoID, userIDFromRequest(r))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := toVideoResponse(v)
+	media, err := h.usecase.GetVideoMedia(r.Context(), v.Id, userIDFromRequest(r))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	data.ThumbnailURL = media.ThumbnailUrl
+	data.Renditions = media.Renditions
+	if v.OwnerId != "" {
+		data.Uploader = &UserResponse{ID: v.OwnerId}
+		if include["uploader"] {
+			user, err := h.usecase.GetUser(r.Context(), v.OwnerId)
+			if err != nil {
+				code := httpStatusFromRPC(err)
+				writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+				return
+			}
+			data.Uploader = &UserResponse{ID: user.Id, DisplayName: user.DisplayName, CreatedAt: user.CreatedAt}
+		}
+	}
+	// Videos posted outside a channel have none; their owner is a user, not a channel
+	if v.ChannelId != "" {
+		data.Channel = &ChannelResponse{ID: v.ChannelId}
+		if include["channel"] {
+			channel, err := h.usecase.GetChannelByID(r.Context(), v.ChannelId)
+			if err != nil {
+				code := httpStatusFromRPC(err)
+				writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+				return
+			}
+			data.Channel = toChannelResponse(channel)
+		}
+	}
+	data.Stats = &PlaybackStatsResponse{ID: v.Id}
+	if include["stats"] {
+		stats, err := h.usecase.GetPlaybackStats(r.Context(), v.Id, "", "")
+		if err != nil {
+			code := httpStatusFromRPC(err)
+			writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+			return
+		}
+		data.Stats = toPlaybackStatsResponse(stats)
+	}
+
+	payload, err := jsonapi.Marshal(data)
+	if err != nil {
+		writeJsonApiError(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
+		return
+	}
+	// The library embeds every relationship; keep only the requested ones
+	one := payload.(*jsonapi.OnePayload)
+	var included []*jsonapi.Node
+	for _, node := range one.Included {
+		for name := range include {
+			if videoIncludes[name] == node.Type {
+				included = append(included, node)
+			}
+		}
+	}
+	one.Included = included
+
+	w.Header().Set("Content-Type", jsonapi.MediaType)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(one); err != nil {
+		log.Printf("Failed to encode response: %v", err)
+	}
+}
+
+// resourcePatch is a JSON:API update document; only the attributes present are changed.
+type resourcePatch struct {
+	Data struct {
+		Type       string                     `json:"type"`
+		ID         string                     `json:"id"`
+		Attributes map[string]json.RawMessage `json:"attributes"`
+	} `json:"data"`
+}
+
+func (h *Handler) updateVideo(w http.ResponseWriter, r *http.Request, videoID string) {
+	var patch resourcePatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	if patch.Data.Type != "video" || patch.Data.ID != videoID {
+		writeJsonApiError(w, http.StatusConflict, "Conflict", "The resource type must be video and its id must match the URL")
+		return
+	}
+
+	req := &metadatapb.UpdateVideoRequest{Id: videoID, UserId: userIDFromRequest(r), UpdateMask: &fieldmaskpb.FieldMask{}}
+	for name, raw := range patch.Data.Attributes {
+		var err error
+		switch name {
+		case "title":
+			err = json.Unmarshal(raw, &req.Title)
+		case "description":
+			err = json.Unmarshal(raw, &req.Description)
+		case "tags":
+			err = json.Unmarshal(raw, &req.Tags)
+		case "category":
+			err = json.Unmarshal(raw, &req.Category)
+		case "visibility":
+			err = json.Unmarshal(raw, &req.Visibility)
+		default:
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Attribute %q cannot be updated", name))
+			return
+		}
+		if err != nil {
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Invalid %s: %v", name, err))
+			return
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, name)
+	}
+	if len(req.UpdateMask.Paths) == 0 {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "No attributes to update")
+		return
+	}
+
+	v, err := h.usecase.UpdateVideo(r.Context(), req)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toVideoResponse(v))
+}
+
+// listVideoAccess lists the users a private video was shared with; only its owner may see them.
+func (h *Handler) listVideoAccess(w http.ResponseWriter, r *http.Request, videoID string) {
+	userIDs, err := h.usecase.ListVideoAccess(r.Context(), videoID, userIDFromRequest(r))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*UserResponse, 0, len(userIDs))
+	for _, id := range userIDs {
+		data = append(data, &UserResponse{ID: id})
+	}
+	writeJsonApi(w, data)
+}
+
+// HandleListTrash lists the caller's trashed videos, which can still be restored.
+func (h *Handler) HandleListTrash(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	videos, err := h.usecase.ListTrash(r.Context(), userIDFromRequest(r))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*VideoResponse, 0)
+	for _, v := range videos {
+		data = append(data, toVideoResponse(v))
+	}
+	writeJsonApi(w, data)
+}
+
 type StreamResponse struct {
-	ID  string `jsonapi:"primary,video-stream"`
-	Url string `jsonapi:"attr,url"`
+	ID            string `jsonapi:"primary,video-stream"`
+	Url           string `jsonapi:"attr,url"`
+	NextEpisodeID string `jsonapi:"attr,next_episode_id,omitempty"`
 }
 
 func (h *Handler) HandleStreamVideo(w http.ResponseWriter, r *http.Request) {
@@ -166,7 +500,7 @@ func (h *Handler) HandleStreamVideo(w http.ResponseWriter, r *http.Request) {
 	}
 	videoID := pathParts[4] // /api/stream/videos/{id}
 
-	url, err := h.usecase.GetStreamURL(r.Context(), videoID)
+	stream, err := h.usecase.GetStreamURL(r.Context(), videoID, r.URL.Query().Get("rendition"), regionFromRequest(r), userIDFromRequest(r))
 	if err != nil {
 		log.Printf("Error getting stream URL: %v", err)
 		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Video not found")
@@ -174,17 +508,253 @@ func (h *Handler) HandleStreamVideo(w http.ResponseWriter, r *http.Request) {
 	}
 
 	data := &StreamResponse{
+		ID:            videoID,
+		Url:           stream.Url,
+		NextEpisodeID: stream.NextEpisodeId,
+	}
+	writeJsonApi(w, data)
+}
+
+type DownloadResponse struct {
+	ID  string `jsonapi:"primary,video-download"`
+	Url string `jsonapi:"attr,url"`
+}
+
+func (h *Handler) HandleDownloadVideo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	// Extract video ID from path: /api/download/videos/{id}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 5 || pathParts[4] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid video ID")
+		return
+	}
+	videoID := pathParts[4]
+
+	url, err := h.usecase.GetDownloadURL(r.Context(), videoID, userIDFromRequest(r))
+	if err != nil {
+		log.Printf("Error getting download URL: %v", err)
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := &DownloadResponse{
 		ID:  videoID,
 		Url: url,
 	}
 	writeJsonApi(w, data)
 }
 
+type CreateStreamKeyRequest struct {
+	ChannelID string `json:"channel_id"`
+	Title     string `json:"title"`
+}
+
+type StreamKeyResponse struct {
+	ID        string `jsonapi:"primary,stream-key"`
+	ChannelID string `jsonapi:"attr,channel_id,omitempty"`
+	Title     string `jsonapi:"attr,title"`
+}
+
+// HandleCreateStreamKey issues the caller a stream key, for a channel they can post to if
+// one is given. Publishing to rtmp://{host}/live/{key} then starts a live video.
+func (h *Handler) HandleCreateStreamKey(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	if userIDFromRequest(r) == "" {
+		writeJsonApiError(w, http.StatusUnauthorized, "Unauthorized", "Sign in to create a stream key")
+		return
+	}
+
+	var req CreateStreamKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	key, err := h.usecase.CreateStreamKey(r.Context(), req.ChannelID, req.Title)
+	if err != nil {
+		log.Printf("Error creating stream key: %v", err)
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	writeJsonApi(w, &StreamKeyResponse{ID: key, ChannelID: req.ChannelID, Title: req.Title})
+}
+
+const (
+	// maxBeaconsPerBatch and maxBeaconBodyBytes bound a single player beacon upload.
+	maxBeaconsPerBatch = 100
+	maxBeaconBodyBytes = 256 << 10
+)
+
+type BeaconBatchResponse struct {
+	ID       string `jsonapi:"primary,beacon-batch"`
+	Accepted int32  `jsonapi:"attr,accepted"`
+	Rejected int32  `jsonapi:"attr,rejected"`
+}
+
+type Percentiles struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+}
+
+type PlaybackStatsData struct {
+	Rendition       string      `json:"rendition,omitempty"`
+	Sessions        int64       `json:"sessions"`
+	StartupMs       Percentiles `json:"startup_ms"`
+	RebufferMs      Percentiles `json:"rebuffer_ms"`
+	RebufferEvents  int64       `json:"rebuffer_events"`
+	BitrateSwitches int64       `json:"bitrate_switches"`
+	Errors          int64       `json:"errors"`
+	FatalSessions   int64       `json:"fatal_sessions"`
+}
+
+type PlaybackStatsResponse struct {
+	ID         string              `jsonapi:"primary,playback-stats"`
+	From       string              `jsonapi:"attr,from"`
+	To         string              `jsonapi:"attr,to"`
+	Overall    PlaybackStatsData   `jsonapi:"attr,overall"`
+	Renditions []PlaybackStatsData `jsonapi:"attr,renditions"`
+}
+
+func (h *Handler) HandleIngestBeacons(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	// Players send beacons with navigator.sendBeacon, so the content type is not checked.
+	var req analyticspb.IngestBeaconsRequest
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBeaconBodyBytes)).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	if len(req.Beacons) == 0 || len(req.Beacons) > maxBeaconsPerBatch {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("A batch must contain 1 to %d beacons", maxBeaconsPerBatch))
+		return
+	}
+
+	resp, err := h.usecase.IngestBeacons(r.Context(), req.Beacons)
+	if err != nil {
+		writeJsonApiError(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
+		return
+	}
+
+	data := &BeaconBatchResponse{
+		ID:       req.Beacons[0].SessionId,
+		Accepted: resp.Accepted,
+		Rejected: resp.Rejected,
+	}
+	writeJsonApi(w, data)
+}
+
+func (h *Handler) HandlePlaybackStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	// Extract video ID from path: /api/analytics/videos/{id}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 5 || pathParts[4] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid video ID")
+		return
+	}
+	videoID := pathParts[4]
+
+	query := r.URL.Query()
+	resp, err := h.usecase.GetPlaybackStats(r.Context(), videoID, query.Get("from"), query.Get("to"))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	writeJsonApi(w, toPlaybackStatsResponse(resp))
+}
+
+func toPlaybackStatsResponse(resp *analyticspb.GetPlaybackStatsResponse) *PlaybackStatsResponse {
+	data := &PlaybackStatsResponse{
+		ID:         resp.VideoId,
+		From:       resp.From,
+		To:         resp.To,
+		Overall:    toPlaybackStatsData(resp.Overall),
+		Renditions: make([]PlaybackStatsData, 0, len(resp.Renditions)),
+	}
+	for _, s := range resp.Renditions {
+		data.Renditions = append(data.Renditions, toPlaybackStatsData(s))
+	}
+	return data
+}
+
+func toPlaybackStatsData(s *analyticspb.PlaybackStats) PlaybackStatsData {
+	percentiles := func(p *analyticspb.Percentiles) Percentiles {
+		return Percentiles{P50: p.GetP50(), P90: p.GetP90(), P99: p.GetP99()}
+	}
+	return PlaybackStatsData{
+		Rendition:       s.GetRendition(),
+		Sessions:        s.GetSessions(),
+		StartupMs:       percentiles(s.GetStartupMs()),
+		RebufferMs:      percentiles(s.GetRebufferMs()),
+		RebufferEvents:  s.GetRebufferEvents(),
+		BitrateSwitches: s.GetBitrateSwitches(),
+		Errors:          s.GetErrors(),
+		FatalSessions:   s.GetFatalSessions(),
+	}
+}
+
+// userIDFromRequest returns the signed-in caller's user ID, or "" for anonymous requests.
+func userIDFromRequest(r *http.Request) string {
+	if user, ok := UserFromContext(r.Context()); ok {
+		return user.Id
+	}
+	return ""
+}
+
+// regionFromRequest returns the client's delivery region: the region query parameter, else the
+// X-Client-Region header set by the edge proxy. Empty lets the streaming service pick any host.
+func regionFromRequest(r *http.Request) string {
+	if region := r.URL.Query().Get("region"); region != "" {
+		return region
+	}
+	return r.Header.Get("X-Client-Region")
+}
+
+// httpStatusFromRPC maps a backend gRPC status onto the matching HTTP status code.
+func httpStatusFromRPC(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition, codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
 func CorsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, HEAD, OPTIONS, PUT, PATCH, DELETE")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Authorization, Range, X-Share-Password")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -0,0 +1,151 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/athandoan/youtube/gateway-service/internal/mocks"
+	"github.com/athandoan/youtube/proto/common"
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
+	streamingpb "github.com/athandoan/youtube/proto/streaming"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHandleDownloadVideo_OwnerFromSession(t *testing.T) {
+	tests := []struct {
+		name     string
+		session  bool
+		wantUser string
+		err      error
+		wantCode int
+	}{
+		{name: "signed in - the session's user asks", session: true, wantUser: "user-1", wantCode: http.StatusOK},
+		{name: "signed out - a spoofed user is ignored", wantUser: "", err: status.Error(codes.PermissionDenied, "download not allowed"), wantCode: http.StatusForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u := mocks.NewMockGatewayUsecase(ctrl)
+			req := httptest.NewRequest("GET", "/api/download/videos/video-123", nil)
+			req.Header.Set("X-User-ID", "owner-1")
+			if tt.session {
+				u.EXPECT().Authenticate(gomock.Any(), "token").Return(&userpb.User{Id: "user-1"}, nil)
+				req.Header.Set("Authorization", "Bearer token")
+			}
+			u.EXPECT().GetDownloadURL(gomock.Any(), "video-123", tt.wantUser).Return("https://s3.example.com/video.mp4", tt.err)
+
+			h := NewHandler(u)
+			rec := httptest.NewRecorder()
+			h.AuthMiddleware(http.HandlerFunc(h.HandleDownloadVideo)).ServeHTTP(rec, req)
+			if rec.Code != tt.wantCode {
+				t.Errorf("HandleDownloadVideo() = %d, want %d", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestHandleVideo_Relationships(t *testing.T) {
+	tests := []struct {
+		name        string
+		video       *common.Video
+		wantChannel bool
+	}{
+		{name: "posted to a channel", video: &common.Video{Id: "video-123", OwnerId: "user-1", ChannelId: "channel-1"}, wantChannel: true},
+		{name: "posted outside a channel has none", video: &common.Video{Id: "video-123", OwnerId: "user-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			u := mocks.NewMockGatewayUsecase(ctrl)
+			u.EXPECT().GetVideo(gomock.Any(), "video-123", "").Return(tt.video, nil)
+			u.EXPECT().GetVideoMedia(gomock.Any(), "video-123", "").
+				Return(&streamingpb.GetVideoMediaResponse{ThumbnailUrl: "https://s3.example.com/thumbnail.jpg", Renditions: []string{"360p", "720p"}}, nil)
+			u.EXPECT().GetUser(gomock.Any(), "user-1").Return(&userpb.User{Id: "user-1", DisplayName: "Ana", CreatedAt: "2026-01-02T03:04:05Z"}, nil)
+			if tt.wantChannel {
+				u.EXPECT().GetChannelByID(gomock.Any(), "channel-1").Return(&metadatapb.Channel{Id: "channel-1", Handle: "ana", DisplayName: "Ana's", SubscriberCount: 7}, nil)
+			}
+
+			rec := httptest.NewRecorder()
+			NewHandler(u).HandleVideo(rec, httptest.NewRequest("GET", "/api/videos/video-123?include=uploader,channel", nil))
+			if rec.Code != http.StatusOK {
+				t.Fatalf("HandleVideo() = %d, want %d", rec.Code, http.StatusOK)
+			}
+
+			var doc struct {
+				Data struct {
+					Attributes struct {
+						ThumbnailURL string   `json:"thumbnail_url"`
+						Renditions   []string `json:"renditions"`
+					} `json:"attributes"`
+					Relationships map[string]struct {
+						Data struct {
+							Type string `json:"type"`
+							ID   string `json:"id"`
+						} `json:"data"`
+					} `json:"relationships"`
+				} `json:"data"`
+				Included []struct {
+					Type       string         `json:"type"`
+					ID         string         `json:"id"`
+					Attributes map[string]any `json:"attributes"`
+				} `json:"included"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+				t.Fatalf("invalid response: %v", err)
+			}
+			if attrs := doc.Data.Attributes; attrs.ThumbnailURL != "https://s3.example.com/thumbnail.jpg" || !slices.Equal(attrs.Renditions, []string{"360p", "720p"}) {
+				t.Errorf("media = %+v, want the thumbnail and renditions", attrs)
+			}
+			if uploader := doc.Data.Relationships["uploader"].Data; uploader.Type != "user" || uploader.ID != "user-1" {
+				t.Errorf("uploader = %+v, want user user-1", uploader)
+			}
+			channel, ok := doc.Data.Relationships["channel"]
+			if ok != tt.wantChannel || tt.wantChannel && channel.Data.ID != "channel-1" {
+				t.Errorf("channel = %+v (present %v), want present %v", channel.Data, ok, tt.wantChannel)
+			}
+
+			included := make(map[string]map[string]any)
+			for _, node := range doc.Included {
+				included[node.Type+"/"+node.ID] = node.Attributes
+			}
+			if user := included["user/user-1"]; user["display_name"] != "Ana" || user["email"] != nil {
+				t.Errorf("included uploader = %v, want the public profile", user)
+			}
+			if channel, ok := included["channel/channel-1"]; ok != tt.wantChannel || ok && (channel["handle"] != "ana" || channel["subscriber_count"] != float64(7)) {
+				t.Errorf("included channel = %v (present %v), want present %v with its attributes", channel, ok, tt.wantChannel)
+			}
+		})
+	}
+}
+
+func TestCorsMiddleware_PreflightAllowsEveryMethod(t *testing.T) {
+	h := CorsMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("preflight reached the handler")
+	}))
+
+	for _, method := range []string{"GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"} {
+		req := httptest.NewRequest("OPTIONS", "/api/videos/video-123", nil)
+		req.Header.Set("Access-Control-Request-Method", method)
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("preflight = %d, want %d", rec.Code, http.StatusOK)
+		}
+		if allowed := strings.Split(rec.Header().Get("Access-Control-Allow-Methods"), ", "); !slices.Contains(allowed, method) {
+			t.Errorf("Access-Control-Allow-Methods = %v, want it to include %s", allowed, method)
+		}
+	}
+}
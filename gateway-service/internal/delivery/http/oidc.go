@@ -0,0 +1,153 @@
+package http
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	"google.golang.org/grpc/status"
+)
+
+// oidcFlowCookie keeps the state, nonce and PKCE verifier of a login in progress in the
+// browser that started it, until the identity provider redirects back.
+const (
+	oidcFlowCookie = "oidc_flow"
+	oidcFlowTTL    = 10 * time.Minute
+)
+
+type oidcFlow struct {
+	State    string `json:"state"`
+	Nonce    string `json:"nonce"`
+	Verifier string `json:"verifier"`
+	Redirect string `json:"redirect"`
+}
+
+// HandleSSOLogin starts single sign-on at GET /api/auth/oidc/login?redirect=/path by sending
+// the browser to the identity provider.
+func (h *Handler) HandleSSOLogin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	flow := oidcFlow{Redirect: localRedirect(r.URL.Query().Get("redirect"))}
+	for _, v := range []*string{&flow.State, &flow.Nonce, &flow.Verifier} {
+		token, err := randomToken()
+		if err != nil {
+			writeJsonApiError(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
+			return
+		}
+		*v = token
+	}
+
+	url, err := h.usecase.SSOAuthURL(r.Context(), flow.State, flow.Nonce, flow.Verifier)
+	if err != nil {
+		writeSSOError(w, err)
+		return
+	}
+	value, err := json.Marshal(flow)
+	if err != nil {
+		writeJsonApiError(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcFlowCookie,
+		Value:    base64.RawURLEncoding.EncodeToString(value),
+		Path:     "/api/auth/oidc/",
+		MaxAge:   int(oidcFlowTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   isHTTPS(r),
+		// Lax still sends it along the provider's top-level redirect back to us
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.Redirect(w, r, url, http.StatusFound)
+}
+
+// HandleSSOCallback finishes single sign-on at GET /api/auth/oidc/callback, where the identity
+// provider sends the browser back, and signs the user in like a password login.
+func (h *Handler) HandleSSOCallback(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	flow, ok := readOIDCFlow(r)
+	// A flow is good for one try, whatever comes of it
+	http.SetCookie(w, &http.Cookie{Name: oidcFlowCookie, Path: "/api/auth/oidc/", MaxAge: -1, HttpOnly: true, Secure: isHTTPS(r), SameSite: http.SameSiteLaxMode})
+
+	// The state proves this browser started the login, so nobody can log it in as themselves
+	q := r.URL.Query()
+	if !ok || q.Get("state") == "" || subtle.ConstantTimeCompare([]byte(q.Get("state")), []byte(flow.State)) != 1 {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "login state doesn't match; start the login again")
+		return
+	}
+	if e := q.Get("error"); e != "" {
+		writeJsonApiError(w, http.StatusUnauthorized, "Unauthorized", strings.TrimSpace("identity provider refused the login: "+e+" "+q.Get("error_description")))
+		return
+	}
+
+	session, err := h.usecase.SSOLogin(r.Context(), q.Get("code"), flow.Verifier, flow.Nonce)
+	if err != nil {
+		writeSSOError(w, err)
+		return
+	}
+	setSessionCookie(w, r, session)
+	http.Redirect(w, r, flow.Redirect, http.StatusFound)
+}
+
+func readOIDCFlow(r *http.Request) (*oidcFlow, bool) {
+	c, err := r.Cookie(oidcFlowCookie)
+	if err != nil {
+		return nil, false
+	}
+	value, err := base64.RawURLEncoding.DecodeString(c.Value)
+	if err != nil {
+		return nil, false
+	}
+	var flow oidcFlow
+	if err := json.Unmarshal(value, &flow); err != nil || flow.State == "" {
+		return nil, false
+	}
+	return &flow, true
+}
+
+func writeSSOError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrSSODisabled):
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", err.Error())
+	case errors.Is(err, domain.ErrSSORejected):
+		writeJsonApiError(w, http.StatusUnauthorized, "Unauthorized", err.Error())
+	default:
+		if _, ok := status.FromError(err); !ok {
+			// Discovery or the token endpoint failed
+			writeJsonApiError(w, http.StatusBadGateway, "Bad Gateway", err.Error())
+			return
+		}
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+	}
+}
+
+// localRedirect keeps the page users land on after logging in on this site, so the login
+// can't be used to send them elsewhere.
+func localRedirect(path string) string {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") || strings.HasPrefix(path, "/\\") {
+		return "/"
+	}
+	return path
+}
+
+// randomToken returns 256 random bits, URL safe; long enough for a PKCE verifier.
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
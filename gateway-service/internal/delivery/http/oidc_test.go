@@ -0,0 +1,145 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	"github.com/athandoan/youtube/gateway-service/internal/infrastructure/oidc"
+	"github.com/athandoan/youtube/gateway-service/internal/infrastructure/oidc/oidctest"
+	"github.com/athandoan/youtube/gateway-service/internal/mocks"
+	"github.com/athandoan/youtube/gateway-service/internal/usecase"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"go.uber.org/mock/gomock"
+)
+
+// newSSOGateway serves the gateway's login endpoints with single sign-on through idp.
+func newSSOGateway(t *testing.T, idp *oidctest.Server, users domain.UserService) *httptest.Server {
+	mux := http.NewServeMux()
+	gw := httptest.NewServer(mux)
+	t.Cleanup(gw.Close)
+
+	sso := oidc.NewProvider(oidc.Config{
+		Issuer:       idp.Issuer(),
+		ClientID:     idp.ClientID,
+		ClientSecret: idp.ClientSecret,
+		RedirectURL:  gw.URL + "/api/auth/oidc/callback",
+		Scopes:       []string{"email", "profile"},
+		RolesClaim:   "groups",
+		RoleMap:      map[string]string{"video-admins": "admin"},
+	}, idp.Client())
+	h := NewHandler(usecase.NewGatewayUsecase(nil, nil, nil, nil, nil, nil, users, sso))
+	mux.HandleFunc("/api/auth/oidc/login", h.HandleSSOLogin)
+	mux.HandleFunc("/api/auth/oidc/callback", h.HandleSSOCallback)
+	mux.HandleFunc("/watch", func(w http.ResponseWriter, r *http.Request) {})
+	return gw
+}
+
+func newBrowser(t *testing.T) *http.Client {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &http.Client{Jar: jar}
+}
+
+func TestSSOLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idp := oidctest.NewServer("gotube", "secret")
+	defer idp.Close()
+	idp.SetClaims(map[string]any{
+		"sub":            "idp-user-7",
+		"email":          "ada@example.com",
+		"email_verified": true,
+		"name":           "Ada Lovelace",
+		"groups":         []string{"video-admins", "staff"},
+	})
+
+	users := mocks.NewMockUserService(ctrl)
+	users.EXPECT().
+		LoginExternal(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, id *domain.Identity) (*userpb.Session, error) {
+			if id.Issuer != idp.Issuer() || id.Subject != "idp-user-7" || id.Email != "ada@example.com" ||
+				!id.EmailVerified || len(id.Roles) != 1 || id.Roles[0] != "admin" {
+				t.Errorf("LoginExternal() identity = %+v", id)
+			}
+			return &userpb.Session{Token: "session-token", ExpiresAt: "2030-01-01T00:00:00Z", User: &userpb.User{Id: "user-1"}}, nil
+		})
+	gw := newSSOGateway(t, idp, users)
+
+	browser := newBrowser(t)
+	resp, err := browser.Get(gw.URL + "/api/auth/oidc/login?redirect=/watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || resp.Request.URL.Path != "/watch" {
+		t.Fatalf("login ended at %s with %s, want /watch", resp.Request.URL, resp.Status)
+	}
+	gwURL, _ := url.Parse(gw.URL)
+	var session string
+	for _, c := range browser.Jar.Cookies(gwURL) {
+		if c.Name == sessionCookie {
+			session = c.Value
+		}
+	}
+	if session != "session-token" {
+		t.Errorf("session cookie = %q, want the new session", session)
+	}
+}
+
+func TestSSOCallback_RejectsForeignState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	idp := oidctest.NewServer("gotube", "secret")
+	defer idp.Close()
+	// No login may happen
+	gw := newSSOGateway(t, idp, mocks.NewMockUserService(ctrl))
+
+	// Someone else's callback URL, e.g. planted by an attacker to log the victim in as them
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	login, err := client.Get(gw.URL + "/api/auth/oidc/login")
+	if err != nil {
+		t.Fatal(err)
+	}
+	login.Body.Close()
+	authorized, err := client.Get(login.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	authorized.Body.Close()
+
+	victim := newBrowser(t)
+	resp, err := victim.Get(authorized.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("callback without the login's state returned %s, want 400", resp.Status)
+	}
+}
+
+func TestLocalRedirect(t *testing.T) {
+	tests := map[string]string{
+		"/watch?v=1":          "/watch?v=1",
+		"":                    "/",
+		"https://evil.com":    "/",
+		"//evil.com/path":     "/",
+		"/\\evil.com":         "/",
+		"javascript:alert(1)": "/",
+	}
+	for in, want := range tests {
+		if got := localRedirect(in); got != want {
+			t.Errorf("localRedirect(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
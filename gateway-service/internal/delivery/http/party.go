@@ -0,0 +1,187 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	"google.golang.org/grpc/status"
+)
+
+const maxPartyFrame = 1 << 10
+
+type CreatePartyRequest struct {
+	VideoID string `json:"video_id"`
+}
+
+type PartyResponse struct {
+	ID          string  `jsonapi:"primary,watch-party"`
+	VideoID     string  `jsonapi:"attr,video_id"`
+	Url         string  `jsonapi:"attr,url"`
+	Playing     bool    `jsonapi:"attr,playing"`
+	Position    float64 `jsonapi:"attr,position"`
+	ExpiresAt   string  `jsonapi:"attr,expires_at"`
+	LeaderToken string  `jsonapi:"attr,leader_token,omitempty"`
+}
+
+// PartyFrame is a watch party WebSocket frame. The leader sends state frames; members
+// receive them, plus error and expired frames.
+type PartyFrame struct {
+	Type     string  `json:"type"`
+	Playing  bool    `json:"playing"`
+	Position float64 `json:"position"`
+	Error    string  `json:"error,omitempty"`
+}
+
+// HandleCreateParty starts a watch party; the response carries the leader token for its creator.
+func (h *Handler) HandleCreateParty(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only POST is allowed")
+		return
+	}
+
+	var req CreatePartyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	if req.VideoID == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "video_id is required")
+		return
+	}
+
+	party, url, err := h.usecase.CreateWatchParty(r.Context(), req.VideoID, r.URL.Query().Get("rendition"), regionFromRequest(r), userIDFromRequest(r))
+	if err != nil {
+		writePartyError(w, err)
+		return
+	}
+	writeJsonApi(w, toPartyResponse(party, url))
+}
+
+// HandleParty serves /api/parties/{id} and the party's WebSocket at /api/parties/{id}/ws.
+func (h *Handler) HandleParty(w http.ResponseWriter, r *http.Request) {
+	// Extract party ID from path: /api/parties/{id}[/ws]
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 4 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid party ID")
+		return
+	}
+	partyID := pathParts[3]
+
+	switch {
+	case len(pathParts) == 4:
+		h.getParty(w, r, partyID)
+	case len(pathParts) == 5 && pathParts[4] == "ws":
+		h.servePartySocket(w, r, partyID)
+	default:
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Unknown party resource")
+	}
+}
+
+// getParty returns the party with a stream URL for the caller, so members joining by link
+// play the same video the way they would play it alone.
+func (h *Handler) getParty(w http.ResponseWriter, r *http.Request, partyID string) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	party, url, err := h.usecase.GetWatchParty(r.Context(), partyID, r.URL.Query().Get("rendition"), regionFromRequest(r), userIDFromRequest(r))
+	if err != nil {
+		writePartyError(w, err)
+		return
+	}
+	writeJsonApi(w, toPartyResponse(party, url))
+}
+
+// servePartySocket sends the party's playback state on connect and whenever it changes.
+// Frames from the connection are applied when it was opened with the leader token.
+func (h *Handler) servePartySocket(w http.ResponseWriter, r *http.Request, partyID string) {
+	states, leave, err := h.usecase.JoinWatchParty(r.Context(), partyID, userIDFromRequest(r))
+	if err != nil {
+		writePartyError(w, err)
+		return
+	}
+	defer leave()
+
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has already replied
+		log.Printf("party %s: upgrade failed: %v", partyID, err)
+		return
+	}
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	s := &socket{conn: conn}
+	go relayParty(ctx, cancel, s, states)
+
+	// Browsers cannot set headers on WebSockets, so the leader passes its token in the query
+	token := r.URL.Query().Get("token")
+	s.keepAlive(maxPartyFrame)
+	for {
+		var f PartyFrame
+		if err := conn.ReadJSON(&f); err != nil {
+			return
+		}
+		if f.Type != "state" {
+			s.write(&PartyFrame{Type: "error", Error: "unknown frame type"})
+			continue
+		}
+		if err := h.usecase.UpdateWatchParty(partyID, token, domain.PlaybackState{Playing: f.Playing, Position: f.Position}); err != nil {
+			s.write(&PartyFrame{Type: "error", Error: err.Error()})
+		}
+	}
+}
+
+// relayParty forwards playback states until the party expires or the client leaves.
+func relayParty(ctx context.Context, cancel context.CancelFunc, s *socket, states <-chan domain.PlaybackState) {
+	defer cancel()
+	defer func() { _ = s.conn.Close() }()
+
+	ticker := time.NewTicker(wsPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case state, ok := <-states:
+			if !ok {
+				s.write(&PartyFrame{Type: "expired"})
+				return
+			}
+			s.write(&PartyFrame{Type: "state", Playing: state.Playing, Position: state.PositionAt(time.Now())})
+		case <-ticker.C:
+			if err := s.ping(); err != nil {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func toPartyResponse(p *domain.WatchParty, url string) *PartyResponse {
+	return &PartyResponse{
+		ID:          p.ID,
+		VideoID:     p.VideoID,
+		Url:         url,
+		Playing:     p.State.Playing,
+		Position:    p.State.PositionAt(time.Now()),
+		ExpiresAt:   p.ExpiresAt.UTC().Format(time.RFC3339),
+		LeaderToken: p.LeaderToken,
+	}
+}
+
+func writePartyError(w http.ResponseWriter, err error) {
+	if errors.Is(err, domain.ErrPartyNotFound) {
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", err.Error())
+		return
+	}
+	code := httpStatusFromRPC(err)
+	writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+}
@@ -0,0 +1,265 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type CreatePlaylistRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+}
+
+type AddPlaylistItemRequest struct {
+	VideoID string `json:"video_id"`
+}
+
+type MovePlaylistItemRequest struct {
+	AfterItemID string `json:"after_item_id"` // empty moves the item to the start
+}
+
+// PlaylistResponse embeds its items, in order, under included; lists leave them out.
+type PlaylistResponse struct {
+	ID              string                  `jsonapi:"primary,playlist"`
+	OwnerID         string                  `jsonapi:"attr,owner_id"`
+	Title           string                  `jsonapi:"attr,title"`
+	Description     string                  `jsonapi:"attr,description,omitempty"`
+	Visibility      string                  `jsonapi:"attr,visibility"`
+	CollaboratorIDs []string                `jsonapi:"attr,collaborator_ids,omitempty"`
+	ItemCount       int32                   `jsonapi:"attr,item_count"`
+	CreatedAt       string                  `jsonapi:"attr,created_at"`
+	Items           []*PlaylistItemResponse `jsonapi:"relation,items,omitempty"`
+}
+
+type PlaylistItemResponse struct {
+	ID       string `jsonapi:"primary,playlist-item"`
+	VideoID  string `jsonapi:"attr,video_id"`
+	Position string `jsonapi:"attr,position"`
+	AddedBy  string `jsonapi:"attr,added_by"`
+	AddedAt  string `jsonapi:"attr,added_at"`
+}
+
+func toPlaylistResponse(p *metadatapb.Playlist) *PlaylistResponse {
+	data := &PlaylistResponse{
+		ID:              p.Id,
+		OwnerID:         p.OwnerId,
+		Title:           p.Title,
+		Description:     p.Description,
+		Visibility:      p.Visibility,
+		CollaboratorIDs: p.CollaboratorIds,
+		ItemCount:       p.ItemCount,
+		CreatedAt:       p.CreatedAt,
+	}
+	for _, item := range p.Items {
+		data.Items = append(data.Items, toPlaylistItemResponse(item))
+	}
+	return data
+}
+
+func toPlaylistItemResponse(item *metadatapb.PlaylistItem) *PlaylistItemResponse {
+	return &PlaylistItemResponse{
+		ID:       item.Id,
+		VideoID:  item.VideoId,
+		Position: item.Position,
+		AddedBy:  item.AddedBy,
+		AddedAt:  item.AddedAt,
+	}
+}
+
+// HandlePlaylists lists a user's playlists at GET /api/playlists?owner_id= (the caller's own
+// by default) and creates one owned by the caller at POST /api/playlists.
+func (h *Handler) HandlePlaylists(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		h.listPlaylists(w, r)
+	case "POST":
+		h.createPlaylist(w, r)
+	default:
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET and POST are allowed")
+	}
+}
+
+func (h *Handler) listPlaylists(w http.ResponseWriter, r *http.Request) {
+	ownerID := r.URL.Query().Get("owner_id")
+	if ownerID == "" {
+		ownerID = userIDFromRequest(r)
+	}
+	if ownerID == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "owner_id is required when not signed in")
+		return
+	}
+
+	playlists, err := h.usecase.ListPlaylists(r.Context(), ownerID)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*PlaylistResponse, 0, len(playlists))
+	for _, p := range playlists {
+		data = append(data, toPlaylistResponse(p))
+	}
+	writeJsonApi(w, data)
+}
+
+func (h *Handler) createPlaylist(w http.ResponseWriter, r *http.Request) {
+	var req CreatePlaylistRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	playlist, err := h.usecase.CreatePlaylist(r.Context(), req.Title, req.Description, req.Visibility)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toPlaylistResponse(playlist))
+}
+
+// HandlePlaylist serves a playlist and its items. The owner manages the playlist and its
+// collaborators; collaborators may add, remove and move its items.
+func (h *Handler) HandlePlaylist(w http.ResponseWriter, r *http.Request) {
+	// Extract playlist ID from path: /api/playlists/{id}[/items[/{itemID}[/move]]|/collaborators/{userID}]
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 4 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid playlist ID")
+		return
+	}
+	playlistID := pathParts[3]
+	item := len(pathParts) >= 6 && pathParts[4] == "items" && pathParts[5] != ""
+	collaborator := len(pathParts) == 6 && pathParts[4] == "collaborators" && pathParts[5] != ""
+
+	var err error
+	switch {
+	case len(pathParts) == 4 && r.Method == "GET":
+		h.getPlaylist(w, r, playlistID)
+		return
+	case len(pathParts) == 4 && r.Method == "PATCH":
+		h.updatePlaylist(w, r, playlistID)
+		return
+	case len(pathParts) == 4 && r.Method == "DELETE":
+		err = h.usecase.DeletePlaylist(r.Context(), playlistID)
+	case len(pathParts) == 5 && pathParts[4] == "items" && r.Method == "POST":
+		h.addPlaylistItem(w, r, playlistID)
+		return
+	case item && len(pathParts) == 6 && r.Method == "DELETE":
+		err = h.usecase.RemovePlaylistItem(r.Context(), playlistID, pathParts[5])
+	case item && len(pathParts) == 7 && pathParts[6] == "move" && r.Method == "POST":
+		h.movePlaylistItem(w, r, playlistID, pathParts[5])
+		return
+	case collaborator && r.Method == "PUT":
+		err = h.usecase.AddPlaylistCollaborator(r.Context(), playlistID, pathParts[5])
+	case collaborator && r.Method == "DELETE":
+		err = h.usecase.RemovePlaylistCollaborator(r.Context(), playlistID, pathParts[5])
+	case len(pathParts) == 4, len(pathParts) == 5 && pathParts[4] == "items", collaborator,
+		item && (len(pathParts) == 6 || len(pathParts) == 7 && pathParts[6] == "move"):
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Method not allowed on this resource")
+		return
+	default:
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Unknown playlist resource")
+		return
+	}
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getPlaylist(w http.ResponseWriter, r *http.Request, playlistID string) {
+	playlist, err := h.usecase.GetPlaylist(r.Context(), playlistID)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toPlaylistResponse(playlist))
+}
+
+func (h *Handler) updatePlaylist(w http.ResponseWriter, r *http.Request, playlistID string) {
+	var patch resourcePatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	if patch.Data.Type != "playlist" || patch.Data.ID != playlistID {
+		writeJsonApiError(w, http.StatusConflict, "Conflict", "The resource type must be playlist and its id must match the URL")
+		return
+	}
+
+	req := &metadatapb.UpdatePlaylistRequest{Id: playlistID, UpdateMask: &fieldmaskpb.FieldMask{}}
+	for name, raw := range patch.Data.Attributes {
+		var err error
+		switch name {
+		case "title":
+			err = json.Unmarshal(raw, &req.Title)
+		case "description":
+			err = json.Unmarshal(raw, &req.Description)
+		case "visibility":
+			err = json.Unmarshal(raw, &req.Visibility)
+		default:
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Attribute %q cannot be updated", name))
+			return
+		}
+		if err != nil {
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Invalid %s: %v", name, err))
+			return
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, name)
+	}
+	if len(req.UpdateMask.Paths) == 0 {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "No attributes to update")
+		return
+	}
+
+	playlist, err := h.usecase.UpdatePlaylist(r.Context(), req)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toPlaylistResponse(playlist))
+}
+
+func (h *Handler) addPlaylistItem(w http.ResponseWriter, r *http.Request, playlistID string) {
+	var req AddPlaylistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	item, err := h.usecase.AddPlaylistItem(r.Context(), playlistID, req.VideoID)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toPlaylistItemResponse(item))
+}
+
+func (h *Handler) movePlaylistItem(w http.ResponseWriter, r *http.Request, playlistID, itemID string) {
+	var req MovePlaylistItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	item, err := h.usecase.MovePlaylistItem(r.Context(), playlistID, itemID, req.AfterItemID)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toPlaylistItemResponse(item))
+}
@@ -0,0 +1,246 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+type CreateSeriesRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+}
+
+type SetEpisodeRequest struct {
+	Season  int32 `json:"season"`
+	Episode int32 `json:"episode"`
+}
+
+// SeriesResponse embeds its episodes, in order, under included; lists leave them out.
+// completed_video_ids are the episodes the signed-in caller has finished.
+type SeriesResponse struct {
+	ID                string           `jsonapi:"primary,series"`
+	OwnerID           string           `jsonapi:"attr,owner_id"`
+	Title             string           `jsonapi:"attr,title"`
+	Description       string           `jsonapi:"attr,description,omitempty"`
+	EpisodeCount      int32            `jsonapi:"attr,episode_count"`
+	CompletedVideoIDs []string         `jsonapi:"attr,completed_video_ids,omitempty"`
+	CreatedAt         string           `jsonapi:"attr,created_at"`
+	Episodes          []*VideoResponse `jsonapi:"relation,episodes,omitempty"`
+}
+
+func toSeriesResponse(s *metadatapb.Series) *SeriesResponse {
+	data := &SeriesResponse{
+		ID:                s.Id,
+		OwnerID:           s.OwnerId,
+		Title:             s.Title,
+		Description:       s.Description,
+		EpisodeCount:      s.EpisodeCount,
+		CompletedVideoIDs: s.CompletedVideoIds,
+		CreatedAt:         s.CreatedAt,
+	}
+	for _, v := range s.Episodes {
+		data.Episodes = append(data.Episodes, toVideoResponse(v))
+	}
+	return data
+}
+
+// HandleSeriesList lists a user's series at GET /api/series?owner_id= (the caller's own by
+// default) and creates one owned by the caller at POST /api/series.
+func (h *Handler) HandleSeriesList(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		h.listSeries(w, r)
+	case "POST":
+		h.createSeries(w, r)
+	default:
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET and POST are allowed")
+	}
+}
+
+func (h *Handler) listSeries(w http.ResponseWriter, r *http.Request) {
+	ownerID := r.URL.Query().Get("owner_id")
+	if ownerID == "" {
+		ownerID = userIDFromRequest(r)
+	}
+	if ownerID == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "owner_id is required when not signed in")
+		return
+	}
+
+	series, err := h.usecase.ListSeries(r.Context(), ownerID)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*SeriesResponse, 0, len(series))
+	for _, s := range series {
+		data = append(data, toSeriesResponse(s))
+	}
+	writeJsonApi(w, data)
+}
+
+func (h *Handler) createSeries(w http.ResponseWriter, r *http.Request) {
+	var req CreateSeriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	series, err := h.usecase.CreateSeries(r.Context(), req.Title, req.Description)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toSeriesResponse(series))
+}
+
+// HandleSeries serves a series, its episodes and the caller's progress through them. The
+// owner numbers the episodes; next returns the episode to play after ?after=, or where the
+// caller left off without it.
+func (h *Handler) HandleSeries(w http.ResponseWriter, r *http.Request) {
+	// Extract series ID from path: /api/series/{id}[/next|/episodes/{videoID}[/completion]]
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 4 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid series ID")
+		return
+	}
+	seriesID := pathParts[3]
+	episode := len(pathParts) >= 6 && pathParts[4] == "episodes" && pathParts[5] != ""
+	completion := episode && len(pathParts) == 7 && pathParts[6] == "completion"
+
+	var err error
+	switch {
+	case len(pathParts) == 4 && r.Method == "GET":
+		h.getSeries(w, r, seriesID)
+		return
+	case len(pathParts) == 4 && r.Method == "PATCH":
+		h.updateSeries(w, r, seriesID)
+		return
+	case len(pathParts) == 4 && r.Method == "DELETE":
+		err = h.usecase.DeleteSeries(r.Context(), seriesID)
+	case len(pathParts) == 5 && pathParts[4] == "next" && r.Method == "GET":
+		h.getNextEpisode(w, r, seriesID)
+		return
+	case episode && len(pathParts) == 6 && r.Method == "PUT":
+		h.setEpisode(w, r, seriesID, pathParts[5])
+		return
+	case episode && len(pathParts) == 6 && r.Method == "DELETE":
+		h.removeEpisode(w, r, seriesID, pathParts[5])
+		return
+	case completion && r.Method == "PUT":
+		err = h.usecase.SetEpisodeCompleted(r.Context(), seriesID, pathParts[5], true)
+	case completion && r.Method == "DELETE":
+		err = h.usecase.SetEpisodeCompleted(r.Context(), seriesID, pathParts[5], false)
+	case len(pathParts) == 4, len(pathParts) == 5 && pathParts[4] == "next",
+		episode && len(pathParts) == 6, completion:
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Method not allowed on this resource")
+		return
+	default:
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Unknown series resource")
+		return
+	}
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getSeries(w http.ResponseWriter, r *http.Request, seriesID string) {
+	series, err := h.usecase.GetSeries(r.Context(), seriesID)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toSeriesResponse(series))
+}
+
+func (h *Handler) updateSeries(w http.ResponseWriter, r *http.Request, seriesID string) {
+	var patch resourcePatch
+	if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+	if patch.Data.Type != "series" || patch.Data.ID != seriesID {
+		writeJsonApiError(w, http.StatusConflict, "Conflict", "The resource type must be series and its id must match the URL")
+		return
+	}
+
+	req := &metadatapb.UpdateSeriesRequest{Id: seriesID, UpdateMask: &fieldmaskpb.FieldMask{}}
+	for name, raw := range patch.Data.Attributes {
+		var err error
+		switch name {
+		case "title":
+			err = json.Unmarshal(raw, &req.Title)
+		case "description":
+			err = json.Unmarshal(raw, &req.Description)
+		default:
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Attribute %q cannot be updated", name))
+			return
+		}
+		if err != nil {
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", fmt.Sprintf("Invalid %s: %v", name, err))
+			return
+		}
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, name)
+	}
+	if len(req.UpdateMask.Paths) == 0 {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "No attributes to update")
+		return
+	}
+
+	series, err := h.usecase.UpdateSeries(r.Context(), req)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toSeriesResponse(series))
+}
+
+func (h *Handler) setEpisode(w http.ResponseWriter, r *http.Request, seriesID, videoID string) {
+	var req SetEpisodeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	series, err := h.usecase.SetEpisode(r.Context(), seriesID, videoID, req.Season, req.Episode)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toSeriesResponse(series))
+}
+
+func (h *Handler) removeEpisode(w http.ResponseWriter, r *http.Request, seriesID, videoID string) {
+	series, err := h.usecase.RemoveEpisode(r.Context(), seriesID, videoID)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toSeriesResponse(series))
+}
+
+func (h *Handler) getNextEpisode(w http.ResponseWriter, r *http.Request, seriesID string) {
+	v, err := h.usecase.GetNextEpisode(r.Context(), seriesID, r.URL.Query().Get("after"))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toVideoResponse(v))
+}
@@ -0,0 +1,96 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type CreateShareLinkRequest struct {
+	ExpiresAt string `json:"expires_at"` // RFC 3339; empty for the default lifetime
+	MaxViews  int32  `json:"max_views"`  // 0 for unlimited
+	Password  string `json:"password"`
+}
+
+// ShareLinkResponse is identified by its token, which is what the link hands out.
+type ShareLinkResponse struct {
+	ID          string `jsonapi:"primary,share-link"`
+	VideoID     string `jsonapi:"attr,video_id"`
+	ExpiresAt   string `jsonapi:"attr,expires_at"`
+	MaxViews    int32  `jsonapi:"attr,max_views"`
+	Views       int32  `jsonapi:"attr,views"`
+	HasPassword bool   `jsonapi:"attr,has_password"`
+	RevokedAt   string `jsonapi:"attr,revoked_at,omitempty"`
+	CreatedAt   string `jsonapi:"attr,created_at"`
+}
+
+func toShareLinkResponse(l *metadatapb.ShareLink) *ShareLinkResponse {
+	return &ShareLinkResponse{
+		ID:          l.Token,
+		VideoID:     l.VideoId,
+		ExpiresAt:   l.ExpiresAt,
+		MaxViews:    l.MaxViews,
+		Views:       l.Views,
+		HasPassword: l.HasPassword,
+		RevokedAt:   l.RevokedAt,
+		CreatedAt:   l.CreatedAt,
+	}
+}
+
+// createShareLink creates a share link to a video; only its owner may create, list or revoke them.
+func (h *Handler) createShareLink(w http.ResponseWriter, r *http.Request, videoID string) {
+	var req CreateShareLinkRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
+
+	link, err := h.usecase.CreateShareLink(r.Context(), videoID, userIDFromRequest(r), req.ExpiresAt, req.MaxViews, req.Password)
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, toShareLinkResponse(link))
+}
+
+func (h *Handler) listShareLinks(w http.ResponseWriter, r *http.Request, videoID string) {
+	links, err := h.usecase.ListShareLinks(r.Context(), videoID, userIDFromRequest(r))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*ShareLinkResponse, 0, len(links))
+	for _, l := range links {
+		data = append(data, toShareLinkResponse(l))
+	}
+	writeJsonApi(w, data)
+}
+
+// HandleShare plays a share link at /api/share/{token} for anyone holding it, signed in or
+// not. Links with a password take it in the X-Share-Password header.
+func (h *Handler) HandleShare(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) != 4 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid share token")
+		return
+	}
+
+	url, videoID, err := h.usecase.GetSharedStreamURL(r.Context(), pathParts[3], r.Header.Get("X-Share-Password"), r.URL.Query().Get("rendition"), regionFromRequest(r))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+	writeJsonApi(w, &StreamResponse{ID: videoID, Url: url})
+}
@@ -0,0 +1,86 @@
+package http
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/jsonapi"
+	"google.golang.org/grpc/status"
+)
+
+// HandleListSubscriptions lists the channels the caller follows at GET /api/subscriptions,
+// most recently followed first.
+func (h *Handler) HandleListSubscriptions(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	channels, err := h.usecase.ListSubscriptions(r.Context())
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*ChannelResponse, 0, len(channels))
+	for _, c := range channels {
+		data = append(data, toChannelResponse(c))
+	}
+	writeJsonApi(w, data)
+}
+
+// HandleSubscriptionFeed serves the newest videos from the caller's subscriptions at
+// GET /api/feed/subscriptions?cursor=&limit=. links.next holds the URL of the next page and
+// is left out on the last one.
+func (h *Handler) HandleSubscriptionFeed(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET is allowed")
+		return
+	}
+
+	query := r.URL.Query()
+	var limit int64
+	if v := query.Get("limit"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 32)
+		if err != nil || n < 1 {
+			writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid limit")
+			return
+		}
+		limit = n
+	}
+
+	videos, next, err := h.usecase.GetSubscriptionFeed(r.Context(), query.Get("cursor"), int32(limit))
+	if err != nil {
+		code := httpStatusFromRPC(err)
+		writeJsonApiError(w, code, http.StatusText(code), status.Convert(err).Message())
+		return
+	}
+
+	data := make([]*VideoResponse, 0, len(videos))
+	for _, v := range videos {
+		data = append(data, toVideoResponse(v))
+	}
+	payload, err := jsonapi.Marshal(data)
+	if err != nil {
+		writeJsonApiError(w, http.StatusInternalServerError, "Internal Server Error", err.Error())
+		return
+	}
+	many := payload.(*jsonapi.ManyPayload)
+	if next != "" {
+		nextQuery := url.Values{"cursor": {next}}
+		if limit > 0 {
+			nextQuery.Set("limit", strconv.FormatInt(limit, 10))
+		}
+		many.Links = &jsonapi.Links{"next": "/api/feed/subscriptions?" + nextQuery.Encode()}
+	}
+
+	w.Header().Set("Content-Type", jsonapi.MediaType)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(many); err != nil {
+		log.Printf("Failed to encode response: %v", err)
+	}
+}
@@ -4,27 +4,312 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	analyticspb "github.com/athandoan/youtube/proto/analytics"
+	chatpb "github.com/athandoan/youtube/proto/chat"
 	"github.com/athandoan/youtube/proto/common"
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
+	streamingpb "github.com/athandoan/youtube/proto/streaming"
 	uploadpb "github.com/athandoan/youtube/proto/upload"
+	userpb "github.com/athandoan/youtube/proto/user"
 )
 
+var (
+	ErrPartyNotFound  = errors.New("watch party not found or expired")
+	ErrNotPartyLeader = errors.New("only the party leader can control playback")
+	ErrInvalidState   = errors.New("invalid playback state")
+	ErrSSODisabled    = errors.New("single sign-on is not configured")
+	ErrSSORejected    = errors.New("identity provider login failed")
+)
+
+// RoleAdmin may manage every user's API keys.
+const RoleAdmin = "admin"
+
+// Scopes limit what an API key may do as its user; signed-in users may do everything.
+const (
+	ScopeUploadWrite = "upload:write" // upload videos
+	ScopeVideosRead  = "videos:read"  // look up and play videos
+	ScopeVideosAdmin = "videos:admin" // edit, share and delete videos and manage playlists and series; implies videos:read
+)
+
+// APIKeyPrefix starts every API key, which tells them apart from session tokens.
+const APIKeyPrefix = "gtk_"
+
+// PlaybackState is where a watch party's shared playback was when its leader last changed it.
+type PlaybackState struct {
+	Playing   bool
+	Position  float64 // seconds into the video at UpdatedAt
+	UpdatedAt time.Time
+}
+
+// PositionAt returns the playback position at now, advancing it while playing.
+func (s PlaybackState) PositionAt(now time.Time) float64 {
+	if !s.Playing || now.Before(s.UpdatedAt) {
+		return s.Position
+	}
+	return s.Position + now.Sub(s.UpdatedAt).Seconds()
+}
+
+type WatchParty struct {
+	ID      string
+	VideoID string
+	// LeaderToken is handed to whoever created the party and authorises playback changes
+	LeaderToken string
+	State       PlaybackState
+	ExpiresAt   time.Time
+}
+
+// PartyHub keeps watch parties and fans their playback state out to members.
+type PartyHub interface {
+	Create(videoID string) (*WatchParty, error)
+	Get(id string) (*WatchParty, error)
+	// SetState replaces the party's playback state when token is its leader token.
+	SetState(id, token string, state PlaybackState) error
+	// Subscribe returns the party's states, starting with the current one, and a func that
+	// ends the subscription. The channel is closed when the party expires.
+	Subscribe(id string) (<-chan PlaybackState, func(), error)
+}
+
 type MetadataService interface {
-	ListVideos(ctx context.Context, query string) ([]*common.Video, error)
+	// ListVideos searches published videos, optionally only those with a tag or in a category.
+	ListVideos(ctx context.Context, query, tag, category string) ([]*common.Video, error)
+	// GetVideo returns a video as userID sees it; private videos are only visible to their
+	// owner and the users they were shared with.
+	GetVideo(ctx context.Context, id, userID string) (*common.Video, error)
+	// UpdateVideo changes the fields named in the request's update mask.
+	UpdateVideo(ctx context.Context, req *metadatapb.UpdateVideoRequest) (*common.Video, error)
+	GrantVideoAccess(ctx context.Context, id, userID, granteeID string) error
+	RevokeVideoAccess(ctx context.Context, id, userID, granteeID string) error
+	ListVideoAccess(ctx context.Context, id, userID string) ([]string, error)
+	// CreateShareLink creates a link that plays the video without an account. expiresAt is
+	// RFC 3339, empty for the default lifetime; maxViews 0 allows unlimited views.
+	CreateShareLink(ctx context.Context, id, userID, expiresAt string, maxViews int32, password string) (*metadatapb.ShareLink, error)
+	ListShareLinks(ctx context.Context, id, userID string) ([]*metadatapb.ShareLink, error)
+	RevokeShareLink(ctx context.Context, id, userID, token string) error
+	CreateStreamKey(ctx context.Context, channelID, title string) (string, error)
+	DeleteVideo(ctx context.Context, id, userID string) error
+	RestoreVideo(ctx context.Context, id, userID string) error
+	// UnpublishVideo makes a video private; owners, admins and moderators can.
+	UnpublishVideo(ctx context.Context, id, userID string) error
+	ListTrash(ctx context.Context, userID string) ([]*common.Video, error)
+	// ListChannelVideos returns a channel's published videos.
+	ListChannelVideos(ctx context.Context, channelID string) ([]*common.Video, error)
+	CreateChannel(ctx context.Context, handle, displayName, description string) (*metadatapb.Channel, error)
+	// GetChannel looks a channel up by its handle.
+	GetChannel(ctx context.Context, handle string) (*metadatapb.Channel, error)
+	GetChannelByID(ctx context.Context, id string) (*metadatapb.Channel, error)
+	// UpdateChannel changes the fields named in the request's update mask.
+	UpdateChannel(ctx context.Context, req *metadatapb.UpdateChannelRequest) (*metadatapb.Channel, error)
+	DeleteChannel(ctx context.Context, id string) error
+	AddChannelMember(ctx context.Context, id, memberID string) error
+	RemoveChannelMember(ctx context.Context, id, memberID string) error
+	Subscribe(ctx context.Context, channelID string) error
+	Unsubscribe(ctx context.Context, channelID string) error
+	ListSubscriptions(ctx context.Context) ([]*metadatapb.Channel, error)
+	// GetSubscriptionFeed returns a page of videos from the caller's subscriptions, newest
+	// first, and the token of the next page, empty on the last.
+	GetSubscriptionFeed(ctx context.Context, pageToken string, pageSize int32) ([]*common.Video, string, error)
+	CreatePlaylist(ctx context.Context, title, description, visibility string) (*metadatapb.Playlist, error)
+	GetPlaylist(ctx context.Context, id string) (*metadatapb.Playlist, error)
+	// ListPlaylists returns a user's playlists; others only see the public ones.
+	ListPlaylists(ctx context.Context, ownerID string) ([]*metadatapb.Playlist, error)
+	// UpdatePlaylist changes the fields named in the request's update mask.
+	UpdatePlaylist(ctx context.Context, req *metadatapb.UpdatePlaylistRequest) (*metadatapb.Playlist, error)
+	DeletePlaylist(ctx context.Context, id string) error
+	AddPlaylistItem(ctx context.Context, id, videoID string) (*metadatapb.PlaylistItem, error)
+	RemovePlaylistItem(ctx context.Context, id, itemID string) error
+	// MovePlaylistItem moves an item right after afterItemID, or to the start when it is empty.
+	MovePlaylistItem(ctx context.Context, id, itemID, afterItemID string) (*metadatapb.PlaylistItem, error)
+	AddPlaylistCollaborator(ctx context.Context, id, userID string) error
+	RemovePlaylistCollaborator(ctx context.Context, id, userID string) error
+	CreateSeries(ctx context.Context, title, description string) (*metadatapb.Series, error)
+	// GetSeries lists the episodes the caller can play, or all of them to its managers.
+	GetSeries(ctx context.Context, id string) (*metadatapb.Series, error)
+	ListSeries(ctx context.Context, ownerID string) ([]*metadatapb.Series, error)
+	// UpdateSeries changes the fields named in the request's update mask.
+	UpdateSeries(ctx context.Context, req *metadatapb.UpdateSeriesRequest) (*metadatapb.Series, error)
+	DeleteSeries(ctx context.Context, id string) error
+	// SetEpisode adds a video to a series, or renumbers it, and returns the series.
+	SetEpisode(ctx context.Context, id, videoID string, season, episode int32) (*metadatapb.Series, error)
+	RemoveEpisode(ctx context.Context, id, videoID string) (*metadatapb.Series, error)
+	// GetNextEpisode returns the episode after afterVideoID, or where the caller left off
+	// when it is empty.
+	GetNextEpisode(ctx context.Context, id, afterVideoID string) (*common.Video, error)
+	SetEpisodeCompleted(ctx context.Context, id, videoID string, completed bool) error
 }
 
 type UploadService interface {
-	InitUpload(ctx context.Context, title, filename string) (string, string, error)
+	InitUpload(ctx context.Context, title, filename, channelID string, allowDownload bool, publishAt string, premiere bool) (string, string, error)
 	CompleteUpload(ctx context.Context, videoID string) error
+	// InitChannelImageUpload returns a URL to upload a channel's avatar or banner to.
+	InitChannelImageUpload(ctx context.Context, channelID, kind, filename string) (string, error)
 }
 
 type StreamingService interface {
-	GetStreamURL(ctx context.Context, videoID string) (string, error)
+	// GetStreamURL returns the playback URL and, for episodes of a series, the one to autoplay next.
+	GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*streamingpb.GetStreamURLResponse, error)
+	// GetSharedStreamURL returns a playback URL for the video of a share link, and its ID.
+	GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error)
+	GetDownloadURL(ctx context.Context, videoID, userID string) (string, error)
+	// GetVideoMedia returns a video's thumbnail URL, empty without one, and its renditions.
+	GetVideoMedia(ctx context.Context, videoID, userID string) (*streamingpb.GetVideoMediaResponse, error)
+	InvalidateStreamURL(ctx context.Context, videoID string) error
+	GetChannelImageURL(ctx context.Context, channelID, kind string) (string, error)
+}
+
+type AnalyticsService interface {
+	IngestBeacons(ctx context.Context, beacons []*analyticspb.Beacon) (*analyticspb.IngestBeaconsResponse, error)
+	GetPlaybackStats(ctx context.Context, videoID, from, to string) (*analyticspb.GetPlaybackStatsResponse, error)
+}
+
+type ChatService interface {
+	PostMessage(ctx context.Context, videoID, userID, text string) (*chatpb.ChatMessage, error)
+	// ListMessages replays a room to viewerID, empty for anonymous viewers, if they may watch the video.
+	ListMessages(ctx context.Context, videoID, viewerID string, fromMs, toMs int64, limit int32) ([]*chatpb.ChatMessage, error)
+	// Subscribe streams a room's events to viewerID until ctx is cancelled or the room closes.
+	// Viewers who may not follow the room get the chat service's error rather than a stream.
+	Subscribe(ctx context.Context, videoID, viewerID string) (chatpb.ChatService_SubscribeClient, error)
+	DeleteMessage(ctx context.Context, videoID, actorID string, messageID int64) error
+	BanUser(ctx context.Context, videoID, actorID, userID string, durationSeconds int32) error
+	SetSlowMode(ctx context.Context, videoID, actorID string, seconds int32) error
+	AddModerator(ctx context.Context, videoID, actorID, userID string) error
+}
+
+// Identity is a user as the identity provider vouched for them in a verified ID token, with
+// their provider groups mapped to local roles.
+type Identity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Roles         []string
+}
+
+// IdentityProvider signs users in with OpenID Connect's authorization code flow and PKCE.
+type IdentityProvider interface {
+	// AuthCodeURL returns where to send the user to log in. state, nonce and verifier are
+	// fresh random values the client keeps until the provider redirects back.
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	// Exchange redeems the code the provider redirected back with and verifies the ID token it
+	// yields. Codes, tokens and nonces that don't check out fail with ErrSSORejected.
+	Exchange(ctx context.Context, code, verifier, nonce string) (*Identity, error)
+}
+
+type UserService interface {
+	Signup(ctx context.Context, email, password, displayName string) (*userpb.Session, error)
+	Login(ctx context.Context, email, password string) (*userpb.Session, error)
+	Logout(ctx context.Context, token string) error
+	// Authenticate returns the user a session token belongs to.
+	Authenticate(ctx context.Context, token string) (*userpb.User, error)
+	// GetUser returns a user's public profile, without their email and roles.
+	GetUser(ctx context.Context, id string) (*userpb.User, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
+	// LoginExternal signs in a user the identity provider verified, giving them its roles.
+	LoginExternal(ctx context.Context, identity *Identity) (*userpb.Session, error)
+	CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (*userpb.CreateAPIKeyResponse, error)
+	// ListAPIKeys returns the user's keys, or every user's when all is set.
+	ListAPIKeys(ctx context.Context, userID string, all bool) ([]*userpb.APIKey, error)
+	// RevokeAPIKey revokes a key of the user, or anyone's when any is set.
+	RevokeAPIKey(ctx context.Context, id, userID string, any bool) error
+	// AuthenticateAPIKey returns the user an active key acts as, and the key with its scopes.
+	AuthenticateAPIKey(ctx context.Context, key string) (*userpb.AuthenticateAPIKeyResponse, error)
 }
 
 type GatewayUsecase interface {
-	InitUpload(ctx context.Context, title, filename string) (*uploadpb.InitUploadResponse, error)
+	// InitUpload starts an upload, posting the video to channelID when it's set.
+	InitUpload(ctx context.Context, title, filename, channelID string, allowDownload bool, publishAt string, premiere bool) (*uploadpb.InitUploadResponse, error)
 	CompleteUpload(ctx context.Context, videoID string) (*uploadpb.CompleteUploadResponse, error)
-	ListVideos(ctx context.Context, query string) ([]*common.Video, error)
-	GetStreamURL(ctx context.Context, videoID string) (string, error)
+	// ListVideos searches published videos, optionally only those with a tag or in a category.
+	ListVideos(ctx context.Context, query, tag, category string) ([]*common.Video, error)
+	GetVideo(ctx context.Context, id, userID string) (*common.Video, error)
+	// UpdateVideo changes the fields named in the request's update mask.
+	UpdateVideo(ctx context.Context, req *metadatapb.UpdateVideoRequest) (*common.Video, error)
+	GrantVideoAccess(ctx context.Context, id, userID, granteeID string) error
+	RevokeVideoAccess(ctx context.Context, id, userID, granteeID string) error
+	ListVideoAccess(ctx context.Context, id, userID string) ([]string, error)
+	CreateShareLink(ctx context.Context, id, userID, expiresAt string, maxViews int32, password string) (*metadatapb.ShareLink, error)
+	ListShareLinks(ctx context.Context, id, userID string) ([]*metadatapb.ShareLink, error)
+	RevokeShareLink(ctx context.Context, id, userID, token string) error
+	GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*streamingpb.GetStreamURLResponse, error)
+	// GetSharedStreamURL plays a share link for anyone holding it, without an account.
+	GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error)
+	GetDownloadURL(ctx context.Context, videoID, userID string) (string, error)
+	GetVideoMedia(ctx context.Context, videoID, userID string) (*streamingpb.GetVideoMediaResponse, error)
+	IngestBeacons(ctx context.Context, beacons []*analyticspb.Beacon) (*analyticspb.IngestBeaconsResponse, error)
+	GetPlaybackStats(ctx context.Context, videoID, from, to string) (*analyticspb.GetPlaybackStatsResponse, error)
+	// GetUser and GetChannelByID resolve the uploader and channel a video relates to.
+	GetUser(ctx context.Context, id string) (*userpb.User, error)
+	GetChannelByID(ctx context.Context, id string) (*metadatapb.Channel, error)
+	CreateStreamKey(ctx context.Context, channelID, title string) (string, error)
+	DeleteVideo(ctx context.Context, id, userID string) error
+	RestoreVideo(ctx context.Context, id, userID string) error
+	// UnpublishVideo makes a video private; owners, admins and moderators can.
+	UnpublishVideo(ctx context.Context, id, userID string) error
+	ListTrash(ctx context.Context, userID string) ([]*common.Video, error)
+	PostChatMessage(ctx context.Context, videoID, userID, text string) (*chatpb.ChatMessage, error)
+	ListChatMessages(ctx context.Context, videoID, viewerID string, fromMs, toMs int64, limit int32) ([]*chatpb.ChatMessage, error)
+	SubscribeChat(ctx context.Context, videoID, viewerID string) (chatpb.ChatService_SubscribeClient, error)
+	DeleteChatMessage(ctx context.Context, videoID, actorID string, messageID int64) error
+	BanChatUser(ctx context.Context, videoID, actorID, userID string, durationSeconds int32) error
+	SetChatSlowMode(ctx context.Context, videoID, actorID string, seconds int32) error
+	AddChatModerator(ctx context.Context, videoID, actorID, userID string) error
+	CreateWatchParty(ctx context.Context, videoID, rendition, region, userID string) (*WatchParty, string, error)
+	GetWatchParty(ctx context.Context, id, rendition, region, userID string) (*WatchParty, string, error)
+	UpdateWatchParty(id, token string, state PlaybackState) error
+	JoinWatchParty(ctx context.Context, id, userID string) (<-chan PlaybackState, func(), error)
+	Signup(ctx context.Context, email, password, displayName string) (*userpb.Session, error)
+	Login(ctx context.Context, email, password string) (*userpb.Session, error)
+	Logout(ctx context.Context, token string) error
+	Authenticate(ctx context.Context, token string) (*userpb.User, error)
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, password string) error
+	// SSOAuthURL returns the identity provider's login page; ErrSSODisabled without one.
+	SSOAuthURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	// SSOLogin signs in whoever the identity provider redirected back with code.
+	SSOLogin(ctx context.Context, code, verifier, nonce string) (*userpb.Session, error)
+	CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (*userpb.CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, userID string) ([]*userpb.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id, userID string) error
+	// ListAllAPIKeys and RevokeAnyAPIKey are for admins.
+	ListAllAPIKeys(ctx context.Context) ([]*userpb.APIKey, error)
+	RevokeAnyAPIKey(ctx context.Context, id string) error
+	AuthenticateAPIKey(ctx context.Context, key string) (*userpb.AuthenticateAPIKeyResponse, error)
+	CreateChannel(ctx context.Context, handle, displayName, description string) (*metadatapb.Channel, error)
+	// The channel methods below find the channel by its handle.
+	GetChannel(ctx context.Context, handle string) (*metadatapb.Channel, error)
+	ListChannelVideos(ctx context.Context, handle string) ([]*common.Video, error)
+	// UpdateChannel changes the fields named in the request's update mask.
+	UpdateChannel(ctx context.Context, handle string, req *metadatapb.UpdateChannelRequest) (*metadatapb.Channel, error)
+	DeleteChannel(ctx context.Context, handle string) error
+	AddChannelMember(ctx context.Context, handle, memberID string) error
+	RemoveChannelMember(ctx context.Context, handle, memberID string) error
+	InitChannelImageUpload(ctx context.Context, handle, kind, filename string) (string, error)
+	GetChannelImageURL(ctx context.Context, handle, kind string) (string, error)
+	Subscribe(ctx context.Context, handle string) error
+	Unsubscribe(ctx context.Context, handle string) error
+	ListSubscriptions(ctx context.Context) ([]*metadatapb.Channel, error)
+	GetSubscriptionFeed(ctx context.Context, pageToken string, pageSize int32) ([]*common.Video, string, error)
+	CreatePlaylist(ctx context.Context, title, description, visibility string) (*metadatapb.Playlist, error)
+	GetPlaylist(ctx context.Context, id string) (*metadatapb.Playlist, error)
+	ListPlaylists(ctx context.Context, ownerID string) ([]*metadatapb.Playlist, error)
+	UpdatePlaylist(ctx context.Context, req *metadatapb.UpdatePlaylistRequest) (*metadatapb.Playlist, error)
+	DeletePlaylist(ctx context.Context, id string) error
+	AddPlaylistItem(ctx context.Context, id, videoID string) (*metadatapb.PlaylistItem, error)
+	RemovePlaylistItem(ctx context.Context, id, itemID string) error
+	MovePlaylistItem(ctx context.Context, id, itemID, afterItemID string) (*metadatapb.PlaylistItem, error)
+	AddPlaylistCollaborator(ctx context.Context, id, userID string) error
+	RemovePlaylistCollaborator(ctx context.Context, id, userID string) error
+	CreateSeries(ctx context.Context, title, description string) (*metadatapb.Series, error)
+	GetSeries(ctx context.Context, id string) (*metadatapb.Series, error)
+	ListSeries(ctx context.Context, ownerID string) ([]*metadatapb.Series, error)
+	UpdateSeries(ctx context.Context, req *metadatapb.UpdateSeriesRequest) (*metadatapb.Series, error)
+	DeleteSeries(ctx context.Context, id string) error
+	SetEpisode(ctx context.Context, id, videoID string, season, episode int32) (*metadatapb.Series, error)
+	RemoveEpisode(ctx context.Context, id, videoID string) (*metadatapb.Series, error)
+	GetNextEpisode(ctx context.Context, id, afterVideoID string) (*common.Video, error)
+	SetEpisodeCompleted(ctx context.Context, id, videoID string, completed bool) error
 }
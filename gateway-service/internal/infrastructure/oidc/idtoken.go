@@ -0,0 +1,154 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+)
+
+// clockSkew is how far our clock may be off the provider's.
+const clockSkew = time.Minute
+
+// signingHashes are the algorithms ID tokens may be signed with. Unsigned tokens ("none")
+// and shared-secret ones (HS256) are refused.
+var signingHashes = map[string]crypto.Hash{
+	"RS256": crypto.SHA256,
+	"RS384": crypto.SHA384,
+	"RS512": crypto.SHA512,
+	"ES256": crypto.SHA256,
+	"ES384": crypto.SHA384,
+}
+
+// verifyIDToken checks the ID token's signature against the provider's keys and its claims
+// against this login, and returns the claims.
+func (p *provider) verifyIDToken(ctx context.Context, keys *keySet, raw, nonce string) (map[string]any, error) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 {
+		return nil, rejected("malformed ID token")
+	}
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, rejected("malformed ID token header")
+	}
+	hash, ok := signingHashes[header.Alg]
+	if !ok {
+		return nil, rejected(fmt.Sprintf("ID token signed with unsupported algorithm %q", header.Alg))
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, rejected("malformed ID token signature")
+	}
+
+	key, err := keys.key(ctx, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+	if !verifySignature(key, header.Alg, hash, parts[0]+"."+parts[1], sig) {
+		return nil, rejected("invalid ID token signature")
+	}
+
+	var claims map[string]any
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, rejected("malformed ID token claims")
+	}
+	if err := p.checkClaims(claims, nonce); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// checkClaims checks that the token was issued by our provider, to us, for this login and
+// is still fresh.
+func (p *provider) checkClaims(claims map[string]any, nonce string) error {
+	if iss, _ := claims["iss"].(string); iss != p.cfg.Issuer {
+		return rejected(fmt.Sprintf("ID token issued by %q", iss))
+	}
+	if sub, _ := claims["sub"].(string); sub == "" {
+		return rejected("ID token has no subject")
+	}
+
+	var audience []string
+	switch aud := claims["aud"].(type) {
+	case string:
+		audience = []string{aud}
+	case []any:
+		for _, a := range aud {
+			if s, ok := a.(string); ok {
+				audience = append(audience, s)
+			}
+		}
+	}
+	found := false
+	for _, aud := range audience {
+		found = found || aud == p.cfg.ClientID
+	}
+	if !found {
+		return rejected("ID token was issued to another client")
+	}
+	// The party the token was issued to, when named, must be us too
+	if azp, ok := claims["azp"].(string); ok && azp != p.cfg.ClientID {
+		return rejected("ID token was issued to another client")
+	}
+
+	now := p.now()
+	exp, ok := claims["exp"].(float64)
+	if !ok || now.After(time.Unix(int64(exp), 0).Add(clockSkew)) {
+		return rejected("ID token has expired")
+	}
+	if iat, ok := claims["iat"].(float64); ok && time.Unix(int64(iat), 0).After(now.Add(clockSkew)) {
+		return rejected("ID token was issued in the future")
+	}
+
+	// The nonce ties the token to the login this browser started, so it can't be replayed
+	got, _ := claims["nonce"].(string)
+	if nonce == "" || subtle.ConstantTimeCompare([]byte(got), []byte(nonce)) != 1 {
+		return rejected("ID token nonce doesn't match")
+	}
+	return nil
+}
+
+func verifySignature(key crypto.PublicKey, alg string, hash crypto.Hash, signed string, sig []byte) bool {
+	h := hash.New()
+	h.Write([]byte(signed))
+	digest := h.Sum(nil)
+
+	switch key := key.(type) {
+	case *rsa.PublicKey:
+		return strings.HasPrefix(alg, "RS") && rsa.VerifyPKCS1v15(key, hash, digest, sig) == nil
+	case *ecdsa.PublicKey:
+		size := (key.Curve.Params().BitSize + 7) / 8
+		if !strings.HasPrefix(alg, "ES") || len(sig) != 2*size {
+			return false
+		}
+		// JWS signatures are r and s side by side rather than ASN.1
+		r := new(big.Int).SetBytes(sig[:size])
+		s := new(big.Int).SetBytes(sig[size:])
+		return ecdsa.Verify(key, digest, r, s)
+	}
+	return false
+}
+
+func decodeSegment(seg string, v any) error {
+	b, err := base64.RawURLEncoding.DecodeString(seg)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func rejected(reason string) error {
+	return fmt.Errorf("%w: %s", domain.ErrSSORejected, reason)
+}
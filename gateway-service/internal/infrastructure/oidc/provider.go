@@ -0,0 +1,217 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	"golang.org/x/oauth2"
+)
+
+type Config struct {
+	// Issuer is the provider's issuer URL; its discovery document is under /.well-known.
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the gateway's callback URL as registered with the provider.
+	RedirectURL string
+	// Scopes are requested besides openid.
+	Scopes []string
+	// RolesClaim names the claim with the user's provider groups or roles; dots reach into
+	// nested objects, e.g. realm_access.roles.
+	RolesClaim string
+	// RoleMap maps the RolesClaim values to local roles; other values are ignored.
+	RoleMap map[string]string
+}
+
+// discovery is the part of the provider's OpenID configuration the login flow needs.
+type discovery struct {
+	Issuer                string   `json:"issuer"`
+	AuthorizationEndpoint string   `json:"authorization_endpoint"`
+	TokenEndpoint         string   `json:"token_endpoint"`
+	JWKSURI               string   `json:"jwks_uri"`
+	CodeChallengeMethods  []string `json:"code_challenge_methods_supported"`
+}
+
+type provider struct {
+	cfg    Config
+	client *http.Client
+	now    func() time.Time
+
+	mu     sync.Mutex
+	oauth2 *oauth2.Config // set once discovery succeeded
+	keys   *keySet
+}
+
+// NewProvider creates an identity provider client. Discovery happens on first use, so the
+// gateway starts even while the provider is unreachable.
+func NewProvider(cfg Config, client *http.Client) domain.IdentityProvider {
+	return &provider{cfg: cfg, client: client, now: time.Now}
+}
+
+// ParseRoleMap parses "group=role,..." pairs mapping provider groups to local roles.
+func ParseRoleMap(s string) (map[string]string, error) {
+	m := make(map[string]string)
+	for _, pair := range strings.Split(s, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		group, role, ok := strings.Cut(pair, "=")
+		group, role = strings.TrimSpace(group), strings.TrimSpace(role)
+		if !ok || group == "" || role == "" {
+			return nil, fmt.Errorf("invalid role mapping %q, want group=role", pair)
+		}
+		m[group] = role
+	}
+	return m, nil
+}
+
+// discover fetches the provider's OpenID configuration, once it succeeds.
+func (p *provider) discover(ctx context.Context) (*oauth2.Config, *keySet, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.oauth2 != nil {
+		return p.oauth2, p.keys, nil
+	}
+
+	var doc discovery
+	if err := getJSON(ctx, p.client, strings.TrimSuffix(p.cfg.Issuer, "/")+"/.well-known/openid-configuration", &doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to discover identity provider: %w", err)
+	}
+	// A document naming another issuer would let that issuer's tokens in
+	if doc.Issuer != p.cfg.Issuer {
+		return nil, nil, fmt.Errorf("identity provider claims issuer %q, want %q", doc.Issuer, p.cfg.Issuer)
+	}
+	if doc.AuthorizationEndpoint == "" || doc.TokenEndpoint == "" || doc.JWKSURI == "" {
+		return nil, nil, errors.New("identity provider discovery document lacks endpoints")
+	}
+	if len(doc.CodeChallengeMethods) > 0 && !slices.Contains(doc.CodeChallengeMethods, "S256") {
+		return nil, nil, errors.New("identity provider doesn't support PKCE with S256")
+	}
+
+	p.oauth2 = &oauth2.Config{
+		ClientID:     p.cfg.ClientID,
+		ClientSecret: p.cfg.ClientSecret,
+		RedirectURL:  p.cfg.RedirectURL,
+		Scopes:       append([]string{"openid"}, p.cfg.Scopes...),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  doc.AuthorizationEndpoint,
+			TokenURL: doc.TokenEndpoint,
+		},
+	}
+	p.keys = &keySet{uri: doc.JWKSURI, client: p.client, now: p.now}
+	return p.oauth2, p.keys, nil
+}
+
+func (p *provider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	conf, _, err := p.discover(ctx)
+	if err != nil {
+		return "", err
+	}
+	return conf.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier), oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+func (p *provider) Exchange(ctx context.Context, code, verifier, nonce string) (*domain.Identity, error) {
+	conf, keys, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := conf.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		var retrieveErr *oauth2.RetrieveError
+		if errors.As(err, &retrieveErr) && retrieveErr.Response.StatusCode < 500 {
+			return nil, fmt.Errorf("%w: %v", domain.ErrSSORejected, err)
+		}
+		return nil, fmt.Errorf("failed to redeem authorization code: %w", err)
+	}
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		return nil, fmt.Errorf("%w: token response has no ID token", domain.ErrSSORejected)
+	}
+
+	claims, err := p.verifyIDToken(ctx, keys, rawIDToken, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return p.identity(claims), nil
+}
+
+// identity maps verified ID token claims to the user they describe.
+func (p *provider) identity(claims map[string]any) *domain.Identity {
+	id := &domain.Identity{Issuer: p.cfg.Issuer}
+	id.Subject, _ = claims["sub"].(string)
+	id.Email, _ = claims["email"].(string)
+	// Some providers send the flag as a string
+	switch v := claims["email_verified"].(type) {
+	case bool:
+		id.EmailVerified = v
+	case string:
+		id.EmailVerified = v == "true"
+	}
+	id.Name, _ = claims["name"].(string)
+	if id.Name == "" {
+		id.Name, _ = claims["preferred_username"].(string)
+	}
+
+	for _, group := range claimStrings(claims, p.cfg.RolesClaim) {
+		if role, ok := p.cfg.RoleMap[group]; ok && !slices.Contains(id.Roles, role) {
+			id.Roles = append(id.Roles, role)
+		}
+	}
+	slices.Sort(id.Roles)
+	return id
+}
+
+// claimStrings returns the strings of the claim at a dotted path, which may hold one
+// string or a list of them.
+func claimStrings(claims map[string]any, path string) []string {
+	if path == "" {
+		return nil
+	}
+	var v any = claims
+	for _, name := range strings.Split(path, ".") {
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil
+		}
+		v = obj[name]
+	}
+
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var out []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: %s", url, resp.Status)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
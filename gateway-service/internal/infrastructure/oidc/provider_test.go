@@ -0,0 +1,201 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	"github.com/athandoan/youtube/gateway-service/internal/infrastructure/oidc/oidctest"
+)
+
+const testRedirectURL = "http://gateway.test/api/auth/oidc/callback"
+
+func newTestProvider(t *testing.T) (*provider, *oidctest.Server) {
+	idp := oidctest.NewServer("gotube", "secret")
+	t.Cleanup(idp.Close)
+	p := NewProvider(Config{
+		Issuer:       idp.Issuer(),
+		ClientID:     "gotube",
+		ClientSecret: "secret",
+		RedirectURL:  testRedirectURL,
+		Scopes:       []string{"email", "profile"},
+		RolesClaim:   "realm_access.roles",
+		RoleMap:      map[string]string{"video-admins": "admin", "video-mods": "moderator"},
+	}, idp.Client()).(*provider)
+	return p, idp
+}
+
+// authorize runs the browser's part of the login and returns the code the provider
+// redirected back with.
+func authorize(t *testing.T, p *provider, state, nonce, verifier string) string {
+	t.Helper()
+	authURL, err := p.AuthCodeURL(context.Background(), state, nonce, verifier)
+	if err != nil {
+		t.Fatalf("AuthCodeURL() error = %v", err)
+	}
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(authURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("authorize returned %s", resp.Status)
+	}
+	location, err := url.Parse(resp.Header.Get("Location"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := location.Query().Get("state"); got != state {
+		t.Fatalf("provider redirected back with state %q, want %q", got, state)
+	}
+	return location.Query().Get("code")
+}
+
+const testVerifier = "a-pkce-verifier-that-is-long-enough-0123456789"
+
+func TestProvider_Exchange(t *testing.T) {
+	p, idp := newTestProvider(t)
+	idp.SetClaims(map[string]any{
+		"sub":            "idp-user-7",
+		"email":          "ada@example.com",
+		"email_verified": true,
+		"name":           "Ada Lovelace",
+		"realm_access":   map[string]any{"roles": []string{"video-mods", "video-admins", "unrelated"}},
+	})
+
+	code := authorize(t, p, "state-1", "nonce-1", testVerifier)
+	identity, err := p.Exchange(context.Background(), code, testVerifier, "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange() error = %v", err)
+	}
+
+	want := &domain.Identity{
+		Issuer:        idp.Issuer(),
+		Subject:       "idp-user-7",
+		Email:         "ada@example.com",
+		EmailVerified: true,
+		Name:          "Ada Lovelace",
+		Roles:         []string{"admin", "moderator"},
+	}
+	if identity.Issuer != want.Issuer || identity.Subject != want.Subject || identity.Email != want.Email ||
+		identity.EmailVerified != want.EmailVerified || identity.Name != want.Name || !slices.Equal(identity.Roles, want.Roles) {
+		t.Errorf("Exchange() = %+v, want %+v", identity, want)
+	}
+
+	// Codes work once
+	if _, err := p.Exchange(context.Background(), code, testVerifier, "nonce-1"); !errors.Is(err, domain.ErrSSORejected) {
+		t.Errorf("Exchange() with a used code error = %v, want ErrSSORejected", err)
+	}
+}
+
+func TestProvider_ExchangeRejects(t *testing.T) {
+	tests := []struct {
+		name     string
+		claims   map[string]any
+		verifier string
+		nonce    string
+	}{
+		{name: "wrong PKCE verifier", verifier: "another-verifier-that-is-long-enough-0123456789"},
+		{name: "nonce of another login", nonce: "nonce-2"},
+		{name: "token for another client", claims: map[string]any{"aud": "someone-else"}},
+		{name: "token authorized for another party", claims: map[string]any{"aud": []string{"gotube", "someone-else"}, "azp": "someone-else"}},
+		{name: "token from another issuer", claims: map[string]any{"iss": "https://evil.example.com"}},
+		{name: "expired token", claims: map[string]any{"exp": time.Now().Add(-time.Hour).Unix()}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, idp := newTestProvider(t)
+			if tt.claims != nil {
+				idp.SetClaims(tt.claims)
+			}
+			verifier, nonce := testVerifier, "nonce-1"
+			if tt.verifier != "" {
+				verifier = tt.verifier
+			}
+			if tt.nonce != "" {
+				nonce = tt.nonce
+			}
+
+			code := authorize(t, p, "state-1", "nonce-1", testVerifier)
+			if _, err := p.Exchange(context.Background(), code, verifier, nonce); !errors.Is(err, domain.ErrSSORejected) {
+				t.Errorf("Exchange() error = %v, want ErrSSORejected", err)
+			}
+		})
+	}
+}
+
+func TestProvider_KeyRotation(t *testing.T) {
+	p, idp := newTestProvider(t)
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	// Tokens outlive the clock moving on past the key cache
+	idp.SetClaims(map[string]any{"exp": now.Add(2 * keyTTL).Unix()})
+	login := func() error {
+		code := authorize(t, p, "state", "nonce", testVerifier)
+		_, err := p.Exchange(context.Background(), code, testVerifier, "nonce")
+		return err
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := login(); err != nil {
+			t.Fatalf("login %d error = %v", i, err)
+		}
+	}
+	if got := idp.JWKSFetches(); got != 1 {
+		t.Errorf("keys fetched %d times for two logins, want them cached", got)
+	}
+
+	// A token signed with a key we don't know fetches the keys again, but not right after
+	// the last fetch
+	idp.RotateKey()
+	if err := login(); !errors.Is(err, domain.ErrSSORejected) {
+		t.Errorf("login with an unknown key right after a fetch error = %v, want ErrSSORejected", err)
+	}
+	now = now.Add(minRefresh)
+	if err := login(); err != nil {
+		t.Errorf("login after the provider rotated its key error = %v", err)
+	}
+	if got := idp.JWKSFetches(); got != 2 {
+		t.Errorf("keys fetched %d times, want once more after the rotation", got)
+	}
+
+	// Keys go stale eventually even when they keep working
+	now = now.Add(keyTTL)
+	if err := login(); err != nil {
+		t.Fatalf("login with stale keys error = %v", err)
+	}
+	if got := idp.JWKSFetches(); got != 3 {
+		t.Errorf("keys fetched %d times, want stale keys refreshed", got)
+	}
+}
+
+func TestProvider_DiscoveryChecksIssuer(t *testing.T) {
+	idp := oidctest.NewServer("gotube", "secret")
+	defer idp.Close()
+	// Same discovery URL, but not the issuer the provider names
+	p := NewProvider(Config{Issuer: idp.Issuer() + "/", ClientID: "gotube"}, idp.Client())
+
+	if _, err := p.AuthCodeURL(context.Background(), "state", "nonce", testVerifier); err == nil {
+		t.Error("AuthCodeURL() should fail when the provider names another issuer")
+	}
+}
+
+func TestParseRoleMap(t *testing.T) {
+	got, err := ParseRoleMap(" video-admins=admin, video-mods = moderator ,")
+	if err != nil {
+		t.Fatalf("ParseRoleMap() error = %v", err)
+	}
+	if len(got) != 2 || got["video-admins"] != "admin" || got["video-mods"] != "moderator" {
+		t.Errorf("ParseRoleMap() = %v", got)
+	}
+	if _, err := ParseRoleMap("video-admins"); err == nil {
+		t.Error("ParseRoleMap() should reject pairs without a role")
+	}
+}
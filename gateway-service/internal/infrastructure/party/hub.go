@@ -0,0 +1,155 @@
+package party
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+// sweepInterval is how often expired parties are dropped and their members disconnected.
+const sweepInterval = time.Minute
+
+type party struct {
+	domain.WatchParty
+	members map[chan domain.PlaybackState]struct{}
+}
+
+type memoryHub struct {
+	mu      sync.Mutex
+	parties map[string]*party
+	ttl     time.Duration
+	now     func() time.Time
+}
+
+// NewMemoryHub keeps parties in this gateway instance. A party expires ttl after it was
+// created or its leader last changed playback.
+func NewMemoryHub(ttl time.Duration) domain.PartyHub {
+	h := &memoryHub{parties: make(map[string]*party), ttl: ttl, now: time.Now}
+	go h.sweep()
+	return h
+}
+
+func (h *memoryHub) Create(videoID string) (*domain.WatchParty, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	now := h.now()
+	p := &party{
+		WatchParty: domain.WatchParty{
+			ID:          uuid.New().String(),
+			VideoID:     videoID,
+			LeaderToken: hex.EncodeToString(b),
+			State:       domain.PlaybackState{UpdatedAt: now},
+			ExpiresAt:   now.Add(h.ttl),
+		},
+		members: make(map[chan domain.PlaybackState]struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.parties[p.ID] = p
+	wp := p.WatchParty
+	return &wp, nil
+}
+
+func (h *memoryHub) Get(id string) (*domain.WatchParty, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	p, err := h.lookup(id)
+	if err != nil {
+		return nil, err
+	}
+	wp := p.WatchParty
+	return &wp, nil
+}
+
+func (h *memoryHub) SetState(id, token string, state domain.PlaybackState) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	p, err := h.lookup(id)
+	if err != nil {
+		return err
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(p.LeaderToken)) != 1 {
+		return domain.ErrNotPartyLeader
+	}
+
+	now := h.now()
+	state.UpdatedAt = now
+	p.State = state
+	p.ExpiresAt = now.Add(h.ttl)
+	for ch := range p.members {
+		send(ch, state)
+	}
+	return nil
+}
+
+func (h *memoryHub) Subscribe(id string) (<-chan domain.PlaybackState, func(), error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	p, err := h.lookup(id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Late joiners get the current state straight away
+	ch := make(chan domain.PlaybackState, 1)
+	ch <- p.State
+	p.members[ch] = struct{}{}
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			h.mu.Lock()
+			defer h.mu.Unlock()
+			if _, ok := p.members[ch]; ok {
+				delete(p.members, ch)
+				close(ch)
+			}
+		})
+	}, nil
+}
+
+// lookup returns a live party; h.mu must be held.
+func (h *memoryHub) lookup(id string) (*party, error) {
+	p, ok := h.parties[id]
+	if !ok || !h.now().Before(p.ExpiresAt) {
+		return nil, domain.ErrPartyNotFound
+	}
+	return p, nil
+}
+
+func (h *memoryHub) sweep() {
+	ticker := time.NewTicker(sweepInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		h.mu.Lock()
+		now := h.now()
+		for id, p := range h.parties {
+			if now.Before(p.ExpiresAt) {
+				continue
+			}
+			for ch := range p.members {
+				close(ch)
+			}
+			p.members = nil
+			delete(h.parties, id)
+		}
+		h.mu.Unlock()
+	}
+}
+
+// send replaces whatever state a member has not read yet; only the latest one matters.
+func send(ch chan domain.PlaybackState, state domain.PlaybackState) {
+	select {
+	case <-ch:
+	default:
+	}
+	ch <- state
+}
@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	analyticspb "github.com/athandoan/youtube/proto/analytics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type analyticsClient struct {
+	client analyticspb.AnalyticsServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewAnalyticsClient(addr string) (domain.AnalyticsService, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	client := analyticspb.NewAnalyticsServiceClient(conn)
+	return &analyticsClient{client: client, conn: conn}, nil
+}
+
+func (a *analyticsClient) IngestBeacons(ctx context.Context, beacons []*analyticspb.Beacon) (*analyticspb.IngestBeaconsResponse, error) {
+	return a.client.IngestBeacons(ctx, &analyticspb.IngestBeaconsRequest{Beacons: beacons})
+}
+
+func (a *analyticsClient) GetPlaybackStats(ctx context.Context, videoID, from, to string) (*analyticspb.GetPlaybackStatsResponse, error) {
+	return a.client.GetPlaybackStats(ctx, &analyticspb.GetPlaybackStatsRequest{
+		VideoId: videoID,
+		From:    from,
+		To:      to,
+	})
+}
@@ -0,0 +1,79 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	chatpb "github.com/athandoan/youtube/proto/chat"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type chatClient struct {
+	client chatpb.ChatServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewChatClient(addr string) (domain.ChatService, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	client := chatpb.NewChatServiceClient(conn)
+	return &chatClient{client: client, conn: conn}, nil
+}
+
+func (c *chatClient) PostMessage(ctx context.Context, videoID, userID, text string) (*chatpb.ChatMessage, error) {
+	return c.client.PostMessage(ctx, &chatpb.PostMessageRequest{VideoId: videoID, UserId: userID, Text: text})
+}
+
+func (c *chatClient) ListMessages(ctx context.Context, videoID, viewerID string, fromMs, toMs int64, limit int32) ([]*chatpb.ChatMessage, error) {
+	resp, err := c.client.ListMessages(ctx, &chatpb.ListMessagesRequest{
+		VideoId:      videoID,
+		FromOffsetMs: fromMs,
+		ToOffsetMs:   toMs,
+		Limit:        limit,
+		ViewerId:     viewerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Messages, nil
+}
+
+func (c *chatClient) Subscribe(ctx context.Context, videoID, viewerID string) (chatpb.ChatService_SubscribeClient, error) {
+	stream, err := c.client.Subscribe(ctx, &chatpb.SubscribeRequest{VideoId: videoID, ViewerId: viewerID})
+	if err != nil {
+		return nil, err
+	}
+	// The chat service sends headers once it has let the viewer in; a refusal ends the stream
+	// without any, and Recv reports it
+	md, err := stream.Header()
+	if err == nil && md == nil {
+		_, err = stream.Recv()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+func (c *chatClient) DeleteMessage(ctx context.Context, videoID, actorID string, messageID int64) error {
+	_, err := c.client.DeleteMessage(ctx, &chatpb.DeleteMessageRequest{VideoId: videoID, ActorId: actorID, MessageId: messageID})
+	return err
+}
+
+func (c *chatClient) BanUser(ctx context.Context, videoID, actorID, userID string, durationSeconds int32) error {
+	_, err := c.client.BanUser(ctx, &chatpb.BanUserRequest{VideoId: videoID, ActorId: actorID, UserId: userID, DurationSeconds: durationSeconds})
+	return err
+}
+
+func (c *chatClient) SetSlowMode(ctx context.Context, videoID, actorID string, seconds int32) error {
+	_, err := c.client.SetSlowMode(ctx, &chatpb.SetSlowModeRequest{VideoId: videoID, ActorId: actorID, Seconds: seconds})
+	return err
+}
+
+func (c *chatClient) AddModerator(ctx context.Context, videoID, actorID, userID string) error {
+	_, err := c.client.AddModerator(ctx, &chatpb.AddModeratorRequest{VideoId: videoID, ActorId: actorID, UserId: userID})
+	return err
+}
@@ -24,10 +24,245 @@ func NewMetadataClient(addr string) (domain.MetadataService, error) {
 	return &metadataClient{client: client, conn: conn}, nil
 }
 
-func (m *metadataClient) ListVideos(ctx context.Context, query string) ([]*common.Video, error) {
-	resp, err := m.client.ListVideos(ctx, &metadatapb.ListVideosRequest{Query: query})
+func (m *metadataClient) ListVideos(ctx context.Context, query, tag, category string) ([]*common.Video, error) {
+	resp, err := m.client.ListVideos(ctx, &metadatapb.ListVideosRequest{Query: query, Tag: tag, Category: category})
 	if err != nil {
 		return nil, err
 	}
 	return resp.Videos, nil
 }
+
+func (m *metadataClient) CreateStreamKey(ctx context.Context, channelID, title string) (string, error) {
+	resp, err := m.client.CreateStreamKey(ctx, &metadatapb.CreateStreamKeyRequest{ChannelId: channelID, Title: title})
+	if err != nil {
+		return "", err
+	}
+	return resp.StreamKey, nil
+}
+
+func (m *metadataClient) GetVideo(ctx context.Context, id, userID string) (*common.Video, error) {
+	return m.client.GetVideo(ctx, &metadatapb.GetVideoRequest{Id: id, Viewer: &metadatapb.Viewer{UserId: userID}})
+}
+
+func (m *metadataClient) UpdateVideo(ctx context.Context, req *metadatapb.UpdateVideoRequest) (*common.Video, error) {
+	return m.client.UpdateVideo(ctx, req)
+}
+
+func (m *metadataClient) GrantVideoAccess(ctx context.Context, id, userID, granteeID string) error {
+	_, err := m.client.GrantVideoAccess(ctx, &metadatapb.VideoAccessRequest{Id: id, UserId: userID, GranteeId: granteeID})
+	return err
+}
+
+func (m *metadataClient) RevokeVideoAccess(ctx context.Context, id, userID, granteeID string) error {
+	_, err := m.client.RevokeVideoAccess(ctx, &metadatapb.VideoAccessRequest{Id: id, UserId: userID, GranteeId: granteeID})
+	return err
+}
+
+func (m *metadataClient) ListVideoAccess(ctx context.Context, id, userID string) ([]string, error) {
+	resp, err := m.client.ListVideoAccess(ctx, &metadatapb.ListVideoAccessRequest{Id: id, UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.UserIds, nil
+}
+
+func (m *metadataClient) CreateShareLink(ctx context.Context, id, userID, expiresAt string, maxViews int32, password string) (*metadatapb.ShareLink, error) {
+	return m.client.CreateShareLink(ctx, &metadatapb.CreateShareLinkRequest{
+		Id:        id,
+		UserId:    userID,
+		ExpiresAt: expiresAt,
+		MaxViews:  maxViews,
+		Password:  password,
+	})
+}
+
+func (m *metadataClient) ListShareLinks(ctx context.Context, id, userID string) ([]*metadatapb.ShareLink, error) {
+	resp, err := m.client.ListShareLinks(ctx, &metadatapb.ListShareLinksRequest{Id: id, UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Links, nil
+}
+
+func (m *metadataClient) RevokeShareLink(ctx context.Context, id, userID, token string) error {
+	_, err := m.client.RevokeShareLink(ctx, &metadatapb.RevokeShareLinkRequest{Id: id, UserId: userID, Token: token})
+	return err
+}
+
+func (m *metadataClient) DeleteVideo(ctx context.Context, id, userID string) error {
+	_, err := m.client.DeleteVideo(ctx, &metadatapb.DeleteVideoRequest{Id: id, UserId: userID})
+	return err
+}
+
+func (m *metadataClient) RestoreVideo(ctx context.Context, id, userID string) error {
+	_, err := m.client.RestoreVideo(ctx, &metadatapb.RestoreVideoRequest{Id: id, UserId: userID})
+	return err
+}
+
+func (m *metadataClient) UnpublishVideo(ctx context.Context, id, userID string) error {
+	_, err := m.client.UnpublishVideo(ctx, &metadatapb.UnpublishVideoRequest{Id: id, UserId: userID})
+	return err
+}
+
+func (m *metadataClient) ListTrash(ctx context.Context, userID string) ([]*common.Video, error) {
+	resp, err := m.client.ListTrash(ctx, &metadatapb.ListTrashRequest{UserId: userID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Videos, nil
+}
+
+func (m *metadataClient) ListChannelVideos(ctx context.Context, channelID string) ([]*common.Video, error) {
+	resp, err := m.client.ListVideos(ctx, &metadatapb.ListVideosRequest{ChannelId: channelID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Videos, nil
+}
+
+func (m *metadataClient) CreateChannel(ctx context.Context, handle, displayName, description string) (*metadatapb.Channel, error) {
+	return m.client.CreateChannel(ctx, &metadatapb.CreateChannelRequest{Handle: handle, DisplayName: displayName, Description: description})
+}
+
+func (m *metadataClient) GetChannel(ctx context.Context, handle string) (*metadatapb.Channel, error) {
+	return m.client.GetChannel(ctx, &metadatapb.GetChannelRequest{Handle: handle})
+}
+
+func (m *metadataClient) GetChannelByID(ctx context.Context, id string) (*metadatapb.Channel, error) {
+	return m.client.GetChannel(ctx, &metadatapb.GetChannelRequest{Id: id})
+}
+
+func (m *metadataClient) UpdateChannel(ctx context.Context, req *metadatapb.UpdateChannelRequest) (*metadatapb.Channel, error) {
+	return m.client.UpdateChannel(ctx, req)
+}
+
+func (m *metadataClient) DeleteChannel(ctx context.Context, id string) error {
+	_, err := m.client.DeleteChannel(ctx, &metadatapb.DeleteChannelRequest{Id: id})
+	return err
+}
+
+func (m *metadataClient) AddChannelMember(ctx context.Context, id, memberID string) error {
+	_, err := m.client.AddChannelMember(ctx, &metadatapb.ChannelMemberRequest{Id: id, MemberId: memberID})
+	return err
+}
+
+func (m *metadataClient) RemoveChannelMember(ctx context.Context, id, memberID string) error {
+	_, err := m.client.RemoveChannelMember(ctx, &metadatapb.ChannelMemberRequest{Id: id, MemberId: memberID})
+	return err
+}
+
+func (m *metadataClient) Subscribe(ctx context.Context, channelID string) error {
+	_, err := m.client.Subscribe(ctx, &metadatapb.SubscriptionRequest{Id: channelID})
+	return err
+}
+
+func (m *metadataClient) Unsubscribe(ctx context.Context, channelID string) error {
+	_, err := m.client.Unsubscribe(ctx, &metadatapb.SubscriptionRequest{Id: channelID})
+	return err
+}
+
+func (m *metadataClient) ListSubscriptions(ctx context.Context) ([]*metadatapb.Channel, error) {
+	resp, err := m.client.ListSubscriptions(ctx, &metadatapb.ListSubscriptionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Channels, nil
+}
+
+func (m *metadataClient) GetSubscriptionFeed(ctx context.Context, pageToken string, pageSize int32) ([]*common.Video, string, error) {
+	resp, err := m.client.GetSubscriptionFeed(ctx, &metadatapb.GetSubscriptionFeedRequest{PageToken: pageToken, PageSize: pageSize})
+	if err != nil {
+		return nil, "", err
+	}
+	return resp.Videos, resp.NextPageToken, nil
+}
+
+func (m *metadataClient) CreatePlaylist(ctx context.Context, title, description, visibility string) (*metadatapb.Playlist, error) {
+	return m.client.CreatePlaylist(ctx, &metadatapb.CreatePlaylistRequest{Title: title, Description: description, Visibility: visibility})
+}
+
+func (m *metadataClient) GetPlaylist(ctx context.Context, id string) (*metadatapb.Playlist, error) {
+	return m.client.GetPlaylist(ctx, &metadatapb.GetPlaylistRequest{Id: id})
+}
+
+func (m *metadataClient) ListPlaylists(ctx context.Context, ownerID string) ([]*metadatapb.Playlist, error) {
+	resp, err := m.client.ListPlaylists(ctx, &metadatapb.ListPlaylistsRequest{OwnerId: ownerID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Playlists, nil
+}
+
+func (m *metadataClient) UpdatePlaylist(ctx context.Context, req *metadatapb.UpdatePlaylistRequest) (*metadatapb.Playlist, error) {
+	return m.client.UpdatePlaylist(ctx, req)
+}
+
+func (m *metadataClient) DeletePlaylist(ctx context.Context, id string) error {
+	_, err := m.client.DeletePlaylist(ctx, &metadatapb.DeletePlaylistRequest{Id: id})
+	return err
+}
+
+func (m *metadataClient) AddPlaylistItem(ctx context.Context, id, videoID string) (*metadatapb.PlaylistItem, error) {
+	return m.client.AddPlaylistItem(ctx, &metadatapb.AddPlaylistItemRequest{Id: id, VideoId: videoID})
+}
+
+func (m *metadataClient) RemovePlaylistItem(ctx context.Context, id, itemID string) error {
+	_, err := m.client.RemovePlaylistItem(ctx, &metadatapb.RemovePlaylistItemRequest{Id: id, ItemId: itemID})
+	return err
+}
+
+func (m *metadataClient) MovePlaylistItem(ctx context.Context, id, itemID, afterItemID string) (*metadatapb.PlaylistItem, error) {
+	return m.client.MovePlaylistItem(ctx, &metadatapb.MovePlaylistItemRequest{Id: id, ItemId: itemID, AfterItemId: afterItemID})
+}
+
+func (m *metadataClient) AddPlaylistCollaborator(ctx context.Context, id, userID string) error {
+	_, err := m.client.AddPlaylistCollaborator(ctx, &metadatapb.PlaylistCollaboratorRequest{Id: id, CollaboratorId: userID})
+	return err
+}
+
+func (m *metadataClient) RemovePlaylistCollaborator(ctx context.Context, id, userID string) error {
+	_, err := m.client.RemovePlaylistCollaborator(ctx, &metadatapb.PlaylistCollaboratorRequest{Id: id, CollaboratorId: userID})
+	return err
+}
+
+func (m *metadataClient) CreateSeries(ctx context.Context, title, description string) (*metadatapb.Series, error) {
+	return m.client.CreateSeries(ctx, &metadatapb.CreateSeriesRequest{Title: title, Description: description})
+}
+
+func (m *metadataClient) GetSeries(ctx context.Context, id string) (*metadatapb.Series, error) {
+	return m.client.GetSeries(ctx, &metadatapb.GetSeriesRequest{Id: id})
+}
+
+func (m *metadataClient) ListSeries(ctx context.Context, ownerID string) ([]*metadatapb.Series, error) {
+	resp, err := m.client.ListSeries(ctx, &metadatapb.ListSeriesRequest{OwnerId: ownerID})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Series, nil
+}
+
+func (m *metadataClient) UpdateSeries(ctx context.Context, req *metadatapb.UpdateSeriesRequest) (*metadatapb.Series, error) {
+	return m.client.UpdateSeries(ctx, req)
+}
+
+func (m *metadataClient) DeleteSeries(ctx context.Context, id string) error {
+	_, err := m.client.DeleteSeries(ctx, &metadatapb.DeleteSeriesRequest{Id: id})
+	return err
+}
+
+func (m *metadataClient) SetEpisode(ctx context.Context, id, videoID string, season, episode int32) (*metadatapb.Series, error) {
+	return m.client.SetEpisode(ctx, &metadatapb.SetEpisodeRequest{Id: id, VideoId: videoID, Season: season, Episode: episode})
+}
+
+func (m *metadataClient) RemoveEpisode(ctx context.Context, id, videoID string) (*metadatapb.Series, error) {
+	return m.client.RemoveEpisode(ctx, &metadatapb.RemoveEpisodeRequest{Id: id, VideoId: videoID})
+}
+
+func (m *metadataClient) GetNextEpisode(ctx context.Context, id, afterVideoID string) (*common.Video, error) {
+	return m.client.GetNextEpisode(ctx, &metadatapb.GetNextEpisodeRequest{Id: id, AfterVideoId: afterVideoID})
+}
+
+func (m *metadataClient) SetEpisodeCompleted(ctx context.Context, id, videoID string, completed bool) error {
+	_, err := m.client.SetEpisodeCompleted(ctx, &metadatapb.SetEpisodeCompletedRequest{Id: id, VideoId: videoID, Completed: completed})
+	return err
+}
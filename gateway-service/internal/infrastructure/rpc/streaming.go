@@ -23,12 +23,52 @@ func NewStreamingClient(addr string) (domain.StreamingService, error) {
 	return &streamingClient{client: client, conn: conn}, nil
 }
 
-func (s *streamingClient) GetStreamURL(ctx context.Context, videoID string) (string, error) {
+func (s *streamingClient) GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*streamingpb.GetStreamURLResponse, error) {
+	return s.client.GetStreamURL(ctx, &streamingpb.GetStreamURLRequest{
+		VideoId:   videoID,
+		Rendition: rendition,
+		Region:    region,
+		UserId:    userID,
+	})
+}
+
+func (s *streamingClient) GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error) {
 	resp, err := s.client.GetStreamURL(ctx, &streamingpb.GetStreamURLRequest{
+		Rendition:     rendition,
+		Region:        region,
+		ShareToken:    token,
+		SharePassword: password,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return resp.Url, resp.VideoId, nil
+}
+
+func (s *streamingClient) GetVideoMedia(ctx context.Context, videoID, userID string) (*streamingpb.GetVideoMediaResponse, error) {
+	return s.client.GetVideoMedia(ctx, &streamingpb.GetVideoMediaRequest{VideoId: videoID, UserId: userID})
+}
+
+func (s *streamingClient) GetDownloadURL(ctx context.Context, videoID, userID string) (string, error) {
+	resp, err := s.client.GetDownloadURL(ctx, &streamingpb.GetDownloadURLRequest{
 		VideoId: videoID,
+		UserId:  userID,
 	})
 	if err != nil {
 		return "", err
 	}
 	return resp.Url, nil
 }
+
+func (s *streamingClient) InvalidateStreamURL(ctx context.Context, videoID string) error {
+	_, err := s.client.InvalidateStreamURL(ctx, &streamingpb.InvalidateStreamURLRequest{VideoId: videoID})
+	return err
+}
+
+func (s *streamingClient) GetChannelImageURL(ctx context.Context, channelID, kind string) (string, error) {
+	resp, err := s.client.GetChannelImageURL(ctx, &streamingpb.GetChannelImageURLRequest{ChannelId: channelID, Kind: kind})
+	if err != nil {
+		return "", err
+	}
+	return resp.Url, nil
+}
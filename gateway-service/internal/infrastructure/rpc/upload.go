@@ -23,10 +23,14 @@ func NewUploadClient(addr string) (domain.UploadService, error) {
 	return &uploadClient{client: client, conn: conn}, nil
 }
 
-func (u *uploadClient) InitUpload(ctx context.Context, title, filename string) (string, string, error) {
+func (u *uploadClient) InitUpload(ctx context.Context, title, filename, channelID string, allowDownload bool, publishAt string, premiere bool) (string, string, error) {
 	resp, err := u.client.InitUpload(ctx, &uploadpb.InitUploadRequest{
-		Title:    title,
-		Filename: filename,
+		Title:         title,
+		Filename:      filename,
+		ChannelId:     channelID,
+		AllowDownload: allowDownload,
+		PublishAt:     publishAt,
+		Premiere:      premiere,
 	})
 	if err != nil {
 		return "", "", err
@@ -40,3 +44,15 @@ func (u *uploadClient) CompleteUpload(ctx context.Context, videoID string) error
 	})
 	return err
 }
+
+func (u *uploadClient) InitChannelImageUpload(ctx context.Context, channelID, kind, filename string) (string, error) {
+	resp, err := u.client.InitChannelImageUpload(ctx, &uploadpb.InitChannelImageUploadRequest{
+		ChannelId: channelID,
+		Kind:      kind,
+		Filename:  filename,
+	})
+	if err != nil {
+		return "", err
+	}
+	return resp.PresignedUrl, nil
+}
@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type userClient struct {
+	client userpb.UserServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewUserClient(addr string) (domain.UserService, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	client := userpb.NewUserServiceClient(conn)
+	return &userClient{client: client, conn: conn}, nil
+}
+
+func (c *userClient) Signup(ctx context.Context, email, password, displayName string) (*userpb.Session, error) {
+	return c.client.Signup(ctx, &userpb.SignupRequest{Email: email, Password: password, DisplayName: displayName})
+}
+
+func (c *userClient) Login(ctx context.Context, email, password string) (*userpb.Session, error) {
+	return c.client.Login(ctx, &userpb.LoginRequest{Email: email, Password: password})
+}
+
+func (c *userClient) Logout(ctx context.Context, token string) error {
+	_, err := c.client.Logout(ctx, &userpb.LogoutRequest{Token: token})
+	return err
+}
+
+func (c *userClient) Authenticate(ctx context.Context, token string) (*userpb.User, error) {
+	return c.client.Authenticate(ctx, &userpb.AuthenticateRequest{Token: token})
+}
+
+func (c *userClient) GetUser(ctx context.Context, id string) (*userpb.User, error) {
+	return c.client.GetUser(ctx, &userpb.GetUserRequest{Id: id})
+}
+
+func (c *userClient) RequestPasswordReset(ctx context.Context, email string) error {
+	_, err := c.client.RequestPasswordReset(ctx, &userpb.RequestPasswordResetRequest{Email: email})
+	return err
+}
+
+func (c *userClient) ResetPassword(ctx context.Context, token, password string) error {
+	_, err := c.client.ResetPassword(ctx, &userpb.ResetPasswordRequest{Token: token, Password: password})
+	return err
+}
+
+func (c *userClient) LoginExternal(ctx context.Context, identity *domain.Identity) (*userpb.Session, error) {
+	return c.client.LoginExternal(ctx, &userpb.LoginExternalRequest{
+		Issuer:        identity.Issuer,
+		Subject:       identity.Subject,
+		Email:         identity.Email,
+		EmailVerified: identity.EmailVerified,
+		DisplayName:   identity.Name,
+		Roles:         identity.Roles,
+	})
+}
+
+func (c *userClient) CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (*userpb.CreateAPIKeyResponse, error) {
+	return c.client.CreateAPIKey(ctx, &userpb.CreateAPIKeyRequest{UserId: userID, Name: name, Scopes: scopes})
+}
+
+func (c *userClient) ListAPIKeys(ctx context.Context, userID string, all bool) ([]*userpb.APIKey, error) {
+	resp, err := c.client.ListAPIKeys(ctx, &userpb.ListAPIKeysRequest{UserId: userID, All: all})
+	if err != nil {
+		return nil, err
+	}
+	return resp.ApiKeys, nil
+}
+
+func (c *userClient) RevokeAPIKey(ctx context.Context, id, userID string, any bool) error {
+	_, err := c.client.RevokeAPIKey(ctx, &userpb.RevokeAPIKeyRequest{Id: id, UserId: userID, Any: any})
+	return err
+}
+
+func (c *userClient) AuthenticateAPIKey(ctx context.Context, key string) (*userpb.AuthenticateAPIKeyResponse, error) {
+	return c.client.AuthenticateAPIKey(ctx, &userpb.AuthenticateAPIKeyRequest{Key: key})
+}
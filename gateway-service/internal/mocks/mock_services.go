@@ -13,11 +13,101 @@ import (
 	context "context"
 	reflect "reflect"
 
+	domain "github.com/athandoan/youtube/gateway-service/internal/domain"
+	analytics "github.com/athandoan/youtube/proto/analytics"
+	chat "github.com/athandoan/youtube/proto/chat"
 	common "github.com/athandoan/youtube/proto/common"
+	metadata "github.com/athandoan/youtube/proto/metadata"
+	streaming "github.com/athandoan/youtube/proto/streaming"
 	upload "github.com/athandoan/youtube/proto/upload"
+	user "github.com/athandoan/youtube/proto/user"
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockPartyHub is a mock of PartyHub interface.
+type MockPartyHub struct {
+	ctrl     *gomock.Controller
+	recorder *MockPartyHubMockRecorder
+	isgomock struct{}
+}
+
+// MockPartyHubMockRecorder is the mock recorder for MockPartyHub.
+type MockPartyHubMockRecorder struct {
+	mock *MockPartyHub
+}
+
+// NewMockPartyHub creates a new mock instance.
+func NewMockPartyHub(ctrl *gomock.Controller) *MockPartyHub {
+	mock := &MockPartyHub{ctrl: ctrl}
+	mock.recorder = &MockPartyHubMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPartyHub) EXPECT() *MockPartyHubMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPartyHub) Create(videoID string) (*domain.WatchParty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", videoID)
+	ret0, _ := ret[0].(*domain.WatchParty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPartyHubMockRecorder) Create(videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPartyHub)(nil).Create), videoID)
+}
+
+// Get mocks base method.
+func (m *MockPartyHub) Get(id string) (*domain.WatchParty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", id)
+	ret0, _ := ret[0].(*domain.WatchParty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPartyHubMockRecorder) Get(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPartyHub)(nil).Get), id)
+}
+
+// SetState mocks base method.
+func (m *MockPartyHub) SetState(id, token string, state domain.PlaybackState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetState", id, token, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetState indicates an expected call of SetState.
+func (mr *MockPartyHubMockRecorder) SetState(id, token, state any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetState", reflect.TypeOf((*MockPartyHub)(nil).SetState), id, token, state)
+}
+
+// Subscribe mocks base method.
+func (m *MockPartyHub) Subscribe(id string) (<-chan domain.PlaybackState, func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", id)
+	ret0, _ := ret[0].(<-chan domain.PlaybackState)
+	ret1, _ := ret[1].(func())
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockPartyHubMockRecorder) Subscribe(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockPartyHub)(nil).Subscribe), id)
+}
+
 // MockMetadataService is a mock of MetadataService interface.
 type MockMetadataService struct {
 	ctrl     *gomock.Controller
@@ -42,194 +132,2494 @@ func (m *MockMetadataService) EXPECT() *MockMetadataServiceMockRecorder {
 	return m.recorder
 }
 
-// ListVideos mocks base method.
-func (m *MockMetadataService) ListVideos(ctx context.Context, query string) ([]*common.Video, error) {
+// AddChannelMember mocks base method.
+func (m *MockMetadataService) AddChannelMember(ctx context.Context, id, memberID string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVideos", ctx, query)
-	ret0, _ := ret[0].([]*common.Video)
+	ret := m.ctrl.Call(m, "AddChannelMember", ctx, id, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChannelMember indicates an expected call of AddChannelMember.
+func (mr *MockMetadataServiceMockRecorder) AddChannelMember(ctx, id, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChannelMember", reflect.TypeOf((*MockMetadataService)(nil).AddChannelMember), ctx, id, memberID)
+}
+
+// AddPlaylistCollaborator mocks base method.
+func (m *MockMetadataService) AddPlaylistCollaborator(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlaylistCollaborator", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPlaylistCollaborator indicates an expected call of AddPlaylistCollaborator.
+func (mr *MockMetadataServiceMockRecorder) AddPlaylistCollaborator(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlaylistCollaborator", reflect.TypeOf((*MockMetadataService)(nil).AddPlaylistCollaborator), ctx, id, userID)
+}
+
+// AddPlaylistItem mocks base method.
+func (m *MockMetadataService) AddPlaylistItem(ctx context.Context, id, videoID string) (*metadata.PlaylistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlaylistItem", ctx, id, videoID)
+	ret0, _ := ret[0].(*metadata.PlaylistItem)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVideos indicates an expected call of ListVideos.
-func (mr *MockMetadataServiceMockRecorder) ListVideos(ctx, query any) *gomock.Call {
+// AddPlaylistItem indicates an expected call of AddPlaylistItem.
+func (mr *MockMetadataServiceMockRecorder) AddPlaylistItem(ctx, id, videoID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideos", reflect.TypeOf((*MockMetadataService)(nil).ListVideos), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlaylistItem", reflect.TypeOf((*MockMetadataService)(nil).AddPlaylistItem), ctx, id, videoID)
 }
 
-// MockUploadService is a mock of UploadService interface.
-type MockUploadService struct {
-	ctrl     *gomock.Controller
-	recorder *MockUploadServiceMockRecorder
-	isgomock struct{}
+// CreateChannel mocks base method.
+func (m *MockMetadataService) CreateChannel(ctx context.Context, handle, displayName, description string) (*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannel", ctx, handle, displayName, description)
+	ret0, _ := ret[0].(*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockUploadServiceMockRecorder is the mock recorder for MockUploadService.
-type MockUploadServiceMockRecorder struct {
-	mock *MockUploadService
+// CreateChannel indicates an expected call of CreateChannel.
+func (mr *MockMetadataServiceMockRecorder) CreateChannel(ctx, handle, displayName, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockMetadataService)(nil).CreateChannel), ctx, handle, displayName, description)
 }
 
-// NewMockUploadService creates a new mock instance.
-func NewMockUploadService(ctrl *gomock.Controller) *MockUploadService {
-	mock := &MockUploadService{ctrl: ctrl}
-	mock.recorder = &MockUploadServiceMockRecorder{mock}
-	return mock
+// CreatePlaylist mocks base method.
+func (m *MockMetadataService) CreatePlaylist(ctx context.Context, title, description, visibility string) (*metadata.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlaylist", ctx, title, description, visibility)
+	ret0, _ := ret[0].(*metadata.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockUploadService) EXPECT() *MockUploadServiceMockRecorder {
-	return m.recorder
+// CreatePlaylist indicates an expected call of CreatePlaylist.
+func (mr *MockMetadataServiceMockRecorder) CreatePlaylist(ctx, title, description, visibility any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockMetadataService)(nil).CreatePlaylist), ctx, title, description, visibility)
 }
 
-// CompleteUpload mocks base method.
-func (m *MockUploadService) CompleteUpload(ctx context.Context, videoID string) error {
+// CreateSeries mocks base method.
+func (m *MockMetadataService) CreateSeries(ctx context.Context, title, description string) (*metadata.Series, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompleteUpload", ctx, videoID)
+	ret := m.ctrl.Call(m, "CreateSeries", ctx, title, description)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockMetadataServiceMockRecorder) CreateSeries(ctx, title, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockMetadataService)(nil).CreateSeries), ctx, title, description)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockMetadataService) CreateShareLink(ctx context.Context, id, userID, expiresAt string, maxViews int32, password string) (*metadata.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", ctx, id, userID, expiresAt, maxViews, password)
+	ret0, _ := ret[0].(*metadata.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockMetadataServiceMockRecorder) CreateShareLink(ctx, id, userID, expiresAt, maxViews, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockMetadataService)(nil).CreateShareLink), ctx, id, userID, expiresAt, maxViews, password)
+}
+
+// CreateStreamKey mocks base method.
+func (m *MockMetadataService) CreateStreamKey(ctx context.Context, channelID, title string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStreamKey", ctx, channelID, title)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStreamKey indicates an expected call of CreateStreamKey.
+func (mr *MockMetadataServiceMockRecorder) CreateStreamKey(ctx, channelID, title any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreamKey", reflect.TypeOf((*MockMetadataService)(nil).CreateStreamKey), ctx, channelID, title)
+}
+
+// DeleteChannel mocks base method.
+func (m *MockMetadataService) DeleteChannel(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannel", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// CompleteUpload indicates an expected call of CompleteUpload.
-func (mr *MockUploadServiceMockRecorder) CompleteUpload(ctx, videoID any) *gomock.Call {
+// DeleteChannel indicates an expected call of DeleteChannel.
+func (mr *MockMetadataServiceMockRecorder) DeleteChannel(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockUploadService)(nil).CompleteUpload), ctx, videoID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockMetadataService)(nil).DeleteChannel), ctx, id)
 }
 
-// InitUpload mocks base method.
-func (m *MockUploadService) InitUpload(ctx context.Context, title, filename string) (string, string, error) {
+// DeletePlaylist mocks base method.
+func (m *MockMetadataService) DeletePlaylist(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitUpload", ctx, title, filename)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "DeletePlaylist", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlaylist indicates an expected call of DeletePlaylist.
+func (mr *MockMetadataServiceMockRecorder) DeletePlaylist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlaylist", reflect.TypeOf((*MockMetadataService)(nil).DeletePlaylist), ctx, id)
+}
+
+// DeleteSeries mocks base method.
+func (m *MockMetadataService) DeleteSeries(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockMetadataServiceMockRecorder) DeleteSeries(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockMetadataService)(nil).DeleteSeries), ctx, id)
+}
+
+// DeleteVideo mocks base method.
+func (m *MockMetadataService) DeleteVideo(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVideo", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVideo indicates an expected call of DeleteVideo.
+func (mr *MockMetadataServiceMockRecorder) DeleteVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVideo", reflect.TypeOf((*MockMetadataService)(nil).DeleteVideo), ctx, id, userID)
+}
+
+// GetChannel mocks base method.
+func (m *MockMetadataService) GetChannel(ctx context.Context, handle string) (*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannel", ctx, handle)
+	ret0, _ := ret[0].(*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannel indicates an expected call of GetChannel.
+func (mr *MockMetadataServiceMockRecorder) GetChannel(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockMetadataService)(nil).GetChannel), ctx, handle)
+}
+
+// GetChannelByID mocks base method.
+func (m *MockMetadataService) GetChannelByID(ctx context.Context, id string) (*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelByID", ctx, id)
+	ret0, _ := ret[0].(*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelByID indicates an expected call of GetChannelByID.
+func (mr *MockMetadataServiceMockRecorder) GetChannelByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelByID", reflect.TypeOf((*MockMetadataService)(nil).GetChannelByID), ctx, id)
+}
+
+// GetNextEpisode mocks base method.
+func (m *MockMetadataService) GetNextEpisode(ctx context.Context, id, afterVideoID string) (*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextEpisode", ctx, id, afterVideoID)
+	ret0, _ := ret[0].(*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextEpisode indicates an expected call of GetNextEpisode.
+func (mr *MockMetadataServiceMockRecorder) GetNextEpisode(ctx, id, afterVideoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextEpisode", reflect.TypeOf((*MockMetadataService)(nil).GetNextEpisode), ctx, id, afterVideoID)
+}
+
+// GetPlaylist mocks base method.
+func (m *MockMetadataService) GetPlaylist(ctx context.Context, id string) (*metadata.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylist", ctx, id)
+	ret0, _ := ret[0].(*metadata.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylist indicates an expected call of GetPlaylist.
+func (mr *MockMetadataServiceMockRecorder) GetPlaylist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylist", reflect.TypeOf((*MockMetadataService)(nil).GetPlaylist), ctx, id)
+}
+
+// GetSeries mocks base method.
+func (m *MockMetadataService) GetSeries(ctx context.Context, id string) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeries", ctx, id)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeries indicates an expected call of GetSeries.
+func (mr *MockMetadataServiceMockRecorder) GetSeries(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeries", reflect.TypeOf((*MockMetadataService)(nil).GetSeries), ctx, id)
+}
+
+// GetSubscriptionFeed mocks base method.
+func (m *MockMetadataService) GetSubscriptionFeed(ctx context.Context, pageToken string, pageSize int32) ([]*common.Video, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionFeed", ctx, pageToken, pageSize)
+	ret0, _ := ret[0].([]*common.Video)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// InitUpload indicates an expected call of InitUpload.
-func (mr *MockUploadServiceMockRecorder) InitUpload(ctx, title, filename any) *gomock.Call {
+// GetSubscriptionFeed indicates an expected call of GetSubscriptionFeed.
+func (mr *MockMetadataServiceMockRecorder) GetSubscriptionFeed(ctx, pageToken, pageSize any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitUpload", reflect.TypeOf((*MockUploadService)(nil).InitUpload), ctx, title, filename)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionFeed", reflect.TypeOf((*MockMetadataService)(nil).GetSubscriptionFeed), ctx, pageToken, pageSize)
 }
 
-// MockStreamingService is a mock of StreamingService interface.
-type MockStreamingService struct {
-	ctrl     *gomock.Controller
-	recorder *MockStreamingServiceMockRecorder
-	isgomock struct{}
+// GetVideo mocks base method.
+func (m *MockMetadataService) GetVideo(ctx context.Context, id, userID string) (*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideo", ctx, id, userID)
+	ret0, _ := ret[0].(*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockStreamingServiceMockRecorder is the mock recorder for MockStreamingService.
-type MockStreamingServiceMockRecorder struct {
-	mock *MockStreamingService
+// GetVideo indicates an expected call of GetVideo.
+func (mr *MockMetadataServiceMockRecorder) GetVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockMetadataService)(nil).GetVideo), ctx, id, userID)
 }
 
-// NewMockStreamingService creates a new mock instance.
-func NewMockStreamingService(ctrl *gomock.Controller) *MockStreamingService {
-	mock := &MockStreamingService{ctrl: ctrl}
-	mock.recorder = &MockStreamingServiceMockRecorder{mock}
-	return mock
+// GrantVideoAccess mocks base method.
+func (m *MockMetadataService) GrantVideoAccess(ctx context.Context, id, userID, granteeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantVideoAccess", ctx, id, userID, granteeID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockStreamingService) EXPECT() *MockStreamingServiceMockRecorder {
-	return m.recorder
+// GrantVideoAccess indicates an expected call of GrantVideoAccess.
+func (mr *MockMetadataServiceMockRecorder) GrantVideoAccess(ctx, id, userID, granteeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantVideoAccess", reflect.TypeOf((*MockMetadataService)(nil).GrantVideoAccess), ctx, id, userID, granteeID)
 }
 
-// GetStreamURL mocks base method.
-func (m *MockStreamingService) GetStreamURL(ctx context.Context, videoID string) (string, error) {
+// ListChannelVideos mocks base method.
+func (m *MockMetadataService) ListChannelVideos(ctx context.Context, channelID string) ([]*common.Video, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStreamURL", ctx, videoID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListChannelVideos", ctx, channelID)
+	ret0, _ := ret[0].([]*common.Video)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetStreamURL indicates an expected call of GetStreamURL.
-func (mr *MockStreamingServiceMockRecorder) GetStreamURL(ctx, videoID any) *gomock.Call {
+// ListChannelVideos indicates an expected call of ListChannelVideos.
+func (mr *MockMetadataServiceMockRecorder) ListChannelVideos(ctx, channelID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamURL", reflect.TypeOf((*MockStreamingService)(nil).GetStreamURL), ctx, videoID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelVideos", reflect.TypeOf((*MockMetadataService)(nil).ListChannelVideos), ctx, channelID)
 }
 
-// MockGatewayUsecase is a mock of GatewayUsecase interface.
-type MockGatewayUsecase struct {
-	ctrl     *gomock.Controller
-	recorder *MockGatewayUsecaseMockRecorder
-	isgomock struct{}
+// ListPlaylists mocks base method.
+func (m *MockMetadataService) ListPlaylists(ctx context.Context, ownerID string) ([]*metadata.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlaylists", ctx, ownerID)
+	ret0, _ := ret[0].([]*metadata.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockGatewayUsecaseMockRecorder is the mock recorder for MockGatewayUsecase.
-type MockGatewayUsecaseMockRecorder struct {
-	mock *MockGatewayUsecase
+// ListPlaylists indicates an expected call of ListPlaylists.
+func (mr *MockMetadataServiceMockRecorder) ListPlaylists(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlaylists", reflect.TypeOf((*MockMetadataService)(nil).ListPlaylists), ctx, ownerID)
 }
 
-// NewMockGatewayUsecase creates a new mock instance.
-func NewMockGatewayUsecase(ctrl *gomock.Controller) *MockGatewayUsecase {
-	mock := &MockGatewayUsecase{ctrl: ctrl}
-	mock.recorder = &MockGatewayUsecaseMockRecorder{mock}
-	return mock
+// ListSeries mocks base method.
+func (m *MockMetadataService) ListSeries(ctx context.Context, ownerID string) ([]*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeries", ctx, ownerID)
+	ret0, _ := ret[0].([]*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockGatewayUsecase) EXPECT() *MockGatewayUsecaseMockRecorder {
-	return m.recorder
+// ListSeries indicates an expected call of ListSeries.
+func (mr *MockMetadataServiceMockRecorder) ListSeries(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeries", reflect.TypeOf((*MockMetadataService)(nil).ListSeries), ctx, ownerID)
 }
 
-// CompleteUpload mocks base method.
-func (m *MockGatewayUsecase) CompleteUpload(ctx context.Context, videoID string) (*upload.CompleteUploadResponse, error) {
+// ListShareLinks mocks base method.
+func (m *MockMetadataService) ListShareLinks(ctx context.Context, id, userID string) ([]*metadata.ShareLink, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompleteUpload", ctx, videoID)
-	ret0, _ := ret[0].(*upload.CompleteUploadResponse)
+	ret := m.ctrl.Call(m, "ListShareLinks", ctx, id, userID)
+	ret0, _ := ret[0].([]*metadata.ShareLink)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CompleteUpload indicates an expected call of CompleteUpload.
-func (mr *MockGatewayUsecaseMockRecorder) CompleteUpload(ctx, videoID any) *gomock.Call {
+// ListShareLinks indicates an expected call of ListShareLinks.
+func (mr *MockMetadataServiceMockRecorder) ListShareLinks(ctx, id, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockGatewayUsecase)(nil).CompleteUpload), ctx, videoID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShareLinks", reflect.TypeOf((*MockMetadataService)(nil).ListShareLinks), ctx, id, userID)
 }
 
-// GetStreamURL mocks base method.
-func (m *MockGatewayUsecase) GetStreamURL(ctx context.Context, videoID string) (string, error) {
+// ListSubscriptions mocks base method.
+func (m *MockMetadataService) ListSubscriptions(ctx context.Context) ([]*metadata.Channel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStreamURL", ctx, videoID)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx)
+	ret0, _ := ret[0].([]*metadata.Channel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetStreamURL indicates an expected call of GetStreamURL.
-func (mr *MockGatewayUsecaseMockRecorder) GetStreamURL(ctx, videoID any) *gomock.Call {
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockMetadataServiceMockRecorder) ListSubscriptions(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamURL", reflect.TypeOf((*MockGatewayUsecase)(nil).GetStreamURL), ctx, videoID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockMetadataService)(nil).ListSubscriptions), ctx)
 }
 
-// InitUpload mocks base method.
-func (m *MockGatewayUsecase) InitUpload(ctx context.Context, title, filename string) (*upload.InitUploadResponse, error) {
+// ListTrash mocks base method.
+func (m *MockMetadataService) ListTrash(ctx context.Context, userID string) ([]*common.Video, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitUpload", ctx, title, filename)
-	ret0, _ := ret[0].(*upload.InitUploadResponse)
+	ret := m.ctrl.Call(m, "ListTrash", ctx, userID)
+	ret0, _ := ret[0].([]*common.Video)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// InitUpload indicates an expected call of InitUpload.
-func (mr *MockGatewayUsecaseMockRecorder) InitUpload(ctx, title, filename any) *gomock.Call {
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockMetadataServiceMockRecorder) ListTrash(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitUpload", reflect.TypeOf((*MockGatewayUsecase)(nil).InitUpload), ctx, title, filename)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockMetadataService)(nil).ListTrash), ctx, userID)
 }
 
-// ListVideos mocks base method.
-func (m *MockGatewayUsecase) ListVideos(ctx context.Context, query string) ([]*common.Video, error) {
+// ListVideoAccess mocks base method.
+func (m *MockMetadataService) ListVideoAccess(ctx context.Context, id, userID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVideos", ctx, query)
-	ret0, _ := ret[0].([]*common.Video)
+	ret := m.ctrl.Call(m, "ListVideoAccess", ctx, id, userID)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVideos indicates an expected call of ListVideos.
-func (mr *MockGatewayUsecaseMockRecorder) ListVideos(ctx, query any) *gomock.Call {
+// ListVideoAccess indicates an expected call of ListVideoAccess.
+func (mr *MockMetadataServiceMockRecorder) ListVideoAccess(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideoAccess", reflect.TypeOf((*MockMetadataService)(nil).ListVideoAccess), ctx, id, userID)
+}
+
+// ListVideos mocks base method.
+func (m *MockMetadataService) ListVideos(ctx context.Context, query, tag, category string) ([]*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVideos", ctx, query, tag, category)
+	ret0, _ := ret[0].([]*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVideos indicates an expected call of ListVideos.
+func (mr *MockMetadataServiceMockRecorder) ListVideos(ctx, query, tag, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideos", reflect.TypeOf((*MockMetadataService)(nil).ListVideos), ctx, query, tag, category)
+}
+
+// MovePlaylistItem mocks base method.
+func (m *MockMetadataService) MovePlaylistItem(ctx context.Context, id, itemID, afterItemID string) (*metadata.PlaylistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePlaylistItem", ctx, id, itemID, afterItemID)
+	ret0, _ := ret[0].(*metadata.PlaylistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePlaylistItem indicates an expected call of MovePlaylistItem.
+func (mr *MockMetadataServiceMockRecorder) MovePlaylistItem(ctx, id, itemID, afterItemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePlaylistItem", reflect.TypeOf((*MockMetadataService)(nil).MovePlaylistItem), ctx, id, itemID, afterItemID)
+}
+
+// RemoveChannelMember mocks base method.
+func (m *MockMetadataService) RemoveChannelMember(ctx context.Context, id, memberID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChannelMember", ctx, id, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveChannelMember indicates an expected call of RemoveChannelMember.
+func (mr *MockMetadataServiceMockRecorder) RemoveChannelMember(ctx, id, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChannelMember", reflect.TypeOf((*MockMetadataService)(nil).RemoveChannelMember), ctx, id, memberID)
+}
+
+// RemoveEpisode mocks base method.
+func (m *MockMetadataService) RemoveEpisode(ctx context.Context, id, videoID string) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEpisode", ctx, id, videoID)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEpisode indicates an expected call of RemoveEpisode.
+func (mr *MockMetadataServiceMockRecorder) RemoveEpisode(ctx, id, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEpisode", reflect.TypeOf((*MockMetadataService)(nil).RemoveEpisode), ctx, id, videoID)
+}
+
+// RemovePlaylistCollaborator mocks base method.
+func (m *MockMetadataService) RemovePlaylistCollaborator(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePlaylistCollaborator", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePlaylistCollaborator indicates an expected call of RemovePlaylistCollaborator.
+func (mr *MockMetadataServiceMockRecorder) RemovePlaylistCollaborator(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePlaylistCollaborator", reflect.TypeOf((*MockMetadataService)(nil).RemovePlaylistCollaborator), ctx, id, userID)
+}
+
+// RemovePlaylistItem mocks base method.
+func (m *MockMetadataService) RemovePlaylistItem(ctx context.Context, id, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePlaylistItem", ctx, id, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePlaylistItem indicates an expected call of RemovePlaylistItem.
+func (mr *MockMetadataServiceMockRecorder) RemovePlaylistItem(ctx, id, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePlaylistItem", reflect.TypeOf((*MockMetadataService)(nil).RemovePlaylistItem), ctx, id, itemID)
+}
+
+// RestoreVideo mocks base method.
+func (m *MockMetadataService) RestoreVideo(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVideo", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVideo indicates an expected call of RestoreVideo.
+func (mr *MockMetadataServiceMockRecorder) RestoreVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVideo", reflect.TypeOf((*MockMetadataService)(nil).RestoreVideo), ctx, id, userID)
+}
+
+// RevokeShareLink mocks base method.
+func (m *MockMetadataService) RevokeShareLink(ctx context.Context, id, userID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShareLink", ctx, id, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockMetadataServiceMockRecorder) RevokeShareLink(ctx, id, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockMetadataService)(nil).RevokeShareLink), ctx, id, userID, token)
+}
+
+// RevokeVideoAccess mocks base method.
+func (m *MockMetadataService) RevokeVideoAccess(ctx context.Context, id, userID, granteeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeVideoAccess", ctx, id, userID, granteeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeVideoAccess indicates an expected call of RevokeVideoAccess.
+func (mr *MockMetadataServiceMockRecorder) RevokeVideoAccess(ctx, id, userID, granteeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeVideoAccess", reflect.TypeOf((*MockMetadataService)(nil).RevokeVideoAccess), ctx, id, userID, granteeID)
+}
+
+// SetEpisode mocks base method.
+func (m *MockMetadataService) SetEpisode(ctx context.Context, id, videoID string, season, episode int32) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEpisode", ctx, id, videoID, season, episode)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEpisode indicates an expected call of SetEpisode.
+func (mr *MockMetadataServiceMockRecorder) SetEpisode(ctx, id, videoID, season, episode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEpisode", reflect.TypeOf((*MockMetadataService)(nil).SetEpisode), ctx, id, videoID, season, episode)
+}
+
+// SetEpisodeCompleted mocks base method.
+func (m *MockMetadataService) SetEpisodeCompleted(ctx context.Context, id, videoID string, completed bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEpisodeCompleted", ctx, id, videoID, completed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEpisodeCompleted indicates an expected call of SetEpisodeCompleted.
+func (mr *MockMetadataServiceMockRecorder) SetEpisodeCompleted(ctx, id, videoID, completed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEpisodeCompleted", reflect.TypeOf((*MockMetadataService)(nil).SetEpisodeCompleted), ctx, id, videoID, completed)
+}
+
+// Subscribe mocks base method.
+func (m *MockMetadataService) Subscribe(ctx context.Context, channelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockMetadataServiceMockRecorder) Subscribe(ctx, channelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockMetadataService)(nil).Subscribe), ctx, channelID)
+}
+
+// UnpublishVideo mocks base method.
+func (m *MockMetadataService) UnpublishVideo(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishVideo", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpublishVideo indicates an expected call of UnpublishVideo.
+func (mr *MockMetadataServiceMockRecorder) UnpublishVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishVideo", reflect.TypeOf((*MockMetadataService)(nil).UnpublishVideo), ctx, id, userID)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockMetadataService) Unsubscribe(ctx context.Context, channelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockMetadataServiceMockRecorder) Unsubscribe(ctx, channelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockMetadataService)(nil).Unsubscribe), ctx, channelID)
+}
+
+// UpdateChannel mocks base method.
+func (m *MockMetadataService) UpdateChannel(ctx context.Context, req *metadata.UpdateChannelRequest) (*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChannel", ctx, req)
+	ret0, _ := ret[0].(*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChannel indicates an expected call of UpdateChannel.
+func (mr *MockMetadataServiceMockRecorder) UpdateChannel(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannel", reflect.TypeOf((*MockMetadataService)(nil).UpdateChannel), ctx, req)
+}
+
+// UpdatePlaylist mocks base method.
+func (m *MockMetadataService) UpdatePlaylist(ctx context.Context, req *metadata.UpdatePlaylistRequest) (*metadata.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlaylist", ctx, req)
+	ret0, _ := ret[0].(*metadata.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePlaylist indicates an expected call of UpdatePlaylist.
+func (mr *MockMetadataServiceMockRecorder) UpdatePlaylist(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlaylist", reflect.TypeOf((*MockMetadataService)(nil).UpdatePlaylist), ctx, req)
+}
+
+// UpdateSeries mocks base method.
+func (m *MockMetadataService) UpdateSeries(ctx context.Context, req *metadata.UpdateSeriesRequest) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeries", ctx, req)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSeries indicates an expected call of UpdateSeries.
+func (mr *MockMetadataServiceMockRecorder) UpdateSeries(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeries", reflect.TypeOf((*MockMetadataService)(nil).UpdateSeries), ctx, req)
+}
+
+// UpdateVideo mocks base method.
+func (m *MockMetadataService) UpdateVideo(ctx context.Context, req *metadata.UpdateVideoRequest) (*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVideo", ctx, req)
+	ret0, _ := ret[0].(*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVideo indicates an expected call of UpdateVideo.
+func (mr *MockMetadataServiceMockRecorder) UpdateVideo(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVideo", reflect.TypeOf((*MockMetadataService)(nil).UpdateVideo), ctx, req)
+}
+
+// MockUploadService is a mock of UploadService interface.
+type MockUploadService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUploadServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockUploadServiceMockRecorder is the mock recorder for MockUploadService.
+type MockUploadServiceMockRecorder struct {
+	mock *MockUploadService
+}
+
+// NewMockUploadService creates a new mock instance.
+func NewMockUploadService(ctrl *gomock.Controller) *MockUploadService {
+	mock := &MockUploadService{ctrl: ctrl}
+	mock.recorder = &MockUploadServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUploadService) EXPECT() *MockUploadServiceMockRecorder {
+	return m.recorder
+}
+
+// CompleteUpload mocks base method.
+func (m *MockUploadService) CompleteUpload(ctx context.Context, videoID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, videoID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockUploadServiceMockRecorder) CompleteUpload(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockUploadService)(nil).CompleteUpload), ctx, videoID)
+}
+
+// InitChannelImageUpload mocks base method.
+func (m *MockUploadService) InitChannelImageUpload(ctx context.Context, channelID, kind, filename string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitChannelImageUpload", ctx, channelID, kind, filename)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitChannelImageUpload indicates an expected call of InitChannelImageUpload.
+func (mr *MockUploadServiceMockRecorder) InitChannelImageUpload(ctx, channelID, kind, filename any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitChannelImageUpload", reflect.TypeOf((*MockUploadService)(nil).InitChannelImageUpload), ctx, channelID, kind, filename)
+}
+
+// InitUpload mocks base method.
+func (m *MockUploadService) InitUpload(ctx context.Context, title, filename, channelID string, allowDownload bool, publishAt string, premiere bool) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitUpload", ctx, title, filename, channelID, allowDownload, publishAt, premiere)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// InitUpload indicates an expected call of InitUpload.
+func (mr *MockUploadServiceMockRecorder) InitUpload(ctx, title, filename, channelID, allowDownload, publishAt, premiere any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitUpload", reflect.TypeOf((*MockUploadService)(nil).InitUpload), ctx, title, filename, channelID, allowDownload, publishAt, premiere)
+}
+
+// MockStreamingService is a mock of StreamingService interface.
+type MockStreamingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamingServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStreamingServiceMockRecorder is the mock recorder for MockStreamingService.
+type MockStreamingServiceMockRecorder struct {
+	mock *MockStreamingService
+}
+
+// NewMockStreamingService creates a new mock instance.
+func NewMockStreamingService(ctrl *gomock.Controller) *MockStreamingService {
+	mock := &MockStreamingService{ctrl: ctrl}
+	mock.recorder = &MockStreamingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamingService) EXPECT() *MockStreamingServiceMockRecorder {
+	return m.recorder
+}
+
+// GetChannelImageURL mocks base method.
+func (m *MockStreamingService) GetChannelImageURL(ctx context.Context, channelID, kind string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelImageURL", ctx, channelID, kind)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelImageURL indicates an expected call of GetChannelImageURL.
+func (mr *MockStreamingServiceMockRecorder) GetChannelImageURL(ctx, channelID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelImageURL", reflect.TypeOf((*MockStreamingService)(nil).GetChannelImageURL), ctx, channelID, kind)
+}
+
+// GetDownloadURL mocks base method.
+func (m *MockStreamingService) GetDownloadURL(ctx context.Context, videoID, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDownloadURL", ctx, videoID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDownloadURL indicates an expected call of GetDownloadURL.
+func (mr *MockStreamingServiceMockRecorder) GetDownloadURL(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadURL", reflect.TypeOf((*MockStreamingService)(nil).GetDownloadURL), ctx, videoID, userID)
+}
+
+// GetSharedStreamURL mocks base method.
+func (m *MockStreamingService) GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedStreamURL", ctx, token, password, rendition, region)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSharedStreamURL indicates an expected call of GetSharedStreamURL.
+func (mr *MockStreamingServiceMockRecorder) GetSharedStreamURL(ctx, token, password, rendition, region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedStreamURL", reflect.TypeOf((*MockStreamingService)(nil).GetSharedStreamURL), ctx, token, password, rendition, region)
+}
+
+// GetStreamURL mocks base method.
+func (m *MockStreamingService) GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*streaming.GetStreamURLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamURL", ctx, videoID, rendition, region, userID)
+	ret0, _ := ret[0].(*streaming.GetStreamURLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamURL indicates an expected call of GetStreamURL.
+func (mr *MockStreamingServiceMockRecorder) GetStreamURL(ctx, videoID, rendition, region, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamURL", reflect.TypeOf((*MockStreamingService)(nil).GetStreamURL), ctx, videoID, rendition, region, userID)
+}
+
+// GetVideoMedia mocks base method.
+func (m *MockStreamingService) GetVideoMedia(ctx context.Context, videoID, userID string) (*streaming.GetVideoMediaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoMedia", ctx, videoID, userID)
+	ret0, _ := ret[0].(*streaming.GetVideoMediaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoMedia indicates an expected call of GetVideoMedia.
+func (mr *MockStreamingServiceMockRecorder) GetVideoMedia(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoMedia", reflect.TypeOf((*MockStreamingService)(nil).GetVideoMedia), ctx, videoID, userID)
+}
+
+// InvalidateStreamURL mocks base method.
+func (m *MockStreamingService) InvalidateStreamURL(ctx context.Context, videoID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateStreamURL", ctx, videoID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateStreamURL indicates an expected call of InvalidateStreamURL.
+func (mr *MockStreamingServiceMockRecorder) InvalidateStreamURL(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateStreamURL", reflect.TypeOf((*MockStreamingService)(nil).InvalidateStreamURL), ctx, videoID)
+}
+
+// MockAnalyticsService is a mock of AnalyticsService interface.
+type MockAnalyticsService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAnalyticsServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAnalyticsServiceMockRecorder is the mock recorder for MockAnalyticsService.
+type MockAnalyticsServiceMockRecorder struct {
+	mock *MockAnalyticsService
+}
+
+// NewMockAnalyticsService creates a new mock instance.
+func NewMockAnalyticsService(ctrl *gomock.Controller) *MockAnalyticsService {
+	mock := &MockAnalyticsService{ctrl: ctrl}
+	mock.recorder = &MockAnalyticsServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAnalyticsService) EXPECT() *MockAnalyticsServiceMockRecorder {
+	return m.recorder
+}
+
+// GetPlaybackStats mocks base method.
+func (m *MockAnalyticsService) GetPlaybackStats(ctx context.Context, videoID, from, to string) (*analytics.GetPlaybackStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaybackStats", ctx, videoID, from, to)
+	ret0, _ := ret[0].(*analytics.GetPlaybackStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaybackStats indicates an expected call of GetPlaybackStats.
+func (mr *MockAnalyticsServiceMockRecorder) GetPlaybackStats(ctx, videoID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaybackStats", reflect.TypeOf((*MockAnalyticsService)(nil).GetPlaybackStats), ctx, videoID, from, to)
+}
+
+// IngestBeacons mocks base method.
+func (m *MockAnalyticsService) IngestBeacons(ctx context.Context, beacons []*analytics.Beacon) (*analytics.IngestBeaconsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestBeacons", ctx, beacons)
+	ret0, _ := ret[0].(*analytics.IngestBeaconsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestBeacons indicates an expected call of IngestBeacons.
+func (mr *MockAnalyticsServiceMockRecorder) IngestBeacons(ctx, beacons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestBeacons", reflect.TypeOf((*MockAnalyticsService)(nil).IngestBeacons), ctx, beacons)
+}
+
+// MockChatService is a mock of ChatService interface.
+type MockChatService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockChatServiceMockRecorder is the mock recorder for MockChatService.
+type MockChatServiceMockRecorder struct {
+	mock *MockChatService
+}
+
+// NewMockChatService creates a new mock instance.
+func NewMockChatService(ctrl *gomock.Controller) *MockChatService {
+	mock := &MockChatService{ctrl: ctrl}
+	mock.recorder = &MockChatServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatService) EXPECT() *MockChatServiceMockRecorder {
+	return m.recorder
+}
+
+// AddModerator mocks base method.
+func (m *MockChatService) AddModerator(ctx context.Context, videoID, actorID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddModerator", ctx, videoID, actorID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddModerator indicates an expected call of AddModerator.
+func (mr *MockChatServiceMockRecorder) AddModerator(ctx, videoID, actorID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddModerator", reflect.TypeOf((*MockChatService)(nil).AddModerator), ctx, videoID, actorID, userID)
+}
+
+// BanUser mocks base method.
+func (m *MockChatService) BanUser(ctx context.Context, videoID, actorID, userID string, durationSeconds int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanUser", ctx, videoID, actorID, userID, durationSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanUser indicates an expected call of BanUser.
+func (mr *MockChatServiceMockRecorder) BanUser(ctx, videoID, actorID, userID, durationSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanUser", reflect.TypeOf((*MockChatService)(nil).BanUser), ctx, videoID, actorID, userID, durationSeconds)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockChatService) DeleteMessage(ctx context.Context, videoID, actorID string, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", ctx, videoID, actorID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockChatServiceMockRecorder) DeleteMessage(ctx, videoID, actorID, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockChatService)(nil).DeleteMessage), ctx, videoID, actorID, messageID)
+}
+
+// ListMessages mocks base method.
+func (m *MockChatService) ListMessages(ctx context.Context, videoID, viewerID string, fromMs, toMs int64, limit int32) ([]*chat.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMessages", ctx, videoID, viewerID, fromMs, toMs, limit)
+	ret0, _ := ret[0].([]*chat.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMessages indicates an expected call of ListMessages.
+func (mr *MockChatServiceMockRecorder) ListMessages(ctx, videoID, viewerID, fromMs, toMs, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMessages", reflect.TypeOf((*MockChatService)(nil).ListMessages), ctx, videoID, viewerID, fromMs, toMs, limit)
+}
+
+// PostMessage mocks base method.
+func (m *MockChatService) PostMessage(ctx context.Context, videoID, userID, text string) (*chat.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostMessage", ctx, videoID, userID, text)
+	ret0, _ := ret[0].(*chat.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostMessage indicates an expected call of PostMessage.
+func (mr *MockChatServiceMockRecorder) PostMessage(ctx, videoID, userID, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostMessage", reflect.TypeOf((*MockChatService)(nil).PostMessage), ctx, videoID, userID, text)
+}
+
+// SetSlowMode mocks base method.
+func (m *MockChatService) SetSlowMode(ctx context.Context, videoID, actorID string, seconds int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSlowMode", ctx, videoID, actorID, seconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSlowMode indicates an expected call of SetSlowMode.
+func (mr *MockChatServiceMockRecorder) SetSlowMode(ctx, videoID, actorID, seconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSlowMode", reflect.TypeOf((*MockChatService)(nil).SetSlowMode), ctx, videoID, actorID, seconds)
+}
+
+// Subscribe mocks base method.
+func (m *MockChatService) Subscribe(ctx context.Context, videoID, viewerID string) (chat.ChatService_SubscribeClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, videoID, viewerID)
+	ret0, _ := ret[0].(chat.ChatService_SubscribeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockChatServiceMockRecorder) Subscribe(ctx, videoID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockChatService)(nil).Subscribe), ctx, videoID, viewerID)
+}
+
+// MockIdentityProvider is a mock of IdentityProvider interface.
+type MockIdentityProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityProviderMockRecorder is the mock recorder for MockIdentityProvider.
+type MockIdentityProviderMockRecorder struct {
+	mock *MockIdentityProvider
+}
+
+// NewMockIdentityProvider creates a new mock instance.
+func NewMockIdentityProvider(ctrl *gomock.Controller) *MockIdentityProvider {
+	mock := &MockIdentityProvider{ctrl: ctrl}
+	mock.recorder = &MockIdentityProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentityProvider) EXPECT() *MockIdentityProviderMockRecorder {
+	return m.recorder
+}
+
+// AuthCodeURL mocks base method.
+func (m *MockIdentityProvider) AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthCodeURL", ctx, state, nonce, verifier)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthCodeURL indicates an expected call of AuthCodeURL.
+func (mr *MockIdentityProviderMockRecorder) AuthCodeURL(ctx, state, nonce, verifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthCodeURL", reflect.TypeOf((*MockIdentityProvider)(nil).AuthCodeURL), ctx, state, nonce, verifier)
+}
+
+// Exchange mocks base method.
+func (m *MockIdentityProvider) Exchange(ctx context.Context, code, verifier, nonce string) (*domain.Identity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Exchange", ctx, code, verifier, nonce)
+	ret0, _ := ret[0].(*domain.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Exchange indicates an expected call of Exchange.
+func (mr *MockIdentityProviderMockRecorder) Exchange(ctx, code, verifier, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Exchange", reflect.TypeOf((*MockIdentityProvider)(nil).Exchange), ctx, code, verifier, nonce)
+}
+
+// MockUserService is a mock of UserService interface.
+type MockUserService struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockUserServiceMockRecorder is the mock recorder for MockUserService.
+type MockUserServiceMockRecorder struct {
+	mock *MockUserService
+}
+
+// NewMockUserService creates a new mock instance.
+func NewMockUserService(ctrl *gomock.Controller) *MockUserService {
+	mock := &MockUserService{ctrl: ctrl}
+	mock.recorder = &MockUserServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockUserService) Authenticate(ctx context.Context, token string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockUserServiceMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockUserService)(nil).Authenticate), ctx, token)
+}
+
+// AuthenticateAPIKey mocks base method.
+func (m *MockUserService) AuthenticateAPIKey(ctx context.Context, key string) (*user.AuthenticateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, key)
+	ret0, _ := ret[0].(*user.AuthenticateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockUserServiceMockRecorder) AuthenticateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockUserService)(nil).AuthenticateAPIKey), ctx, key)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockUserService) CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (*user.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, userID, name, scopes)
+	ret0, _ := ret[0].(*user.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockUserServiceMockRecorder) CreateAPIKey(ctx, userID, name, scopes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockUserService)(nil).CreateAPIKey), ctx, userID, name, scopes)
+}
+
+// GetUser mocks base method.
+func (m *MockUserService) GetUser(ctx context.Context, id string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockUserServiceMockRecorder) GetUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserService)(nil).GetUser), ctx, id)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockUserService) ListAPIKeys(ctx context.Context, userID string, all bool) ([]*user.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, userID, all)
+	ret0, _ := ret[0].([]*user.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockUserServiceMockRecorder) ListAPIKeys(ctx, userID, all any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockUserService)(nil).ListAPIKeys), ctx, userID, all)
+}
+
+// Login mocks base method.
+func (m *MockUserService) Login(ctx context.Context, email, password string) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, email, password)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockUserServiceMockRecorder) Login(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUserService)(nil).Login), ctx, email, password)
+}
+
+// LoginExternal mocks base method.
+func (m *MockUserService) LoginExternal(ctx context.Context, identity *domain.Identity) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginExternal", ctx, identity)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginExternal indicates an expected call of LoginExternal.
+func (mr *MockUserServiceMockRecorder) LoginExternal(ctx, identity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginExternal", reflect.TypeOf((*MockUserService)(nil).LoginExternal), ctx, identity)
+}
+
+// Logout mocks base method.
+func (m *MockUserService) Logout(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockUserServiceMockRecorder) Logout(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUserService)(nil).Logout), ctx, token)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockUserService) RequestPasswordReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockUserServiceMockRecorder) RequestPasswordReset(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockUserService)(nil).RequestPasswordReset), ctx, email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockUserService) ResetPassword(ctx context.Context, token, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockUserServiceMockRecorder) ResetPassword(ctx, token, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockUserService)(nil).ResetPassword), ctx, token, password)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockUserService) RevokeAPIKey(ctx context.Context, id, userID string, any bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, id, userID, any)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockUserServiceMockRecorder) RevokeAPIKey(ctx, id, userID, any any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockUserService)(nil).RevokeAPIKey), ctx, id, userID, any)
+}
+
+// Signup mocks base method.
+func (m *MockUserService) Signup(ctx context.Context, email, password, displayName string) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signup", ctx, email, password, displayName)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Signup indicates an expected call of Signup.
+func (mr *MockUserServiceMockRecorder) Signup(ctx, email, password, displayName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signup", reflect.TypeOf((*MockUserService)(nil).Signup), ctx, email, password, displayName)
+}
+
+// MockGatewayUsecase is a mock of GatewayUsecase interface.
+type MockGatewayUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGatewayUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGatewayUsecaseMockRecorder is the mock recorder for MockGatewayUsecase.
+type MockGatewayUsecaseMockRecorder struct {
+	mock *MockGatewayUsecase
+}
+
+// NewMockGatewayUsecase creates a new mock instance.
+func NewMockGatewayUsecase(ctrl *gomock.Controller) *MockGatewayUsecase {
+	mock := &MockGatewayUsecase{ctrl: ctrl}
+	mock.recorder = &MockGatewayUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGatewayUsecase) EXPECT() *MockGatewayUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddChannelMember mocks base method.
+func (m *MockGatewayUsecase) AddChannelMember(ctx context.Context, handle, memberID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChannelMember", ctx, handle, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChannelMember indicates an expected call of AddChannelMember.
+func (mr *MockGatewayUsecaseMockRecorder) AddChannelMember(ctx, handle, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChannelMember", reflect.TypeOf((*MockGatewayUsecase)(nil).AddChannelMember), ctx, handle, memberID)
+}
+
+// AddChatModerator mocks base method.
+func (m *MockGatewayUsecase) AddChatModerator(ctx context.Context, videoID, actorID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChatModerator", ctx, videoID, actorID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChatModerator indicates an expected call of AddChatModerator.
+func (mr *MockGatewayUsecaseMockRecorder) AddChatModerator(ctx, videoID, actorID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatModerator", reflect.TypeOf((*MockGatewayUsecase)(nil).AddChatModerator), ctx, videoID, actorID, userID)
+}
+
+// AddPlaylistCollaborator mocks base method.
+func (m *MockGatewayUsecase) AddPlaylistCollaborator(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlaylistCollaborator", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPlaylistCollaborator indicates an expected call of AddPlaylistCollaborator.
+func (mr *MockGatewayUsecaseMockRecorder) AddPlaylistCollaborator(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlaylistCollaborator", reflect.TypeOf((*MockGatewayUsecase)(nil).AddPlaylistCollaborator), ctx, id, userID)
+}
+
+// AddPlaylistItem mocks base method.
+func (m *MockGatewayUsecase) AddPlaylistItem(ctx context.Context, id, videoID string) (*metadata.PlaylistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlaylistItem", ctx, id, videoID)
+	ret0, _ := ret[0].(*metadata.PlaylistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPlaylistItem indicates an expected call of AddPlaylistItem.
+func (mr *MockGatewayUsecaseMockRecorder) AddPlaylistItem(ctx, id, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlaylistItem", reflect.TypeOf((*MockGatewayUsecase)(nil).AddPlaylistItem), ctx, id, videoID)
+}
+
+// Authenticate mocks base method.
+func (m *MockGatewayUsecase) Authenticate(ctx context.Context, token string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, token)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockGatewayUsecaseMockRecorder) Authenticate(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockGatewayUsecase)(nil).Authenticate), ctx, token)
+}
+
+// AuthenticateAPIKey mocks base method.
+func (m *MockGatewayUsecase) AuthenticateAPIKey(ctx context.Context, key string) (*user.AuthenticateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthenticateAPIKey", ctx, key)
+	ret0, _ := ret[0].(*user.AuthenticateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthenticateAPIKey indicates an expected call of AuthenticateAPIKey.
+func (mr *MockGatewayUsecaseMockRecorder) AuthenticateAPIKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthenticateAPIKey", reflect.TypeOf((*MockGatewayUsecase)(nil).AuthenticateAPIKey), ctx, key)
+}
+
+// BanChatUser mocks base method.
+func (m *MockGatewayUsecase) BanChatUser(ctx context.Context, videoID, actorID, userID string, durationSeconds int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanChatUser", ctx, videoID, actorID, userID, durationSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanChatUser indicates an expected call of BanChatUser.
+func (mr *MockGatewayUsecaseMockRecorder) BanChatUser(ctx, videoID, actorID, userID, durationSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanChatUser", reflect.TypeOf((*MockGatewayUsecase)(nil).BanChatUser), ctx, videoID, actorID, userID, durationSeconds)
+}
+
+// CompleteUpload mocks base method.
+func (m *MockGatewayUsecase) CompleteUpload(ctx context.Context, videoID string) (*upload.CompleteUploadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, videoID)
+	ret0, _ := ret[0].(*upload.CompleteUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockGatewayUsecaseMockRecorder) CompleteUpload(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockGatewayUsecase)(nil).CompleteUpload), ctx, videoID)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockGatewayUsecase) CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (*user.CreateAPIKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, userID, name, scopes)
+	ret0, _ := ret[0].(*user.CreateAPIKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockGatewayUsecaseMockRecorder) CreateAPIKey(ctx, userID, name, scopes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockGatewayUsecase)(nil).CreateAPIKey), ctx, userID, name, scopes)
+}
+
+// CreateChannel mocks base method.
+func (m *MockGatewayUsecase) CreateChannel(ctx context.Context, handle, displayName, description string) (*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannel", ctx, handle, displayName, description)
+	ret0, _ := ret[0].(*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChannel indicates an expected call of CreateChannel.
+func (mr *MockGatewayUsecaseMockRecorder) CreateChannel(ctx, handle, displayName, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockGatewayUsecase)(nil).CreateChannel), ctx, handle, displayName, description)
+}
+
+// CreatePlaylist mocks base method.
+func (m *MockGatewayUsecase) CreatePlaylist(ctx context.Context, title, description, visibility string) (*metadata.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlaylist", ctx, title, description, visibility)
+	ret0, _ := ret[0].(*metadata.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlaylist indicates an expected call of CreatePlaylist.
+func (mr *MockGatewayUsecaseMockRecorder) CreatePlaylist(ctx, title, description, visibility any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockGatewayUsecase)(nil).CreatePlaylist), ctx, title, description, visibility)
+}
+
+// CreateSeries mocks base method.
+func (m *MockGatewayUsecase) CreateSeries(ctx context.Context, title, description string) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeries", ctx, title, description)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockGatewayUsecaseMockRecorder) CreateSeries(ctx, title, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockGatewayUsecase)(nil).CreateSeries), ctx, title, description)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockGatewayUsecase) CreateShareLink(ctx context.Context, id, userID, expiresAt string, maxViews int32, password string) (*metadata.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", ctx, id, userID, expiresAt, maxViews, password)
+	ret0, _ := ret[0].(*metadata.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockGatewayUsecaseMockRecorder) CreateShareLink(ctx, id, userID, expiresAt, maxViews, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockGatewayUsecase)(nil).CreateShareLink), ctx, id, userID, expiresAt, maxViews, password)
+}
+
+// CreateStreamKey mocks base method.
+func (m *MockGatewayUsecase) CreateStreamKey(ctx context.Context, channelID, title string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStreamKey", ctx, channelID, title)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStreamKey indicates an expected call of CreateStreamKey.
+func (mr *MockGatewayUsecaseMockRecorder) CreateStreamKey(ctx, channelID, title any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreamKey", reflect.TypeOf((*MockGatewayUsecase)(nil).CreateStreamKey), ctx, channelID, title)
+}
+
+// CreateWatchParty mocks base method.
+func (m *MockGatewayUsecase) CreateWatchParty(ctx context.Context, videoID, rendition, region, userID string) (*domain.WatchParty, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWatchParty", ctx, videoID, rendition, region, userID)
+	ret0, _ := ret[0].(*domain.WatchParty)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateWatchParty indicates an expected call of CreateWatchParty.
+func (mr *MockGatewayUsecaseMockRecorder) CreateWatchParty(ctx, videoID, rendition, region, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWatchParty", reflect.TypeOf((*MockGatewayUsecase)(nil).CreateWatchParty), ctx, videoID, rendition, region, userID)
+}
+
+// DeleteChannel mocks base method.
+func (m *MockGatewayUsecase) DeleteChannel(ctx context.Context, handle string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannel", ctx, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannel indicates an expected call of DeleteChannel.
+func (mr *MockGatewayUsecaseMockRecorder) DeleteChannel(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockGatewayUsecase)(nil).DeleteChannel), ctx, handle)
+}
+
+// DeleteChatMessage mocks base method.
+func (m *MockGatewayUsecase) DeleteChatMessage(ctx context.Context, videoID, actorID string, messageID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatMessage", ctx, videoID, actorID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatMessage indicates an expected call of DeleteChatMessage.
+func (mr *MockGatewayUsecaseMockRecorder) DeleteChatMessage(ctx, videoID, actorID, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatMessage", reflect.TypeOf((*MockGatewayUsecase)(nil).DeleteChatMessage), ctx, videoID, actorID, messageID)
+}
+
+// DeletePlaylist mocks base method.
+func (m *MockGatewayUsecase) DeletePlaylist(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlaylist", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlaylist indicates an expected call of DeletePlaylist.
+func (mr *MockGatewayUsecaseMockRecorder) DeletePlaylist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlaylist", reflect.TypeOf((*MockGatewayUsecase)(nil).DeletePlaylist), ctx, id)
+}
+
+// DeleteSeries mocks base method.
+func (m *MockGatewayUsecase) DeleteSeries(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockGatewayUsecaseMockRecorder) DeleteSeries(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockGatewayUsecase)(nil).DeleteSeries), ctx, id)
+}
+
+// DeleteVideo mocks base method.
+func (m *MockGatewayUsecase) DeleteVideo(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVideo", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVideo indicates an expected call of DeleteVideo.
+func (mr *MockGatewayUsecaseMockRecorder) DeleteVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVideo", reflect.TypeOf((*MockGatewayUsecase)(nil).DeleteVideo), ctx, id, userID)
+}
+
+// GetChannel mocks base method.
+func (m *MockGatewayUsecase) GetChannel(ctx context.Context, handle string) (*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannel", ctx, handle)
+	ret0, _ := ret[0].(*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannel indicates an expected call of GetChannel.
+func (mr *MockGatewayUsecaseMockRecorder) GetChannel(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockGatewayUsecase)(nil).GetChannel), ctx, handle)
+}
+
+// GetChannelByID mocks base method.
+func (m *MockGatewayUsecase) GetChannelByID(ctx context.Context, id string) (*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelByID", ctx, id)
+	ret0, _ := ret[0].(*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelByID indicates an expected call of GetChannelByID.
+func (mr *MockGatewayUsecaseMockRecorder) GetChannelByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelByID", reflect.TypeOf((*MockGatewayUsecase)(nil).GetChannelByID), ctx, id)
+}
+
+// GetChannelImageURL mocks base method.
+func (m *MockGatewayUsecase) GetChannelImageURL(ctx context.Context, handle, kind string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelImageURL", ctx, handle, kind)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelImageURL indicates an expected call of GetChannelImageURL.
+func (mr *MockGatewayUsecaseMockRecorder) GetChannelImageURL(ctx, handle, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelImageURL", reflect.TypeOf((*MockGatewayUsecase)(nil).GetChannelImageURL), ctx, handle, kind)
+}
+
+// GetDownloadURL mocks base method.
+func (m *MockGatewayUsecase) GetDownloadURL(ctx context.Context, videoID, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDownloadURL", ctx, videoID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDownloadURL indicates an expected call of GetDownloadURL.
+func (mr *MockGatewayUsecaseMockRecorder) GetDownloadURL(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadURL", reflect.TypeOf((*MockGatewayUsecase)(nil).GetDownloadURL), ctx, videoID, userID)
+}
+
+// GetNextEpisode mocks base method.
+func (m *MockGatewayUsecase) GetNextEpisode(ctx context.Context, id, afterVideoID string) (*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextEpisode", ctx, id, afterVideoID)
+	ret0, _ := ret[0].(*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextEpisode indicates an expected call of GetNextEpisode.
+func (mr *MockGatewayUsecaseMockRecorder) GetNextEpisode(ctx, id, afterVideoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextEpisode", reflect.TypeOf((*MockGatewayUsecase)(nil).GetNextEpisode), ctx, id, afterVideoID)
+}
+
+// GetPlaybackStats mocks base method.
+func (m *MockGatewayUsecase) GetPlaybackStats(ctx context.Context, videoID, from, to string) (*analytics.GetPlaybackStatsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaybackStats", ctx, videoID, from, to)
+	ret0, _ := ret[0].(*analytics.GetPlaybackStatsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaybackStats indicates an expected call of GetPlaybackStats.
+func (mr *MockGatewayUsecaseMockRecorder) GetPlaybackStats(ctx, videoID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaybackStats", reflect.TypeOf((*MockGatewayUsecase)(nil).GetPlaybackStats), ctx, videoID, from, to)
+}
+
+// GetPlaylist mocks base method.
+func (m *MockGatewayUsecase) GetPlaylist(ctx context.Context, id string) (*metadata.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylist", ctx, id)
+	ret0, _ := ret[0].(*metadata.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylist indicates an expected call of GetPlaylist.
+func (mr *MockGatewayUsecaseMockRecorder) GetPlaylist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylist", reflect.TypeOf((*MockGatewayUsecase)(nil).GetPlaylist), ctx, id)
+}
+
+// GetSeries mocks base method.
+func (m *MockGatewayUsecase) GetSeries(ctx context.Context, id string) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeries", ctx, id)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeries indicates an expected call of GetSeries.
+func (mr *MockGatewayUsecaseMockRecorder) GetSeries(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeries", reflect.TypeOf((*MockGatewayUsecase)(nil).GetSeries), ctx, id)
+}
+
+// GetSharedStreamURL mocks base method.
+func (m *MockGatewayUsecase) GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedStreamURL", ctx, token, password, rendition, region)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSharedStreamURL indicates an expected call of GetSharedStreamURL.
+func (mr *MockGatewayUsecaseMockRecorder) GetSharedStreamURL(ctx, token, password, rendition, region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedStreamURL", reflect.TypeOf((*MockGatewayUsecase)(nil).GetSharedStreamURL), ctx, token, password, rendition, region)
+}
+
+// GetStreamURL mocks base method.
+func (m *MockGatewayUsecase) GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*streaming.GetStreamURLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamURL", ctx, videoID, rendition, region, userID)
+	ret0, _ := ret[0].(*streaming.GetStreamURLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamURL indicates an expected call of GetStreamURL.
+func (mr *MockGatewayUsecaseMockRecorder) GetStreamURL(ctx, videoID, rendition, region, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamURL", reflect.TypeOf((*MockGatewayUsecase)(nil).GetStreamURL), ctx, videoID, rendition, region, userID)
+}
+
+// GetSubscriptionFeed mocks base method.
+func (m *MockGatewayUsecase) GetSubscriptionFeed(ctx context.Context, pageToken string, pageSize int32) ([]*common.Video, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptionFeed", ctx, pageToken, pageSize)
+	ret0, _ := ret[0].([]*common.Video)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSubscriptionFeed indicates an expected call of GetSubscriptionFeed.
+func (mr *MockGatewayUsecaseMockRecorder) GetSubscriptionFeed(ctx, pageToken, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptionFeed", reflect.TypeOf((*MockGatewayUsecase)(nil).GetSubscriptionFeed), ctx, pageToken, pageSize)
+}
+
+// GetUser mocks base method.
+func (m *MockGatewayUsecase) GetUser(ctx context.Context, id string) (*user.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, id)
+	ret0, _ := ret[0].(*user.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockGatewayUsecaseMockRecorder) GetUser(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockGatewayUsecase)(nil).GetUser), ctx, id)
+}
+
+// GetVideo mocks base method.
+func (m *MockGatewayUsecase) GetVideo(ctx context.Context, id, userID string) (*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideo", ctx, id, userID)
+	ret0, _ := ret[0].(*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideo indicates an expected call of GetVideo.
+func (mr *MockGatewayUsecaseMockRecorder) GetVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockGatewayUsecase)(nil).GetVideo), ctx, id, userID)
+}
+
+// GetVideoMedia mocks base method.
+func (m *MockGatewayUsecase) GetVideoMedia(ctx context.Context, videoID, userID string) (*streaming.GetVideoMediaResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoMedia", ctx, videoID, userID)
+	ret0, _ := ret[0].(*streaming.GetVideoMediaResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoMedia indicates an expected call of GetVideoMedia.
+func (mr *MockGatewayUsecaseMockRecorder) GetVideoMedia(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoMedia", reflect.TypeOf((*MockGatewayUsecase)(nil).GetVideoMedia), ctx, videoID, userID)
+}
+
+// GetWatchParty mocks base method.
+func (m *MockGatewayUsecase) GetWatchParty(ctx context.Context, id, rendition, region, userID string) (*domain.WatchParty, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchParty", ctx, id, rendition, region, userID)
+	ret0, _ := ret[0].(*domain.WatchParty)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWatchParty indicates an expected call of GetWatchParty.
+func (mr *MockGatewayUsecaseMockRecorder) GetWatchParty(ctx, id, rendition, region, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchParty", reflect.TypeOf((*MockGatewayUsecase)(nil).GetWatchParty), ctx, id, rendition, region, userID)
+}
+
+// GrantVideoAccess mocks base method.
+func (m *MockGatewayUsecase) GrantVideoAccess(ctx context.Context, id, userID, granteeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantVideoAccess", ctx, id, userID, granteeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantVideoAccess indicates an expected call of GrantVideoAccess.
+func (mr *MockGatewayUsecaseMockRecorder) GrantVideoAccess(ctx, id, userID, granteeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantVideoAccess", reflect.TypeOf((*MockGatewayUsecase)(nil).GrantVideoAccess), ctx, id, userID, granteeID)
+}
+
+// IngestBeacons mocks base method.
+func (m *MockGatewayUsecase) IngestBeacons(ctx context.Context, beacons []*analytics.Beacon) (*analytics.IngestBeaconsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IngestBeacons", ctx, beacons)
+	ret0, _ := ret[0].(*analytics.IngestBeaconsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IngestBeacons indicates an expected call of IngestBeacons.
+func (mr *MockGatewayUsecaseMockRecorder) IngestBeacons(ctx, beacons any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IngestBeacons", reflect.TypeOf((*MockGatewayUsecase)(nil).IngestBeacons), ctx, beacons)
+}
+
+// InitChannelImageUpload mocks base method.
+func (m *MockGatewayUsecase) InitChannelImageUpload(ctx context.Context, handle, kind, filename string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitChannelImageUpload", ctx, handle, kind, filename)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitChannelImageUpload indicates an expected call of InitChannelImageUpload.
+func (mr *MockGatewayUsecaseMockRecorder) InitChannelImageUpload(ctx, handle, kind, filename any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitChannelImageUpload", reflect.TypeOf((*MockGatewayUsecase)(nil).InitChannelImageUpload), ctx, handle, kind, filename)
+}
+
+// InitUpload mocks base method.
+func (m *MockGatewayUsecase) InitUpload(ctx context.Context, title, filename, channelID string, allowDownload bool, publishAt string, premiere bool) (*upload.InitUploadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitUpload", ctx, title, filename, channelID, allowDownload, publishAt, premiere)
+	ret0, _ := ret[0].(*upload.InitUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitUpload indicates an expected call of InitUpload.
+func (mr *MockGatewayUsecaseMockRecorder) InitUpload(ctx, title, filename, channelID, allowDownload, publishAt, premiere any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitUpload", reflect.TypeOf((*MockGatewayUsecase)(nil).InitUpload), ctx, title, filename, channelID, allowDownload, publishAt, premiere)
+}
+
+// JoinWatchParty mocks base method.
+func (m *MockGatewayUsecase) JoinWatchParty(ctx context.Context, id, userID string) (<-chan domain.PlaybackState, func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinWatchParty", ctx, id, userID)
+	ret0, _ := ret[0].(<-chan domain.PlaybackState)
+	ret1, _ := ret[1].(func())
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// JoinWatchParty indicates an expected call of JoinWatchParty.
+func (mr *MockGatewayUsecaseMockRecorder) JoinWatchParty(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinWatchParty", reflect.TypeOf((*MockGatewayUsecase)(nil).JoinWatchParty), ctx, id, userID)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockGatewayUsecase) ListAPIKeys(ctx context.Context, userID string) ([]*user.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx, userID)
+	ret0, _ := ret[0].([]*user.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockGatewayUsecaseMockRecorder) ListAPIKeys(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockGatewayUsecase)(nil).ListAPIKeys), ctx, userID)
+}
+
+// ListAllAPIKeys mocks base method.
+func (m *MockGatewayUsecase) ListAllAPIKeys(ctx context.Context) ([]*user.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllAPIKeys", ctx)
+	ret0, _ := ret[0].([]*user.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllAPIKeys indicates an expected call of ListAllAPIKeys.
+func (mr *MockGatewayUsecaseMockRecorder) ListAllAPIKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllAPIKeys", reflect.TypeOf((*MockGatewayUsecase)(nil).ListAllAPIKeys), ctx)
+}
+
+// ListChannelVideos mocks base method.
+func (m *MockGatewayUsecase) ListChannelVideos(ctx context.Context, handle string) ([]*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChannelVideos", ctx, handle)
+	ret0, _ := ret[0].([]*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChannelVideos indicates an expected call of ListChannelVideos.
+func (mr *MockGatewayUsecaseMockRecorder) ListChannelVideos(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChannelVideos", reflect.TypeOf((*MockGatewayUsecase)(nil).ListChannelVideos), ctx, handle)
+}
+
+// ListChatMessages mocks base method.
+func (m *MockGatewayUsecase) ListChatMessages(ctx context.Context, videoID, viewerID string, fromMs, toMs int64, limit int32) ([]*chat.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChatMessages", ctx, videoID, viewerID, fromMs, toMs, limit)
+	ret0, _ := ret[0].([]*chat.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChatMessages indicates an expected call of ListChatMessages.
+func (mr *MockGatewayUsecaseMockRecorder) ListChatMessages(ctx, videoID, viewerID, fromMs, toMs, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChatMessages", reflect.TypeOf((*MockGatewayUsecase)(nil).ListChatMessages), ctx, videoID, viewerID, fromMs, toMs, limit)
+}
+
+// ListPlaylists mocks base method.
+func (m *MockGatewayUsecase) ListPlaylists(ctx context.Context, ownerID string) ([]*metadata.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlaylists", ctx, ownerID)
+	ret0, _ := ret[0].([]*metadata.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlaylists indicates an expected call of ListPlaylists.
+func (mr *MockGatewayUsecaseMockRecorder) ListPlaylists(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlaylists", reflect.TypeOf((*MockGatewayUsecase)(nil).ListPlaylists), ctx, ownerID)
+}
+
+// ListSeries mocks base method.
+func (m *MockGatewayUsecase) ListSeries(ctx context.Context, ownerID string) ([]*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeries", ctx, ownerID)
+	ret0, _ := ret[0].([]*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeries indicates an expected call of ListSeries.
+func (mr *MockGatewayUsecaseMockRecorder) ListSeries(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeries", reflect.TypeOf((*MockGatewayUsecase)(nil).ListSeries), ctx, ownerID)
+}
+
+// ListShareLinks mocks base method.
+func (m *MockGatewayUsecase) ListShareLinks(ctx context.Context, id, userID string) ([]*metadata.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShareLinks", ctx, id, userID)
+	ret0, _ := ret[0].([]*metadata.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShareLinks indicates an expected call of ListShareLinks.
+func (mr *MockGatewayUsecaseMockRecorder) ListShareLinks(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShareLinks", reflect.TypeOf((*MockGatewayUsecase)(nil).ListShareLinks), ctx, id, userID)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockGatewayUsecase) ListSubscriptions(ctx context.Context) ([]*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx)
+	ret0, _ := ret[0].([]*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockGatewayUsecaseMockRecorder) ListSubscriptions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockGatewayUsecase)(nil).ListSubscriptions), ctx)
+}
+
+// ListTrash mocks base method.
+func (m *MockGatewayUsecase) ListTrash(ctx context.Context, userID string) ([]*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, userID)
+	ret0, _ := ret[0].([]*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockGatewayUsecaseMockRecorder) ListTrash(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockGatewayUsecase)(nil).ListTrash), ctx, userID)
+}
+
+// ListVideoAccess mocks base method.
+func (m *MockGatewayUsecase) ListVideoAccess(ctx context.Context, id, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVideoAccess", ctx, id, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVideoAccess indicates an expected call of ListVideoAccess.
+func (mr *MockGatewayUsecaseMockRecorder) ListVideoAccess(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideoAccess", reflect.TypeOf((*MockGatewayUsecase)(nil).ListVideoAccess), ctx, id, userID)
+}
+
+// ListVideos mocks base method.
+func (m *MockGatewayUsecase) ListVideos(ctx context.Context, query, tag, category string) ([]*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVideos", ctx, query, tag, category)
+	ret0, _ := ret[0].([]*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVideos indicates an expected call of ListVideos.
+func (mr *MockGatewayUsecaseMockRecorder) ListVideos(ctx, query, tag, category any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideos", reflect.TypeOf((*MockGatewayUsecase)(nil).ListVideos), ctx, query, tag, category)
+}
+
+// Login mocks base method.
+func (m *MockGatewayUsecase) Login(ctx context.Context, email, password string) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", ctx, email, password)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockGatewayUsecaseMockRecorder) Login(ctx, email, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockGatewayUsecase)(nil).Login), ctx, email, password)
+}
+
+// Logout mocks base method.
+func (m *MockGatewayUsecase) Logout(ctx context.Context, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockGatewayUsecaseMockRecorder) Logout(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockGatewayUsecase)(nil).Logout), ctx, token)
+}
+
+// MovePlaylistItem mocks base method.
+func (m *MockGatewayUsecase) MovePlaylistItem(ctx context.Context, id, itemID, afterItemID string) (*metadata.PlaylistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePlaylistItem", ctx, id, itemID, afterItemID)
+	ret0, _ := ret[0].(*metadata.PlaylistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePlaylistItem indicates an expected call of MovePlaylistItem.
+func (mr *MockGatewayUsecaseMockRecorder) MovePlaylistItem(ctx, id, itemID, afterItemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePlaylistItem", reflect.TypeOf((*MockGatewayUsecase)(nil).MovePlaylistItem), ctx, id, itemID, afterItemID)
+}
+
+// PostChatMessage mocks base method.
+func (m *MockGatewayUsecase) PostChatMessage(ctx context.Context, videoID, userID, text string) (*chat.ChatMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostChatMessage", ctx, videoID, userID, text)
+	ret0, _ := ret[0].(*chat.ChatMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostChatMessage indicates an expected call of PostChatMessage.
+func (mr *MockGatewayUsecaseMockRecorder) PostChatMessage(ctx, videoID, userID, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostChatMessage", reflect.TypeOf((*MockGatewayUsecase)(nil).PostChatMessage), ctx, videoID, userID, text)
+}
+
+// RemoveChannelMember mocks base method.
+func (m *MockGatewayUsecase) RemoveChannelMember(ctx context.Context, handle, memberID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChannelMember", ctx, handle, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveChannelMember indicates an expected call of RemoveChannelMember.
+func (mr *MockGatewayUsecaseMockRecorder) RemoveChannelMember(ctx, handle, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChannelMember", reflect.TypeOf((*MockGatewayUsecase)(nil).RemoveChannelMember), ctx, handle, memberID)
+}
+
+// RemoveEpisode mocks base method.
+func (m *MockGatewayUsecase) RemoveEpisode(ctx context.Context, id, videoID string) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEpisode", ctx, id, videoID)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEpisode indicates an expected call of RemoveEpisode.
+func (mr *MockGatewayUsecaseMockRecorder) RemoveEpisode(ctx, id, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEpisode", reflect.TypeOf((*MockGatewayUsecase)(nil).RemoveEpisode), ctx, id, videoID)
+}
+
+// RemovePlaylistCollaborator mocks base method.
+func (m *MockGatewayUsecase) RemovePlaylistCollaborator(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePlaylistCollaborator", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePlaylistCollaborator indicates an expected call of RemovePlaylistCollaborator.
+func (mr *MockGatewayUsecaseMockRecorder) RemovePlaylistCollaborator(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePlaylistCollaborator", reflect.TypeOf((*MockGatewayUsecase)(nil).RemovePlaylistCollaborator), ctx, id, userID)
+}
+
+// RemovePlaylistItem mocks base method.
+func (m *MockGatewayUsecase) RemovePlaylistItem(ctx context.Context, id, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePlaylistItem", ctx, id, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePlaylistItem indicates an expected call of RemovePlaylistItem.
+func (mr *MockGatewayUsecaseMockRecorder) RemovePlaylistItem(ctx, id, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePlaylistItem", reflect.TypeOf((*MockGatewayUsecase)(nil).RemovePlaylistItem), ctx, id, itemID)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockGatewayUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockGatewayUsecaseMockRecorder) RequestPasswordReset(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockGatewayUsecase)(nil).RequestPasswordReset), ctx, email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockGatewayUsecase) ResetPassword(ctx context.Context, token, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, token, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockGatewayUsecaseMockRecorder) ResetPassword(ctx, token, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockGatewayUsecase)(nil).ResetPassword), ctx, token, password)
+}
+
+// RestoreVideo mocks base method.
+func (m *MockGatewayUsecase) RestoreVideo(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVideo", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RestoreVideo indicates an expected call of RestoreVideo.
+func (mr *MockGatewayUsecaseMockRecorder) RestoreVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVideo", reflect.TypeOf((*MockGatewayUsecase)(nil).RestoreVideo), ctx, id, userID)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockGatewayUsecase) RevokeAPIKey(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockGatewayUsecaseMockRecorder) RevokeAPIKey(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockGatewayUsecase)(nil).RevokeAPIKey), ctx, id, userID)
+}
+
+// RevokeAnyAPIKey mocks base method.
+func (m *MockGatewayUsecase) RevokeAnyAPIKey(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAnyAPIKey", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAnyAPIKey indicates an expected call of RevokeAnyAPIKey.
+func (mr *MockGatewayUsecaseMockRecorder) RevokeAnyAPIKey(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAnyAPIKey", reflect.TypeOf((*MockGatewayUsecase)(nil).RevokeAnyAPIKey), ctx, id)
+}
+
+// RevokeShareLink mocks base method.
+func (m *MockGatewayUsecase) RevokeShareLink(ctx context.Context, id, userID, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShareLink", ctx, id, userID, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockGatewayUsecaseMockRecorder) RevokeShareLink(ctx, id, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockGatewayUsecase)(nil).RevokeShareLink), ctx, id, userID, token)
+}
+
+// RevokeVideoAccess mocks base method.
+func (m *MockGatewayUsecase) RevokeVideoAccess(ctx context.Context, id, userID, granteeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeVideoAccess", ctx, id, userID, granteeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeVideoAccess indicates an expected call of RevokeVideoAccess.
+func (mr *MockGatewayUsecaseMockRecorder) RevokeVideoAccess(ctx, id, userID, granteeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeVideoAccess", reflect.TypeOf((*MockGatewayUsecase)(nil).RevokeVideoAccess), ctx, id, userID, granteeID)
+}
+
+// SSOAuthURL mocks base method.
+func (m *MockGatewayUsecase) SSOAuthURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSOAuthURL", ctx, state, nonce, verifier)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SSOAuthURL indicates an expected call of SSOAuthURL.
+func (mr *MockGatewayUsecaseMockRecorder) SSOAuthURL(ctx, state, nonce, verifier any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSOAuthURL", reflect.TypeOf((*MockGatewayUsecase)(nil).SSOAuthURL), ctx, state, nonce, verifier)
+}
+
+// SSOLogin mocks base method.
+func (m *MockGatewayUsecase) SSOLogin(ctx context.Context, code, verifier, nonce string) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SSOLogin", ctx, code, verifier, nonce)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SSOLogin indicates an expected call of SSOLogin.
+func (mr *MockGatewayUsecaseMockRecorder) SSOLogin(ctx, code, verifier, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SSOLogin", reflect.TypeOf((*MockGatewayUsecase)(nil).SSOLogin), ctx, code, verifier, nonce)
+}
+
+// SetChatSlowMode mocks base method.
+func (m *MockGatewayUsecase) SetChatSlowMode(ctx context.Context, videoID, actorID string, seconds int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChatSlowMode", ctx, videoID, actorID, seconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChatSlowMode indicates an expected call of SetChatSlowMode.
+func (mr *MockGatewayUsecaseMockRecorder) SetChatSlowMode(ctx, videoID, actorID, seconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChatSlowMode", reflect.TypeOf((*MockGatewayUsecase)(nil).SetChatSlowMode), ctx, videoID, actorID, seconds)
+}
+
+// SetEpisode mocks base method.
+func (m *MockGatewayUsecase) SetEpisode(ctx context.Context, id, videoID string, season, episode int32) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEpisode", ctx, id, videoID, season, episode)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEpisode indicates an expected call of SetEpisode.
+func (mr *MockGatewayUsecaseMockRecorder) SetEpisode(ctx, id, videoID, season, episode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEpisode", reflect.TypeOf((*MockGatewayUsecase)(nil).SetEpisode), ctx, id, videoID, season, episode)
+}
+
+// SetEpisodeCompleted mocks base method.
+func (m *MockGatewayUsecase) SetEpisodeCompleted(ctx context.Context, id, videoID string, completed bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEpisodeCompleted", ctx, id, videoID, completed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEpisodeCompleted indicates an expected call of SetEpisodeCompleted.
+func (mr *MockGatewayUsecaseMockRecorder) SetEpisodeCompleted(ctx, id, videoID, completed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEpisodeCompleted", reflect.TypeOf((*MockGatewayUsecase)(nil).SetEpisodeCompleted), ctx, id, videoID, completed)
+}
+
+// Signup mocks base method.
+func (m *MockGatewayUsecase) Signup(ctx context.Context, email, password, displayName string) (*user.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Signup", ctx, email, password, displayName)
+	ret0, _ := ret[0].(*user.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Signup indicates an expected call of Signup.
+func (mr *MockGatewayUsecaseMockRecorder) Signup(ctx, email, password, displayName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Signup", reflect.TypeOf((*MockGatewayUsecase)(nil).Signup), ctx, email, password, displayName)
+}
+
+// Subscribe mocks base method.
+func (m *MockGatewayUsecase) Subscribe(ctx context.Context, handle string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockGatewayUsecaseMockRecorder) Subscribe(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockGatewayUsecase)(nil).Subscribe), ctx, handle)
+}
+
+// SubscribeChat mocks base method.
+func (m *MockGatewayUsecase) SubscribeChat(ctx context.Context, videoID, viewerID string) (chat.ChatService_SubscribeClient, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeChat", ctx, videoID, viewerID)
+	ret0, _ := ret[0].(chat.ChatService_SubscribeClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeChat indicates an expected call of SubscribeChat.
+func (mr *MockGatewayUsecaseMockRecorder) SubscribeChat(ctx, videoID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeChat", reflect.TypeOf((*MockGatewayUsecase)(nil).SubscribeChat), ctx, videoID, viewerID)
+}
+
+// UnpublishVideo mocks base method.
+func (m *MockGatewayUsecase) UnpublishVideo(ctx context.Context, id, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpublishVideo", ctx, id, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpublishVideo indicates an expected call of UnpublishVideo.
+func (mr *MockGatewayUsecaseMockRecorder) UnpublishVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpublishVideo", reflect.TypeOf((*MockGatewayUsecase)(nil).UnpublishVideo), ctx, id, userID)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockGatewayUsecase) Unsubscribe(ctx context.Context, handle string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockGatewayUsecaseMockRecorder) Unsubscribe(ctx, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockGatewayUsecase)(nil).Unsubscribe), ctx, handle)
+}
+
+// UpdateChannel mocks base method.
+func (m *MockGatewayUsecase) UpdateChannel(ctx context.Context, handle string, req *metadata.UpdateChannelRequest) (*metadata.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChannel", ctx, handle, req)
+	ret0, _ := ret[0].(*metadata.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateChannel indicates an expected call of UpdateChannel.
+func (mr *MockGatewayUsecaseMockRecorder) UpdateChannel(ctx, handle, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannel", reflect.TypeOf((*MockGatewayUsecase)(nil).UpdateChannel), ctx, handle, req)
+}
+
+// UpdatePlaylist mocks base method.
+func (m *MockGatewayUsecase) UpdatePlaylist(ctx context.Context, req *metadata.UpdatePlaylistRequest) (*metadata.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlaylist", ctx, req)
+	ret0, _ := ret[0].(*metadata.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePlaylist indicates an expected call of UpdatePlaylist.
+func (mr *MockGatewayUsecaseMockRecorder) UpdatePlaylist(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlaylist", reflect.TypeOf((*MockGatewayUsecase)(nil).UpdatePlaylist), ctx, req)
+}
+
+// UpdateSeries mocks base method.
+func (m *MockGatewayUsecase) UpdateSeries(ctx context.Context, req *metadata.UpdateSeriesRequest) (*metadata.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeries", ctx, req)
+	ret0, _ := ret[0].(*metadata.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSeries indicates an expected call of UpdateSeries.
+func (mr *MockGatewayUsecaseMockRecorder) UpdateSeries(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeries", reflect.TypeOf((*MockGatewayUsecase)(nil).UpdateSeries), ctx, req)
+}
+
+// UpdateVideo mocks base method.
+func (m *MockGatewayUsecase) UpdateVideo(ctx context.Context, req *metadata.UpdateVideoRequest) (*common.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVideo", ctx, req)
+	ret0, _ := ret[0].(*common.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVideo indicates an expected call of UpdateVideo.
+func (mr *MockGatewayUsecaseMockRecorder) UpdateVideo(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVideo", reflect.TypeOf((*MockGatewayUsecase)(nil).UpdateVideo), ctx, req)
+}
+
+// UpdateWatchParty mocks base method.
+func (m *MockGatewayUsecase) UpdateWatchParty(id, token string, state domain.PlaybackState) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWatchParty", id, token, state)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWatchParty indicates an expected call of UpdateWatchParty.
+func (mr *MockGatewayUsecaseMockRecorder) UpdateWatchParty(id, token, state any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVideos", reflect.TypeOf((*MockGatewayUsecase)(nil).ListVideos), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWatchParty", reflect.TypeOf((*MockGatewayUsecase)(nil).UpdateWatchParty), id, token, state)
 }
@@ -2,24 +2,39 @@ package usecase
 
 import (
 	"context"
+	"log"
+	"math"
+	"slices"
 
 	"github.com/athandoan/youtube/gateway-service/internal/domain"
+	analyticspb "github.com/athandoan/youtube/proto/analytics"
+	chatpb "github.com/athandoan/youtube/proto/chat"
 	"github.com/athandoan/youtube/proto/common"
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
+	streamingpb "github.com/athandoan/youtube/proto/streaming"
 	uploadpb "github.com/athandoan/youtube/proto/upload"
+	userpb "github.com/athandoan/youtube/proto/user"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type gatewayUsecase struct {
 	metadata  domain.MetadataService
 	upload    domain.UploadService
 	streaming domain.StreamingService
+	analytics domain.AnalyticsService
+	chat      domain.ChatService
+	parties   domain.PartyHub
+	users     domain.UserService
+	sso       domain.IdentityProvider // nil when single sign-on is off
 }
 
-func NewGatewayUsecase(metadata domain.MetadataService, upload domain.UploadService, streaming domain.StreamingService) domain.GatewayUsecase {
-	return &gatewayUsecase{metadata: metadata, upload: upload, streaming: streaming}
+func NewGatewayUsecase(metadata domain.MetadataService, upload domain.UploadService, streaming domain.StreamingService, analytics domain.AnalyticsService, chat domain.ChatService, parties domain.PartyHub, users domain.UserService, sso domain.IdentityProvider) domain.GatewayUsecase {
+	return &gatewayUsecase{metadata: metadata, upload: upload, streaming: streaming, analytics: analytics, chat: chat, parties: parties, users: users, sso: sso}
 }
 
-func (u *gatewayUsecase) InitUpload(ctx context.Context, title, filename string) (*uploadpb.InitUploadResponse, error) {
-	id, url, err := u.upload.InitUpload(ctx, title, filename)
+func (u *gatewayUsecase) InitUpload(ctx context.Context, title, filename, channelID string, allowDownload bool, publishAt string, premiere bool) (*uploadpb.InitUploadResponse, error) {
+	id, url, err := u.upload.InitUpload(ctx, title, filename, channelID, allowDownload, publishAt, premiere)
 	if err != nil {
 		return nil, err
 	}
@@ -37,10 +52,462 @@ func (u *gatewayUsecase) CompleteUpload(ctx context.Context, videoID string) (*u
 	return &uploadpb.CompleteUploadResponse{Status: "success"}, nil
 }
 
-func (u *gatewayUsecase) ListVideos(ctx context.Context, query string) ([]*common.Video, error) {
-	return u.metadata.ListVideos(ctx, query)
+func (u *gatewayUsecase) ListVideos(ctx context.Context, query, tag, category string) ([]*common.Video, error) {
+	return u.metadata.ListVideos(ctx, query, tag, category)
 }
 
-func (u *gatewayUsecase) GetStreamURL(ctx context.Context, videoID string) (string, error) {
-	return u.streaming.GetStreamURL(ctx, videoID)
+func (u *gatewayUsecase) GetVideo(ctx context.Context, id, userID string) (*common.Video, error) {
+	return u.metadata.GetVideo(ctx, id, userID)
+}
+
+// UpdateVideo applies the update and, when the visibility changed, drops the video's cached
+// stream URLs so a video made private stops playing for everyone else.
+func (u *gatewayUsecase) UpdateVideo(ctx context.Context, req *metadatapb.UpdateVideoRequest) (*common.Video, error) {
+	video, err := u.metadata.UpdateVideo(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(req.GetUpdateMask().GetPaths(), "visibility") {
+		if err := u.streaming.InvalidateStreamURL(ctx, req.Id); err != nil {
+			log.Printf("failed to invalidate stream URLs of video %s: %v", req.Id, err)
+		}
+	}
+	return video, nil
+}
+
+func (u *gatewayUsecase) GrantVideoAccess(ctx context.Context, id, userID, granteeID string) error {
+	return u.metadata.GrantVideoAccess(ctx, id, userID, granteeID)
+}
+
+func (u *gatewayUsecase) RevokeVideoAccess(ctx context.Context, id, userID, granteeID string) error {
+	return u.metadata.RevokeVideoAccess(ctx, id, userID, granteeID)
+}
+
+func (u *gatewayUsecase) ListVideoAccess(ctx context.Context, id, userID string) ([]string, error) {
+	return u.metadata.ListVideoAccess(ctx, id, userID)
+}
+
+func (u *gatewayUsecase) CreateShareLink(ctx context.Context, id, userID, expiresAt string, maxViews int32, password string) (*metadatapb.ShareLink, error) {
+	return u.metadata.CreateShareLink(ctx, id, userID, expiresAt, maxViews, password)
+}
+
+func (u *gatewayUsecase) ListShareLinks(ctx context.Context, id, userID string) ([]*metadatapb.ShareLink, error) {
+	return u.metadata.ListShareLinks(ctx, id, userID)
+}
+
+func (u *gatewayUsecase) RevokeShareLink(ctx context.Context, id, userID, token string) error {
+	return u.metadata.RevokeShareLink(ctx, id, userID, token)
+}
+
+func (u *gatewayUsecase) GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error) {
+	return u.streaming.GetSharedStreamURL(ctx, token, password, rendition, region)
+}
+
+func (u *gatewayUsecase) GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*streamingpb.GetStreamURLResponse, error) {
+	return u.streaming.GetStreamURL(ctx, videoID, rendition, region, userID)
+}
+
+func (u *gatewayUsecase) GetDownloadURL(ctx context.Context, videoID, userID string) (string, error) {
+	return u.streaming.GetDownloadURL(ctx, videoID, userID)
+}
+
+func (u *gatewayUsecase) GetVideoMedia(ctx context.Context, videoID, userID string) (*streamingpb.GetVideoMediaResponse, error) {
+	return u.streaming.GetVideoMedia(ctx, videoID, userID)
+}
+
+func (u *gatewayUsecase) IngestBeacons(ctx context.Context, beacons []*analyticspb.Beacon) (*analyticspb.IngestBeaconsResponse, error) {
+	return u.analytics.IngestBeacons(ctx, beacons)
+}
+
+func (u *gatewayUsecase) GetPlaybackStats(ctx context.Context, videoID, from, to string) (*analyticspb.GetPlaybackStatsResponse, error) {
+	return u.analytics.GetPlaybackStats(ctx, videoID, from, to)
+}
+
+func (u *gatewayUsecase) GetUser(ctx context.Context, id string) (*userpb.User, error) {
+	return u.users.GetUser(ctx, id)
+}
+
+func (u *gatewayUsecase) GetChannelByID(ctx context.Context, id string) (*metadatapb.Channel, error) {
+	return u.metadata.GetChannelByID(ctx, id)
+}
+
+func (u *gatewayUsecase) CreateStreamKey(ctx context.Context, channelID, title string) (string, error) {
+	return u.metadata.CreateStreamKey(ctx, channelID, title)
+}
+
+// DeleteVideo moves a video to the trash and drops its cached stream URLs, so it stops
+// playing right away.
+func (u *gatewayUsecase) DeleteVideo(ctx context.Context, id, userID string) error {
+	if err := u.metadata.DeleteVideo(ctx, id, userID); err != nil {
+		return err
+	}
+	// Cached URLs would keep a trashed video playing; the deletion stands either way
+	if err := u.streaming.InvalidateStreamURL(ctx, id); err != nil {
+		log.Printf("failed to invalidate stream URLs of deleted video %s: %v", id, err)
+	}
+	return nil
+}
+
+func (u *gatewayUsecase) RestoreVideo(ctx context.Context, id, userID string) error {
+	return u.metadata.RestoreVideo(ctx, id, userID)
+}
+
+// UnpublishVideo takes a video out of public view and drops its cached stream URLs, like
+// DeleteVideo does.
+func (u *gatewayUsecase) UnpublishVideo(ctx context.Context, id, userID string) error {
+	if err := u.metadata.UnpublishVideo(ctx, id, userID); err != nil {
+		return err
+	}
+	if err := u.streaming.InvalidateStreamURL(ctx, id); err != nil {
+		log.Printf("failed to invalidate stream URLs of unpublished video %s: %v", id, err)
+	}
+	return nil
+}
+
+func (u *gatewayUsecase) ListTrash(ctx context.Context, userID string) ([]*common.Video, error) {
+	return u.metadata.ListTrash(ctx, userID)
+}
+
+func (u *gatewayUsecase) PostChatMessage(ctx context.Context, videoID, userID, text string) (*chatpb.ChatMessage, error) {
+	return u.chat.PostMessage(ctx, videoID, userID, text)
+}
+
+func (u *gatewayUsecase) ListChatMessages(ctx context.Context, videoID, viewerID string, fromMs, toMs int64, limit int32) ([]*chatpb.ChatMessage, error) {
+	return u.chat.ListMessages(ctx, videoID, viewerID, fromMs, toMs, limit)
+}
+
+func (u *gatewayUsecase) SubscribeChat(ctx context.Context, videoID, viewerID string) (chatpb.ChatService_SubscribeClient, error) {
+	return u.chat.Subscribe(ctx, videoID, viewerID)
+}
+
+func (u *gatewayUsecase) DeleteChatMessage(ctx context.Context, videoID, actorID string, messageID int64) error {
+	return u.chat.DeleteMessage(ctx, videoID, actorID, messageID)
+}
+
+func (u *gatewayUsecase) BanChatUser(ctx context.Context, videoID, actorID, userID string, durationSeconds int32) error {
+	return u.chat.BanUser(ctx, videoID, actorID, userID, durationSeconds)
+}
+
+func (u *gatewayUsecase) SetChatSlowMode(ctx context.Context, videoID, actorID string, seconds int32) error {
+	return u.chat.SetSlowMode(ctx, videoID, actorID, seconds)
+}
+
+func (u *gatewayUsecase) AddChatModerator(ctx context.Context, videoID, actorID, userID string) error {
+	return u.chat.AddModerator(ctx, videoID, actorID, userID)
+}
+
+// CreateWatchParty starts a party for a video the caller can play and returns it with the stream URL.
+func (u *gatewayUsecase) CreateWatchParty(ctx context.Context, videoID, rendition, region, userID string) (*domain.WatchParty, string, error) {
+	stream, err := u.streaming.GetStreamURL(ctx, videoID, rendition, region, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	party, err := u.parties.Create(videoID)
+	if err != nil {
+		return nil, "", err
+	}
+	return party, stream.Url, nil
+}
+
+// GetWatchParty returns a party with a stream URL for the member joining it.
+func (u *gatewayUsecase) GetWatchParty(ctx context.Context, id, rendition, region, userID string) (*domain.WatchParty, string, error) {
+	party, err := u.parties.Get(id)
+	if err != nil {
+		return nil, "", err
+	}
+	stream, err := u.streaming.GetStreamURL(ctx, party.VideoID, rendition, region, userID)
+	if err != nil {
+		return nil, "", err
+	}
+	// Only the creator gets to lead
+	party.LeaderToken = ""
+	return party, stream.Url, nil
+}
+
+func (u *gatewayUsecase) UpdateWatchParty(id, token string, state domain.PlaybackState) error {
+	if state.Position < 0 || math.IsNaN(state.Position) || math.IsInf(state.Position, 0) {
+		return domain.ErrInvalidState
+	}
+	return u.parties.SetState(id, token, state)
+}
+
+func (u *gatewayUsecase) JoinWatchParty(ctx context.Context, id, userID string) (<-chan domain.PlaybackState, func(), error) {
+	party, err := u.parties.Get(id)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Following a party reveals what its video is doing, so members must be able to watch it
+	if _, err := u.metadata.GetVideo(ctx, party.VideoID, userID); err != nil {
+		if c := status.Code(err); c == codes.NotFound || c == codes.PermissionDenied {
+			return nil, nil, domain.ErrPartyNotFound
+		}
+		return nil, nil, err
+	}
+	return u.parties.Subscribe(id)
+}
+
+func (u *gatewayUsecase) Signup(ctx context.Context, email, password, displayName string) (*userpb.Session, error) {
+	return u.users.Signup(ctx, email, password, displayName)
+}
+
+func (u *gatewayUsecase) Login(ctx context.Context, email, password string) (*userpb.Session, error) {
+	return u.users.Login(ctx, email, password)
+}
+
+func (u *gatewayUsecase) Logout(ctx context.Context, token string) error {
+	return u.users.Logout(ctx, token)
+}
+
+func (u *gatewayUsecase) Authenticate(ctx context.Context, token string) (*userpb.User, error) {
+	return u.users.Authenticate(ctx, token)
+}
+
+func (u *gatewayUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	return u.users.RequestPasswordReset(ctx, email)
+}
+
+func (u *gatewayUsecase) ResetPassword(ctx context.Context, token, password string) error {
+	return u.users.ResetPassword(ctx, token, password)
+}
+
+func (u *gatewayUsecase) SSOAuthURL(ctx context.Context, state, nonce, verifier string) (string, error) {
+	if u.sso == nil {
+		return "", domain.ErrSSODisabled
+	}
+	return u.sso.AuthCodeURL(ctx, state, nonce, verifier)
+}
+
+// SSOLogin verifies the provider's ID token and signs its user in to their local account.
+func (u *gatewayUsecase) SSOLogin(ctx context.Context, code, verifier, nonce string) (*userpb.Session, error) {
+	if u.sso == nil {
+		return nil, domain.ErrSSODisabled
+	}
+	identity, err := u.sso.Exchange(ctx, code, verifier, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return u.users.LoginExternal(ctx, identity)
+}
+
+func (u *gatewayUsecase) CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (*userpb.CreateAPIKeyResponse, error) {
+	return u.users.CreateAPIKey(ctx, userID, name, scopes)
+}
+
+func (u *gatewayUsecase) ListAPIKeys(ctx context.Context, userID string) ([]*userpb.APIKey, error) {
+	return u.users.ListAPIKeys(ctx, userID, false)
+}
+
+func (u *gatewayUsecase) RevokeAPIKey(ctx context.Context, id, userID string) error {
+	return u.users.RevokeAPIKey(ctx, id, userID, false)
+}
+
+func (u *gatewayUsecase) ListAllAPIKeys(ctx context.Context) ([]*userpb.APIKey, error) {
+	return u.users.ListAPIKeys(ctx, "", true)
+}
+
+func (u *gatewayUsecase) RevokeAnyAPIKey(ctx context.Context, id string) error {
+	return u.users.RevokeAPIKey(ctx, id, "", true)
+}
+
+func (u *gatewayUsecase) AuthenticateAPIKey(ctx context.Context, key string) (*userpb.AuthenticateAPIKeyResponse, error) {
+	return u.users.AuthenticateAPIKey(ctx, key)
+}
+
+func (u *gatewayUsecase) CreateChannel(ctx context.Context, handle, displayName, description string) (*metadatapb.Channel, error) {
+	return u.metadata.CreateChannel(ctx, handle, displayName, description)
+}
+
+func (u *gatewayUsecase) GetChannel(ctx context.Context, handle string) (*metadatapb.Channel, error) {
+	return u.metadata.GetChannel(ctx, handle)
+}
+
+func (u *gatewayUsecase) ListChannelVideos(ctx context.Context, handle string) ([]*common.Video, error) {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return nil, err
+	}
+	return u.metadata.ListChannelVideos(ctx, channel.Id)
+}
+
+func (u *gatewayUsecase) UpdateChannel(ctx context.Context, handle string, req *metadatapb.UpdateChannelRequest) (*metadatapb.Channel, error) {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return nil, err
+	}
+	req.Id = channel.Id
+	return u.metadata.UpdateChannel(ctx, req)
+}
+
+func (u *gatewayUsecase) DeleteChannel(ctx context.Context, handle string) error {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return err
+	}
+	return u.metadata.DeleteChannel(ctx, channel.Id)
+}
+
+func (u *gatewayUsecase) AddChannelMember(ctx context.Context, handle, memberID string) error {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return err
+	}
+	return u.metadata.AddChannelMember(ctx, channel.Id, memberID)
+}
+
+func (u *gatewayUsecase) RemoveChannelMember(ctx context.Context, handle, memberID string) error {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return err
+	}
+	return u.metadata.RemoveChannelMember(ctx, channel.Id, memberID)
+}
+
+func (u *gatewayUsecase) InitChannelImageUpload(ctx context.Context, handle, kind, filename string) (string, error) {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return "", err
+	}
+	return u.upload.InitChannelImageUpload(ctx, channel.Id, kind, filename)
+}
+
+func (u *gatewayUsecase) GetChannelImageURL(ctx context.Context, handle, kind string) (string, error) {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return "", err
+	}
+	return u.streaming.GetChannelImageURL(ctx, channel.Id, kind)
+}
+
+func (u *gatewayUsecase) Subscribe(ctx context.Context, handle string) error {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return err
+	}
+	return u.metadata.Subscribe(ctx, channel.Id)
+}
+
+func (u *gatewayUsecase) Unsubscribe(ctx context.Context, handle string) error {
+	channel, err := u.metadata.GetChannel(ctx, handle)
+	if err != nil {
+		return err
+	}
+	return u.metadata.Unsubscribe(ctx, channel.Id)
+}
+
+func (u *gatewayUsecase) ListSubscriptions(ctx context.Context) ([]*metadatapb.Channel, error) {
+	return u.metadata.ListSubscriptions(ctx)
+}
+
+func (u *gatewayUsecase) GetSubscriptionFeed(ctx context.Context, pageToken string, pageSize int32) ([]*common.Video, string, error) {
+	return u.metadata.GetSubscriptionFeed(ctx, pageToken, pageSize)
+}
+
+func (u *gatewayUsecase) CreatePlaylist(ctx context.Context, title, description, visibility string) (*metadatapb.Playlist, error) {
+	return u.metadata.CreatePlaylist(ctx, title, description, visibility)
+}
+
+func (u *gatewayUsecase) GetPlaylist(ctx context.Context, id string) (*metadatapb.Playlist, error) {
+	return u.metadata.GetPlaylist(ctx, id)
+}
+
+func (u *gatewayUsecase) ListPlaylists(ctx context.Context, ownerID string) ([]*metadatapb.Playlist, error) {
+	return u.metadata.ListPlaylists(ctx, ownerID)
+}
+
+func (u *gatewayUsecase) UpdatePlaylist(ctx context.Context, req *metadatapb.UpdatePlaylistRequest) (*metadatapb.Playlist, error) {
+	return u.metadata.UpdatePlaylist(ctx, req)
+}
+
+func (u *gatewayUsecase) DeletePlaylist(ctx context.Context, id string) error {
+	return u.metadata.DeletePlaylist(ctx, id)
+}
+
+func (u *gatewayUsecase) AddPlaylistItem(ctx context.Context, id, videoID string) (*metadatapb.PlaylistItem, error) {
+	return u.metadata.AddPlaylistItem(ctx, id, videoID)
+}
+
+func (u *gatewayUsecase) RemovePlaylistItem(ctx context.Context, id, itemID string) error {
+	return u.metadata.RemovePlaylistItem(ctx, id, itemID)
+}
+
+func (u *gatewayUsecase) MovePlaylistItem(ctx context.Context, id, itemID, afterItemID string) (*metadatapb.PlaylistItem, error) {
+	return u.metadata.MovePlaylistItem(ctx, id, itemID, afterItemID)
+}
+
+func (u *gatewayUsecase) AddPlaylistCollaborator(ctx context.Context, id, userID string) error {
+	return u.metadata.AddPlaylistCollaborator(ctx, id, userID)
+}
+
+func (u *gatewayUsecase) RemovePlaylistCollaborator(ctx context.Context, id, userID string) error {
+	return u.metadata.RemovePlaylistCollaborator(ctx, id, userID)
+}
+
+func (u *gatewayUsecase) CreateSeries(ctx context.Context, title, description string) (*metadatapb.Series, error) {
+	return u.metadata.CreateSeries(ctx, title, description)
+}
+
+func (u *gatewayUsecase) GetSeries(ctx context.Context, id string) (*metadatapb.Series, error) {
+	return u.metadata.GetSeries(ctx, id)
+}
+
+func (u *gatewayUsecase) ListSeries(ctx context.Context, ownerID string) ([]*metadatapb.Series, error) {
+	return u.metadata.ListSeries(ctx, ownerID)
+}
+
+func (u *gatewayUsecase) UpdateSeries(ctx context.Context, req *metadatapb.UpdateSeriesRequest) (*metadatapb.Series, error) {
+	return u.metadata.UpdateSeries(ctx, req)
+}
+
+// DeleteSeries looks the series up first, so the episodes it leaves behind stop autoplaying
+// into each other.
+func (u *gatewayUsecase) DeleteSeries(ctx context.Context, id string) error {
+	series, err := u.metadata.GetSeries(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := u.metadata.DeleteSeries(ctx, id); err != nil {
+		return err
+	}
+	u.invalidateEpisodes(ctx, series)
+	return nil
+}
+
+func (u *gatewayUsecase) SetEpisode(ctx context.Context, id, videoID string, season, episode int32) (*metadatapb.Series, error) {
+	series, err := u.metadata.SetEpisode(ctx, id, videoID, season, episode)
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateEpisodes(ctx, series, videoID)
+	return series, nil
+}
+
+func (u *gatewayUsecase) RemoveEpisode(ctx context.Context, id, videoID string) (*metadatapb.Series, error) {
+	series, err := u.metadata.RemoveEpisode(ctx, id, videoID)
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateEpisodes(ctx, series, videoID)
+	return series, nil
+}
+
+// invalidateEpisodes drops the cached stream URLs of a series' episodes and of videoIDs, as
+// they carry the episode to autoplay next. The change stands either way; stale entries
+// expire with their URLs.
+func (u *gatewayUsecase) invalidateEpisodes(ctx context.Context, series *metadatapb.Series, videoIDs ...string) {
+	for _, v := range series.Episodes {
+		videoIDs = append(videoIDs, v.Id)
+	}
+	for _, id := range videoIDs {
+		if err := u.streaming.InvalidateStreamURL(ctx, id); err != nil {
+			log.Printf("failed to invalidate stream URLs of episode %s: %v", id, err)
+		}
+	}
+}
+
+func (u *gatewayUsecase) GetNextEpisode(ctx context.Context, id, afterVideoID string) (*common.Video, error) {
+	return u.metadata.GetNextEpisode(ctx, id, afterVideoID)
+}
+
+func (u *gatewayUsecase) SetEpisodeCompleted(ctx context.Context, id, videoID string, completed bool) error {
+	return u.metadata.SetEpisodeCompleted(ctx, id, videoID, completed)
 }
@@ -5,9 +5,18 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/athandoan/youtube/gateway-service/internal/domain"
 	"github.com/athandoan/youtube/gateway-service/internal/mocks"
+	analyticspb "github.com/athandoan/youtube/proto/analytics"
+	chatpb "github.com/athandoan/youtube/proto/chat"
 	"github.com/athandoan/youtube/proto/common"
+	metadatapb "github.com/athandoan/youtube/proto/metadata"
+	streamingpb "github.com/athandoan/youtube/proto/streaming"
+	userpb "github.com/athandoan/youtube/proto/user"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 func TestGatewayUsecase_InitUpload(t *testing.T) {
@@ -15,6 +24,7 @@ func TestGatewayUsecase_InitUpload(t *testing.T) {
 		name      string
 		title     string
 		filename  string
+		channelID string
 		setupMock func(upload *mocks.MockUploadService)
 		wantID    string
 		wantURL   string
@@ -26,20 +36,33 @@ func TestGatewayUsecase_InitUpload(t *testing.T) {
 			filename: "video.mp4",
 			setupMock: func(upload *mocks.MockUploadService) {
 				upload.EXPECT().
-					InitUpload(gomock.Any(), "My Video", "video.mp4").
+					InitUpload(gomock.Any(), "My Video", "video.mp4", "", false, "", false).
 					Return("video-123", "https://presigned-url.example.com", nil)
 			},
 			wantID:  "video-123",
 			wantURL: "https://presigned-url.example.com",
 			wantErr: false,
 		},
+		{
+			name:      "success - posts to a channel",
+			title:     "My Video",
+			filename:  "video.mp4",
+			channelID: "channel-1",
+			setupMock: func(upload *mocks.MockUploadService) {
+				upload.EXPECT().
+					InitUpload(gomock.Any(), "My Video", "video.mp4", "channel-1", false, "", false).
+					Return("video-123", "https://presigned-url.example.com", nil)
+			},
+			wantID:  "video-123",
+			wantURL: "https://presigned-url.example.com",
+		},
 		{
 			name:     "error - upload service fails",
 			title:    "My Video",
 			filename: "video.mp4",
 			setupMock: func(upload *mocks.MockUploadService) {
 				upload.EXPECT().
-					InitUpload(gomock.Any(), "My Video", "video.mp4").
+					InitUpload(gomock.Any(), "My Video", "video.mp4", "", false, "", false).
 					Return("", "", errors.New("upload service unavailable"))
 			},
 			wantErr: true,
@@ -54,10 +77,14 @@ func TestGatewayUsecase_InitUpload(t *testing.T) {
 			mockMetadata := mocks.NewMockMetadataService(ctrl)
 			mockUpload := mocks.NewMockUploadService(ctrl)
 			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+			mockChat := mocks.NewMockChatService(ctrl)
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			mockUsers := mocks.NewMockUserService(ctrl)
 			tt.setupMock(mockUpload)
 
-			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming)
-			resp, err := uc.InitUpload(context.Background(), tt.title, tt.filename)
+			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+			resp, err := uc.InitUpload(context.Background(), tt.title, tt.filename, tt.channelID, false, "", false)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("InitUpload() error = %v, wantErr %v", err, tt.wantErr)
@@ -113,9 +140,13 @@ func TestGatewayUsecase_CompleteUpload(t *testing.T) {
 			mockMetadata := mocks.NewMockMetadataService(ctrl)
 			mockUpload := mocks.NewMockUploadService(ctrl)
 			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+			mockChat := mocks.NewMockChatService(ctrl)
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			mockUsers := mocks.NewMockUserService(ctrl)
 			tt.setupMock(mockUpload)
 
-			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming)
+			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
 			resp, err := uc.CompleteUpload(context.Background(), tt.videoID)
 
 			if (err != nil) != tt.wantErr {
@@ -143,7 +174,7 @@ func TestGatewayUsecase_ListVideos(t *testing.T) {
 			query: "",
 			setupMock: func(metadata *mocks.MockMetadataService) {
 				metadata.EXPECT().
-					ListVideos(gomock.Any(), "").
+					ListVideos(gomock.Any(), "", "", "").
 					Return([]*common.Video{
 						{Id: "video-1", Title: "Video 1"},
 						{Id: "video-2", Title: "Video 2"},
@@ -158,7 +189,7 @@ func TestGatewayUsecase_ListVideos(t *testing.T) {
 			query: "golang",
 			setupMock: func(metadata *mocks.MockMetadataService) {
 				metadata.EXPECT().
-					ListVideos(gomock.Any(), "golang").
+					ListVideos(gomock.Any(), "golang", "", "").
 					Return([]*common.Video{
 						{Id: "video-1", Title: "Golang Tutorial"},
 					}, nil)
@@ -171,7 +202,7 @@ func TestGatewayUsecase_ListVideos(t *testing.T) {
 			query: "nonexistent",
 			setupMock: func(metadata *mocks.MockMetadataService) {
 				metadata.EXPECT().
-					ListVideos(gomock.Any(), "nonexistent").
+					ListVideos(gomock.Any(), "nonexistent", "", "").
 					Return([]*common.Video{}, nil)
 			},
 			wantCount: 0,
@@ -182,7 +213,7 @@ func TestGatewayUsecase_ListVideos(t *testing.T) {
 			query: "",
 			setupMock: func(metadata *mocks.MockMetadataService) {
 				metadata.EXPECT().
-					ListVideos(gomock.Any(), "").
+					ListVideos(gomock.Any(), "", "", "").
 					Return(nil, errors.New("metadata service unavailable"))
 			},
 			wantErr: true,
@@ -197,10 +228,14 @@ func TestGatewayUsecase_ListVideos(t *testing.T) {
 			mockMetadata := mocks.NewMockMetadataService(ctrl)
 			mockUpload := mocks.NewMockUploadService(ctrl)
 			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+			mockChat := mocks.NewMockChatService(ctrl)
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			mockUsers := mocks.NewMockUserService(ctrl)
 			tt.setupMock(mockMetadata)
 
-			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming)
-			videos, err := uc.ListVideos(context.Background(), tt.query)
+			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+			videos, err := uc.ListVideos(context.Background(), tt.query, "", "")
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("ListVideos() error = %v, wantErr %v", err, tt.wantErr)
@@ -227,8 +262,8 @@ func TestGatewayUsecase_GetStreamURL(t *testing.T) {
 			videoID: "video-123",
 			setupMock: func(streaming *mocks.MockStreamingService) {
 				streaming.EXPECT().
-					GetStreamURL(gomock.Any(), "video-123").
-					Return("https://stream.example.com/video-123?signature=xxx", nil)
+					GetStreamURL(gomock.Any(), "video-123", "", "", "user-1").
+					Return(&streamingpb.GetStreamURLResponse{Url: "https://stream.example.com/video-123?signature=xxx"}, nil)
 			},
 			wantURL: "https://stream.example.com/video-123?signature=xxx",
 			wantErr: false,
@@ -238,8 +273,8 @@ func TestGatewayUsecase_GetStreamURL(t *testing.T) {
 			videoID: "nonexistent-id",
 			setupMock: func(streaming *mocks.MockStreamingService) {
 				streaming.EXPECT().
-					GetStreamURL(gomock.Any(), "nonexistent-id").
-					Return("", errors.New("video not found"))
+					GetStreamURL(gomock.Any(), "nonexistent-id", "", "", "user-1").
+					Return(nil, errors.New("video not found"))
 			},
 			wantErr: true,
 		},
@@ -248,8 +283,8 @@ func TestGatewayUsecase_GetStreamURL(t *testing.T) {
 			videoID: "video-123",
 			setupMock: func(streaming *mocks.MockStreamingService) {
 				streaming.EXPECT().
-					GetStreamURL(gomock.Any(), "video-123").
-					Return("", errors.New("connection refused"))
+					GetStreamURL(gomock.Any(), "video-123", "", "", "user-1").
+					Return(nil, errors.New("connection refused"))
 			},
 			wantErr: true,
 		},
@@ -263,18 +298,870 @@ func TestGatewayUsecase_GetStreamURL(t *testing.T) {
 			mockMetadata := mocks.NewMockMetadataService(ctrl)
 			mockUpload := mocks.NewMockUploadService(ctrl)
 			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+			mockChat := mocks.NewMockChatService(ctrl)
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			mockUsers := mocks.NewMockUserService(ctrl)
 			tt.setupMock(mockStreaming)
 
-			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming)
-			url, err := uc.GetStreamURL(context.Background(), tt.videoID)
+			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+			stream, err := uc.GetStreamURL(context.Background(), tt.videoID, "", "", "user-1")
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetStreamURL() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
+			if !tt.wantErr && stream.Url != tt.wantURL {
+				t.Errorf("GetStreamURL() = %v, want %v", stream.Url, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_GetDownloadURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		videoID   string
+		userID    string
+		setupMock func(streaming *mocks.MockStreamingService)
+		wantURL   string
+		wantErr   bool
+	}{
+		{
+			name:    "success - returns download URL",
+			videoID: "video-123",
+			userID:  "user-1",
+			setupMock: func(streaming *mocks.MockStreamingService) {
+				streaming.EXPECT().
+					GetDownloadURL(gomock.Any(), "video-123", "user-1").
+					Return("https://s3.example.com/video-123?response-content-disposition=attachment", nil)
+			},
+			wantURL: "https://s3.example.com/video-123?response-content-disposition=attachment",
+			wantErr: false,
+		},
+		{
+			name:    "error - download not allowed",
+			videoID: "video-123",
+			setupMock: func(streaming *mocks.MockStreamingService) {
+				streaming.EXPECT().
+					GetDownloadURL(gomock.Any(), "video-123", "").
+					Return("", errors.New("download not allowed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockUpload := mocks.NewMockUploadService(ctrl)
+			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+			mockChat := mocks.NewMockChatService(ctrl)
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			mockUsers := mocks.NewMockUserService(ctrl)
+			tt.setupMock(mockStreaming)
+
+			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+			url, err := uc.GetDownloadURL(context.Background(), tt.videoID, tt.userID)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetDownloadURL() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
 			if !tt.wantErr && url != tt.wantURL {
-				t.Errorf("GetStreamURL() = %v, want %v", url, tt.wantURL)
+				t.Errorf("GetDownloadURL() = %v, want %v", url, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_IngestBeacons(t *testing.T) {
+	beacons := []*analyticspb.Beacon{
+		{VideoId: "video-123", SessionId: "session-1", Type: "startup", ValueMs: 900},
+	}
+
+	tests := []struct {
+		name         string
+		setupMock    func(analytics *mocks.MockAnalyticsService)
+		wantAccepted int32
+		wantErr      bool
+	}{
+		{
+			name: "success - forwards beacons to analytics",
+			setupMock: func(analytics *mocks.MockAnalyticsService) {
+				analytics.EXPECT().
+					IngestBeacons(gomock.Any(), beacons).
+					Return(&analyticspb.IngestBeaconsResponse{Accepted: 1}, nil)
+			},
+			wantAccepted: 1,
+			wantErr:      false,
+		},
+		{
+			name: "error - analytics service unavailable",
+			setupMock: func(analytics *mocks.MockAnalyticsService) {
+				analytics.EXPECT().
+					IngestBeacons(gomock.Any(), beacons).
+					Return(nil, errors.New("connection refused"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockUpload := mocks.NewMockUploadService(ctrl)
+			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+			mockChat := mocks.NewMockChatService(ctrl)
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			mockUsers := mocks.NewMockUserService(ctrl)
+			tt.setupMock(mockAnalytics)
+
+			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+			resp, err := uc.IngestBeacons(context.Background(), beacons)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("IngestBeacons() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+
+			if !tt.wantErr && resp.Accepted != tt.wantAccepted {
+				t.Errorf("IngestBeacons() accepted = %v, want %v", resp.Accepted, tt.wantAccepted)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_CreateStreamKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockUpload := mocks.NewMockUploadService(ctrl)
+	mockStreaming := mocks.NewMockStreamingService(ctrl)
+	mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+	mockChat := mocks.NewMockChatService(ctrl)
+	mockParties := mocks.NewMockPartyHub(ctrl)
+	mockUsers := mocks.NewMockUserService(ctrl)
+
+	mockMetadata.EXPECT().
+		CreateStreamKey(gomock.Any(), "channel-1", "Weekly show").
+		Return("key-123", nil)
+
+	uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+	key, err := uc.CreateStreamKey(context.Background(), "channel-1", "Weekly show")
+	if err != nil {
+		t.Fatalf("CreateStreamKey() unexpected error: %v", err)
+	}
+	if key != "key-123" {
+		t.Errorf("CreateStreamKey() = %v, want key-123", key)
+	}
+}
+
+func TestGatewayUsecase_PostChatMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockUpload := mocks.NewMockUploadService(ctrl)
+	mockStreaming := mocks.NewMockStreamingService(ctrl)
+	mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+	mockChat := mocks.NewMockChatService(ctrl)
+	mockParties := mocks.NewMockPartyHub(ctrl)
+	mockUsers := mocks.NewMockUserService(ctrl)
+
+	mockChat.EXPECT().
+		PostMessage(gomock.Any(), "video-123", "user-1", "hello").
+		Return(&chatpb.ChatMessage{Id: 7, VideoId: "video-123", UserId: "user-1", Text: "hello"}, nil)
+
+	uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+	msg, err := uc.PostChatMessage(context.Background(), "video-123", "user-1", "hello")
+	if err != nil {
+		t.Fatalf("PostChatMessage() unexpected error: %v", err)
+	}
+	if msg.Id != 7 {
+		t.Errorf("PostChatMessage() id = %v, want 7", msg.Id)
+	}
+}
+
+func TestGatewayUsecase_BanChatUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockUpload := mocks.NewMockUploadService(ctrl)
+	mockStreaming := mocks.NewMockStreamingService(ctrl)
+	mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+	mockChat := mocks.NewMockChatService(ctrl)
+	mockParties := mocks.NewMockPartyHub(ctrl)
+	mockUsers := mocks.NewMockUserService(ctrl)
+
+	mockChat.EXPECT().
+		BanUser(gomock.Any(), "video-123", "viewer", "troll", int32(600)).
+		Return(status.Error(codes.PermissionDenied, "only the owner and moderators can do that"))
+
+	uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+	err := uc.BanChatUser(context.Background(), "video-123", "viewer", "troll", 600)
+	if status.Code(err) != codes.PermissionDenied {
+		t.Errorf("BanChatUser() error = %v, want PermissionDenied", err)
+	}
+}
+
+func TestGatewayUsecase_CreateWatchParty(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(streaming *mocks.MockStreamingService, parties *mocks.MockPartyHub)
+		wantURL   string
+		wantErr   bool
+	}{
+		{
+			name: "success - creates a party for a playable video",
+			setupMock: func(streaming *mocks.MockStreamingService, parties *mocks.MockPartyHub) {
+				streaming.EXPECT().
+					GetStreamURL(gomock.Any(), "video-123", "720p", "eu", "user-1").
+					Return(&streamingpb.GetStreamURLResponse{Url: "https://cdn.example.com/video-123/720p.mp4"}, nil)
+				parties.EXPECT().
+					Create("video-123").
+					Return(&domain.WatchParty{ID: "party-1", VideoID: "video-123", LeaderToken: "secret"}, nil)
+			},
+			wantURL: "https://cdn.example.com/video-123/720p.mp4",
+		},
+		{
+			name: "error - video cannot be played",
+			setupMock: func(streaming *mocks.MockStreamingService, parties *mocks.MockPartyHub) {
+				streaming.EXPECT().
+					GetStreamURL(gomock.Any(), "video-123", "720p", "eu", "user-1").
+					Return(nil, status.Error(codes.NotFound, "video not found"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockUpload := mocks.NewMockUploadService(ctrl)
+			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+			mockChat := mocks.NewMockChatService(ctrl)
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			mockUsers := mocks.NewMockUserService(ctrl)
+			tt.setupMock(mockStreaming, mockParties)
+
+			uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+			party, url, err := uc.CreateWatchParty(context.Background(), "video-123", "720p", "eu", "user-1")
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CreateWatchParty() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if url != tt.wantURL {
+					t.Errorf("CreateWatchParty() url = %v, want %v", url, tt.wantURL)
+				}
+				if party.LeaderToken == "" {
+					t.Error("CreateWatchParty() should hand the leader token to the creator")
+				}
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_GetWatchParty(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockUpload := mocks.NewMockUploadService(ctrl)
+	mockStreaming := mocks.NewMockStreamingService(ctrl)
+	mockAnalytics := mocks.NewMockAnalyticsService(ctrl)
+	mockChat := mocks.NewMockChatService(ctrl)
+	mockParties := mocks.NewMockPartyHub(ctrl)
+	mockUsers := mocks.NewMockUserService(ctrl)
+
+	mockParties.EXPECT().
+		Get("party-1").
+		Return(&domain.WatchParty{ID: "party-1", VideoID: "video-123", LeaderToken: "secret"}, nil)
+	mockStreaming.EXPECT().
+		GetStreamURL(gomock.Any(), "video-123", "", "", "user-1").
+		Return(&streamingpb.GetStreamURLResponse{Url: "https://cdn.example.com/video-123.mp4"}, nil)
+
+	uc := NewGatewayUsecase(mockMetadata, mockUpload, mockStreaming, mockAnalytics, mockChat, mockParties, mockUsers, nil)
+	party, url, err := uc.GetWatchParty(context.Background(), "party-1", "", "", "user-1")
+	if err != nil {
+		t.Fatalf("GetWatchParty() unexpected error: %v", err)
+	}
+	if url != "https://cdn.example.com/video-123.mp4" {
+		t.Errorf("GetWatchParty() url = %v", url)
+	}
+	if party.LeaderToken != "" {
+		t.Error("GetWatchParty() must not reveal the leader token to members")
+	}
+}
+
+func TestGatewayUsecase_JoinWatchParty(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(metadata *mocks.MockMetadataService, parties *mocks.MockPartyHub)
+		wantErr   error
+	}{
+		{
+			name: "success - viewer may watch the video",
+			setupMock: func(metadata *mocks.MockMetadataService, parties *mocks.MockPartyHub) {
+				parties.EXPECT().Get("party-1").Return(&domain.WatchParty{ID: "party-1", VideoID: "video-123"}, nil)
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123", "user-1").Return(&common.Video{Id: "video-123"}, nil)
+				parties.EXPECT().Subscribe("party-1").Return(make(chan domain.PlaybackState), func() {}, nil)
+			},
+		},
+		{
+			name: "error - video is private",
+			setupMock: func(metadata *mocks.MockMetadataService, parties *mocks.MockPartyHub) {
+				parties.EXPECT().Get("party-1").Return(&domain.WatchParty{ID: "party-1", VideoID: "video-123"}, nil)
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123", "user-1").Return(nil, status.Error(codes.PermissionDenied, "video is private"))
+			},
+			wantErr: domain.ErrPartyNotFound,
+		},
+		{
+			name: "error - party expired",
+			setupMock: func(metadata *mocks.MockMetadataService, parties *mocks.MockPartyHub) {
+				parties.EXPECT().Get("party-1").Return(nil, domain.ErrPartyNotFound)
+			},
+			wantErr: domain.ErrPartyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			tt.setupMock(mockMetadata, mockParties)
+
+			uc := NewGatewayUsecase(mockMetadata, nil, nil, nil, nil, mockParties, nil, nil)
+			_, _, err := uc.JoinWatchParty(context.Background(), "party-1", "user-1")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("JoinWatchParty() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_UpdateWatchParty(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     domain.PlaybackState
+		setupMock func(parties *mocks.MockPartyHub)
+		wantErr   error
+	}{
+		{
+			name:  "success - leader seeks",
+			state: domain.PlaybackState{Playing: true, Position: 42.5},
+			setupMock: func(parties *mocks.MockPartyHub) {
+				parties.EXPECT().SetState("party-1", "secret", domain.PlaybackState{Playing: true, Position: 42.5}).Return(nil)
+			},
+		},
+		{
+			name:      "error - negative position",
+			state:     domain.PlaybackState{Position: -1},
+			setupMock: func(parties *mocks.MockPartyHub) {},
+			wantErr:   domain.ErrInvalidState,
+		},
+		{
+			name:  "error - not the leader",
+			state: domain.PlaybackState{Playing: false, Position: 10},
+			setupMock: func(parties *mocks.MockPartyHub) {
+				parties.EXPECT().SetState("party-1", "secret", gomock.Any()).Return(domain.ErrNotPartyLeader)
+			},
+			wantErr: domain.ErrNotPartyLeader,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockParties := mocks.NewMockPartyHub(ctrl)
+			tt.setupMock(mockParties)
+
+			uc := NewGatewayUsecase(nil, nil, nil, nil, nil, mockParties, nil, nil)
+			err := uc.UpdateWatchParty("party-1", "secret", tt.state)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("UpdateWatchParty() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_DeleteVideo(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService)
+		wantCode  codes.Code
+	}{
+		{
+			name: "success - deletes and drops cached stream URLs",
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().DeleteVideo(gomock.Any(), "video-123", "user-1").Return(nil)
+				streaming.EXPECT().InvalidateStreamURL(gomock.Any(), "video-123").Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "success - a failed invalidation does not undo the deletion",
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().DeleteVideo(gomock.Any(), "video-123", "user-1").Return(nil)
+				streaming.EXPECT().InvalidateStreamURL(gomock.Any(), "video-123").Return(errors.New("unavailable"))
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "error - not the owner",
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().DeleteVideo(gomock.Any(), "video-123", "user-1").
+					Return(status.Error(codes.PermissionDenied, "only the owner can do that"))
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			tt.setupMock(mockMetadata, mockStreaming)
+
+			uc := NewGatewayUsecase(mockMetadata, nil, mockStreaming, nil, nil, nil, nil, nil)
+			err := uc.DeleteVideo(context.Background(), "video-123", "user-1")
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("DeleteVideo() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_SetEpisode(t *testing.T) {
+	series := &metadatapb.Series{Id: "series-1", Episodes: []*common.Video{{Id: "ep-1"}, {Id: "video-123"}}}
+	tests := []struct {
+		name      string
+		setupMock func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService)
+		wantCode  codes.Code
+	}{
+		{
+			name: "success - drops the episodes' cached next episodes",
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().SetEpisode(gomock.Any(), "series-1", "video-123", int32(1), int32(2)).Return(series, nil)
+				streaming.EXPECT().InvalidateStreamURL(gomock.Any(), "video-123").Return(nil).Times(2)
+				streaming.EXPECT().InvalidateStreamURL(gomock.Any(), "ep-1").Return(errors.New("unavailable"))
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "error - numbers taken",
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().SetEpisode(gomock.Any(), "series-1", "video-123", int32(1), int32(2)).
+					Return(nil, status.Error(codes.AlreadyExists, "another video of the series has that season and episode"))
+			},
+			wantCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			tt.setupMock(mockMetadata, mockStreaming)
+
+			uc := NewGatewayUsecase(mockMetadata, nil, mockStreaming, nil, nil, nil, nil, nil)
+			_, err := uc.SetEpisode(context.Background(), "series-1", "video-123", 1, 2)
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("SetEpisode() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_UnpublishVideo(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService)
+		wantCode  codes.Code
+	}{
+		{
+			name: "success - unpublishes and drops cached stream URLs",
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().UnpublishVideo(gomock.Any(), "video-123", "mod-1").Return(nil)
+				streaming.EXPECT().InvalidateStreamURL(gomock.Any(), "video-123").Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "error - caller lacks the permission",
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().UnpublishVideo(gomock.Any(), "video-123", "mod-1").
+					Return(status.Error(codes.PermissionDenied, "not allowed on this video"))
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			tt.setupMock(mockMetadata, mockStreaming)
+
+			uc := NewGatewayUsecase(mockMetadata, nil, mockStreaming, nil, nil, nil, nil, nil)
+			err := uc.UnpublishVideo(context.Background(), "video-123", "mod-1")
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("UnpublishVideo() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_GetVideo(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(metadata *mocks.MockMetadataService)
+		wantTitle string
+		wantCode  codes.Code
+	}{
+		{
+			name: "success - returns the video",
+			setupMock: func(metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123", "user-1").
+					Return(&common.Video{Id: "video-123", Title: "Golang Tutorial"}, nil)
+			},
+			wantTitle: "Golang Tutorial",
+			wantCode:  codes.OK,
+		},
+		{
+			name: "error - video not found",
+			setupMock: func(metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123", "user-1").
+					Return(nil, status.Error(codes.NotFound, "video not found"))
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			tt.setupMock(mockMetadata)
+
+			uc := NewGatewayUsecase(mockMetadata, nil, nil, nil, nil, nil, nil, nil)
+			v, err := uc.GetVideo(context.Background(), "video-123", "user-1")
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetVideo() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && v.Title != tt.wantTitle {
+				t.Errorf("GetVideo() title = %q, want %q", v.Title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_UpdateVideo(t *testing.T) {
+	tests := []struct {
+		name      string
+		paths     []string
+		setupMock func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService)
+		wantCode  codes.Code
+	}{
+		{
+			name:  "success - a title change keeps cached stream URLs",
+			paths: []string{"title"},
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().UpdateVideo(gomock.Any(), gomock.Any()).
+					Return(&common.Video{Id: "video-123", Title: "New title"}, nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:  "success - a visibility change drops cached stream URLs",
+			paths: []string{"visibility"},
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().UpdateVideo(gomock.Any(), gomock.Any()).
+					Return(&common.Video{Id: "video-123", Visibility: "private"}, nil)
+				streaming.EXPECT().InvalidateStreamURL(gomock.Any(), "video-123").Return(errors.New("unavailable"))
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name:  "error - not the owner",
+			paths: []string{"visibility"},
+			setupMock: func(metadata *mocks.MockMetadataService, streaming *mocks.MockStreamingService) {
+				metadata.EXPECT().UpdateVideo(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.PermissionDenied, "only the owner can do that"))
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockStreaming := mocks.NewMockStreamingService(ctrl)
+			tt.setupMock(mockMetadata, mockStreaming)
+
+			uc := NewGatewayUsecase(mockMetadata, nil, mockStreaming, nil, nil, nil, nil, nil)
+			_, err := uc.UpdateVideo(context.Background(), &metadatapb.UpdateVideoRequest{
+				Id:         "video-123",
+				UserId:     "user-1",
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: tt.paths},
+			})
+			if status.Code(err) != tt.wantCode {
+				t.Errorf("UpdateVideo() error = %v, want code %v", err, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_GetSharedStreamURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStreaming := mocks.NewMockStreamingService(ctrl)
+	mockStreaming.EXPECT().
+		GetSharedStreamURL(gomock.Any(), "token", "hunter2", "720p", "eu").
+		Return("https://cdn.example.com/video-123/720p.mp4", "video-123", nil)
+
+	uc := NewGatewayUsecase(nil, nil, mockStreaming, nil, nil, nil, nil, nil)
+	url, videoID, err := uc.GetSharedStreamURL(context.Background(), "token", "hunter2", "720p", "eu")
+	if err != nil {
+		t.Fatalf("GetSharedStreamURL() unexpected error: %v", err)
+	}
+	if url != "https://cdn.example.com/video-123/720p.mp4" || videoID != "video-123" {
+		t.Errorf("GetSharedStreamURL() = %v, %v", url, videoID)
+	}
+}
+
+func TestGatewayUsecase_Login(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(users *mocks.MockUserService)
+		wantToken string
+		wantCode  codes.Code
+	}{
+		{
+			name: "success",
+			setupMock: func(users *mocks.MockUserService) {
+				users.EXPECT().
+					Login(gomock.Any(), "ana@example.com", "correct horse").
+					Return(&userpb.Session{Token: "token", User: &userpb.User{Id: "user-1"}}, nil)
+			},
+			wantToken: "token",
+		},
+		{
+			name: "error - wrong password keeps its status",
+			setupMock: func(users *mocks.MockUserService) {
+				users.EXPECT().
+					Login(gomock.Any(), "ana@example.com", "correct horse").
+					Return(nil, status.Error(codes.Unauthenticated, "invalid email or password"))
+			},
+			wantCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUsers := mocks.NewMockUserService(ctrl)
+			tt.setupMock(mockUsers)
+
+			uc := NewGatewayUsecase(nil, nil, nil, nil, nil, nil, mockUsers, nil)
+			session, err := uc.Login(context.Background(), "ana@example.com", "correct horse")
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("Login() error = %v, wantCode %v", err, tt.wantCode)
+			}
+			if err == nil && session.Token != tt.wantToken {
+				t.Errorf("Login() token = %v, want %v", session.Token, tt.wantToken)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_SSOLogin(t *testing.T) {
+	identity := &domain.Identity{Issuer: "https://idp.example.com", Subject: "sub-1", Roles: []string{"admin"}}
+
+	tests := []struct {
+		name      string
+		disabled  bool
+		setupMock func(sso *mocks.MockIdentityProvider, users *mocks.MockUserService)
+		wantErr   error
+	}{
+		{
+			name: "success - signs in the verified identity",
+			setupMock: func(sso *mocks.MockIdentityProvider, users *mocks.MockUserService) {
+				sso.EXPECT().Exchange(gomock.Any(), "code", "verifier", "nonce").Return(identity, nil)
+				users.EXPECT().LoginExternal(gomock.Any(), identity).Return(&userpb.Session{Token: "token"}, nil)
+			},
+		},
+		{
+			name: "error - rejected token signs nobody in",
+			setupMock: func(sso *mocks.MockIdentityProvider, users *mocks.MockUserService) {
+				sso.EXPECT().Exchange(gomock.Any(), "code", "verifier", "nonce").Return(nil, domain.ErrSSORejected)
+			},
+			wantErr: domain.ErrSSORejected,
+		},
+		{
+			name:      "error - single sign-on not configured",
+			disabled:  true,
+			setupMock: func(sso *mocks.MockIdentityProvider, users *mocks.MockUserService) {},
+			wantErr:   domain.ErrSSODisabled,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockSSO := mocks.NewMockIdentityProvider(ctrl)
+			mockUsers := mocks.NewMockUserService(ctrl)
+			tt.setupMock(mockSSO, mockUsers)
+
+			var sso domain.IdentityProvider = mockSSO
+			if tt.disabled {
+				sso = nil
+			}
+			uc := NewGatewayUsecase(nil, nil, nil, nil, nil, nil, mockUsers, sso)
+			_, err := uc.SSOLogin(context.Background(), "code", "verifier", "nonce")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SSOLogin() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_UpdateChannel(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(metadata *mocks.MockMetadataService)
+		wantName  string
+		wantCode  codes.Code
+	}{
+		{
+			name: "success - updates the channel the handle names",
+			setupMock: func(metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetChannel(gomock.Any(), "cooking").Return(&metadatapb.Channel{Id: "channel-1", Handle: "cooking"}, nil)
+				metadata.EXPECT().UpdateChannel(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, req *metadatapb.UpdateChannelRequest) (*metadatapb.Channel, error) {
+						if req.Id != "channel-1" {
+							t.Errorf("UpdateChannel() id = %q, want channel-1", req.Id)
+						}
+						return &metadatapb.Channel{Id: req.Id, Handle: "cooking", DisplayName: req.DisplayName}, nil
+					})
+			},
+			wantName: "Cooking",
+			wantCode: codes.OK,
+		},
+		{
+			name: "error - unknown handle",
+			setupMock: func(metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetChannel(gomock.Any(), "cooking").Return(nil, status.Error(codes.NotFound, "channel not found"))
+			},
+			wantCode: codes.NotFound,
+		},
+		{
+			name: "error - not the owner",
+			setupMock: func(metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetChannel(gomock.Any(), "cooking").Return(&metadatapb.Channel{Id: "channel-1", Handle: "cooking"}, nil)
+				metadata.EXPECT().UpdateChannel(gomock.Any(), gomock.Any()).
+					Return(nil, status.Error(codes.PermissionDenied, "only the channel's owner or an admin can do that"))
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			tt.setupMock(mockMetadata)
+
+			uc := NewGatewayUsecase(mockMetadata, nil, nil, nil, nil, nil, nil, nil)
+			req := &metadatapb.UpdateChannelRequest{DisplayName: "Cooking", UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"display_name"}}}
+			channel, err := uc.UpdateChannel(context.Background(), "cooking", req)
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("UpdateChannel() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && channel.DisplayName != tt.wantName {
+				t.Errorf("UpdateChannel() display name = %q, want %q", channel.DisplayName, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestGatewayUsecase_Subscribe(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(metadata *mocks.MockMetadataService)
+		wantCode  codes.Code
+	}{
+		{
+			name: "success - subscribes to the channel the handle names",
+			setupMock: func(metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetChannel(gomock.Any(), "cooking").Return(&metadatapb.Channel{Id: "channel-1", Handle: "cooking"}, nil)
+				metadata.EXPECT().Subscribe(gomock.Any(), "channel-1").Return(nil)
+			},
+			wantCode: codes.OK,
+		},
+		{
+			name: "error - unknown handle",
+			setupMock: func(metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetChannel(gomock.Any(), "cooking").Return(nil, status.Error(codes.NotFound, "channel not found"))
+			},
+			wantCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			tt.setupMock(mockMetadata)
+
+			uc := NewGatewayUsecase(mockMetadata, nil, nil, nil, nil, nil, nil, nil)
+			if err := uc.Subscribe(context.Background(), "cooking"); status.Code(err) != tt.wantCode {
+				t.Errorf("Subscribe() error = %v, want code %v", err, tt.wantCode)
 			}
 		})
 	}
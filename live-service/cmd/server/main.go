@@ -0,0 +1,134 @@
+package main
+
+import (
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	handler "github.com/athandoan/youtube/live-service/internal/delivery/http"
+	"github.com/athandoan/youtube/live-service/internal/delivery/rtmp"
+	"github.com/athandoan/youtube/live-service/internal/delivery/whip"
+	"github.com/athandoan/youtube/live-service/internal/infrastructure/hls"
+	"github.com/athandoan/youtube/live-service/internal/infrastructure/recording"
+	"github.com/athandoan/youtube/live-service/internal/infrastructure/rpc"
+	"github.com/athandoan/youtube/live-service/internal/infrastructure/storage"
+	"github.com/athandoan/youtube/live-service/internal/usecase"
+	"github.com/pion/webrtc/v4"
+)
+
+func main() {
+	// 1. Connect to Metadata Service (stream keys and live status)
+	metaAddr := os.Getenv("METADATA_SERVICE_ADDR")
+	if metaAddr == "" {
+		metaAddr = "metadata-service:50051"
+	}
+	metadataService, err := rpc.NewMetadataClient(metaAddr)
+	if err != nil {
+		log.Fatalf("did not connect to metadata: %v", err)
+	}
+
+	// 2. Connect to Streaming Service (drops cached playback of ended streams)
+	streamingAddr := os.Getenv("STREAMING_SERVICE_ADDR")
+	if streamingAddr == "" {
+		streamingAddr = "streaming-service:50053"
+	}
+	streamingService, err := rpc.NewStreamingClient(streamingAddr)
+	if err != nil {
+		log.Fatalf("did not connect to streaming: %v", err)
+	}
+
+	// 3. Init MinIO (recordings are stored alongside uploads)
+	storageService, err := storage.NewMinioStorage(
+		os.Getenv("MINIO_ENDPOINT"),
+		os.Getenv("MINIO_ACCESS_KEY"),
+		os.Getenv("MINIO_SECRET_KEY"),
+		os.Getenv("MINIO_USE_SSL") == "true",
+		os.Getenv("MINIO_BUCKET"),
+	)
+	if err != nil {
+		log.Fatalf("failed to create storage service: %v", err)
+	}
+
+	// 4. Init HLS Packager
+	hlsDir := os.Getenv("LIVE_HLS_DIR")
+	if hlsDir == "" {
+		hlsDir = "live"
+	}
+	segmentSeconds := 2
+	if v := os.Getenv("HLS_SEGMENT_SECONDS"); v != "" {
+		if segmentSeconds, err = strconv.Atoi(v); err != nil || segmentSeconds <= 0 {
+			log.Fatalf("invalid HLS_SEGMENT_SECONDS: %q", v)
+		}
+	}
+	dvrSeconds := 1800
+	if v := os.Getenv("HLS_DVR_WINDOW_SECONDS"); v != "" {
+		if dvrSeconds, err = strconv.Atoi(v); err != nil || dvrSeconds < 0 {
+			log.Fatalf("invalid HLS_DVR_WINDOW_SECONDS: %q", v)
+		}
+	}
+	packagers := hls.NewPackagerFactory(hls.Config{
+		Dir:             hlsDir,
+		SegmentDuration: time.Duration(segmentSeconds) * time.Second,
+		DVRWindow:       time.Duration(dvrSeconds) * time.Second,
+	})
+
+	// 5. Init Usecase; ended streams are published as recordings
+	recordings := recording.NewQueue(hlsDir, metadataService, streamingService, storageService)
+	uc := usecase.NewLiveUsecase(metadataService, packagers, recordings)
+
+	// 6. Init WHIP (WebRTC) ingest; all media arrives on one UDP port so it can be published from a container
+	whipUDPPort := 8189
+	if v := os.Getenv("WHIP_UDP_PORT"); v != "" {
+		if whipUDPPort, err = strconv.Atoi(v); err != nil || whipUDPPort <= 0 {
+			log.Fatalf("invalid WHIP_UDP_PORT: %q", v)
+		}
+	}
+	udpConn, err := net.ListenUDP("udp", &net.UDPAddr{Port: whipUDPPort})
+	if err != nil {
+		log.Fatalf("failed to listen for WebRTC: %v", err)
+	}
+	se := webrtc.SettingEngine{}
+	se.SetICEUDPMux(webrtc.NewICEUDPMux(nil, udpConn))
+	if ip := os.Getenv("WHIP_PUBLIC_IP"); ip != "" {
+		se.SetNAT1To1IPs([]string{ip}, webrtc.ICECandidateTypeHost)
+	}
+	webrtcAPI, err := whip.NewAPI(se)
+	if err != nil {
+		log.Fatalf("failed to init WebRTC: %v", err)
+	}
+
+	// 7. Start HLS and WHIP HTTP Server
+	httpPort := os.Getenv("HTTP_PORT")
+	if httpPort == "" {
+		httpPort = "8083"
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/live/videos/", handler.NewHandler(hlsDir, metadataService).HandleLive)
+	whipHandler := whip.NewHandler(uc, webrtcAPI)
+	mux.HandleFunc("/whip", whipHandler.HandleWHIP)
+	mux.HandleFunc("/whip/", whipHandler.HandleWHIP)
+	go func() {
+		log.Printf("Live Service (HLS, WHIP) running on :%s", httpPort)
+		if err := http.ListenAndServe(":"+httpPort, mux); err != nil {
+			log.Fatalf("failed to serve HTTP: %v", err)
+		}
+	}()
+
+	// 8. Start RTMP Ingest
+	rtmpPort := os.Getenv("RTMP_PORT")
+	if rtmpPort == "" {
+		rtmpPort = "1935"
+	}
+	lis, err := net.Listen("tcp", ":"+rtmpPort)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	log.Printf("Live Service (RTMP) running on :%s", rtmpPort)
+	if err := rtmp.NewServer(uc).Serve(lis); err != nil {
+		log.Fatalf("failed to serve RTMP: %v", err)
+	}
+}
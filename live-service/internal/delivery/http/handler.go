@@ -0,0 +1,116 @@
+package http
+
+import (
+	"errors"
+	"log"
+	"net/http"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/athandoan/youtube/live-service/internal/infrastructure/hls"
+)
+
+// accessTTL is how long a viewer's access to a stream is remembered, as players fetch the
+// playlist and a segment every few seconds.
+const accessTTL = 10 * time.Second
+
+var (
+	videoIDPattern = regexp.MustCompile(`^[0-9a-fA-F-]{1,64}$`)
+	segmentPattern = regexp.MustCompile(`^segment[0-9]+\.ts$`)
+)
+
+type accessKey struct {
+	videoID, viewerID string
+}
+
+type accessEntry struct {
+	err     error
+	expires time.Time
+}
+
+// Handler serves the packager's HLS output from disk to viewers the metadata service lets
+// watch the stream. Requests come through the gateway, which sets X-User-ID from the session.
+type Handler struct {
+	dir      string
+	metadata domain.MetadataService
+
+	mu     sync.Mutex
+	access map[accessKey]accessEntry
+}
+
+func NewHandler(dir string, metadata domain.MetadataService) *Handler {
+	return &Handler{dir: dir, metadata: metadata, access: map[accessKey]accessEntry{}}
+}
+
+// HandleLive serves /live/videos/{videoID}/index.m3u8 and the segments it lists.
+func (h *Handler) HandleLive(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "Only GET is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// Path: /live/videos/{videoID}/{file}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) != 5 || pathParts[2] != "videos" || !videoIDPattern.MatchString(pathParts[3]) {
+		http.NotFound(w, r)
+		return
+	}
+	videoID, name := pathParts[3], pathParts[4]
+
+	switch {
+	case name == hls.PlaylistName:
+		// Players poll the playlist; it must never be cached
+		w.Header().Set("Content-Type", "application/vnd.apple.mpegurl")
+		w.Header().Set("Cache-Control", "no-cache")
+	case segmentPattern.MatchString(name):
+		// Private streams must not be kept by shared caches
+		w.Header().Set("Content-Type", "video/mp2t")
+		w.Header().Set("Cache-Control", "private, max-age=3600")
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	if err := h.checkAccess(r, videoID, r.Header.Get("X-User-ID")); err != nil {
+		if errors.Is(err, domain.ErrVideoNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		log.Printf("live %s: failed to check access: %v", videoID, err)
+		http.Error(w, "Failed to check access", http.StatusBadGateway)
+		return
+	}
+
+	http.ServeFile(w, r, filepath.Join(h.dir, videoID, name))
+}
+
+// checkAccess returns ErrVideoNotFound unless viewerID may watch the video, remembering the
+// answer for accessTTL. Streams that are trashed or made private stop playing within that time.
+func (h *Handler) checkAccess(r *http.Request, videoID, viewerID string) error {
+	key := accessKey{videoID: videoID, viewerID: viewerID}
+	now := time.Now()
+	h.mu.Lock()
+	e, ok := h.access[key]
+	h.mu.Unlock()
+	if ok && now.Before(e.expires) {
+		return e.err
+	}
+
+	err := h.metadata.CheckViewer(r.Context(), videoID, viewerID)
+	if err != nil && !errors.Is(err, domain.ErrVideoNotFound) {
+		return err
+	}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for k, e := range h.access {
+		if !now.Before(e.expires) {
+			delete(h.access, k)
+		}
+	}
+	h.access[key] = accessEntry{err: err, expires: now.Add(accessTTL)}
+	return err
+}
@@ -0,0 +1,114 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/athandoan/youtube/live-service/internal/infrastructure/hls"
+	"github.com/athandoan/youtube/live-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_HandleLive(t *testing.T) {
+	tests := []struct {
+		name      string
+		path      string
+		viewerID  string
+		setupMock func(m *mocks.MockMetadataService)
+		wantCode  int
+	}{
+		{
+			name:     "success - playlist for a viewer allowed to watch",
+			path:     "/live/videos/abc-1/" + hls.PlaylistName,
+			viewerID: "user-1",
+			setupMock: func(m *mocks.MockMetadataService) {
+				m.EXPECT().CheckViewer(gomock.Any(), "abc-1", "user-1").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "success - segment for an anonymous viewer of a public stream",
+			path: "/live/videos/abc-1/segment0.ts",
+			setupMock: func(m *mocks.MockMetadataService) {
+				m.EXPECT().CheckViewer(gomock.Any(), "abc-1", "").Return(nil)
+			},
+			wantCode: http.StatusOK,
+		},
+		{
+			name: "error - private, trashed or deleted stream",
+			path: "/live/videos/abc-1/" + hls.PlaylistName,
+			setupMock: func(m *mocks.MockMetadataService) {
+				m.EXPECT().CheckViewer(gomock.Any(), "abc-1", "").Return(domain.ErrVideoNotFound)
+			},
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name: "error - metadata service unavailable",
+			path: "/live/videos/abc-1/" + hls.PlaylistName,
+			setupMock: func(m *mocks.MockMetadataService) {
+				m.EXPECT().CheckViewer(gomock.Any(), "abc-1", "").Return(errors.New("unavailable"))
+			},
+			wantCode: http.StatusBadGateway,
+		},
+		{
+			name:      "error - other files are not served",
+			path:      "/live/videos/abc-1/.pending",
+			setupMock: func(m *mocks.MockMetadataService) {},
+			wantCode:  http.StatusNotFound,
+		},
+		{
+			name:      "error - old path without videos",
+			path:      "/live/abc-1/" + hls.PlaylistName,
+			setupMock: func(m *mocks.MockMetadataService) {},
+			wantCode:  http.StatusNotFound,
+		},
+	}
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "abc-1"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{hls.PlaylistName, "segment0.ts", ".pending"} {
+		if err := os.WriteFile(filepath.Join(dir, "abc-1", name), []byte("data"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			metadata := mocks.NewMockMetadataService(ctrl)
+			tt.setupMock(metadata)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			if tt.viewerID != "" {
+				req.Header.Set("X-User-ID", tt.viewerID)
+			}
+			rec := httptest.NewRecorder()
+			NewHandler(dir, metadata).HandleLive(rec, req)
+			if rec.Code != tt.wantCode {
+				t.Errorf("HandleLive() code = %d, want %d", rec.Code, tt.wantCode)
+			}
+		})
+	}
+}
+
+func TestHandler_HandleLive_CachesAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadata := mocks.NewMockMetadataService(ctrl)
+	metadata.EXPECT().CheckViewer(gomock.Any(), "abc-1", "").Return(domain.ErrVideoNotFound).Times(1)
+
+	h := NewHandler(t.TempDir(), metadata)
+	for range 3 {
+		rec := httptest.NewRecorder()
+		h.HandleLive(rec, httptest.NewRequest(http.MethodGet, "/live/videos/abc-1/segment0.ts", nil))
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("HandleLive() code = %d, want %d", rec.Code, http.StatusNotFound)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package rtmp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+)
+
+// AMF0 type markers. Commands from publishers only use a handful of them.
+const (
+	amfNumber      = 0x00
+	amfBoolean     = 0x01
+	amfString      = 0x02
+	amfObject      = 0x03
+	amfNull        = 0x05
+	amfUndefined   = 0x06
+	amfECMAArray   = 0x08
+	amfObjectEnd   = 0x09
+	amfStrictArray = 0x0a
+	amfDate        = 0x0b
+	amfLongString  = 0x0c
+)
+
+// maxAMFDepth bounds how deeply objects and arrays may nest; commands nest two levels at most.
+const maxAMFDepth = 16
+
+var (
+	errAMFObjectEnd = errors.New("amf0: object end")
+	errAMFTooDeep   = errors.New("amf0: values nested too deeply")
+)
+
+// amfObj keeps encoded objects in a stable key order.
+type amfObj map[string]any
+
+// decodeAMF decodes every AMF0 value in b. Numbers decode to float64, objects and ECMA arrays
+// to amfObj, null and undefined to nil.
+func decodeAMF(b []byte) ([]any, error) {
+	r := bytes.NewReader(b)
+	var vals []any
+	for r.Len() > 0 {
+		v, err := decodeAMFValue(r, 0)
+		if err != nil {
+			return vals, err
+		}
+		vals = append(vals, v)
+	}
+	return vals, nil
+}
+
+func decodeAMFValue(r *bytes.Reader, depth int) (any, error) {
+	if depth > maxAMFDepth {
+		return nil, errAMFTooDeep
+	}
+	marker, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	switch marker {
+	case amfNumber:
+		var bits uint64
+		if err := binary.Read(r, binary.BigEndian, &bits); err != nil {
+			return nil, err
+		}
+		return math.Float64frombits(bits), nil
+	case amfBoolean:
+		b, err := r.ReadByte()
+		return b != 0, err
+	case amfString:
+		return readAMFString(r, 2)
+	case amfLongString:
+		return readAMFString(r, 4)
+	case amfObject:
+		return readAMFProperties(r, depth+1)
+	case amfECMAArray:
+		// The count is advisory; the properties end with an object end marker like objects do.
+		if _, err := r.Seek(4, io.SeekCurrent); err != nil {
+			return nil, err
+		}
+		return readAMFProperties(r, depth+1)
+	case amfStrictArray:
+		var n uint32
+		if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+			return nil, err
+		}
+		arr := make([]any, 0, min(int(n), 64))
+		for i := uint32(0); i < n; i++ {
+			v, err := decodeAMFValue(r, depth+1)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case amfDate:
+		var ms uint64
+		if err := binary.Read(r, binary.BigEndian, &ms); err != nil {
+			return nil, err
+		}
+		_, err := r.Seek(2, io.SeekCurrent) // time zone, unused
+		return math.Float64frombits(ms), err
+	case amfNull, amfUndefined:
+		return nil, nil
+	case amfObjectEnd:
+		return nil, errAMFObjectEnd
+	default:
+		return nil, fmt.Errorf("amf0: unsupported type marker 0x%02x", marker)
+	}
+}
+
+func readAMFString(r *bytes.Reader, lenSize int) (string, error) {
+	var n uint32
+	if lenSize == 2 {
+		var n16 uint16
+		if err := binary.Read(r, binary.BigEndian, &n16); err != nil {
+			return "", err
+		}
+		n = uint32(n16)
+	} else if err := binary.Read(r, binary.BigEndian, &n); err != nil {
+		return "", err
+	}
+	if int64(n) > int64(r.Len()) {
+		return "", io.ErrUnexpectedEOF
+	}
+	buf := make([]byte, n)
+	_, err := io.ReadFull(r, buf)
+	return string(buf), err
+}
+
+func readAMFProperties(r *bytes.Reader, depth int) (amfObj, error) {
+	obj := amfObj{}
+	for {
+		key, err := readAMFString(r, 2)
+		if err != nil {
+			return nil, err
+		}
+		v, err := decodeAMFValue(r, depth)
+		if errors.Is(err, errAMFObjectEnd) && key == "" {
+			return obj, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = v
+	}
+}
+
+// encodeAMF encodes values as AMF0. Supported types are float64, int, bool, string, amfObj and nil.
+func encodeAMF(vals ...any) []byte {
+	var buf bytes.Buffer
+	for _, v := range vals {
+		writeAMFValue(&buf, v)
+	}
+	return buf.Bytes()
+}
+
+func writeAMFValue(buf *bytes.Buffer, v any) {
+	switch v := v.(type) {
+	case float64:
+		buf.WriteByte(amfNumber)
+		_ = binary.Write(buf, binary.BigEndian, math.Float64bits(v))
+	case int:
+		writeAMFValue(buf, float64(v))
+	case bool:
+		buf.WriteByte(amfBoolean)
+		if v {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	case string:
+		buf.WriteByte(amfString)
+		writeAMFKey(buf, v)
+	case amfObj:
+		buf.WriteByte(amfObject)
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			writeAMFKey(buf, k)
+			writeAMFValue(buf, v[k])
+		}
+		buf.Write([]byte{0, 0, amfObjectEnd})
+	default:
+		buf.WriteByte(amfNull)
+	}
+}
+
+func writeAMFKey(buf *bytes.Buffer, s string) {
+	_ = binary.Write(buf, binary.BigEndian, uint16(len(s)))
+	buf.WriteString(s)
+}
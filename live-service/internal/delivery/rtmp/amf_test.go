@@ -0,0 +1,37 @@
+package rtmp
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeAMF_RoundTrip(t *testing.T) {
+	vals := []any{"publish", 5.0, nil, "stream-key", amfObj{"live": true, "nested": amfObj{"n": 1.0}}}
+	got, err := decodeAMF(encodeAMF(vals...))
+	if err != nil {
+		t.Fatalf("decodeAMF() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, vals) {
+		t.Errorf("decodeAMF() = %#v, want %#v", got, vals)
+	}
+}
+
+func TestDecodeAMF_LimitsDepth(t *testing.T) {
+	nest := func(depth int) []byte {
+		var b bytes.Buffer
+		for i := 0; i < depth; i++ {
+			b.Write([]byte{amfStrictArray, 0, 0, 0, 1})
+		}
+		b.WriteByte(amfNull)
+		return b.Bytes()
+	}
+
+	if _, err := decodeAMF(nest(maxAMFDepth)); err != nil {
+		t.Errorf("decodeAMF() at the depth limit error = %v", err)
+	}
+	if _, err := decodeAMF(nest(100000)); !errors.Is(err, errAMFTooDeep) {
+		t.Errorf("decodeAMF() past the depth limit error = %v, want %v", err, errAMFTooDeep)
+	}
+}
@@ -0,0 +1,257 @@
+package rtmp
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Message type IDs used by publishers.
+const (
+	msgSetChunkSize     = 1
+	msgAbort            = 2
+	msgAck              = 3
+	msgUserControl      = 4
+	msgWindowAckSize    = 5
+	msgSetPeerBandwidth = 6
+	msgAudio            = 8
+	msgVideo            = 9
+	msgDataAMF3         = 15
+	msgCommandAMF3      = 17
+	msgDataAMF0         = 18
+	msgCommandAMF0      = 20
+)
+
+const (
+	defaultChunkSize = 128
+	maxChunkSize     = 1 << 24
+	extendedStamp    = 0xffffff
+
+	// maxUnauthMessageSize bounds messages until a stream key is accepted; the commands
+	// that lead up to publishing are a few hundred bytes.
+	maxUnauthMessageSize = 64 << 10
+	// maxMessageSize is the largest length a chunk header can carry.
+	maxMessageSize = 1<<24 - 1
+	// maxChunkStreams bounds the chunk streams a peer may interleave; encoders use a handful.
+	maxChunkStreams = 64
+)
+
+type message struct {
+	typeID    uint8
+	streamID  uint32
+	timestamp uint32
+	payload   []byte
+}
+
+// chunkStream is the header state the peer compresses later chunks against.
+type chunkStream struct {
+	timestamp  uint32
+	delta      uint32
+	length     uint32
+	typeID     uint8
+	streamID   uint32
+	extended   bool
+	inProgress bool
+	buf        []byte
+}
+
+type chunkReader struct {
+	r         *bufio.Reader
+	chunkSize uint32
+	// maxMessageSize is raised once the peer is publishing with a valid stream key.
+	maxMessageSize uint32
+	streams        map[uint32]*chunkStream
+}
+
+func newChunkReader(r *bufio.Reader) *chunkReader {
+	return &chunkReader{r: r, chunkSize: defaultChunkSize, maxMessageSize: maxUnauthMessageSize, streams: map[uint32]*chunkStream{}}
+}
+
+// readMessage reads chunks until one of the interleaved chunk streams completes a message.
+func (c *chunkReader) readMessage() (*message, error) {
+	for {
+		msg, err := c.readChunk()
+		if err != nil || msg != nil {
+			return msg, err
+		}
+	}
+}
+
+func (c *chunkReader) readChunk() (*message, error) {
+	b0, err := c.r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	format := b0 >> 6
+	csid := uint32(b0 & 0x3f)
+	switch csid {
+	case 0:
+		b, err := c.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		csid = 64 + uint32(b)
+	case 1:
+		var b [2]byte
+		if _, err := io.ReadFull(c.r, b[:]); err != nil {
+			return nil, err
+		}
+		csid = 64 + uint32(b[0]) + uint32(b[1])*256
+	}
+
+	cs, ok := c.streams[csid]
+	if !ok {
+		if format != 0 {
+			return nil, fmt.Errorf("chunk stream %d starts with format %d header", csid, format)
+		}
+		if len(c.streams) >= maxChunkStreams {
+			return nil, fmt.Errorf("more than %d chunk streams", maxChunkStreams)
+		}
+		cs = &chunkStream{}
+		c.streams[csid] = cs
+	}
+
+	var hdr [11]byte
+	switch format {
+	case 0:
+		if _, err := io.ReadFull(c.r, hdr[:11]); err != nil {
+			return nil, err
+		}
+		ts := uint24(hdr[0:3])
+		cs.length = uint24(hdr[3:6])
+		cs.typeID = hdr[6]
+		cs.streamID = binary.LittleEndian.Uint32(hdr[7:11])
+		if cs.extended = ts == extendedStamp; cs.extended {
+			if ts, err = c.readExtendedStamp(); err != nil {
+				return nil, err
+			}
+		}
+		cs.timestamp = ts
+		cs.delta = ts
+		cs.inProgress = false
+	case 1, 2:
+		n := 7
+		if format == 2 {
+			n = 3
+		}
+		if _, err := io.ReadFull(c.r, hdr[:n]); err != nil {
+			return nil, err
+		}
+		delta := uint24(hdr[0:3])
+		if format == 1 {
+			cs.length = uint24(hdr[3:6])
+			cs.typeID = hdr[6]
+		}
+		if cs.extended = delta == extendedStamp; cs.extended {
+			if delta, err = c.readExtendedStamp(); err != nil {
+				return nil, err
+			}
+		}
+		cs.delta = delta
+		cs.timestamp += delta
+		cs.inProgress = false
+	case 3:
+		if cs.extended {
+			if _, err := c.readExtendedStamp(); err != nil {
+				return nil, err
+			}
+		}
+		// A format 3 header that starts a new message repeats the previous delta
+		if !cs.inProgress {
+			cs.timestamp += cs.delta
+		}
+	}
+
+	if !cs.inProgress {
+		if cs.length > c.maxMessageSize {
+			return nil, fmt.Errorf("message of %d bytes exceeds the %d byte limit", cs.length, c.maxMessageSize)
+		}
+		cs.buf = nil
+		cs.inProgress = true
+	}
+	// Grow the buffer with the chunks that arrive rather than by the length the peer claims
+	n := min(c.chunkSize, cs.length-uint32(len(cs.buf)))
+	start := len(cs.buf)
+	cs.buf = slices.Grow(cs.buf, int(n))[:start+int(n)]
+	if _, err := io.ReadFull(c.r, cs.buf[start:]); err != nil {
+		return nil, err
+	}
+	if uint32(len(cs.buf)) < cs.length {
+		return nil, nil
+	}
+
+	cs.inProgress = false
+	msg := &message{typeID: cs.typeID, streamID: cs.streamID, timestamp: cs.timestamp, payload: cs.buf}
+	cs.buf = nil
+	return msg, nil
+}
+
+func (c *chunkReader) readExtendedStamp() (uint32, error) {
+	var b [4]byte
+	if _, err := io.ReadFull(c.r, b[:]); err != nil {
+		return 0, err
+	}
+	return binary.BigEndian.Uint32(b[:]), nil
+}
+
+type chunkWriter struct {
+	w         *bufio.Writer
+	chunkSize uint32
+}
+
+// writeMessage writes m on chunk stream csid (2-63) with a full header followed by format 3 chunks.
+func (c *chunkWriter) writeMessage(csid uint8, m *message) error {
+	ts := m.timestamp
+	extended := ts >= extendedStamp
+	if extended {
+		ts = extendedStamp
+	}
+
+	var hdr [16]byte
+	hdr[0] = csid & 0x3f
+	putUint24(hdr[1:4], ts)
+	putUint24(hdr[4:7], uint32(len(m.payload)))
+	hdr[7] = m.typeID
+	binary.LittleEndian.PutUint32(hdr[8:12], m.streamID)
+	n := 12
+	if extended {
+		binary.BigEndian.PutUint32(hdr[12:16], m.timestamp)
+		n = 16
+	}
+	if _, err := c.w.Write(hdr[:n]); err != nil {
+		return err
+	}
+
+	payload := m.payload
+	for {
+		chunk := min(uint32(len(payload)), c.chunkSize)
+		if _, err := c.w.Write(payload[:chunk]); err != nil {
+			return err
+		}
+		payload = payload[chunk:]
+		if len(payload) == 0 {
+			break
+		}
+		if err := c.w.WriteByte(0xc0 | csid&0x3f); err != nil {
+			return err
+		}
+		if extended {
+			if err := binary.Write(c.w, binary.BigEndian, m.timestamp); err != nil {
+				return err
+			}
+		}
+	}
+	return c.w.Flush()
+}
+
+func uint24(b []byte) uint32 {
+	return uint32(b[0])<<16 | uint32(b[1])<<8 | uint32(b[2])
+}
+
+func putUint24(b []byte, v uint32) {
+	b[0] = byte(v >> 16)
+	b[1] = byte(v >> 8)
+	b[2] = byte(v)
+}
@@ -0,0 +1,80 @@
+package rtmp
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// encodeChunks writes m the way a publisher would, with the given chunk size.
+func encodeChunks(t *testing.T, m *message, chunkSize uint32) *bufio.Reader {
+	t.Helper()
+	var buf bytes.Buffer
+	w := &chunkWriter{w: bufio.NewWriter(&buf), chunkSize: chunkSize}
+	if err := w.writeMessage(csidCommand, m); err != nil {
+		t.Fatalf("writeMessage() error = %v", err)
+	}
+	return bufio.NewReader(&buf)
+}
+
+func TestChunkReader_ReadsChunkedMessage(t *testing.T) {
+	payload := bytes.Repeat([]byte("x"), 1000)
+	r := newChunkReader(encodeChunks(t, &message{typeID: msgVideo, streamID: 1, timestamp: 40, payload: payload}, defaultChunkSize))
+
+	msg, err := r.readMessage()
+	if err != nil {
+		t.Fatalf("readMessage() error = %v", err)
+	}
+	if msg.typeID != msgVideo || msg.streamID != 1 || msg.timestamp != 40 || !bytes.Equal(msg.payload, payload) {
+		t.Errorf("readMessage() = type %d stream %d ts %d with %d bytes", msg.typeID, msg.streamID, msg.timestamp, len(msg.payload))
+	}
+}
+
+func TestChunkReader_LimitsMessageSize(t *testing.T) {
+	payload := make([]byte, maxUnauthMessageSize+1)
+	tests := []struct {
+		name    string
+		publish bool
+		wantErr bool
+	}{
+		{name: "error - oversized before publishing", wantErr: true},
+		{name: "success - large media once publishing", publish: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newChunkReader(encodeChunks(t, &message{typeID: msgVideo, streamID: 1, payload: payload}, maxChunkSize))
+			r.chunkSize = maxChunkSize
+			if tt.publish {
+				r.maxMessageSize = maxMessageSize
+			}
+
+			msg, err := r.readMessage()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readMessage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && len(msg.payload) != len(payload) {
+				t.Errorf("readMessage() payload = %d bytes, want %d", len(msg.payload), len(payload))
+			}
+		})
+	}
+}
+
+func TestChunkReader_LimitsChunkStreams(t *testing.T) {
+	var buf bytes.Buffer
+	for csid := 0; csid <= maxChunkStreams; csid++ {
+		// Format 0 header on a two-byte chunk stream ID, announcing a message that never arrives
+		buf.Write([]byte{0, byte(csid), 0, 0, 0, 0, 0x10, 0, msgVideo, 1, 0, 0, 0})
+		buf.WriteString(strings.Repeat("x", defaultChunkSize))
+	}
+	r := newChunkReader(bufio.NewReader(&buf))
+
+	var err error
+	for err == nil {
+		_, err = r.readChunk()
+	}
+	if !strings.Contains(err.Error(), "chunk streams") {
+		t.Errorf("readChunk() error = %v, want too many chunk streams", err)
+	}
+}
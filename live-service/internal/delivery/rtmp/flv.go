@@ -0,0 +1,221 @@
+package rtmp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+)
+
+const (
+	flvCodecAVC = 7
+	flvCodecAAC = 10
+
+	avcSequenceHeader = 0
+	avcNALU           = 1
+	aacSequenceHeader = 0
+
+	nalTypeIDR = 5
+	nalTypeSPS = 7
+	nalTypePPS = 8
+	nalTypeAUD = 9
+)
+
+var (
+	errUnsupportedVideo = errors.New("unsupported video codec, only H.264 is accepted")
+	errUnsupportedAudio = errors.New("unsupported audio codec, only AAC is accepted")
+)
+
+var (
+	startCode = []byte{0, 0, 0, 1}
+	audNAL    = []byte{0, 0, 0, 1, nalTypeAUD, 0xf0}
+)
+
+// flvDemuxer converts RTMP audio and video message bodies (FLV tag data) into packets.
+type flvDemuxer struct {
+	nalLengthSize int
+	sps, pps      [][]byte
+
+	aacProfile   byte // ADTS profile, object type - 1
+	aacFreqIndex byte
+	aacChannels  byte
+	hasAAC       bool
+}
+
+// video returns the packet in an AVC message, or nil for sequence headers and anything
+// received before the decoder configuration.
+func (d *flvDemuxer) video(timestamp uint32, b []byte) (*domain.Packet, error) {
+	if len(b) < 5 {
+		return nil, nil
+	}
+	// Enhanced RTMP sets the top bit for HEVC/AV1/VP9 FourCC headers
+	if b[0]&0x80 != 0 || b[0]&0x0f != flvCodecAVC {
+		return nil, errUnsupportedVideo
+	}
+	keyframe := b[0]>>4 == 1
+	cts := int32(uint24(b[2:5])<<8) >> 8 // signed 24 bit
+	data := b[5:]
+
+	switch b[1] {
+	case avcSequenceHeader:
+		return nil, d.parseAVCConfig(data)
+	case avcNALU:
+		if d.nalLengthSize == 0 {
+			return nil, nil
+		}
+	default:
+		return nil, nil
+	}
+
+	nalus, err := splitNALUs(data, d.nalLengthSize)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, 0, len(data)+64)
+	out = append(out, audNAL...)
+	hasParams := false
+	for _, n := range nalus {
+		if t := n[0] & 0x1f; t == nalTypeSPS || t == nalTypePPS {
+			hasParams = true
+		}
+	}
+	if keyframe && !hasParams {
+		for _, ps := range append(d.sps[:len(d.sps):len(d.sps)], d.pps...) {
+			out = append(out, startCode...)
+			out = append(out, ps...)
+		}
+	}
+	for _, n := range nalus {
+		if n[0]&0x1f == nalTypeAUD {
+			continue
+		}
+		if n[0]&0x1f == nalTypeIDR {
+			keyframe = true
+		}
+		out = append(out, startCode...)
+		out = append(out, n...)
+	}
+
+	dts := time.Duration(timestamp) * time.Millisecond
+	return &domain.Packet{
+		Kind:     domain.TrackVideo,
+		DTS:      dts,
+		PTS:      dts + time.Duration(cts)*time.Millisecond,
+		Keyframe: keyframe,
+		Data:     out,
+	}, nil
+}
+
+// parseAVCConfig reads an AVCDecoderConfigurationRecord (ISO/IEC 14496-15).
+func (d *flvDemuxer) parseAVCConfig(b []byte) error {
+	if len(b) < 7 {
+		return fmt.Errorf("short AVC decoder configuration")
+	}
+	d.nalLengthSize = int(b[4]&0x03) + 1
+	d.sps, d.pps = nil, nil
+
+	p := b[5:]
+	var err error
+	if d.sps, p, err = readParameterSets(p, int(p[0]&0x1f)); err != nil {
+		return err
+	}
+	if len(p) < 1 {
+		return fmt.Errorf("AVC decoder configuration has no PPS count")
+	}
+	d.pps, _, err = readParameterSets(p, int(p[0]))
+	return err
+}
+
+func readParameterSets(p []byte, count int) ([][]byte, []byte, error) {
+	p = p[1:]
+	var sets [][]byte
+	for i := 0; i < count; i++ {
+		if len(p) < 2 {
+			return nil, nil, fmt.Errorf("truncated AVC parameter set")
+		}
+		n := int(binary.BigEndian.Uint16(p))
+		if len(p) < 2+n {
+			return nil, nil, fmt.Errorf("truncated AVC parameter set")
+		}
+		sets = append(sets, p[2:2+n])
+		p = p[2+n:]
+	}
+	return sets, p, nil
+}
+
+func splitNALUs(b []byte, lengthSize int) ([][]byte, error) {
+	var nalus [][]byte
+	for len(b) > 0 {
+		if len(b) < lengthSize {
+			return nil, fmt.Errorf("truncated NAL unit length")
+		}
+		n := 0
+		for i := 0; i < lengthSize; i++ {
+			n = n<<8 | int(b[i])
+		}
+		b = b[lengthSize:]
+		if n > len(b) {
+			return nil, fmt.Errorf("NAL unit length %d exceeds message", n)
+		}
+		if n > 0 {
+			nalus = append(nalus, b[:n])
+		}
+		b = b[n:]
+	}
+	return nalus, nil
+}
+
+// audio returns an ADTS framed AAC packet, or nil for sequence headers.
+func (d *flvDemuxer) audio(timestamp uint32, b []byte) (*domain.Packet, error) {
+	if len(b) < 2 {
+		return nil, nil
+	}
+	if b[0]>>4 != flvCodecAAC {
+		return nil, errUnsupportedAudio
+	}
+	if b[1] == aacSequenceHeader {
+		return nil, d.parseAudioSpecificConfig(b[2:])
+	}
+	if !d.hasAAC {
+		return nil, nil
+	}
+
+	raw := b[2:]
+	frameLen := len(raw) + 7
+	out := make([]byte, 7, frameLen)
+	out[0] = 0xff
+	out[1] = 0xf1 // MPEG-4, layer 0, no CRC
+	out[2] = d.aacProfile<<6 | d.aacFreqIndex<<2 | d.aacChannels>>2
+	out[3] = (d.aacChannels&0x03)<<6 | byte(frameLen>>11)&0x03
+	out[4] = byte(frameLen >> 3)
+	out[5] = byte(frameLen&0x07)<<5 | 0x1f
+	out[6] = 0xfc
+	out = append(out, raw...)
+
+	ts := time.Duration(timestamp) * time.Millisecond
+	return &domain.Packet{Kind: domain.TrackAudio, AudioCodec: domain.AudioAAC, DTS: ts, PTS: ts, Keyframe: true, Data: out}, nil
+}
+
+// parseAudioSpecificConfig reads the fields ADTS headers repeat (ISO/IEC 14496-3).
+func (d *flvDemuxer) parseAudioSpecificConfig(b []byte) error {
+	if len(b) < 2 {
+		return fmt.Errorf("short AAC audio specific config")
+	}
+	objectType := b[0] >> 3
+	freqIndex := (b[0]&0x07)<<1 | b[1]>>7
+	if freqIndex == 0x0f {
+		return fmt.Errorf("AAC with explicit sample rate is not supported")
+	}
+	// ADTS can only signal the first four object types; HE-AAC decodes fine as implicit SBR on LC
+	if objectType < 1 || objectType > 4 {
+		objectType = 2
+	}
+	d.aacProfile = objectType - 1
+	d.aacFreqIndex = freqIndex
+	d.aacChannels = (b[1] >> 3) & 0x0f
+	d.hasAAC = true
+	return nil
+}
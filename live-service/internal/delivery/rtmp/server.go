@@ -0,0 +1,308 @@
+package rtmp
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+)
+
+const (
+	handshakeSize    = 1536
+	handshakeTimeout = 10 * time.Second
+	// readTimeout drops publishers that stop sending without closing the connection
+	readTimeout = 30 * time.Second
+
+	serverChunkSize = 4096
+	windowAckSize   = 2500000
+
+	csidControl = 2
+	csidCommand = 3
+	csidStatus  = 5
+)
+
+// Server accepts RTMP publishes (rtmp://host/{app}/{streamKey}) and feeds them to live broadcasts.
+// Only publishing is supported; viewers watch the HLS output.
+type Server struct {
+	usecase domain.LiveUsecase
+}
+
+func NewServer(u domain.LiveUsecase) *Server {
+	return &Server{usecase: u}
+}
+
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		nc, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.handle(nc)
+	}
+}
+
+func (s *Server) handle(nc net.Conn) {
+	defer func() { _ = nc.Close() }()
+
+	c := &conn{
+		nc:      nc,
+		usecase: s.usecase,
+		counter: &countingReader{r: nc},
+		writer:  &chunkWriter{w: bufio.NewWriter(nc), chunkSize: defaultChunkSize},
+	}
+	err := c.serve()
+	if c.broadcast != nil {
+		if cerr := c.broadcast.Close(); cerr != nil {
+			log.Printf("rtmp %s: failed to end broadcast: %v", nc.RemoteAddr(), cerr)
+		}
+	}
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, errPublishEnded) {
+		log.Printf("rtmp %s: %v", nc.RemoteAddr(), err)
+	}
+}
+
+var errPublishEnded = errors.New("publish ended")
+
+type conn struct {
+	nc      net.Conn
+	usecase domain.LiveUsecase
+	counter *countingReader
+	reader  *chunkReader
+	writer  *chunkWriter
+
+	peerAckWindow uint32
+	acked         uint64
+
+	broadcast    domain.Broadcast
+	demux        flvDemuxer
+	audioDropped bool
+}
+
+func (c *conn) serve() error {
+	br := bufio.NewReaderSize(c.counter, 64*1024)
+	_ = c.nc.SetDeadline(time.Now().Add(handshakeTimeout))
+	if err := c.handshake(br); err != nil {
+		return fmt.Errorf("handshake: %w", err)
+	}
+	_ = c.nc.SetDeadline(time.Time{})
+
+	c.reader = newChunkReader(br)
+	for {
+		_ = c.nc.SetReadDeadline(time.Now().Add(readTimeout))
+		msg, err := c.reader.readMessage()
+		if err != nil {
+			return err
+		}
+		if err := c.acknowledge(); err != nil {
+			return err
+		}
+		if err := c.handleMessage(msg); err != nil {
+			return err
+		}
+	}
+}
+
+// handshake performs the plain (unsigned) RTMP handshake that OBS, FFmpeg and most encoders use.
+func (c *conn) handshake(br *bufio.Reader) error {
+	version, err := br.ReadByte()
+	if err != nil {
+		return err
+	}
+	if version != 3 {
+		return fmt.Errorf("unsupported RTMP version %d", version)
+	}
+	c1 := make([]byte, handshakeSize)
+	if _, err := io.ReadFull(br, c1); err != nil {
+		return err
+	}
+
+	s0s1s2 := make([]byte, 1+2*handshakeSize)
+	s0s1s2[0] = 3
+	if _, err := rand.Read(s0s1s2[9 : 1+handshakeSize]); err != nil {
+		return err
+	}
+	copy(s0s1s2[1+handshakeSize:], c1)
+	if _, err := c.nc.Write(s0s1s2); err != nil {
+		return err
+	}
+
+	_, err = io.ReadFull(br, make([]byte, handshakeSize)) // C2, echo of S1
+	return err
+}
+
+// acknowledge sends the acknowledgements the peer asked for with its window size.
+func (c *conn) acknowledge() error {
+	if c.peerAckWindow == 0 || c.counter.n-c.acked < uint64(c.peerAckWindow) {
+		return nil
+	}
+	c.acked = c.counter.n
+	return c.writeControl(msgAck, uint32(c.acked))
+}
+
+func (c *conn) handleMessage(msg *message) error {
+	switch msg.typeID {
+	case msgSetChunkSize:
+		if len(msg.payload) < 4 {
+			return fmt.Errorf("short set chunk size message")
+		}
+		size := binary.BigEndian.Uint32(msg.payload) & 0x7fffffff
+		if size == 0 || size > maxChunkSize {
+			return fmt.Errorf("invalid chunk size %d", size)
+		}
+		c.reader.chunkSize = size
+	case msgWindowAckSize:
+		if len(msg.payload) >= 4 {
+			c.peerAckWindow = binary.BigEndian.Uint32(msg.payload)
+		}
+	case msgCommandAMF3:
+		if len(msg.payload) > 0 {
+			return c.handleCommand(msg.streamID, msg.payload[1:])
+		}
+	case msgCommandAMF0:
+		return c.handleCommand(msg.streamID, msg.payload)
+	case msgVideo:
+		return c.handleMedia(c.demux.video(msg.timestamp, msg.payload))
+	case msgAudio:
+		pkt, err := c.demux.audio(msg.timestamp, msg.payload)
+		if errors.Is(err, errUnsupportedAudio) {
+			// Keep the video going without sound rather than dropping the publish
+			if !c.audioDropped {
+				log.Printf("rtmp %s: %v, dropping audio", c.nc.RemoteAddr(), err)
+				c.audioDropped = true
+			}
+			return nil
+		}
+		return c.handleMedia(pkt, err)
+	}
+	return nil
+}
+
+func (c *conn) handleMedia(pkt *domain.Packet, err error) error {
+	if err != nil {
+		return err
+	}
+	if pkt == nil || c.broadcast == nil {
+		return nil
+	}
+	return c.broadcast.WritePacket(pkt)
+}
+
+func (c *conn) handleCommand(streamID uint32, payload []byte) error {
+	vals, err := decodeAMF(payload)
+	if err != nil && len(vals) < 2 {
+		return fmt.Errorf("decode command: %w", err)
+	}
+	if len(vals) < 2 {
+		return nil
+	}
+	name, _ := vals[0].(string)
+	txn, _ := vals[1].(float64)
+
+	switch name {
+	case "connect":
+		if err := c.writeControl(msgWindowAckSize, windowAckSize); err != nil {
+			return err
+		}
+		bw := make([]byte, 5)
+		binary.BigEndian.PutUint32(bw, windowAckSize)
+		bw[4] = 2 // dynamic
+		if err := c.writeMessage(csidControl, msgSetPeerBandwidth, 0, bw); err != nil {
+			return err
+		}
+		if err := c.writeControl(msgSetChunkSize, serverChunkSize); err != nil {
+			return err
+		}
+		c.writer.chunkSize = serverChunkSize
+		return c.writeCommand(0, "_result", txn,
+			amfObj{"fmsVer": "FMS/3,0,1,123", "capabilities": 31},
+			amfObj{"level": "status", "code": "NetConnection.Connect.Success", "description": "Connection succeeded.", "objectEncoding": 0})
+	case "releaseStream", "FCPublish":
+		return c.writeCommand(0, "_result", txn, nil, nil)
+	case "createStream":
+		return c.writeCommand(0, "_result", txn, nil, 1)
+	case "publish":
+		if len(vals) < 4 {
+			return fmt.Errorf("publish without a stream name")
+		}
+		name, _ := vals[3].(string)
+		return c.publish(streamID, name)
+	case "FCUnpublish", "deleteStream", "closeStream":
+		if c.broadcast != nil {
+			return errPublishEnded
+		}
+	}
+	return nil
+}
+
+func (c *conn) publish(streamID uint32, name string) error {
+	if c.broadcast != nil {
+		return fmt.Errorf("connection is already publishing")
+	}
+	// Encoders may append query parameters to the stream name
+	key, _, _ := strings.Cut(name, "?")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	b, err := c.usecase.StartBroadcast(ctx, key)
+	if err != nil {
+		code := "NetStream.Publish.Failed"
+		if errors.Is(err, domain.ErrInvalidStreamKey) {
+			code = "NetStream.Publish.BadName"
+		}
+		_ = c.writeStatus(streamID, "error", code, err.Error())
+		return fmt.Errorf("publish rejected: %w", err)
+	}
+	c.broadcast = b
+	c.reader.maxMessageSize = maxMessageSize
+	log.Printf("rtmp %s: publishing video %s", c.nc.RemoteAddr(), b.VideoID())
+
+	begin := make([]byte, 6) // user control event 0, StreamBegin
+	binary.BigEndian.PutUint32(begin[2:], streamID)
+	if err := c.writeMessage(csidControl, msgUserControl, 0, begin); err != nil {
+		return err
+	}
+	return c.writeStatus(streamID, "status", "NetStream.Publish.Start", "Publishing "+b.VideoID())
+}
+
+func (c *conn) writeStatus(streamID uint32, level, code, description string) error {
+	return c.writeCommand(streamID, "onStatus", 0, nil, amfObj{"level": level, "code": code, "description": description})
+}
+
+func (c *conn) writeCommand(streamID uint32, vals ...any) error {
+	csid := uint8(csidCommand)
+	if streamID != 0 {
+		csid = csidStatus
+	}
+	return c.writeMessage(csid, msgCommandAMF0, streamID, encodeAMF(vals...))
+}
+
+func (c *conn) writeControl(typeID uint8, v uint32) error {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return c.writeMessage(csidControl, typeID, 0, b)
+}
+
+func (c *conn) writeMessage(csid uint8, typeID uint8, streamID uint32, payload []byte) error {
+	_ = c.nc.SetWriteDeadline(time.Now().Add(handshakeTimeout))
+	return c.writer.writeMessage(csid, &message{typeID: typeID, streamID: streamID, payload: payload})
+}
+
+type countingReader struct {
+	r io.Reader
+	n uint64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += uint64(n)
+	return n, err
+}
@@ -0,0 +1,163 @@
+package whip
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/pion/rtp"
+	"github.com/pion/rtp/codecs"
+	"github.com/pion/webrtc/v4/pkg/media"
+	"github.com/pion/webrtc/v4/pkg/media/samplebuilder"
+)
+
+const (
+	nalTypeIDR = 5
+	nalTypeSPS = 7
+	nalTypePPS = 8
+	nalTypeAUD = 9
+)
+
+var (
+	startCode = []byte{0, 0, 0, 1}
+	audNAL    = []byte{0, 0, 0, 1, nalTypeAUD, 0xf0}
+)
+
+type depacketizer interface {
+	// push adds an RTP packet received at elapsed into the session and returns the packets it completed.
+	push(pkt *rtp.Packet, elapsed time.Duration) []*domain.Packet
+}
+
+// clock turns RTP timestamps into stream time. Tracks have unrelated RTP clocks, so each is
+// anchored to when its first sample arrived and advances by its own timestamps from there.
+type clock struct {
+	rate    uint32
+	started bool
+	base    time.Duration
+	last    uint32
+	elapsed int64 // RTP ticks since the first sample, unwrapped
+}
+
+func (c *clock) at(ts uint32, arrival time.Duration) time.Duration {
+	if !c.started {
+		c.started, c.base, c.last = true, arrival, ts
+	}
+	c.elapsed += int64(int32(ts - c.last))
+	c.last = ts
+	return c.base + time.Duration(c.elapsed)*time.Second/time.Duration(c.rate)
+}
+
+type videoDepacketizer struct {
+	builder  *samplebuilder.SampleBuilder
+	clock    clock
+	sps, pps []byte
+}
+
+func newVideoDepacketizer(clockRate uint32) *videoDepacketizer {
+	return &videoDepacketizer{
+		builder: samplebuilder.New(512, &codecs.H264Packet{}, clockRate),
+		clock:   clock{rate: clockRate},
+	}
+}
+
+// push returns access units in Annex B with parameter sets ahead of keyframes, as the packager expects.
+func (d *videoDepacketizer) push(pkt *rtp.Packet, elapsed time.Duration) []*domain.Packet {
+	d.builder.Push(pkt)
+	var out []*domain.Packet
+	for s := d.builder.Pop(); s != nil; s = d.builder.Pop() {
+		if p := d.accessUnit(s, elapsed); p != nil {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func (d *videoDepacketizer) accessUnit(s *media.Sample, elapsed time.Duration) *domain.Packet {
+	nalus := splitAnnexB(s.Data)
+	if len(nalus) == 0 {
+		return nil
+	}
+
+	keyframe, hasParams := false, false
+	for _, n := range nalus {
+		switch n[0] & 0x1f {
+		case nalTypeIDR:
+			keyframe = true
+		case nalTypeSPS:
+			d.sps, hasParams = n, true
+		case nalTypePPS:
+			d.pps = n
+		}
+	}
+
+	data := make([]byte, 0, len(s.Data)+len(d.sps)+len(d.pps)+32)
+	data = append(data, audNAL...)
+	if keyframe && !hasParams && d.sps != nil && d.pps != nil {
+		for _, ps := range [][]byte{d.sps, d.pps} {
+			data = append(data, startCode...)
+			data = append(data, ps...)
+		}
+	}
+	for _, n := range nalus {
+		if n[0]&0x1f == nalTypeAUD {
+			continue
+		}
+		data = append(data, startCode...)
+		data = append(data, n...)
+	}
+
+	// WebRTC H.264 has no B-frames, so presentation and decode order match
+	ts := d.clock.at(s.PacketTimestamp, elapsed)
+	return &domain.Packet{Kind: domain.TrackVideo, DTS: ts, PTS: ts, Keyframe: keyframe, Data: data}
+}
+
+// splitAnnexB returns the NAL units of an Annex B byte stream without their start codes.
+func splitAnnexB(b []byte) [][]byte {
+	var nalus [][]byte
+	for {
+		i := bytes.Index(b, []byte{0, 0, 1})
+		if i < 0 {
+			break
+		}
+		b = b[i+3:]
+		end := bytes.Index(b, []byte{0, 0, 1})
+		if end < 0 {
+			end = len(b)
+		}
+		n := bytes.TrimRight(b[:end], "\x00")
+		if len(n) > 0 {
+			nalus = append(nalus, n)
+		}
+		b = b[end:]
+	}
+	return nalus
+}
+
+type audioDepacketizer struct {
+	builder *samplebuilder.SampleBuilder
+	clock   clock
+}
+
+func newAudioDepacketizer(clockRate uint32) *audioDepacketizer {
+	return &audioDepacketizer{
+		builder: samplebuilder.New(16, &codecs.OpusPacket{}, clockRate),
+		clock:   clock{rate: clockRate},
+	}
+}
+
+func (d *audioDepacketizer) push(pkt *rtp.Packet, elapsed time.Duration) []*domain.Packet {
+	d.builder.Push(pkt)
+	var out []*domain.Packet
+	for s := d.builder.Pop(); s != nil; s = d.builder.Pop() {
+		ts := d.clock.at(s.PacketTimestamp, elapsed)
+		out = append(out, &domain.Packet{
+			Kind:       domain.TrackAudio,
+			AudioCodec: domain.AudioOpus,
+			DTS:        ts,
+			PTS:        ts,
+			Keyframe:   true,
+			Data:       s.Data,
+		})
+	}
+	return out
+}
@@ -0,0 +1,288 @@
+package whip
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/pion/interceptor"
+	"github.com/pion/rtcp"
+	"github.com/pion/webrtc/v4"
+)
+
+const (
+	// maxOfferSize bounds the SDP offer body; browser offers are a few kilobytes
+	maxOfferSize = 64 * 1024
+	// gatherTimeout bounds ICE gathering before the answer is sent
+	gatherTimeout = 5 * time.Second
+	// keyframeInterval is how often the publisher is asked for a keyframe so segments can be cut
+	keyframeInterval = 2 * time.Second
+)
+
+// NewAPI builds a WebRTC stack that accepts what the packager can carry: H.264 video and Opus audio.
+func NewAPI(se webrtc.SettingEngine) (*webrtc.API, error) {
+	m := &webrtc.MediaEngine{}
+	feedback := []webrtc.RTCPFeedback{{Type: "goog-remb"}, {Type: "nack"}, {Type: "nack", Parameter: "pli"}}
+	for i, profile := range []string{"42001f", "42e01f", "4d001f", "64001f"} {
+		err := m.RegisterCodec(webrtc.RTPCodecParameters{
+			RTPCodecCapability: webrtc.RTPCodecCapability{
+				MimeType:     webrtc.MimeTypeH264,
+				ClockRate:    90000,
+				SDPFmtpLine:  "level-asymmetry-allowed=1;packetization-mode=1;profile-level-id=" + profile,
+				RTCPFeedback: feedback,
+			},
+			PayloadType: webrtc.PayloadType(102 + 2*i),
+		}, webrtc.RTPCodecTypeVideo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := m.RegisterCodec(webrtc.RTPCodecParameters{
+		RTPCodecCapability: webrtc.RTPCodecCapability{
+			MimeType:    webrtc.MimeTypeOpus,
+			ClockRate:   48000,
+			Channels:    2,
+			SDPFmtpLine: "minptime=10;useinbandfec=1",
+		},
+		PayloadType: 111,
+	}, webrtc.RTPCodecTypeAudio)
+	if err != nil {
+		return nil, err
+	}
+
+	ir := &interceptor.Registry{}
+	if err := webrtc.RegisterDefaultInterceptors(m, ir); err != nil {
+		return nil, err
+	}
+	return webrtc.NewAPI(webrtc.WithMediaEngine(m), webrtc.WithSettingEngine(se), webrtc.WithInterceptorRegistry(ir)), nil
+}
+
+// Handler implements WHIP (RFC 9725): POST /whip with an SDP offer and the stream key as a
+// bearer token starts a broadcast, DELETE /whip/{sessionID} ends it.
+type Handler struct {
+	usecase domain.LiveUsecase
+	api     *webrtc.API
+
+	mu       sync.Mutex
+	sessions map[string]*session
+}
+
+func NewHandler(u domain.LiveUsecase, api *webrtc.API) *Handler {
+	return &Handler{usecase: u, api: api, sessions: map[string]*session{}}
+}
+
+func (h *Handler) HandleWHIP(w http.ResponseWriter, r *http.Request) {
+	// Browsers publish from the app's origin; Location must be readable to end the session
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, DELETE, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Authorization, Content-Type")
+	w.Header().Set("Access-Control-Expose-Headers", "Location")
+
+	switch {
+	case r.Method == http.MethodOptions:
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && r.URL.Path == "/whip":
+		h.publish(w, r)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/whip/"):
+		h.unpublish(w, r)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *Handler) publish(w http.ResponseWriter, r *http.Request) {
+	if ct, _, _ := strings.Cut(r.Header.Get("Content-Type"), ";"); strings.TrimSpace(ct) != "application/sdp" {
+		http.Error(w, "Content-Type must be application/sdp", http.StatusUnsupportedMediaType)
+		return
+	}
+	streamKey, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || streamKey == "" {
+		http.Error(w, "Stream key is required as a bearer token", http.StatusUnauthorized)
+		return
+	}
+	offer, err := io.ReadAll(io.LimitReader(r.Body, maxOfferSize))
+	if err != nil {
+		http.Error(w, "Failed to read offer", http.StatusBadRequest)
+		return
+	}
+
+	pc, err := h.api.NewPeerConnection(webrtc.Configuration{})
+	if err != nil {
+		log.Printf("whip: failed to create peer connection: %v", err)
+		http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+		return
+	}
+	// The offer is checked before the key so a bad request doesn't create a live video
+	if err := pc.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeOffer, SDP: string(offer)}); err != nil {
+		_ = pc.Close()
+		http.Error(w, "Invalid SDP offer: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	b, err := h.usecase.StartBroadcast(r.Context(), streamKey)
+	if err != nil {
+		_ = pc.Close()
+		switch {
+		case errors.Is(err, domain.ErrInvalidStreamKey):
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+		case errors.Is(err, domain.ErrStreamKeyInUse):
+			http.Error(w, err.Error(), http.StatusConflict)
+		default:
+			log.Printf("whip: failed to start broadcast: %v", err)
+			http.Error(w, "Failed to start broadcast", http.StatusBadGateway)
+		}
+		return
+	}
+
+	s := &session{id: newSessionID(), pc: pc, broadcast: b, started: time.Now(), done: make(chan struct{})}
+	s.onClose = func() { h.remove(s.id) }
+	pc.OnTrack(s.handleTrack)
+	pc.OnConnectionStateChange(func(state webrtc.PeerConnectionState) {
+		if state == webrtc.PeerConnectionStateFailed || state == webrtc.PeerConnectionStateClosed {
+			s.close()
+		}
+	})
+
+	answer, err := s.answer()
+	if err != nil {
+		s.close()
+		log.Printf("whip: failed to answer offer: %v", err)
+		http.Error(w, "Failed to negotiate session", http.StatusInternalServerError)
+		return
+	}
+
+	h.mu.Lock()
+	h.sessions[s.id] = s
+	h.mu.Unlock()
+	log.Printf("whip %s: publishing video %s", s.id, b.VideoID())
+
+	w.Header().Set("Content-Type", "application/sdp")
+	w.Header().Set("Location", "/whip/"+s.id)
+	w.WriteHeader(http.StatusCreated)
+	_, _ = io.WriteString(w, answer)
+}
+
+func (h *Handler) unpublish(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/whip/")
+	h.mu.Lock()
+	s := h.sessions[id]
+	h.mu.Unlock()
+	if s == nil {
+		http.NotFound(w, r)
+		return
+	}
+	s.close()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *Handler) remove(id string) {
+	h.mu.Lock()
+	delete(h.sessions, id)
+	h.mu.Unlock()
+}
+
+func newSessionID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// session is one WHIP publish: a receive-only peer connection feeding a broadcast.
+type session struct {
+	id        string
+	pc        *webrtc.PeerConnection
+	broadcast domain.Broadcast
+	started   time.Time
+	onClose   func()
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// answer creates the SDP answer with all ICE candidates in it, since WHIP has no trickle by default.
+func (s *session) answer() (string, error) {
+	answer, err := s.pc.CreateAnswer(nil)
+	if err != nil {
+		return "", err
+	}
+	gathered := webrtc.GatheringCompletePromise(s.pc)
+	if err := s.pc.SetLocalDescription(answer); err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), gatherTimeout)
+	defer cancel()
+	select {
+	case <-gathered:
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+	return s.pc.LocalDescription().SDP, nil
+}
+
+func (s *session) handleTrack(track *webrtc.TrackRemote, _ *webrtc.RTPReceiver) {
+	var d depacketizer
+	switch {
+	case strings.EqualFold(track.Codec().MimeType, webrtc.MimeTypeH264):
+		d = newVideoDepacketizer(track.Codec().ClockRate)
+		go s.requestKeyframes(track.SSRC())
+	case strings.EqualFold(track.Codec().MimeType, webrtc.MimeTypeOpus):
+		d = newAudioDepacketizer(track.Codec().ClockRate)
+	default:
+		log.Printf("whip %s: ignoring %s track", s.id, track.Codec().MimeType)
+		return
+	}
+
+	for {
+		pkt, _, err := track.ReadRTP()
+		if err != nil {
+			return
+		}
+		for _, p := range d.push(pkt, time.Since(s.started)) {
+			if err := s.broadcast.WritePacket(p); err != nil {
+				log.Printf("whip %s: %v", s.id, err)
+				s.close()
+				return
+			}
+		}
+	}
+}
+
+// requestKeyframes sends periodic PLIs; browsers otherwise send keyframes rarely, which
+// would stretch segments far past the target duration.
+func (s *session) requestKeyframes(ssrc webrtc.SSRC) {
+	ticker := time.NewTicker(keyframeInterval)
+	defer ticker.Stop()
+	for {
+		if err := s.pc.WriteRTCP([]rtcp.Packet{&rtcp.PictureLossIndication{MediaSSRC: uint32(ssrc)}}); err != nil {
+			return
+		}
+		select {
+		case <-ticker.C:
+		case <-s.done:
+			return
+		}
+	}
+}
+
+func (s *session) close() {
+	s.closeOnce.Do(func() {
+		close(s.done)
+		if err := s.pc.Close(); err != nil {
+			log.Printf("whip %s: failed to close peer connection: %v", s.id, err)
+		}
+		if err := s.broadcast.Close(); err != nil {
+			log.Printf("whip %s: failed to end broadcast: %v", s.id, err)
+		}
+		if s.onClose != nil {
+			s.onClose()
+		}
+	})
+}
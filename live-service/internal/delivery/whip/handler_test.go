@@ -0,0 +1,226 @@
+package whip
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/athandoan/youtube/live-service/internal/mocks"
+	"github.com/pion/ice/v4"
+	"github.com/pion/webrtc/v4"
+	"github.com/pion/webrtc/v4/pkg/media"
+	"go.uber.org/mock/gomock"
+)
+
+// loopbackAPI keeps ICE on the loopback interface so both peers run in the test process.
+func loopbackAPI(t *testing.T) *webrtc.API {
+	se := webrtc.SettingEngine{}
+	se.SetIncludeLoopbackCandidate(true)
+	se.SetICEMulticastDNSMode(ice.MulticastDNSModeDisabled)
+	se.SetNetworkTypes([]webrtc.NetworkType{webrtc.NetworkTypeUDP4})
+	api, err := NewAPI(se)
+	if err != nil {
+		t.Fatalf("NewAPI: %v", err)
+	}
+	return api
+}
+
+func postOffer(t *testing.T, url, streamKey, offer string) *http.Response {
+	req, _ := http.NewRequest(http.MethodPost, url+"/whip", strings.NewReader(offer))
+	req.Header.Set("Content-Type", "application/sdp")
+	req.Header.Set("Authorization", "Bearer "+streamKey)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("POST /whip: %v", err)
+	}
+	return resp
+}
+
+func TestHandler_Publish(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	usecase := mocks.NewMockLiveUsecase(ctrl)
+	broadcast := mocks.NewMockBroadcast(ctrl)
+
+	var mu sync.Mutex
+	var gotKeyframe, gotAudio bool
+	received := make(chan struct{})
+	closed := make(chan struct{})
+	usecase.EXPECT().StartBroadcast(gomock.Any(), "key-123").Return(broadcast, nil)
+	broadcast.EXPECT().VideoID().Return("video-123").AnyTimes()
+	broadcast.EXPECT().WritePacket(gomock.Any()).DoAndReturn(func(p *domain.Packet) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if p.Kind == domain.TrackVideo && p.Keyframe && strings.Contains(string(p.Data), "\x00\x00\x00\x01\x67") {
+			gotKeyframe = true
+		}
+		if p.Kind == domain.TrackAudio && p.AudioCodec == domain.AudioOpus {
+			gotAudio = true
+		}
+		if gotKeyframe && gotAudio {
+			select {
+			case <-received:
+			default:
+				close(received)
+			}
+		}
+		return nil
+	}).AnyTimes()
+	broadcast.EXPECT().Close().DoAndReturn(func() error {
+		close(closed)
+		return nil
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(NewHandler(usecase, loopbackAPI(t)).HandleWHIP))
+	defer server.Close()
+
+	// Publish the way a browser does: send-only H.264 and Opus tracks
+	pc, err := loopbackAPI(t).NewPeerConnection(webrtc.Configuration{})
+	if err != nil {
+		t.Fatalf("NewPeerConnection: %v", err)
+	}
+	defer func() { _ = pc.Close() }()
+	video, _ := webrtc.NewTrackLocalStaticSample(webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeH264}, "video", "whip")
+	audio, _ := webrtc.NewTrackLocalStaticSample(webrtc.RTPCodecCapability{MimeType: webrtc.MimeTypeOpus}, "audio", "whip")
+	for _, track := range []webrtc.TrackLocal{video, audio} {
+		if _, err := pc.AddTransceiverFromTrack(track, webrtc.RTPTransceiverInit{Direction: webrtc.RTPTransceiverDirectionSendonly}); err != nil {
+			t.Fatalf("AddTransceiverFromTrack: %v", err)
+		}
+	}
+	offer, _ := pc.CreateOffer(nil)
+	gathered := webrtc.GatheringCompletePromise(pc)
+	if err := pc.SetLocalDescription(offer); err != nil {
+		t.Fatalf("SetLocalDescription: %v", err)
+	}
+	<-gathered
+
+	resp := postOffer(t, server.URL, "key-123", pc.LocalDescription().SDP)
+	answer, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Fatalf("POST /whip status = %d, body %q", resp.StatusCode, answer)
+	}
+	location := resp.Header.Get("Location")
+	if !strings.HasPrefix(location, "/whip/") {
+		t.Fatalf("Location = %q", location)
+	}
+	if err := pc.SetRemoteDescription(webrtc.SessionDescription{Type: webrtc.SDPTypeAnswer, SDP: string(answer)}); err != nil {
+		t.Fatalf("SetRemoteDescription: %v", err)
+	}
+
+	// SPS, PPS and an IDR slice; the contents only need to survive packetization
+	keyframe := []byte{0, 0, 0, 1, 0x67, 0x42, 0xc0, 0x1f, 0xda, 0x01, 0x40, 0x16, 0xe8,
+		0, 0, 0, 1, 0x68, 0xce, 0x3c, 0x80,
+		0, 0, 0, 1, 0x65, 0x88, 0x84, 0x00, 0x33, 0xff}
+	opus := []byte{0xfc, 0xff, 0xfe}
+	ticker := time.NewTicker(20 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(15 * time.Second)
+	for done := false; !done; {
+		select {
+		case <-ticker.C:
+			_ = video.WriteSample(media.Sample{Data: keyframe, Duration: 20 * time.Millisecond})
+			_ = audio.WriteSample(media.Sample{Data: opus, Duration: 20 * time.Millisecond})
+		case <-received:
+			done = true
+		case <-timeout:
+			t.Fatal("timed out waiting for video and audio packets")
+		}
+	}
+
+	req, _ := http.NewRequest(http.MethodDelete, server.URL+location, nil)
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("DELETE: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("DELETE status = %d", resp.StatusCode)
+	}
+	select {
+	case <-closed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("broadcast was not closed")
+	}
+
+	// The session is gone once ended
+	resp, err = http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("DELETE: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("second DELETE status = %d, want %d", resp.StatusCode, http.StatusNotFound)
+	}
+}
+
+func TestHandler_PublishRejected(t *testing.T) {
+	tests := []struct {
+		name       string
+		streamKey  string
+		offer      string
+		setupMock  func(usecase *mocks.MockLiveUsecase)
+		wantStatus int
+	}{
+		{
+			name:       "error - missing stream key",
+			streamKey:  "",
+			setupMock:  func(usecase *mocks.MockLiveUsecase) {},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "error - invalid offer",
+			streamKey:  "key-123",
+			offer:      "not sdp",
+			setupMock:  func(usecase *mocks.MockLiveUsecase) {},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "error - unknown stream key",
+			streamKey: "key-123",
+			setupMock: func(usecase *mocks.MockLiveUsecase) {
+				usecase.EXPECT().StartBroadcast(gomock.Any(), "key-123").Return(nil, domain.ErrInvalidStreamKey)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:      "error - stream key already publishing",
+			streamKey: "key-123",
+			setupMock: func(usecase *mocks.MockLiveUsecase) {
+				usecase.EXPECT().StartBroadcast(gomock.Any(), "key-123").Return(nil, domain.ErrStreamKeyInUse)
+			},
+			wantStatus: http.StatusConflict,
+		},
+	}
+
+	api := loopbackAPI(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			usecase := mocks.NewMockLiveUsecase(ctrl)
+			tt.setupMock(usecase)
+
+			offer := tt.offer
+			if offer == "" {
+				pc, _ := api.NewPeerConnection(webrtc.Configuration{})
+				defer func() { _ = pc.Close() }()
+				_, _ = pc.AddTransceiverFromKind(webrtc.RTPCodecTypeVideo, webrtc.RTPTransceiverInit{Direction: webrtc.RTPTransceiverDirectionSendonly})
+				o, _ := pc.CreateOffer(nil)
+				offer = o.SDP
+			}
+
+			server := httptest.NewServer(http.HandlerFunc(NewHandler(usecase, api).HandleWHIP))
+			defer server.Close()
+
+			resp := postOffer(t, server.URL, tt.streamKey, offer)
+			_ = resp.Body.Close()
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package domain
+
+//go:generate mockgen -source=live.go -destination=../mocks/mock_services.go -package=mocks
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidStreamKey = errors.New("invalid stream key")
+	ErrStreamKeyInUse   = errors.New("stream key is already publishing")
+	ErrVideoNotFound    = errors.New("video not found")
+)
+
+// Live status values shared with the metadata service.
+const (
+	LiveStatusLive  = "live"
+	LiveStatusEnded = "ended"
+)
+
+type TrackKind int
+
+const (
+	TrackVideo TrackKind = iota
+	TrackAudio
+)
+
+type AudioCodec int
+
+const (
+	AudioAAC AudioCodec = iota
+	AudioOpus
+)
+
+// Packet is one access unit of a live stream: H.264 in Annex B with parameter sets ahead
+// of keyframes, AAC with ADTS headers, or a raw Opus packet.
+type Packet struct {
+	Kind       TrackKind
+	AudioCodec AudioCodec // for audio packets
+	DTS        time.Duration
+	PTS        time.Duration
+	Keyframe   bool
+	Data       []byte
+}
+
+type LiveVideo struct {
+	ID        string
+	OwnerID   string
+	ChannelID string // empty for streams outside a channel
+	Title     string
+}
+
+type MetadataService interface {
+	// StartLiveStream authenticates streamKey and creates the live video, or returns ErrInvalidStreamKey.
+	StartLiveStream(ctx context.Context, streamKey string) (*LiveVideo, error)
+	// CheckViewer returns ErrVideoNotFound unless viewerID, empty for anonymous viewers, may
+	// watch the video: it exists, is not in the trash, and is not private to others.
+	CheckViewer(ctx context.Context, id, viewerID string) error
+	UpdateLiveStatus(ctx context.Context, id, liveStatus string) error
+	// CompleteRecording makes an ended stream's recording, stored at objectKey in bucket, a
+	// ready video, or fails it when duration is 0. It returns ErrVideoNotFound once the video
+	// has been purged from the trash.
+	CompleteRecording(ctx context.Context, id string, duration time.Duration, bucket, objectKey string) error
+}
+
+type StreamingService interface {
+	// InvalidateStreamURL drops the playback URLs cached for a video.
+	InvalidateStreamURL(ctx context.Context, id string) error
+}
+
+// Storage uploads finished recordings to the bucket uploads are served from.
+type Storage interface {
+	Bucket() string
+	PutFile(ctx context.Context, objectKey, path, contentType string) error
+	Remove(ctx context.Context, objectKey string) error
+}
+
+// Packager turns a packet stream into rolling HLS with a DVR window. Close turns the playlist
+// into the VOD playlist of the recording.
+type Packager interface {
+	WritePacket(p *Packet) error
+	Close() error
+}
+
+type PackagerFactory interface {
+	NewPackager(videoID string) (Packager, error)
+}
+
+// Broadcast is an authenticated publish. Ingest protocols write media to it, from any
+// goroutine, and close it when the publisher disconnects, which marks the video ended and
+// queues its recording.
+type Broadcast interface {
+	VideoID() string
+	WritePacket(p *Packet) error
+	Close() error
+}
+
+// RecordingQueue processes the recordings of ended streams in the background.
+type RecordingQueue interface {
+	Enqueue(videoID string)
+}
+
+type LiveUsecase interface {
+	StartBroadcast(ctx context.Context, streamKey string) (Broadcast, error)
+}
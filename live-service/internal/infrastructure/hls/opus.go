@@ -0,0 +1,37 @@
+package hls
+
+import "github.com/asticode/go-astits"
+
+// Opus in MPEG-TS follows ETSI TS 102 366 Annex A: a private data stream tagged with an
+// "Opus" registration descriptor, each packet behind an opus_control_header.
+
+const privateStream1 = 0xbd
+
+// opusChannelConfig is the channel_config_code for stereo; WebRTC Opus is always negotiated as 2 channels.
+const opusChannelConfig = 2
+
+var opusDescriptors = []*astits.Descriptor{
+	{
+		Tag:          astits.DescriptorTagRegistration,
+		Length:       4,
+		Registration: &astits.DescriptorRegistration{FormatIdentifier: 0x4f707573}, // "Opus"
+	},
+	{
+		Tag:       astits.DescriptorTagExtension,
+		Length:    2,
+		Extension: &astits.DescriptorExtension{Tag: 0x80, Unknown: &[]byte{opusChannelConfig}},
+	},
+}
+
+// opusAccessUnit prefixes an Opus packet with its control header: the 0x7fe sync prefix
+// without trim or extension flags, then the size as 0xff runs plus a remainder.
+func opusAccessUnit(packet []byte) []byte {
+	out := make([]byte, 0, len(packet)+2+len(packet)/255+1)
+	out = append(out, 0x7f, 0xe0)
+	n := len(packet)
+	for ; n >= 255; n -= 255 {
+		out = append(out, 0xff)
+	}
+	out = append(out, byte(n))
+	return append(out, packet...)
+}
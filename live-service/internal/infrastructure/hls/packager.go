@@ -0,0 +1,305 @@
+package hls
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/asticode/go-astits"
+	"github.com/athandoan/youtube/live-service/internal/domain"
+)
+
+const (
+	PlaylistName = "index.m3u8"
+
+	videoPID uint16 = 256
+	audioPID uint16 = 257
+
+	// audioOnlyDelay is how long audio may arrive without video before the stream is
+	// packaged as audio only.
+	audioOnlyDelay = time.Second
+	// minPlaylistSegments keeps a short DVR window above the three segments players hold back from the live edge
+	minPlaylistSegments = 6
+	// timestampOffset keeps PTS above DTS for B-frames at the start of the stream
+	timestampOffset = time.Second
+)
+
+type Config struct {
+	// Dir holds one directory of segments and a playlist per video.
+	Dir string
+	// SegmentDuration is the target; segments are cut at the first keyframe after it.
+	SegmentDuration time.Duration
+	// DVRWindow is how far back viewers can seek in the live playlist. Every segment is kept
+	// on disk regardless, since the whole stream becomes the recording.
+	DVRWindow time.Duration
+}
+
+type packagerFactory struct {
+	cfg Config
+}
+
+func NewPackagerFactory(cfg Config) domain.PackagerFactory {
+	return &packagerFactory{cfg: cfg}
+}
+
+func (f *packagerFactory) NewPackager(videoID string) (domain.Packager, error) {
+	dir := filepath.Join(f.cfg.Dir, videoID)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &packager{cfg: f.cfg, dir: dir}, nil
+}
+
+type segment struct {
+	name     string
+	duration time.Duration
+}
+
+// packager writes MPEG-TS segments and a sliding window playlist for one stream.
+type packager struct {
+	cfg Config
+	dir string
+
+	hasVideo      bool
+	hasAudio      bool
+	audioCodec    domain.AudioCodec
+	firstAudioDTS time.Duration
+
+	file     *os.File
+	bw       *bufio.Writer
+	mx       *astits.Muxer
+	segStart time.Duration
+	segPIDs  map[uint16]bool
+	lastDTS  time.Duration
+
+	segments []segment // every segment so far; the live playlist shows the tail
+}
+
+func (p *packager) WritePacket(pkt *domain.Packet) error {
+	switch pkt.Kind {
+	case domain.TrackVideo:
+		p.hasVideo = true
+	case domain.TrackAudio:
+		if !p.hasAudio {
+			p.hasAudio = true
+			p.audioCodec = pkt.AudioCodec
+			p.firstAudioDTS = pkt.DTS
+		}
+	}
+
+	// Segments start on keyframes, or on any audio frame for streams without video
+	cutPoint := pkt.Kind == domain.TrackVideo && pkt.Keyframe ||
+		pkt.Kind == domain.TrackAudio && !p.hasVideo && pkt.DTS-p.firstAudioDTS >= audioOnlyDelay
+	if p.mx == nil {
+		if !cutPoint {
+			return nil
+		}
+		if err := p.openSegment(pkt.DTS); err != nil {
+			return err
+		}
+	} else if cutPoint && pkt.DTS-p.segStart >= p.cfg.SegmentDuration {
+		if err := p.closeSegment(pkt.DTS); err != nil {
+			return err
+		}
+		if err := p.openSegment(pkt.DTS); err != nil {
+			return err
+		}
+	}
+
+	pid := videoPID
+	if pkt.Kind == domain.TrackAudio {
+		pid = audioPID
+	}
+	if !p.segPIDs[pid] {
+		// A track that showed up mid-segment; it is added to the PMT and carried from here on
+		if err := p.addStream(pid); err != nil {
+			return err
+		}
+		if _, err := p.mx.WriteTables(); err != nil {
+			return err
+		}
+	}
+
+	return p.writePES(pid, pkt)
+}
+
+func (p *packager) openSegment(start time.Duration) error {
+	name := fmt.Sprintf("segment%d.ts", len(p.segments))
+	f, err := os.Create(filepath.Join(p.dir, name))
+	if err != nil {
+		return err
+	}
+	p.file = f
+	p.bw = bufio.NewWriterSize(f, 256*1024)
+	p.mx = astits.NewMuxer(context.Background(), p.bw)
+	p.segStart = start
+	p.segPIDs = map[uint16]bool{}
+
+	if p.hasVideo {
+		if err := p.addStream(videoPID); err != nil {
+			return err
+		}
+	}
+	if p.hasAudio {
+		if err := p.addStream(audioPID); err != nil {
+			return err
+		}
+	}
+	_, err = p.mx.WriteTables()
+	return err
+}
+
+func (p *packager) addStream(pid uint16) error {
+	es := astits.PMTElementaryStream{ElementaryPID: pid, StreamType: astits.StreamTypeH264Video}
+	if pid == audioPID {
+		es.StreamType = astits.StreamTypeAACAudio
+		if p.audioCodec == domain.AudioOpus {
+			es.StreamType = astits.StreamTypePrivateData
+			es.ElementaryStreamDescriptors = opusDescriptors
+		}
+	}
+	if err := p.mx.AddElementaryStream(es); err != nil {
+		return err
+	}
+	p.segPIDs[pid] = true
+	if pid == videoPID || !p.segPIDs[videoPID] {
+		p.mx.SetPCRPID(pid)
+	}
+	return nil
+}
+
+func (p *packager) writePES(pid uint16, pkt *domain.Packet) error {
+	dts := clock(pkt.DTS)
+	pts := clock(pkt.PTS)
+	header := &astits.PESOptionalHeader{
+		MarkerBits:             2,
+		DataAlignmentIndicator: true,
+		PTSDTSIndicator:        astits.PTSDTSIndicatorOnlyPTS,
+		PTS:                    pts,
+	}
+	if pid == videoPID && pkt.PTS != pkt.DTS {
+		header.PTSDTSIndicator = astits.PTSDTSIndicatorBothPresent
+		header.DTS = dts
+	}
+
+	pes := &astits.PESData{
+		Header: &astits.PESHeader{OptionalHeader: header},
+		Data:   pkt.Data,
+	}
+	if pid == audioPID && p.audioCodec == domain.AudioOpus {
+		pes.Header.StreamID = privateStream1
+		pes.Data = opusAccessUnit(pkt.Data)
+	}
+
+	var af *astits.PacketAdaptationField
+	if pid == p.pcrPID() {
+		af = &astits.PacketAdaptationField{
+			HasPCR:                true,
+			PCR:                   dts,
+			RandomAccessIndicator: pid == videoPID && pkt.Keyframe,
+		}
+	}
+
+	_, err := p.mx.WriteData(&astits.MuxerData{
+		PID:             pid,
+		AdaptationField: af,
+		PES:             pes,
+	})
+	if err == nil && pkt.DTS > p.lastDTS {
+		p.lastDTS = pkt.DTS
+	}
+	return err
+}
+
+// pcrPID carries the program clock: video when the segment has it, audio otherwise.
+func (p *packager) pcrPID() uint16 {
+	if p.segPIDs[videoPID] {
+		return videoPID
+	}
+	return audioPID
+}
+
+// closeSegment finishes the open segment at end and republishes the playlist.
+func (p *packager) closeSegment(end time.Duration) error {
+	if err := p.finishSegment(end); err != nil {
+		return err
+	}
+	return p.writePlaylist(false)
+}
+
+func (p *packager) finishSegment(end time.Duration) error {
+	if err := p.bw.Flush(); err != nil {
+		return err
+	}
+	if err := p.file.Close(); err != nil {
+		return err
+	}
+	p.segments = append(p.segments, segment{name: filepath.Base(p.file.Name()), duration: end - p.segStart})
+	p.mx, p.bw, p.file = nil, nil, nil
+	return nil
+}
+
+func (p *packager) Close() error {
+	if p.mx != nil {
+		// The last frame's own duration is unknown; a frame at 30fps is close enough
+		if err := p.finishSegment(p.lastDTS + 33*time.Millisecond); err != nil {
+			return err
+		}
+	}
+	return p.writePlaylist(true)
+}
+
+// window returns the segments inside the DVR window and the index of the first.
+func (p *packager) window() (int, []segment) {
+	first := len(p.segments)
+	var d time.Duration
+	for first > 0 && (d < p.cfg.DVRWindow || len(p.segments)-first < minPlaylistSegments) {
+		first--
+		d += p.segments[first].duration
+	}
+	return first, p.segments[first:]
+}
+
+// writePlaylist atomically replaces the playlist. Once the stream has ended it becomes the VOD
+// playlist of the recording: every segment, and EXT-X-ENDLIST so players stop polling.
+func (p *packager) writePlaylist(ended bool) error {
+	first, segs := p.window()
+	if ended {
+		first, segs = 0, p.segments
+	}
+
+	target := p.cfg.SegmentDuration
+	for _, s := range segs {
+		target = max(target, s.duration)
+	}
+
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	fmt.Fprintf(&b, "#EXT-X-TARGETDURATION:%d\n", int(math.Ceil(target.Seconds())))
+	if ended {
+		b.WriteString("#EXT-X-PLAYLIST-TYPE:VOD\n")
+	}
+	fmt.Fprintf(&b, "#EXT-X-MEDIA-SEQUENCE:%d\n", first)
+	for _, s := range segs {
+		fmt.Fprintf(&b, "#EXTINF:%.3f,\n%s\n", s.duration.Seconds(), s.name)
+	}
+	if ended {
+		b.WriteString("#EXT-X-ENDLIST\n")
+	}
+
+	tmp := filepath.Join(p.dir, PlaylistName+".tmp")
+	if err := os.WriteFile(tmp, []byte(b.String()), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(p.dir, PlaylistName))
+}
+
+// clock converts a stream timestamp to the 90kHz MPEG-TS clock.
+func clock(d time.Duration) *astits.ClockReference {
+	return &astits.ClockReference{Base: int64((d + timestampOffset) * 90000 / time.Second)}
+}
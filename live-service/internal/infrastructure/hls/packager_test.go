@@ -0,0 +1,128 @@
+package hls
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func segments(durations ...time.Duration) []segment {
+	segs := make([]segment, len(durations))
+	for i, d := range durations {
+		segs[i] = segment{name: fmt.Sprintf("segment%d.ts", i), duration: d}
+	}
+	return segs
+}
+
+func repeat(d time.Duration, n int) []time.Duration {
+	ds := make([]time.Duration, n)
+	for i := range ds {
+		ds[i] = d
+	}
+	return ds
+}
+
+func TestPackager_Window(t *testing.T) {
+	tests := []struct {
+		name      string
+		dvr       time.Duration
+		segments  []time.Duration
+		wantFirst int
+	}{
+		{
+			name:      "success - keeps every segment inside the DVR window",
+			dvr:       20 * time.Second,
+			segments:  repeat(2*time.Second, 8),
+			wantFirst: 0,
+		},
+		{
+			name:      "success - drops segments older than the DVR window",
+			dvr:       20 * time.Second,
+			segments:  repeat(2*time.Second, 15),
+			wantFirst: 5,
+		},
+		{
+			name:      "success - keeps a minimum number of segments with a short window",
+			dvr:       time.Second,
+			segments:  repeat(2*time.Second, 10),
+			wantFirst: 10 - minPlaylistSegments,
+		},
+		{
+			name:      "success - no DVR still keeps the minimum",
+			dvr:       0,
+			segments:  repeat(2*time.Second, 10),
+			wantFirst: 10 - minPlaylistSegments,
+		},
+		{
+			name:      "success - a long segment fills the window",
+			dvr:       10 * time.Second,
+			segments:  []time.Duration{2 * time.Second, 2 * time.Second, 12 * time.Second, 2 * time.Second, 2 * time.Second, 2 * time.Second, 2 * time.Second, 2 * time.Second},
+			wantFirst: 2,
+		},
+		{
+			name:      "success - empty",
+			dvr:       20 * time.Second,
+			wantFirst: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &packager{cfg: Config{DVRWindow: tt.dvr}, segments: segments(tt.segments...)}
+			first, segs := p.window()
+			if first != tt.wantFirst {
+				t.Errorf("window() first = %d, want %d", first, tt.wantFirst)
+			}
+			if len(segs) != len(tt.segments)-tt.wantFirst {
+				t.Errorf("window() returned %d segments, want %d", len(segs), len(tt.segments)-tt.wantFirst)
+			}
+		})
+	}
+}
+
+func TestPackager_WritePlaylist(t *testing.T) {
+	dir := t.TempDir()
+	p := &packager{
+		cfg:      Config{SegmentDuration: 2 * time.Second, DVRWindow: time.Second},
+		dir:      dir,
+		segments: segments(append(repeat(2*time.Second, 7), 2500*time.Millisecond)...),
+	}
+
+	if err := p.writePlaylist(false); err != nil {
+		t.Fatalf("writePlaylist(false) error = %v", err)
+	}
+	live := readPlaylist(t, dir)
+	for _, want := range []string{"#EXT-X-TARGETDURATION:3\n", "#EXT-X-MEDIA-SEQUENCE:2\n", "#EXTINF:2.500,\nsegment7.ts\n"} {
+		if !strings.Contains(live, want) {
+			t.Errorf("live playlist missing %q:\n%s", want, live)
+		}
+	}
+	if strings.Contains(live, "segment1.ts") || strings.Contains(live, "#EXT-X-ENDLIST") {
+		t.Errorf("live playlist should only list the window and stay open:\n%s", live)
+	}
+
+	if err := p.writePlaylist(true); err != nil {
+		t.Fatalf("writePlaylist(true) error = %v", err)
+	}
+	vod := readPlaylist(t, dir)
+	for _, want := range []string{"#EXT-X-PLAYLIST-TYPE:VOD\n", "#EXT-X-MEDIA-SEQUENCE:0\n", "segment0.ts\n", "#EXT-X-ENDLIST\n"} {
+		if !strings.Contains(vod, want) {
+			t.Errorf("VOD playlist missing %q:\n%s", want, vod)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(dir, PlaylistName+".tmp")); !os.IsNotExist(err) {
+		t.Errorf("temporary playlist left behind: %v", err)
+	}
+}
+
+func readPlaylist(t *testing.T, dir string) string {
+	t.Helper()
+	b, err := os.ReadFile(filepath.Join(dir, PlaylistName))
+	if err != nil {
+		t.Fatalf("failed to read playlist: %v", err)
+	}
+	return string(b)
+}
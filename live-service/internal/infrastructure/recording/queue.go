@@ -0,0 +1,240 @@
+package recording
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/athandoan/youtube/live-service/internal/infrastructure/hls"
+)
+
+const (
+	// pendingName marks a recording that has not been published yet, so a restart picks it up again.
+	pendingName   = ".pending"
+	recordingName = "recording.mp4"
+	thumbnailName = "thumbnail.jpg"
+
+	processTimeout = time.Hour
+	reportTimeout  = 10 * time.Second
+	retryDelay     = time.Minute
+	// thumbnailOffset is how far into the recording the thumbnail is taken, past the usual black start.
+	thumbnailOffset = 5 * time.Second
+)
+
+// queue publishes finished recordings one at a time: it remuxes the HLS segments into an MP4,
+// uploads it with a thumbnail to the bucket uploads are served from, reports it to the metadata
+// service and removes it from disk. Recordings that fail for a passing reason are retried, and
+// those whose video has been purged meanwhile are dropped.
+type queue struct {
+	dir       string
+	metadata  domain.MetadataService
+	streaming domain.StreamingService
+	storage   domain.Storage
+
+	mu      sync.Mutex
+	pending []string
+	wake    chan struct{}
+}
+
+// NewQueue starts the worker for recordings under dir, the packager's output directory,
+// resuming any left pending by a previous run.
+func NewQueue(dir string, metadata domain.MetadataService, streaming domain.StreamingService, storage domain.Storage) domain.RecordingQueue {
+	q := newQueue(dir, metadata, streaming, storage)
+	go q.run()
+	return q
+}
+
+func newQueue(dir string, metadata domain.MetadataService, streaming domain.StreamingService, storage domain.Storage) *queue {
+	q := &queue{dir: dir, metadata: metadata, streaming: streaming, storage: storage, wake: make(chan struct{}, 1)}
+	markers, err := filepath.Glob(filepath.Join(dir, "*", pendingName))
+	if err != nil {
+		log.Printf("recordings: failed to list pending: %v", err)
+	}
+	for _, m := range markers {
+		q.pending = append(q.pending, filepath.Base(filepath.Dir(m)))
+	}
+	if len(q.pending) > 0 {
+		log.Printf("recordings: resuming %d pending", len(q.pending))
+	}
+	return q
+}
+
+func (q *queue) Enqueue(videoID string) {
+	if err := os.WriteFile(filepath.Join(q.dir, videoID, pendingName), nil, 0o644); err != nil {
+		log.Printf("recording %s: failed to mark pending: %v", videoID, err)
+	}
+	q.mu.Lock()
+	q.pending = append(q.pending, videoID)
+	q.mu.Unlock()
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// next takes the oldest pending recording, or returns false when there is none.
+func (q *queue) next() (string, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.pending) == 0 {
+		return "", false
+	}
+	videoID := q.pending[0]
+	q.pending = q.pending[1:]
+	return videoID, true
+}
+
+func (q *queue) run() {
+	for {
+		videoID, ok := q.next()
+		if !ok {
+			<-q.wake
+			continue
+		}
+		if err := q.process(videoID); err != nil {
+			log.Printf("recording %s: %v; retrying in %s", videoID, err, retryDelay)
+			time.AfterFunc(retryDelay, func() { q.Enqueue(videoID) })
+		}
+	}
+}
+
+// process publishes one recording. A recording that cannot be played is reported without a
+// duration, which fails its video; other errors are returned to be retried.
+func (q *queue) process(videoID string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), processTimeout)
+	defer cancel()
+	dir := filepath.Join(q.dir, videoID)
+
+	var duration time.Duration
+	var objectKey string
+	mp4 := filepath.Join(dir, recordingName)
+	if d, err := probe(dir); err != nil {
+		log.Printf("recording %s: %v", videoID, err)
+	} else if err := remux(ctx, dir, mp4); err != nil {
+		log.Printf("recording %s: failed to remux: %v", videoID, err)
+	} else {
+		objectKey = videoID + "/" + recordingName
+		if err := q.storage.PutFile(ctx, objectKey, mp4, "video/mp4"); err != nil {
+			return fmt.Errorf("failed to upload: %w", err)
+		}
+		duration = d
+		q.storeThumbnail(ctx, videoID, mp4, d)
+	}
+
+	reportCtx, cancelReport := context.WithTimeout(context.Background(), reportTimeout)
+	defer cancelReport()
+	err := q.metadata.CompleteRecording(reportCtx, videoID, duration, q.storage.Bucket(), objectKey)
+	if errors.Is(err, domain.ErrVideoNotFound) {
+		// Purged from the trash before its recording was in, so nothing will clean it up later
+		return q.drop(ctx, videoID)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to complete: %w", err)
+	}
+	// Playback of the stream was cached as the live playlist, which is about to be removed
+	if err := q.streaming.InvalidateStreamURL(reportCtx, videoID); err != nil {
+		return fmt.Errorf("failed to invalidate stream URL: %w", err)
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		log.Printf("recording %s: failed to remove from disk: %v", videoID, err)
+	}
+	log.Printf("recording %s: ready, %s", videoID, duration.Round(time.Second))
+	return nil
+}
+
+// drop removes the recording of a purged video from the bucket and from disk.
+func (q *queue) drop(ctx context.Context, videoID string) error {
+	for _, name := range []string{recordingName, thumbnailName} {
+		if err := q.storage.Remove(ctx, videoID+"/"+name); err != nil {
+			return fmt.Errorf("failed to remove %s of purged video: %w", name, err)
+		}
+	}
+	if err := os.RemoveAll(filepath.Join(q.dir, videoID)); err != nil {
+		log.Printf("recording %s: failed to remove from disk: %v", videoID, err)
+	}
+	log.Printf("recording %s: dropped, its video was purged", videoID)
+	return nil
+}
+
+// storeThumbnail uploads a frame of the recording. Recordings without video have none, so
+// failures are only logged.
+func (q *queue) storeThumbnail(ctx context.Context, videoID, mp4 string, duration time.Duration) {
+	jpg := filepath.Join(filepath.Dir(mp4), thumbnailName)
+	if err := thumbnail(ctx, mp4, jpg, min(thumbnailOffset, duration/2)); err != nil {
+		log.Printf("recording %s: no thumbnail: %v", videoID, err)
+		return
+	}
+	if err := q.storage.PutFile(ctx, videoID+"/"+thumbnailName, jpg, "image/jpeg"); err != nil {
+		log.Printf("recording %s: failed to upload thumbnail: %v", videoID, err)
+	}
+}
+
+// remux copies the recording's segments into a single MP4 without re-encoding.
+func remux(ctx context.Context, dir, out string) error {
+	return ffmpeg(ctx, "-i", filepath.Join(dir, hls.PlaylistName), "-c", "copy", "-movflags", "+faststart", out)
+}
+
+// thumbnail writes the frame at offset into the video as a JPEG.
+func thumbnail(ctx context.Context, video, out string, offset time.Duration) error {
+	return ffmpeg(ctx, "-ss", strconv.FormatFloat(offset.Seconds(), 'f', 3, 64), "-i", video, "-frames:v", "1", "-vf", "scale=640:-2", out)
+}
+
+func ffmpeg(ctx context.Context, args ...string) error {
+	cmd := exec.CommandContext(ctx, "ffmpeg", append([]string{"-y", "-loglevel", "error"}, args...)...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// probe checks the VOD playlist of a recording against its segments and returns its duration.
+func probe(dir string) (time.Duration, error) {
+	f, err := os.Open(filepath.Join(dir, hls.PlaylistName))
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = f.Close() }()
+
+	var total time.Duration
+	ended := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case strings.HasPrefix(line, "#EXTINF:"):
+			secs, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			d, err := strconv.ParseFloat(secs, 64)
+			if err != nil {
+				return 0, fmt.Errorf("invalid segment duration %q", line)
+			}
+			total += time.Duration(d * float64(time.Second))
+		case line == "#EXT-X-ENDLIST":
+			ended = true
+		case line != "" && !strings.HasPrefix(line, "#"):
+			info, err := os.Stat(filepath.Join(dir, filepath.Base(line)))
+			if err != nil {
+				return 0, err
+			}
+			if info.Size() == 0 {
+				return 0, fmt.Errorf("segment %s is empty", line)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+	if !ended {
+		return 0, fmt.Errorf("playlist was not finalized")
+	}
+	return total, nil
+}
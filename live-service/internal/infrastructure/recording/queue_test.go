@@ -0,0 +1,156 @@
+package recording
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/athandoan/youtube/live-service/internal/infrastructure/hls"
+	"github.com/athandoan/youtube/live-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+// writeRecording lays out a recording the way the packager leaves it.
+func writeRecording(t *testing.T, dir, playlist string, segments map[string]string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, hls.PlaylistName), []byte(playlist), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range segments {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestProbe(t *testing.T) {
+	const vod = "#EXTM3U\n#EXT-X-PLAYLIST-TYPE:VOD\n#EXTINF:2.000,\nsegment0.ts\n#EXTINF:1.500,\nsegment1.ts\n#EXT-X-ENDLIST\n"
+	tests := []struct {
+		name     string
+		playlist string
+		segments map[string]string
+		want     time.Duration
+		wantErr  bool
+	}{
+		{
+			name:     "success - sums segment durations",
+			playlist: vod,
+			segments: map[string]string{"segment0.ts": "ts", "segment1.ts": "ts"},
+			want:     3500 * time.Millisecond,
+		},
+		{
+			name:     "error - playlist not finalized",
+			playlist: "#EXTM3U\n#EXTINF:2.000,\nsegment0.ts\n",
+			segments: map[string]string{"segment0.ts": "ts"},
+			wantErr:  true,
+		},
+		{
+			name:     "error - missing segment",
+			playlist: vod,
+			segments: map[string]string{"segment0.ts": "ts"},
+			wantErr:  true,
+		},
+		{
+			name:     "error - empty segment",
+			playlist: vod,
+			segments: map[string]string{"segment0.ts": "ts", "segment1.ts": ""},
+			wantErr:  true,
+		},
+		{
+			name:     "error - invalid duration",
+			playlist: "#EXTM3U\n#EXTINF:abc,\nsegment0.ts\n#EXT-X-ENDLIST\n",
+			segments: map[string]string{"segment0.ts": "ts"},
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeRecording(t, dir, tt.playlist, tt.segments)
+
+			got, err := probe(dir)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("probe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("probe() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueue_ResumesPending(t *testing.T) {
+	dir := t.TempDir()
+	writeRecording(t, filepath.Join(dir, "video-1"), "", nil)
+	writeRecording(t, filepath.Join(dir, "video-2"), "", nil)
+	writeRecording(t, filepath.Join(dir, "live-3"), "", nil)
+
+	q := newQueue(dir, nil, nil, nil)
+	q.Enqueue("video-1")
+	q.Enqueue("video-2")
+
+	restarted := newQueue(dir, nil, nil, nil)
+	slices.Sort(restarted.pending)
+	if !slices.Equal(restarted.pending, []string{"video-1", "video-2"}) {
+		t.Errorf("pending after restart = %v, want video-1 and video-2", restarted.pending)
+	}
+}
+
+func TestQueue_FailsUnplayableRecording(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadata := mocks.NewMockMetadataService(ctrl)
+	streaming := mocks.NewMockStreamingService(ctrl)
+	storage := mocks.NewMockStorage(ctrl)
+
+	dir := t.TempDir()
+	writeRecording(t, filepath.Join(dir, "video-1"), "#EXTM3U\n", nil)
+	q := newQueue(dir, metadata, streaming, storage)
+	q.Enqueue("video-1")
+
+	storage.EXPECT().Bucket().Return("videos")
+	metadata.EXPECT().CompleteRecording(gomock.Any(), "video-1", time.Duration(0), "videos", "").Return(nil)
+	streaming.EXPECT().InvalidateStreamURL(gomock.Any(), "video-1").Return(nil)
+
+	videoID, _ := q.next()
+	if err := q.process(videoID); err != nil {
+		t.Fatalf("process() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "video-1")); !os.IsNotExist(err) {
+		t.Errorf("recording left on disk: %v", err)
+	}
+	if restarted := newQueue(dir, nil, nil, nil); len(restarted.pending) != 0 {
+		t.Errorf("pending after processing = %v, want none", restarted.pending)
+	}
+}
+
+func TestQueue_DropsRecordingOfPurgedVideo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	metadata := mocks.NewMockMetadataService(ctrl)
+	streaming := mocks.NewMockStreamingService(ctrl)
+	storage := mocks.NewMockStorage(ctrl)
+
+	dir := t.TempDir()
+	writeRecording(t, filepath.Join(dir, "video-1"), "#EXTM3U\n", nil)
+	q := newQueue(dir, metadata, streaming, storage)
+	q.Enqueue("video-1")
+
+	storage.EXPECT().Bucket().Return("videos")
+	metadata.EXPECT().CompleteRecording(gomock.Any(), "video-1", time.Duration(0), "videos", "").Return(domain.ErrVideoNotFound)
+	storage.EXPECT().Remove(gomock.Any(), "video-1/recording.mp4").Return(nil)
+	storage.EXPECT().Remove(gomock.Any(), "video-1/thumbnail.jpg").Return(nil)
+
+	videoID, _ := q.next()
+	if err := q.process(videoID); err != nil {
+		t.Fatalf("process() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "video-1")); !os.IsNotExist(err) {
+		t.Errorf("recording left on disk: %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type metadataClient struct {
+	client pb.MetadataServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewMetadataClient(addr string) (domain.MetadataService, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(identify))
+	if err != nil {
+		return nil, err
+	}
+	client := pb.NewMetadataServiceClient(conn)
+	return &metadataClient{client: client, conn: conn}, nil
+}
+
+// identify tells the metadata and streaming services the calls come from this service, which
+// they only let services make.
+func identify(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(metadata.AppendToOutgoingContext(ctx, "x-service", "live-service"), method, req, reply, cc, opts...)
+}
+
+func (m *metadataClient) StartLiveStream(ctx context.Context, streamKey string) (*domain.LiveVideo, error) {
+	resp, err := m.client.StartLiveStream(ctx, &pb.StartLiveStreamRequest{StreamKey: streamKey})
+	if err != nil {
+		if status.Code(err) == codes.Unauthenticated {
+			return nil, fmt.Errorf("%w: %s", domain.ErrInvalidStreamKey, status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return &domain.LiveVideo{
+		ID:        resp.Id,
+		OwnerID:   resp.OwnerId,
+		ChannelID: resp.ChannelId,
+		Title:     resp.Title,
+	}, nil
+}
+
+func (m *metadataClient) CheckViewer(ctx context.Context, id, viewerID string) error {
+	_, err := m.client.GetVideo(ctx, &pb.GetVideoRequest{Id: id, Viewer: &pb.Viewer{UserId: viewerID}})
+	switch status.Code(err) {
+	case codes.NotFound, codes.PermissionDenied:
+		return domain.ErrVideoNotFound
+	}
+	return err
+}
+
+func (m *metadataClient) UpdateLiveStatus(ctx context.Context, id, liveStatus string) error {
+	_, err := m.client.UpdateLiveStatus(ctx, &pb.UpdateLiveStatusRequest{Id: id, LiveStatus: liveStatus})
+	return err
+}
+
+func (m *metadataClient) CompleteRecording(ctx context.Context, id string, duration time.Duration, bucket, objectKey string) error {
+	_, err := m.client.CompleteRecording(ctx, &pb.CompleteRecordingRequest{
+		Id:              id,
+		DurationSeconds: duration.Seconds(),
+		Bucket:          bucket,
+		ObjectKey:       objectKey,
+	})
+	if status.Code(err) == codes.NotFound {
+		return domain.ErrVideoNotFound
+	}
+	return err
+}
@@ -0,0 +1,29 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/streaming"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type streamingClient struct {
+	client pb.StreamingServiceClient
+	conn   *grpc.ClientConn
+}
+
+func NewStreamingClient(addr string) (domain.StreamingService, error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(identify))
+	if err != nil {
+		return nil, err
+	}
+	client := pb.NewStreamingServiceClient(conn)
+	return &streamingClient{client: client, conn: conn}, nil
+}
+
+func (s *streamingClient) InvalidateStreamURL(ctx context.Context, id string) error {
+	_, err := s.client.InvalidateStreamURL(ctx, &pb.InvalidateStreamURLRequest{VideoId: id})
+	return err
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+type minioStorage struct {
+	client *minio.Client
+	bucket string
+}
+
+func NewMinioStorage(endpoint, accessKey, secretKey string, useSSL bool, bucket string) (domain.Storage, error) {
+	client, err := minio.New(endpoint, &minio.Options{
+		Creds:        credentials.NewStaticV4(accessKey, secretKey, ""),
+		Secure:       useSSL,
+		Region:       "us-east-1",
+		BucketLookup: minio.BucketLookupPath,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &minioStorage{client: client, bucket: bucket}, nil
+}
+
+func (s *minioStorage) Bucket() string {
+	return s.bucket
+}
+
+func (s *minioStorage) PutFile(ctx context.Context, objectKey, path, contentType string) error {
+	_, err := s.client.FPutObject(ctx, s.bucket, objectKey, path, minio.PutObjectOptions{ContentType: contentType})
+	return err
+}
+
+func (s *minioStorage) Remove(ctx context.Context, objectKey string) error {
+	return s.client.RemoveObject(ctx, s.bucket, objectKey, minio.RemoveObjectOptions{})
+}
@@ -0,0 +1,436 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: live.go
+//
+// Generated by this command:
+//
+//	mockgen -source=live.go -destination=../mocks/mock_services.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/athandoan/youtube/live-service/internal/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMetadataService is a mock of MetadataService interface.
+type MockMetadataService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMetadataServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMetadataServiceMockRecorder is the mock recorder for MockMetadataService.
+type MockMetadataServiceMockRecorder struct {
+	mock *MockMetadataService
+}
+
+// NewMockMetadataService creates a new mock instance.
+func NewMockMetadataService(ctrl *gomock.Controller) *MockMetadataService {
+	mock := &MockMetadataService{ctrl: ctrl}
+	mock.recorder = &MockMetadataServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMetadataService) EXPECT() *MockMetadataServiceMockRecorder {
+	return m.recorder
+}
+
+// CheckViewer mocks base method.
+func (m *MockMetadataService) CheckViewer(ctx context.Context, id, viewerID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckViewer", ctx, id, viewerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckViewer indicates an expected call of CheckViewer.
+func (mr *MockMetadataServiceMockRecorder) CheckViewer(ctx, id, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckViewer", reflect.TypeOf((*MockMetadataService)(nil).CheckViewer), ctx, id, viewerID)
+}
+
+// CompleteRecording mocks base method.
+func (m *MockMetadataService) CompleteRecording(ctx context.Context, id string, duration time.Duration, bucket, objectKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteRecording", ctx, id, duration, bucket, objectKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteRecording indicates an expected call of CompleteRecording.
+func (mr *MockMetadataServiceMockRecorder) CompleteRecording(ctx, id, duration, bucket, objectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteRecording", reflect.TypeOf((*MockMetadataService)(nil).CompleteRecording), ctx, id, duration, bucket, objectKey)
+}
+
+// StartLiveStream mocks base method.
+func (m *MockMetadataService) StartLiveStream(ctx context.Context, streamKey string) (*domain.LiveVideo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLiveStream", ctx, streamKey)
+	ret0, _ := ret[0].(*domain.LiveVideo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLiveStream indicates an expected call of StartLiveStream.
+func (mr *MockMetadataServiceMockRecorder) StartLiveStream(ctx, streamKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLiveStream", reflect.TypeOf((*MockMetadataService)(nil).StartLiveStream), ctx, streamKey)
+}
+
+// UpdateLiveStatus mocks base method.
+func (m *MockMetadataService) UpdateLiveStatus(ctx context.Context, id, liveStatus string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLiveStatus", ctx, id, liveStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLiveStatus indicates an expected call of UpdateLiveStatus.
+func (mr *MockMetadataServiceMockRecorder) UpdateLiveStatus(ctx, id, liveStatus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLiveStatus", reflect.TypeOf((*MockMetadataService)(nil).UpdateLiveStatus), ctx, id, liveStatus)
+}
+
+// MockStreamingService is a mock of StreamingService interface.
+type MockStreamingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamingServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStreamingServiceMockRecorder is the mock recorder for MockStreamingService.
+type MockStreamingServiceMockRecorder struct {
+	mock *MockStreamingService
+}
+
+// NewMockStreamingService creates a new mock instance.
+func NewMockStreamingService(ctrl *gomock.Controller) *MockStreamingService {
+	mock := &MockStreamingService{ctrl: ctrl}
+	mock.recorder = &MockStreamingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamingService) EXPECT() *MockStreamingServiceMockRecorder {
+	return m.recorder
+}
+
+// InvalidateStreamURL mocks base method.
+func (m *MockStreamingService) InvalidateStreamURL(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateStreamURL", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateStreamURL indicates an expected call of InvalidateStreamURL.
+func (mr *MockStreamingServiceMockRecorder) InvalidateStreamURL(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateStreamURL", reflect.TypeOf((*MockStreamingService)(nil).InvalidateStreamURL), ctx, id)
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// Bucket mocks base method.
+func (m *MockStorage) Bucket() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Bucket")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Bucket indicates an expected call of Bucket.
+func (mr *MockStorageMockRecorder) Bucket() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Bucket", reflect.TypeOf((*MockStorage)(nil).Bucket))
+}
+
+// PutFile mocks base method.
+func (m *MockStorage) PutFile(ctx context.Context, objectKey, path, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutFile", ctx, objectKey, path, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PutFile indicates an expected call of PutFile.
+func (mr *MockStorageMockRecorder) PutFile(ctx, objectKey, path, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutFile", reflect.TypeOf((*MockStorage)(nil).PutFile), ctx, objectKey, path, contentType)
+}
+
+// Remove mocks base method.
+func (m *MockStorage) Remove(ctx context.Context, objectKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, objectKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockStorageMockRecorder) Remove(ctx, objectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockStorage)(nil).Remove), ctx, objectKey)
+}
+
+// MockPackager is a mock of Packager interface.
+type MockPackager struct {
+	ctrl     *gomock.Controller
+	recorder *MockPackagerMockRecorder
+	isgomock struct{}
+}
+
+// MockPackagerMockRecorder is the mock recorder for MockPackager.
+type MockPackagerMockRecorder struct {
+	mock *MockPackager
+}
+
+// NewMockPackager creates a new mock instance.
+func NewMockPackager(ctrl *gomock.Controller) *MockPackager {
+	mock := &MockPackager{ctrl: ctrl}
+	mock.recorder = &MockPackagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPackager) EXPECT() *MockPackagerMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockPackager) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockPackagerMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockPackager)(nil).Close))
+}
+
+// WritePacket mocks base method.
+func (m *MockPackager) WritePacket(p *domain.Packet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePacket", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WritePacket indicates an expected call of WritePacket.
+func (mr *MockPackagerMockRecorder) WritePacket(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePacket", reflect.TypeOf((*MockPackager)(nil).WritePacket), p)
+}
+
+// MockPackagerFactory is a mock of PackagerFactory interface.
+type MockPackagerFactory struct {
+	ctrl     *gomock.Controller
+	recorder *MockPackagerFactoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPackagerFactoryMockRecorder is the mock recorder for MockPackagerFactory.
+type MockPackagerFactoryMockRecorder struct {
+	mock *MockPackagerFactory
+}
+
+// NewMockPackagerFactory creates a new mock instance.
+func NewMockPackagerFactory(ctrl *gomock.Controller) *MockPackagerFactory {
+	mock := &MockPackagerFactory{ctrl: ctrl}
+	mock.recorder = &MockPackagerFactoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPackagerFactory) EXPECT() *MockPackagerFactoryMockRecorder {
+	return m.recorder
+}
+
+// NewPackager mocks base method.
+func (m *MockPackagerFactory) NewPackager(videoID string) (domain.Packager, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewPackager", videoID)
+	ret0, _ := ret[0].(domain.Packager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewPackager indicates an expected call of NewPackager.
+func (mr *MockPackagerFactoryMockRecorder) NewPackager(videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewPackager", reflect.TypeOf((*MockPackagerFactory)(nil).NewPackager), videoID)
+}
+
+// MockBroadcast is a mock of Broadcast interface.
+type MockBroadcast struct {
+	ctrl     *gomock.Controller
+	recorder *MockBroadcastMockRecorder
+	isgomock struct{}
+}
+
+// MockBroadcastMockRecorder is the mock recorder for MockBroadcast.
+type MockBroadcastMockRecorder struct {
+	mock *MockBroadcast
+}
+
+// NewMockBroadcast creates a new mock instance.
+func NewMockBroadcast(ctrl *gomock.Controller) *MockBroadcast {
+	mock := &MockBroadcast{ctrl: ctrl}
+	mock.recorder = &MockBroadcastMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroadcast) EXPECT() *MockBroadcastMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockBroadcast) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockBroadcastMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockBroadcast)(nil).Close))
+}
+
+// VideoID mocks base method.
+func (m *MockBroadcast) VideoID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VideoID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// VideoID indicates an expected call of VideoID.
+func (mr *MockBroadcastMockRecorder) VideoID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VideoID", reflect.TypeOf((*MockBroadcast)(nil).VideoID))
+}
+
+// WritePacket mocks base method.
+func (m *MockBroadcast) WritePacket(p *domain.Packet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WritePacket", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WritePacket indicates an expected call of WritePacket.
+func (mr *MockBroadcastMockRecorder) WritePacket(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WritePacket", reflect.TypeOf((*MockBroadcast)(nil).WritePacket), p)
+}
+
+// MockRecordingQueue is a mock of RecordingQueue interface.
+type MockRecordingQueue struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecordingQueueMockRecorder
+	isgomock struct{}
+}
+
+// MockRecordingQueueMockRecorder is the mock recorder for MockRecordingQueue.
+type MockRecordingQueueMockRecorder struct {
+	mock *MockRecordingQueue
+}
+
+// NewMockRecordingQueue creates a new mock instance.
+func NewMockRecordingQueue(ctrl *gomock.Controller) *MockRecordingQueue {
+	mock := &MockRecordingQueue{ctrl: ctrl}
+	mock.recorder = &MockRecordingQueueMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecordingQueue) EXPECT() *MockRecordingQueueMockRecorder {
+	return m.recorder
+}
+
+// Enqueue mocks base method.
+func (m *MockRecordingQueue) Enqueue(videoID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Enqueue", videoID)
+}
+
+// Enqueue indicates an expected call of Enqueue.
+func (mr *MockRecordingQueueMockRecorder) Enqueue(videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Enqueue", reflect.TypeOf((*MockRecordingQueue)(nil).Enqueue), videoID)
+}
+
+// MockLiveUsecase is a mock of LiveUsecase interface.
+type MockLiveUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLiveUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLiveUsecaseMockRecorder is the mock recorder for MockLiveUsecase.
+type MockLiveUsecaseMockRecorder struct {
+	mock *MockLiveUsecase
+}
+
+// NewMockLiveUsecase creates a new mock instance.
+func NewMockLiveUsecase(ctrl *gomock.Controller) *MockLiveUsecase {
+	mock := &MockLiveUsecase{ctrl: ctrl}
+	mock.recorder = &MockLiveUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLiveUsecase) EXPECT() *MockLiveUsecaseMockRecorder {
+	return m.recorder
+}
+
+// StartBroadcast mocks base method.
+func (m *MockLiveUsecase) StartBroadcast(ctx context.Context, streamKey string) (domain.Broadcast, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartBroadcast", ctx, streamKey)
+	ret0, _ := ret[0].(domain.Broadcast)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartBroadcast indicates an expected call of StartBroadcast.
+func (mr *MockLiveUsecaseMockRecorder) StartBroadcast(ctx, streamKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartBroadcast", reflect.TypeOf((*MockLiveUsecase)(nil).StartBroadcast), ctx, streamKey)
+}
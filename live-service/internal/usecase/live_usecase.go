@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+)
+
+// endTimeout bounds marking a video ended after the publisher has already gone.
+const endTimeout = 10 * time.Second
+
+type liveUsecase struct {
+	metadata   domain.MetadataService
+	packagers  domain.PackagerFactory
+	recordings domain.RecordingQueue
+
+	mu     sync.Mutex
+	active map[string]bool // stream keys with a publish in progress
+}
+
+func NewLiveUsecase(metadata domain.MetadataService, packagers domain.PackagerFactory, recordings domain.RecordingQueue) domain.LiveUsecase {
+	return &liveUsecase{
+		metadata:   metadata,
+		packagers:  packagers,
+		recordings: recordings,
+		active:     make(map[string]bool),
+	}
+}
+
+// StartBroadcast authenticates a publish and creates its live video. A key can only
+// publish once at a time so two encoders can't interleave into one playlist.
+func (u *liveUsecase) StartBroadcast(ctx context.Context, streamKey string) (domain.Broadcast, error) {
+	if streamKey == "" {
+		return nil, domain.ErrInvalidStreamKey
+	}
+
+	u.mu.Lock()
+	if u.active[streamKey] {
+		u.mu.Unlock()
+		return nil, domain.ErrStreamKeyInUse
+	}
+	u.active[streamKey] = true
+	u.mu.Unlock()
+
+	video, err := u.metadata.StartLiveStream(ctx, streamKey)
+	if err != nil {
+		u.release(streamKey)
+		return nil, err
+	}
+
+	packager, err := u.packagers.NewPackager(video.ID)
+	if err != nil {
+		u.release(streamKey)
+		return nil, errors.Join(err, u.end(ctx, video.ID))
+	}
+
+	return &broadcast{usecase: u, streamKey: streamKey, videoID: video.ID, packager: packager}, nil
+}
+
+// end marks a video ended and queues its recording, which fails the video if nothing was recorded.
+func (u *liveUsecase) end(ctx context.Context, videoID string) error {
+	err := u.metadata.UpdateLiveStatus(ctx, videoID, domain.LiveStatusEnded)
+	u.recordings.Enqueue(videoID)
+	return err
+}
+
+func (u *liveUsecase) release(streamKey string) {
+	u.mu.Lock()
+	delete(u.active, streamKey)
+	u.mu.Unlock()
+}
+
+type broadcast struct {
+	usecase   *liveUsecase
+	streamKey string
+	videoID   string
+	packager  domain.Packager
+	closeOnce sync.Once
+
+	mu     sync.Mutex // serializes tracks arriving on separate goroutines
+	closed bool
+}
+
+func (b *broadcast) VideoID() string {
+	return b.videoID
+}
+
+func (b *broadcast) WritePacket(p *domain.Packet) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		return nil
+	}
+	return b.packager.WritePacket(p)
+}
+
+// Close finishes the recording, frees the stream key and marks the video ended.
+func (b *broadcast) Close() error {
+	var err error
+	b.closeOnce.Do(func() {
+		b.mu.Lock()
+		b.closed = true
+		perr := b.packager.Close()
+		b.mu.Unlock()
+		b.usecase.release(b.streamKey)
+
+		ctx, cancel := context.WithTimeout(context.Background(), endTimeout)
+		defer cancel()
+		err = errors.Join(perr, b.usecase.end(ctx, b.videoID))
+	})
+	return err
+}
@@ -0,0 +1,127 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/athandoan/youtube/live-service/internal/domain"
+	"github.com/athandoan/youtube/live-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLiveUsecase_StartBroadcast(t *testing.T) {
+	tests := []struct {
+		name      string
+		streamKey string
+		setupMock func(metadata *mocks.MockMetadataService, packagers *mocks.MockPackagerFactory, recordings *mocks.MockRecordingQueue)
+		wantErr   error
+	}{
+		{
+			name:      "success - creates live video and packager",
+			streamKey: "key-123",
+			setupMock: func(metadata *mocks.MockMetadataService, packagers *mocks.MockPackagerFactory, recordings *mocks.MockRecordingQueue) {
+				metadata.EXPECT().
+					StartLiveStream(gomock.Any(), "key-123").
+					Return(&domain.LiveVideo{ID: "video-123", ChannelID: "channel-1"}, nil)
+				packagers.EXPECT().NewPackager("video-123").Return(nil, nil)
+			},
+		},
+		{
+			name:      "error - empty stream key",
+			streamKey: "",
+			setupMock: func(metadata *mocks.MockMetadataService, packagers *mocks.MockPackagerFactory, recordings *mocks.MockRecordingQueue) {
+			},
+			wantErr: domain.ErrInvalidStreamKey,
+		},
+		{
+			name:      "error - unknown stream key",
+			streamKey: "key-123",
+			setupMock: func(metadata *mocks.MockMetadataService, packagers *mocks.MockPackagerFactory, recordings *mocks.MockRecordingQueue) {
+				metadata.EXPECT().
+					StartLiveStream(gomock.Any(), "key-123").
+					Return(nil, domain.ErrInvalidStreamKey)
+			},
+			wantErr: domain.ErrInvalidStreamKey,
+		},
+		{
+			name:      "error - packager fails and the video is ended",
+			streamKey: "key-123",
+			setupMock: func(metadata *mocks.MockMetadataService, packagers *mocks.MockPackagerFactory, recordings *mocks.MockRecordingQueue) {
+				metadata.EXPECT().
+					StartLiveStream(gomock.Any(), "key-123").
+					Return(&domain.LiveVideo{ID: "video-123"}, nil)
+				packagers.EXPECT().NewPackager("video-123").Return(nil, errors.New("disk full"))
+				metadata.EXPECT().UpdateLiveStatus(gomock.Any(), "video-123", domain.LiveStatusEnded).Return(nil)
+				recordings.EXPECT().Enqueue("video-123")
+			},
+			wantErr: errors.New("disk full"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockPackagers := mocks.NewMockPackagerFactory(ctrl)
+			mockRecordings := mocks.NewMockRecordingQueue(ctrl)
+			tt.setupMock(mockMetadata, mockPackagers, mockRecordings)
+
+			uc := NewLiveUsecase(mockMetadata, mockPackagers, mockRecordings)
+			b, err := uc.StartBroadcast(context.Background(), tt.streamKey)
+
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("StartBroadcast() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) && err.Error() != tt.wantErr.Error() {
+				t.Errorf("StartBroadcast() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && b.VideoID() != "video-123" {
+				t.Errorf("VideoID() = %v, want video-123", b.VideoID())
+			}
+		})
+	}
+}
+
+func TestLiveUsecase_StreamKeyPublishesOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockPackagers := mocks.NewMockPackagerFactory(ctrl)
+	mockPackager := mocks.NewMockPackager(ctrl)
+	mockRecordings := mocks.NewMockRecordingQueue(ctrl)
+
+	mockMetadata.EXPECT().
+		StartLiveStream(gomock.Any(), "key-123").
+		Return(&domain.LiveVideo{ID: "video-1"}, nil)
+	mockMetadata.EXPECT().
+		StartLiveStream(gomock.Any(), "key-123").
+		Return(&domain.LiveVideo{ID: "video-2"}, nil)
+	mockPackagers.EXPECT().NewPackager(gomock.Any()).Return(mockPackager, nil).Times(2)
+	mockPackager.EXPECT().Close().Return(nil)
+	mockMetadata.EXPECT().UpdateLiveStatus(gomock.Any(), "video-1", domain.LiveStatusEnded).Return(nil)
+	mockRecordings.EXPECT().Enqueue("video-1")
+
+	uc := NewLiveUsecase(mockMetadata, mockPackagers, mockRecordings)
+	first, err := uc.StartBroadcast(context.Background(), "key-123")
+	if err != nil {
+		t.Fatalf("StartBroadcast() unexpected error: %v", err)
+	}
+
+	if _, err := uc.StartBroadcast(context.Background(), "key-123"); !errors.Is(err, domain.ErrStreamKeyInUse) {
+		t.Errorf("second StartBroadcast() error = %v, want %v", err, domain.ErrStreamKeyInUse)
+	}
+
+	// Closing twice ends the video and queues its recording once, and frees the key for the next publish
+	if err := first.Close(); err != nil {
+		t.Fatalf("Close() unexpected error: %v", err)
+	}
+	_ = first.Close()
+
+	if _, err := uc.StartBroadcast(context.Background(), "key-123"); err != nil {
+		t.Errorf("StartBroadcast() after Close unexpected error: %v", err)
+	}
+}
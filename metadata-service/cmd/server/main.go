@@ -1,11 +1,16 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
+	"time"
 
 	handler "github.com/athandoan/youtube/metadata-service/internal/delivery/grpc"
+	"github.com/athandoan/youtube/metadata-service/internal/delivery/scheduler"
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/athandoan/youtube/metadata-service/internal/infrastructure/events"
 	"github.com/athandoan/youtube/metadata-service/internal/repository"
 	"github.com/athandoan/youtube/metadata-service/internal/usecase"
 	pb "github.com/athandoan/youtube/proto/metadata"
@@ -24,13 +29,45 @@ func main() {
 		log.Fatalf("failed to init repository: %v", err)
 	}
 
-	// 2. Init Usecase
-	uc := usecase.NewVideoUsecase(repo)
+	// 2. Init Usecase; publication events go to a webhook when one is configured
+	var publisher domain.EventPublisher = events.NewLogPublisher()
+	if url := os.Getenv("EVENTS_WEBHOOK_URL"); url != "" {
+		publisher = events.NewWebhookPublisher(url)
+	}
+	uc := usecase.NewVideoUsecase(repo, publisher)
+	channels := usecase.NewChannelUsecase(repo)
+	playlists := usecase.NewPlaylistUsecase(repo)
+	series := usecase.NewSeriesUsecase(repo)
+	subscriptions := usecase.NewSubscriptionUsecase(repo)
+
+	// 3. Start the scheduled publishing loop
+	interval := 15 * time.Second
+	if v := os.Getenv("PUBLISH_INTERVAL"); v != "" {
+		if interval, err = time.ParseDuration(v); err != nil || interval <= 0 {
+			log.Fatalf("invalid PUBLISH_INTERVAL: %q", v)
+		}
+	}
+	go scheduler.NewPublisher(uc, interval).Run(context.Background())
+
+	// 4. Start purging trashed videos once they are past retention
+	retention := 30 * 24 * time.Hour
+	if v := os.Getenv("TRASH_RETENTION"); v != "" {
+		if retention, err = time.ParseDuration(v); err != nil || retention < 0 {
+			log.Fatalf("invalid TRASH_RETENTION: %q", v)
+		}
+	}
+	purgeInterval := time.Hour
+	if v := os.Getenv("TRASH_PURGE_INTERVAL"); v != "" {
+		if purgeInterval, err = time.ParseDuration(v); err != nil || purgeInterval <= 0 {
+			log.Fatalf("invalid TRASH_PURGE_INTERVAL: %q", v)
+		}
+	}
+	go scheduler.NewPurger(uc, purgeInterval, retention).Run(context.Background())
 
-	// 3. Init Handler
-	h := handler.NewMetadataHandler(uc)
+	// 5. Init Handler
+	h := handler.NewMetadataHandler(uc, channels, playlists, series, subscriptions)
 
-	// 4. Start gRPC Server
+	// 6. Start gRPC Server
 	port := os.Getenv("GRPC_PORT")
 	if port == "" {
 		port = "50051"
@@ -40,7 +77,8 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
+	// Callers are identified by the gRPC metadata the gateway and other services send
+	s := grpc.NewServer(grpc.UnaryInterceptor(handler.AuthInterceptor))
 	pb.RegisterMetadataServiceServer(s, h)
 
 	log.Printf("Metadata Service (gRPC) running on :%s", port)
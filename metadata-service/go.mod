@@ -7,6 +7,7 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/mattn/go-sqlite3 v1.14.22
 	go.uber.org/mock v0.6.0
+	golang.org/x/crypto v0.44.0
 	google.golang.org/grpc v1.78.0
 )
 
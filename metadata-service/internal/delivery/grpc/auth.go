@@ -0,0 +1,125 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys callers identify themselves with. The gateway sends the signed-in user it
+// calls for; other services send their name. Services trust them, so they must only be
+// reachable by the gateway and each other.
+const (
+	userIDKey    = "x-user-id"
+	userRolesKey = "x-user-roles"
+	serviceKey   = "x-service"
+)
+
+// access says who may call an RPC.
+type access int
+
+const (
+	servicesOnly access = iota
+	anyone
+	signedIn
+)
+
+// methodAccess lists the RPCs open to users. Anything not listed, such as the calls the
+// upload and live services make while processing videos, is for services only.
+var methodAccess = map[string]access{
+	pb.MetadataService_GetVideo_FullMethodName:                   anyone, // users only get the videos they may watch
+	pb.MetadataService_ListVideos_FullMethodName:                 anyone,
+	pb.MetadataService_ResolveShareLink_FullMethodName:           anyone,
+	pb.MetadataService_UpdateVideo_FullMethodName:                signedIn,
+	pb.MetadataService_DeleteVideo_FullMethodName:                signedIn,
+	pb.MetadataService_RestoreVideo_FullMethodName:               signedIn,
+	pb.MetadataService_UnpublishVideo_FullMethodName:             signedIn,
+	pb.MetadataService_ListTrash_FullMethodName:                  signedIn,
+	pb.MetadataService_GrantVideoAccess_FullMethodName:           signedIn,
+	pb.MetadataService_RevokeVideoAccess_FullMethodName:          signedIn,
+	pb.MetadataService_ListVideoAccess_FullMethodName:            signedIn,
+	pb.MetadataService_CreateShareLink_FullMethodName:            signedIn,
+	pb.MetadataService_ListShareLinks_FullMethodName:             signedIn,
+	pb.MetadataService_RevokeShareLink_FullMethodName:            signedIn,
+	pb.MetadataService_CreateStreamKey_FullMethodName:            signedIn,
+	pb.MetadataService_GetChannel_FullMethodName:                 anyone,
+	pb.MetadataService_CreateChannel_FullMethodName:              signedIn,
+	pb.MetadataService_UpdateChannel_FullMethodName:              signedIn,
+	pb.MetadataService_DeleteChannel_FullMethodName:              signedIn,
+	pb.MetadataService_AddChannelMember_FullMethodName:           signedIn,
+	pb.MetadataService_RemoveChannelMember_FullMethodName:        signedIn,
+	pb.MetadataService_Subscribe_FullMethodName:                  signedIn,
+	pb.MetadataService_Unsubscribe_FullMethodName:                signedIn,
+	pb.MetadataService_ListSubscriptions_FullMethodName:          signedIn,
+	pb.MetadataService_GetSubscriptionFeed_FullMethodName:        signedIn,
+	pb.MetadataService_GetPlaylist_FullMethodName:                anyone,
+	pb.MetadataService_ListPlaylists_FullMethodName:              anyone,
+	pb.MetadataService_CreatePlaylist_FullMethodName:             signedIn,
+	pb.MetadataService_UpdatePlaylist_FullMethodName:             signedIn,
+	pb.MetadataService_DeletePlaylist_FullMethodName:             signedIn,
+	pb.MetadataService_AddPlaylistItem_FullMethodName:            signedIn,
+	pb.MetadataService_RemovePlaylistItem_FullMethodName:         signedIn,
+	pb.MetadataService_MovePlaylistItem_FullMethodName:           signedIn,
+	pb.MetadataService_AddPlaylistCollaborator_FullMethodName:    signedIn,
+	pb.MetadataService_RemovePlaylistCollaborator_FullMethodName: signedIn,
+	pb.MetadataService_GetSeries_FullMethodName:                  anyone,
+	pb.MetadataService_ListSeries_FullMethodName:                 anyone,
+	pb.MetadataService_GetNextEpisode_FullMethodName:             anyone,
+	pb.MetadataService_CreateSeries_FullMethodName:               signedIn,
+	pb.MetadataService_UpdateSeries_FullMethodName:               signedIn,
+	pb.MetadataService_DeleteSeries_FullMethodName:               signedIn,
+	pb.MetadataService_SetEpisode_FullMethodName:                 signedIn,
+	pb.MetadataService_RemoveEpisode_FullMethodName:              signedIn,
+	pb.MetadataService_SetEpisodeCompleted_FullMethodName:        signedIn,
+}
+
+type callerKey struct{}
+
+// AuthInterceptor identifies the caller of every RPC and rejects calls it may not make.
+// Whether a caller may act on a particular video is left to the usecase, which knows the owner.
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	caller := callerFromMetadata(ctx)
+	if caller.Service == "" {
+		switch methodAccess[info.FullMethod] {
+		case servicesOnly:
+			return nil, status.Error(codes.PermissionDenied, "only for internal services")
+		case signedIn:
+			if caller.UserID == "" {
+				return nil, status.Error(codes.Unauthenticated, "sign in first")
+			}
+		}
+		// Users can only act as themselves, or as nobody, which never grants more
+		if r, ok := req.(interface{ GetUserId() string }); ok && r.GetUserId() != "" && r.GetUserId() != caller.UserID {
+			return nil, status.Error(codes.PermissionDenied, "user_id must be the caller")
+		}
+		if r, ok := req.(interface{ GetViewer() *pb.Viewer }); ok && r.GetViewer().GetUserId() != "" && r.GetViewer().GetUserId() != caller.UserID {
+			return nil, status.Error(codes.PermissionDenied, "viewer must be the caller")
+		}
+	}
+	return handler(context.WithValue(ctx, callerKey{}, caller), req)
+}
+
+func callerFromMetadata(ctx context.Context) *domain.Caller {
+	md, _ := metadata.FromIncomingContext(ctx)
+	caller := &domain.Caller{Roles: md.Get(userRolesKey)}
+	if v := md.Get(userIDKey); len(v) > 0 {
+		caller.UserID = v[0]
+	}
+	if v := md.Get(serviceKey); len(v) > 0 {
+		caller.Service = v[0]
+	}
+	return caller
+}
+
+// callerFrom returns the caller AuthInterceptor identified; without it, the call is anonymous.
+func callerFrom(ctx context.Context) *domain.Caller {
+	if caller, ok := ctx.Value(callerKey{}).(*domain.Caller); ok {
+		return caller
+	}
+	return &domain.Caller{}
+}
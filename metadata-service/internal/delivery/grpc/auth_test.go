@@ -0,0 +1,74 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	tests := []struct {
+		name     string
+		md       metadata.MD
+		method   string
+		req      any
+		wantCode codes.Code
+	}{
+		{name: "anonymous reads a video", method: pb.MetadataService_GetVideo_FullMethodName, req: &pb.GetVideoRequest{Id: "v1"}},
+		{name: "user deletes as themselves", md: metadata.Pairs(userIDKey, "user-1"), method: pb.MetadataService_DeleteVideo_FullMethodName,
+			req: &pb.DeleteVideoRequest{Id: "v1", UserId: "user-1"}},
+		{name: "anonymous deletes", method: pb.MetadataService_DeleteVideo_FullMethodName,
+			req: &pb.DeleteVideoRequest{Id: "v1"}, wantCode: codes.Unauthenticated},
+		{name: "user deletes as someone else", md: metadata.Pairs(userIDKey, "user-1"), method: pb.MetadataService_DeleteVideo_FullMethodName,
+			req: &pb.DeleteVideoRequest{Id: "v1", UserId: "user-2"}, wantCode: codes.PermissionDenied},
+		{name: "user watches as someone else", md: metadata.Pairs(userIDKey, "user-1"), method: pb.MetadataService_GetVideo_FullMethodName,
+			req: &pb.GetVideoRequest{Id: "v1", Viewer: &pb.Viewer{UserId: "user-2"}}, wantCode: codes.PermissionDenied},
+		{name: "user watches as nobody", md: metadata.Pairs(userIDKey, "user-1"), method: pb.MetadataService_GetVideo_FullMethodName,
+			req: &pb.GetVideoRequest{Id: "v1", Viewer: &pb.Viewer{}}},
+		{name: "admin calls a service RPC", md: metadata.Pairs(userIDKey, "user-1", userRolesKey, "admin"), method: pb.MetadataService_CreateVideo_FullMethodName,
+			req: &pb.CreateVideoRequest{Title: "t"}, wantCode: codes.PermissionDenied},
+		{name: "service creates a video for its uploader", md: metadata.Pairs(serviceKey, "upload-service"), method: pb.MetadataService_CreateVideo_FullMethodName,
+			req: &pb.CreateVideoRequest{Title: "t", OwnerId: "user-1"}},
+		{name: "service watches for a viewer", md: metadata.Pairs(serviceKey, "streaming-service"), method: pb.MetadataService_GetVideo_FullMethodName,
+			req: &pb.GetVideoRequest{Id: "v1", Viewer: &pb.Viewer{UserId: "user-2"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			called := false
+			handler := func(ctx context.Context, req any) (any, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := AuthInterceptor(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("AuthInterceptor() code = %v, want %v (%v)", got, tt.wantCode, err)
+			}
+			if called != (tt.wantCode == codes.OK) {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCode == codes.OK)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptor_Caller(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(userIDKey, "user-1", userRolesKey, "admin", userRolesKey, "moderator"))
+	handler := func(ctx context.Context, req any) (any, error) {
+		caller := callerFrom(ctx)
+		if caller.UserID != "user-1" || len(caller.Roles) != 2 || caller.Roles[0] != "admin" || caller.Roles[1] != "moderator" {
+			t.Errorf("callerFrom() = %+v", caller)
+		}
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: pb.MetadataService_UnpublishVideo_FullMethodName}
+	if _, err := AuthInterceptor(ctx, &pb.UnpublishVideoRequest{Id: "v1", UserId: "user-1"}, info, handler); err != nil {
+		t.Fatalf("AuthInterceptor() error = %v", err)
+	}
+}
@@ -0,0 +1,165 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *MetadataHandler) CreateChannel(ctx context.Context, req *pb.CreateChannelRequest) (*pb.Channel, error) {
+	channel, err := h.Channels.Create(ctx, callerFrom(ctx), req.Handle, req.DisplayName, req.Description)
+	if err != nil {
+		if errors.Is(err, domain.ErrHandleTaken) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		return nil, channelStatus(err)
+	}
+	return toProtoChannel(channel), nil
+}
+
+func (h *MetadataHandler) GetChannel(ctx context.Context, req *pb.GetChannelRequest) (*pb.Channel, error) {
+	channel, err := h.Channels.Get(ctx, req.Id, req.Handle)
+	if err != nil {
+		return nil, channelStatus(err)
+	}
+	return toProtoChannel(channel), nil
+}
+
+func (h *MetadataHandler) UpdateChannel(ctx context.Context, req *pb.UpdateChannelRequest) (*pb.Channel, error) {
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	update := &domain.ChannelUpdate{}
+	for _, p := range req.UpdateMask.Paths {
+		switch p {
+		case "display_name":
+			update.DisplayName = &req.DisplayName
+		case "description":
+			update.Description = &req.Description
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "cannot update %q", p)
+		}
+	}
+
+	channel, err := h.Channels.Update(ctx, req.Id, callerFrom(ctx), update)
+	if err != nil {
+		return nil, channelStatus(err)
+	}
+	return toProtoChannel(channel), nil
+}
+
+func (h *MetadataHandler) DeleteChannel(ctx context.Context, req *pb.DeleteChannelRequest) (*pb.DeleteChannelResponse, error) {
+	if err := h.Channels.Delete(ctx, req.Id, callerFrom(ctx)); err != nil {
+		return nil, channelStatus(err)
+	}
+	return &pb.DeleteChannelResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) AddChannelMember(ctx context.Context, req *pb.ChannelMemberRequest) (*pb.ChannelMemberResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+	if err := h.Channels.AddMember(ctx, req.Id, callerFrom(ctx), req.MemberId); err != nil {
+		return nil, channelStatus(err)
+	}
+	return &pb.ChannelMemberResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) RemoveChannelMember(ctx context.Context, req *pb.ChannelMemberRequest) (*pb.ChannelMemberResponse, error) {
+	if req.MemberId == "" {
+		return nil, status.Error(codes.InvalidArgument, "member_id is required")
+	}
+	if err := h.Channels.RemoveMember(ctx, req.Id, callerFrom(ctx), req.MemberId); err != nil {
+		return nil, channelStatus(err)
+	}
+	return &pb.ChannelMemberResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) SetChannelImage(ctx context.Context, req *pb.SetChannelImageRequest) (*pb.SetChannelImageResponse, error) {
+	var image *domain.ChannelImage
+	if req.Image != nil {
+		image = &domain.ChannelImage{Bucket: req.Image.Bucket, ObjectKey: req.Image.ObjectKey}
+	}
+	if err := h.Channels.SetImage(ctx, req.Id, req.Kind, image); err != nil {
+		return nil, channelStatus(err)
+	}
+	return &pb.SetChannelImageResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) Subscribe(ctx context.Context, req *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := h.Subscriptions.Subscribe(ctx, req.Id, callerFrom(ctx)); err != nil {
+		return nil, channelStatus(err)
+	}
+	return &pb.SubscriptionResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) Unsubscribe(ctx context.Context, req *pb.SubscriptionRequest) (*pb.SubscriptionResponse, error) {
+	if err := h.Subscriptions.Unsubscribe(ctx, req.Id, callerFrom(ctx)); err != nil {
+		return nil, channelStatus(err)
+	}
+	return &pb.SubscriptionResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) ListSubscriptions(ctx context.Context, req *pb.ListSubscriptionsRequest) (*pb.ListSubscriptionsResponse, error) {
+	channels, err := h.Subscriptions.List(ctx, callerFrom(ctx))
+	if err != nil {
+		return nil, channelStatus(err)
+	}
+	resp := &pb.ListSubscriptionsResponse{}
+	for _, c := range channels {
+		resp.Channels = append(resp.Channels, toProtoChannel(c))
+	}
+	return resp, nil
+}
+
+func (h *MetadataHandler) GetSubscriptionFeed(ctx context.Context, req *pb.GetSubscriptionFeedRequest) (*pb.GetSubscriptionFeedResponse, error) {
+	videos, next, err := h.Subscriptions.Feed(ctx, callerFrom(ctx), req.PageToken, int(req.PageSize))
+	if err != nil {
+		return nil, channelStatus(err)
+	}
+	resp := &pb.GetSubscriptionFeedResponse{NextPageToken: next}
+	for _, v := range videos {
+		resp.Videos = append(resp.Videos, toProtoVideo(v))
+	}
+	return resp, nil
+}
+
+func channelStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrChannelNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrNotChannelOwner):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrInvalidChannel), errors.Is(err, domain.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
+
+func toProtoChannel(c *domain.Channel) *pb.Channel {
+	return &pb.Channel{
+		Id:          c.ID,
+		Handle:      c.Handle,
+		DisplayName: c.DisplayName,
+		Description: c.Description,
+		OwnerId:     c.OwnerID,
+		MemberIds:   c.MemberIDs,
+		Avatar:      toProtoChannelImage(c.Avatar),
+		Banner:      toProtoChannelImage(c.Banner),
+		CreatedAt:   c.CreatedAt.UTC().Format(time.RFC3339),
+
+		SubscriberCount: int64(c.SubscriberCount),
+	}
+}
+
+func toProtoChannelImage(image *domain.ChannelImage) *pb.ChannelImage {
+	if image == nil {
+		return nil
+	}
+	return &pb.ChannelImage{Bucket: image.Bucket, ObjectKey: image.ObjectKey}
+}
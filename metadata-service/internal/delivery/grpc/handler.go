@@ -2,51 +2,109 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/athandoan/youtube/metadata-service/internal/domain"
 	"github.com/athandoan/youtube/proto/common"
 	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type MetadataHandler struct {
 	pb.UnimplementedMetadataServiceServer
-	Usecase domain.VideoUsecase
+	Usecase       domain.VideoUsecase
+	Channels      domain.ChannelUsecase
+	Playlists     domain.PlaylistUsecase
+	Series        domain.SeriesUsecase
+	Subscriptions domain.SubscriptionUsecase
 }
 
-func NewMetadataHandler(u domain.VideoUsecase) *MetadataHandler {
-	return &MetadataHandler{Usecase: u}
+func NewMetadataHandler(u domain.VideoUsecase, channels domain.ChannelUsecase, playlists domain.PlaylistUsecase, series domain.SeriesUsecase, subscriptions domain.SubscriptionUsecase) *MetadataHandler {
+	return &MetadataHandler{Usecase: u, Channels: channels, Playlists: playlists, Series: series, Subscriptions: subscriptions}
 }
 
 func (h *MetadataHandler) CreateVideo(ctx context.Context, req *pb.CreateVideoRequest) (*pb.CreateVideoResponse, error) {
-	id, err := h.Usecase.Create(ctx, req.Title, req.Bucket, req.ObjectKey)
+	var publishAt time.Time
+	if req.PublishAt != "" {
+		t, err := time.Parse(time.RFC3339, req.PublishAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "publish_at must be an RFC 3339 timestamp")
+		}
+		publishAt = t
+	}
+	id, err := h.Usecase.Create(ctx, req.OwnerId, req.ChannelId, req.Title, req.Bucket, req.ObjectKey, req.AllowDownload, publishAt, req.Premiere)
 	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrPremiereNoSchedule):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrNotChannelMember):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, err
 	}
 	return &pb.CreateVideoResponse{Id: id}, nil
 }
 
+func (h *MetadataHandler) UpdateVideo(ctx context.Context, req *pb.UpdateVideoRequest) (*common.Video, error) {
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	update := &domain.VideoUpdate{}
+	for _, p := range req.UpdateMask.Paths {
+		switch p {
+		case "title":
+			update.Title = &req.Title
+		case "description":
+			update.Description = &req.Description
+		case "tags":
+			tags := req.Tags
+			if tags == nil {
+				tags = []string{}
+			}
+			update.Tags = &tags
+		case "category":
+			update.Category = &req.Category
+		case "visibility":
+			update.Visibility = &req.Visibility
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "cannot update %q", p)
+		}
+	}
+
+	v, err := h.Usecase.Update(ctx, req.Id, callerFrom(ctx), update)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrVideoNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrInvalidUpdate):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return toProtoVideo(v), nil
+}
+
 func (h *MetadataHandler) ListVideos(ctx context.Context, req *pb.ListVideosRequest) (*pb.ListVideosResponse, error) {
-	videos, err := h.Usecase.List(ctx, req.Query)
+	videos, err := h.Usecase.List(ctx, req.Query, domain.VideoFilter{Tag: req.Tag, Category: req.Category, ChannelID: req.ChannelId})
 	if err != nil {
 		return nil, err
 	}
 
 	var pbVideos []*common.Video
 	for _, v := range videos {
-		pbVideos = append(pbVideos, &common.Video{
-			Id:         v.ID,
-			Title:      v.Title,
-			Status:     v.Status,
-			CreatedAt:  v.CreatedAt.Format("2006-01-02 15:04:05"),
-			BucketName: v.BucketName,
-			ObjectKey:  v.ObjectKey,
-		})
+		pbVideos = append(pbVideos, toProtoVideo(v))
 	}
 	return &pb.ListVideosResponse{Videos: pbVideos}, nil
 }
 
 func (h *MetadataHandler) UpdateVideoStatus(ctx context.Context, req *pb.UpdateVideoStatusRequest) (*pb.UpdateVideoStatusResponse, error) {
-	err := h.Usecase.UpdateStatus(ctx, req.Id, req.Status)
+	err := h.Usecase.UpdateStatus(ctx, req.Id, req.Status, req.DurationSeconds)
 	if err != nil {
 		return nil, err
 	}
@@ -54,16 +112,292 @@ func (h *MetadataHandler) UpdateVideoStatus(ctx context.Context, req *pb.UpdateV
 }
 
 func (h *MetadataHandler) GetVideo(ctx context.Context, req *pb.GetVideoRequest) (*common.Video, error) {
-	v, err := h.Usecase.Get(ctx, req.Id)
+	caller := callerFrom(ctx)
+	var v *domain.Video
+	var err error
+	if req.Unrestricted && caller.Service != "" {
+		v, err = h.Usecase.Get(ctx, req.Id)
+	} else {
+		viewerID := caller.UserID
+		if req.Viewer != nil {
+			viewerID = req.Viewer.UserId
+		}
+		v, err = h.Usecase.GetForViewer(ctx, req.Id, viewerID)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrVideoNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrPrivateVideo):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, err
+	}
+	return toProtoVideo(v), nil
+}
+
+func (h *MetadataHandler) CreateStreamKey(ctx context.Context, req *pb.CreateStreamKeyRequest) (*pb.CreateStreamKeyResponse, error) {
+	key, err := h.Usecase.CreateStreamKey(ctx, callerFrom(ctx).UserID, req.ChannelId, req.Title)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrChannelNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrNotChannelMember):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.CreateStreamKeyResponse{StreamKey: key.Key}, nil
+}
+
+func (h *MetadataHandler) StartLiveStream(ctx context.Context, req *pb.StartLiveStreamRequest) (*common.Video, error) {
+	v, err := h.Usecase.StartLiveStream(ctx, req.StreamKey)
+	if err != nil {
+		// A key whose owner left its channel is as good as revoked
+		if errors.Is(err, domain.ErrStreamKeyNotFound) || errors.Is(err, domain.ErrNotChannelMember) {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+		return nil, err
+	}
+	return toProtoVideo(v), nil
+}
+
+func (h *MetadataHandler) UpdateLiveStatus(ctx context.Context, req *pb.UpdateLiveStatusRequest) (*pb.UpdateLiveStatusResponse, error) {
+	if err := h.Usecase.UpdateLiveStatus(ctx, req.Id, req.LiveStatus); err != nil {
+		if errors.Is(err, domain.ErrInvalidLiveStatus) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.UpdateLiveStatusResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) CompleteRecording(ctx context.Context, req *pb.CompleteRecordingRequest) (*pb.CompleteRecordingResponse, error) {
+	if err := h.Usecase.CompleteRecording(ctx, req.Id, req.DurationSeconds, req.Bucket, req.ObjectKey); err != nil {
+		// Purged before the recording was in; the live service drops it
+		if errors.Is(err, domain.ErrVideoNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.CompleteRecordingResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) DeleteVideo(ctx context.Context, req *pb.DeleteVideoRequest) (*pb.DeleteVideoResponse, error) {
+	if err := h.Usecase.Delete(ctx, req.Id, callerFrom(ctx)); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrVideoNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		case errors.Is(err, domain.ErrVideoLive):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.DeleteVideoResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) RestoreVideo(ctx context.Context, req *pb.RestoreVideoRequest) (*pb.RestoreVideoResponse, error) {
+	if err := h.Usecase.Restore(ctx, req.Id, callerFrom(ctx)); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrVideoNotFound):
+			return nil, status.Error(codes.NotFound, "video not found in the trash")
+		case errors.Is(err, domain.ErrForbidden):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.RestoreVideoResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) UnpublishVideo(ctx context.Context, req *pb.UnpublishVideoRequest) (*pb.UnpublishVideoResponse, error) {
+	if err := h.Usecase.Unpublish(ctx, req.Id, callerFrom(ctx)); err != nil {
+		return nil, accessStatus(err)
+	}
+	return &pb.UnpublishVideoResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) ListTrash(ctx context.Context, req *pb.ListTrashRequest) (*pb.ListTrashResponse, error) {
+	videos, err := h.Usecase.ListTrash(ctx, req.UserId)
 	if err != nil {
 		return nil, err
 	}
+
+	var pbVideos []*common.Video
+	for _, v := range videos {
+		pbVideos = append(pbVideos, toProtoVideo(v))
+	}
+	return &pb.ListTrashResponse{Videos: pbVideos}, nil
+}
+
+func (h *MetadataHandler) GrantVideoAccess(ctx context.Context, req *pb.VideoAccessRequest) (*pb.VideoAccessResponse, error) {
+	if req.GranteeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "grantee_id is required")
+	}
+	if err := h.Usecase.GrantAccess(ctx, req.Id, callerFrom(ctx), req.GranteeId); err != nil {
+		return nil, accessStatus(err)
+	}
+	return &pb.VideoAccessResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) RevokeVideoAccess(ctx context.Context, req *pb.VideoAccessRequest) (*pb.VideoAccessResponse, error) {
+	if req.GranteeId == "" {
+		return nil, status.Error(codes.InvalidArgument, "grantee_id is required")
+	}
+	if err := h.Usecase.RevokeAccess(ctx, req.Id, callerFrom(ctx), req.GranteeId); err != nil {
+		return nil, accessStatus(err)
+	}
+	return &pb.VideoAccessResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) ListVideoAccess(ctx context.Context, req *pb.ListVideoAccessRequest) (*pb.ListVideoAccessResponse, error) {
+	users, err := h.Usecase.ListAccess(ctx, req.Id, callerFrom(ctx))
+	if err != nil {
+		return nil, accessStatus(err)
+	}
+	return &pb.ListVideoAccessResponse{UserIds: users}, nil
+}
+
+func (h *MetadataHandler) CreateShareLink(ctx context.Context, req *pb.CreateShareLinkRequest) (*pb.ShareLink, error) {
+	var expiresAt time.Time
+	if req.ExpiresAt != "" {
+		t, err := time.Parse(time.RFC3339, req.ExpiresAt)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "expires_at must be an RFC 3339 timestamp")
+		}
+		expiresAt = t
+	}
+	link, err := h.Usecase.CreateShareLink(ctx, req.Id, callerFrom(ctx), expiresAt, int(req.MaxViews), req.Password)
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidShareLink) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, accessStatus(err)
+	}
+	return toProtoShareLink(link), nil
+}
+
+func (h *MetadataHandler) ListShareLinks(ctx context.Context, req *pb.ListShareLinksRequest) (*pb.ListShareLinksResponse, error) {
+	links, err := h.Usecase.ListShareLinks(ctx, req.Id, callerFrom(ctx))
+	if err != nil {
+		return nil, accessStatus(err)
+	}
+	resp := &pb.ListShareLinksResponse{}
+	for _, l := range links {
+		resp.Links = append(resp.Links, toProtoShareLink(l))
+	}
+	return resp, nil
+}
+
+func (h *MetadataHandler) RevokeShareLink(ctx context.Context, req *pb.RevokeShareLinkRequest) (*pb.RevokeShareLinkResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+	if err := h.Usecase.RevokeShareLink(ctx, req.Id, callerFrom(ctx), req.Token); err != nil {
+		if errors.Is(err, domain.ErrShareLinkNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, accessStatus(err)
+	}
+	return &pb.RevokeShareLinkResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) ResolveShareLink(ctx context.Context, req *pb.ResolveShareLinkRequest) (*common.Video, error) {
+	v, err := h.Usecase.ResolveShareLink(ctx, req.Token, req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrShareLinkNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, domain.ErrSharePassword):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, err
+	}
+	return toProtoVideo(v), nil
+}
+
+func accessStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrVideoNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return err
+}
+
+func (h *MetadataHandler) ListStorageCleanups(ctx context.Context, req *pb.ListStorageCleanupsRequest) (*pb.ListStorageCleanupsResponse, error) {
+	cleanups, err := h.Usecase.ListStorageCleanups(ctx, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	resp := &pb.ListStorageCleanupsResponse{}
+	for _, c := range cleanups {
+		resp.Cleanups = append(resp.Cleanups, &pb.StorageCleanup{
+			VideoId:  c.VideoID,
+			Bucket:   c.Bucket,
+			Prefix:   c.Prefix,
+			Attempts: int32(c.Attempts),
+		})
+	}
+	return resp, nil
+}
+
+func (h *MetadataHandler) CompleteStorageCleanup(ctx context.Context, req *pb.CompleteStorageCleanupRequest) (*pb.CompleteStorageCleanupResponse, error) {
+	if err := h.Usecase.CompleteStorageCleanup(ctx, req.VideoId, req.Error); err != nil {
+		return nil, err
+	}
+	return &pb.CompleteStorageCleanupResponse{Status: "success"}, nil
+}
+
+func toProtoShareLink(l *domain.ShareLink) *pb.ShareLink {
+	var revokedAt string
+	if !l.RevokedAt.IsZero() {
+		revokedAt = l.RevokedAt.UTC().Format(time.RFC3339)
+	}
+	return &pb.ShareLink{
+		Token:       l.Token,
+		VideoId:     l.VideoID,
+		ExpiresAt:   l.ExpiresAt.UTC().Format(time.RFC3339),
+		MaxViews:    int32(l.MaxViews),
+		Views:       int32(l.Views),
+		HasPassword: l.PasswordHash != "",
+		RevokedAt:   revokedAt,
+		CreatedAt:   l.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toProtoVideo(v *domain.Video) *common.Video {
+	var publishAt string
+	if !v.PublishAt.IsZero() {
+		publishAt = v.PublishAt.UTC().Format(time.RFC3339)
+	}
+	var deletedAt string
+	if v.Trashed() {
+		deletedAt = v.DeletedAt.UTC().Format(time.RFC3339)
+	}
 	return &common.Video{
-		Id:         v.ID,
-		Title:      v.Title,
-		Status:     v.Status,
-		CreatedAt:  v.CreatedAt.Format("2006-01-02 15:04:05"),
-		BucketName: v.BucketName,
-		ObjectKey:  v.ObjectKey,
-	}, nil
+		Id:              v.ID,
+		Title:           v.Title,
+		Status:          v.Status,
+		CreatedAt:       v.CreatedAt.Format("2006-01-02 15:04:05"),
+		BucketName:      v.BucketName,
+		ObjectKey:       v.ObjectKey,
+		OwnerId:         v.OwnerID,
+		AllowDownload:   v.AllowDownload,
+		LiveStatus:      v.LiveStatus,
+		DurationSeconds: v.DurationSeconds,
+		PublishAt:       publishAt,
+		Premiere:        v.Premiere,
+		DeletedAt:       deletedAt,
+		Description:     v.Description,
+		Tags:            v.Tags,
+		Category:        v.Category,
+		Visibility:      v.Visibility,
+		ChannelId:       v.ChannelID,
+		SeriesId:        v.SeriesID,
+		Season:          int32(v.Season),
+		Episode:         int32(v.Episode),
+	}
 }
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/athandoan/youtube/metadata-service/internal/mocks"
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetadataHandler_GetVideo(t *testing.T) {
+	private := &domain.Video{ID: "v1", OwnerID: "owner-1", Visibility: domain.VisibilityPrivate}
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		req       *pb.GetVideoRequest
+		setupMock func(m *mocks.MockVideoUsecase)
+		wantCode  codes.Code
+	}{
+		{
+			name:   "success - service reads for itself",
+			caller: &domain.Caller{Service: "upload-service"},
+			req:    &pb.GetVideoRequest{Id: "v1", Unrestricted: true},
+			setupMock: func(m *mocks.MockVideoUsecase) {
+				m.EXPECT().Get(gomock.Any(), "v1").Return(private, nil)
+			},
+		},
+		{
+			name:   "success - service reads for a viewer",
+			caller: &domain.Caller{Service: "streaming-service"},
+			req:    &pb.GetVideoRequest{Id: "v1", Viewer: &pb.Viewer{UserId: "owner-1"}},
+			setupMock: func(m *mocks.MockVideoUsecase) {
+				m.EXPECT().GetForViewer(gomock.Any(), "v1", "owner-1").Return(private, nil)
+			},
+		},
+		{
+			name:   "success - user without a viewer reads as themselves",
+			caller: &domain.Caller{UserID: "owner-1"},
+			req:    &pb.GetVideoRequest{Id: "v1"},
+			setupMock: func(m *mocks.MockVideoUsecase) {
+				m.EXPECT().GetForViewer(gomock.Any(), "v1", "owner-1").Return(private, nil)
+			},
+		},
+		{
+			name:   "error - service without a viewer reads as anonymous",
+			caller: &domain.Caller{Service: "chat-service"},
+			req:    &pb.GetVideoRequest{Id: "v1"},
+			setupMock: func(m *mocks.MockVideoUsecase) {
+				m.EXPECT().GetForViewer(gomock.Any(), "v1", "").Return(nil, domain.ErrPrivateVideo)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "error - anonymous user asking for an unrestricted read",
+			caller: &domain.Caller{},
+			req:    &pb.GetVideoRequest{Id: "v1", Unrestricted: true},
+			setupMock: func(m *mocks.MockVideoUsecase) {
+				m.EXPECT().GetForViewer(gomock.Any(), "v1", "").Return(nil, domain.ErrPrivateVideo)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+		{
+			name:   "error - signed-in user asking for an unrestricted read",
+			caller: &domain.Caller{UserID: "user-2"},
+			req:    &pb.GetVideoRequest{Id: "v1", Unrestricted: true},
+			setupMock: func(m *mocks.MockVideoUsecase) {
+				m.EXPECT().GetForViewer(gomock.Any(), "v1", "user-2").Return(nil, domain.ErrPrivateVideo)
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			uc := mocks.NewMockVideoUsecase(ctrl)
+			tt.setupMock(uc)
+
+			h := NewMetadataHandler(uc, nil, nil, nil, nil)
+			ctx := context.WithValue(context.Background(), callerKey{}, tt.caller)
+			_, err := h.GetVideo(ctx, tt.req)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("GetVideo() code = %v, want %v (%v)", got, tt.wantCode, err)
+			}
+		})
+	}
+}
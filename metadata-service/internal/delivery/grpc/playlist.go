@@ -0,0 +1,159 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *MetadataHandler) CreatePlaylist(ctx context.Context, req *pb.CreatePlaylistRequest) (*pb.Playlist, error) {
+	playlist, err := h.Playlists.Create(ctx, callerFrom(ctx), req.Title, req.Description, req.Visibility)
+	if err != nil {
+		return nil, playlistStatus(err)
+	}
+	return toProtoPlaylist(playlist), nil
+}
+
+func (h *MetadataHandler) GetPlaylist(ctx context.Context, req *pb.GetPlaylistRequest) (*pb.Playlist, error) {
+	playlist, err := h.Playlists.Get(ctx, req.Id, callerFrom(ctx))
+	if err != nil {
+		return nil, playlistStatus(err)
+	}
+	return toProtoPlaylist(playlist), nil
+}
+
+func (h *MetadataHandler) ListPlaylists(ctx context.Context, req *pb.ListPlaylistsRequest) (*pb.ListPlaylistsResponse, error) {
+	playlists, err := h.Playlists.List(ctx, req.OwnerId, callerFrom(ctx))
+	if err != nil {
+		return nil, playlistStatus(err)
+	}
+	resp := &pb.ListPlaylistsResponse{}
+	for _, p := range playlists {
+		resp.Playlists = append(resp.Playlists, toProtoPlaylist(p))
+	}
+	return resp, nil
+}
+
+func (h *MetadataHandler) UpdatePlaylist(ctx context.Context, req *pb.UpdatePlaylistRequest) (*pb.Playlist, error) {
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	update := &domain.PlaylistUpdate{}
+	for _, p := range req.UpdateMask.Paths {
+		switch p {
+		case "title":
+			update.Title = &req.Title
+		case "description":
+			update.Description = &req.Description
+		case "visibility":
+			update.Visibility = &req.Visibility
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "cannot update %q", p)
+		}
+	}
+
+	playlist, err := h.Playlists.Update(ctx, req.Id, callerFrom(ctx), update)
+	if err != nil {
+		return nil, playlistStatus(err)
+	}
+	return toProtoPlaylist(playlist), nil
+}
+
+func (h *MetadataHandler) DeletePlaylist(ctx context.Context, req *pb.DeletePlaylistRequest) (*pb.DeletePlaylistResponse, error) {
+	if err := h.Playlists.Delete(ctx, req.Id, callerFrom(ctx)); err != nil {
+		return nil, playlistStatus(err)
+	}
+	return &pb.DeletePlaylistResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) AddPlaylistItem(ctx context.Context, req *pb.AddPlaylistItemRequest) (*pb.PlaylistItem, error) {
+	item, err := h.Playlists.AddItem(ctx, req.Id, callerFrom(ctx), req.VideoId)
+	if err != nil {
+		return nil, playlistStatus(err)
+	}
+	return toProtoPlaylistItem(item), nil
+}
+
+func (h *MetadataHandler) RemovePlaylistItem(ctx context.Context, req *pb.RemovePlaylistItemRequest) (*pb.RemovePlaylistItemResponse, error) {
+	if err := h.Playlists.RemoveItem(ctx, req.Id, callerFrom(ctx), req.ItemId); err != nil {
+		return nil, playlistStatus(err)
+	}
+	return &pb.RemovePlaylistItemResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) MovePlaylistItem(ctx context.Context, req *pb.MovePlaylistItemRequest) (*pb.PlaylistItem, error) {
+	item, err := h.Playlists.MoveItem(ctx, req.Id, callerFrom(ctx), req.ItemId, req.AfterItemId)
+	if err != nil {
+		return nil, playlistStatus(err)
+	}
+	return toProtoPlaylistItem(item), nil
+}
+
+func (h *MetadataHandler) AddPlaylistCollaborator(ctx context.Context, req *pb.PlaylistCollaboratorRequest) (*pb.PlaylistCollaboratorResponse, error) {
+	if req.CollaboratorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "collaborator_id is required")
+	}
+	if err := h.Playlists.AddCollaborator(ctx, req.Id, callerFrom(ctx), req.CollaboratorId); err != nil {
+		return nil, playlistStatus(err)
+	}
+	return &pb.PlaylistCollaboratorResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) RemovePlaylistCollaborator(ctx context.Context, req *pb.PlaylistCollaboratorRequest) (*pb.PlaylistCollaboratorResponse, error) {
+	if req.CollaboratorId == "" {
+		return nil, status.Error(codes.InvalidArgument, "collaborator_id is required")
+	}
+	if err := h.Playlists.RemoveCollaborator(ctx, req.Id, callerFrom(ctx), req.CollaboratorId); err != nil {
+		return nil, playlistStatus(err)
+	}
+	return &pb.PlaylistCollaboratorResponse{Status: "success"}, nil
+}
+
+func playlistStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrPlaylistNotFound), errors.Is(err, domain.ErrPlaylistItemNotFound),
+		errors.Is(err, domain.ErrVideoNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrNotPlaylistOwner), errors.Is(err, domain.ErrNotPlaylistEditor),
+		errors.Is(err, domain.ErrPrivateVideo):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrInvalidPlaylist):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrPositionTaken):
+		// Only after retries; the playlist is being edited heavily
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return err
+}
+
+func toProtoPlaylist(p *domain.Playlist) *pb.Playlist {
+	resp := &pb.Playlist{
+		Id:              p.ID,
+		OwnerId:         p.OwnerID,
+		Title:           p.Title,
+		Description:     p.Description,
+		Visibility:      p.Visibility,
+		CollaboratorIds: p.CollaboratorIDs,
+		ItemCount:       int32(p.ItemCount),
+		CreatedAt:       p.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	for _, item := range p.Items {
+		resp.Items = append(resp.Items, toProtoPlaylistItem(item))
+	}
+	return resp
+}
+
+func toProtoPlaylistItem(item *domain.PlaylistItem) *pb.PlaylistItem {
+	return &pb.PlaylistItem{
+		Id:       item.ID,
+		VideoId:  item.VideoID,
+		Position: item.Position,
+		AddedBy:  item.AddedBy,
+		AddedAt:  item.AddedAt.UTC().Format(time.RFC3339),
+	}
+}
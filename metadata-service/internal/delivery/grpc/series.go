@@ -0,0 +1,137 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/athandoan/youtube/proto/common"
+	pb "github.com/athandoan/youtube/proto/metadata"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *MetadataHandler) CreateSeries(ctx context.Context, req *pb.CreateSeriesRequest) (*pb.Series, error) {
+	series, err := h.Series.Create(ctx, callerFrom(ctx), req.Title, req.Description)
+	if err != nil {
+		return nil, seriesStatus(err)
+	}
+	return toProtoSeries(series), nil
+}
+
+func (h *MetadataHandler) GetSeries(ctx context.Context, req *pb.GetSeriesRequest) (*pb.Series, error) {
+	series, err := h.Series.Get(ctx, req.Id, callerFrom(ctx))
+	if err != nil {
+		return nil, seriesStatus(err)
+	}
+	return toProtoSeries(series), nil
+}
+
+func (h *MetadataHandler) ListSeries(ctx context.Context, req *pb.ListSeriesRequest) (*pb.ListSeriesResponse, error) {
+	series, err := h.Series.List(ctx, req.OwnerId)
+	if err != nil {
+		return nil, seriesStatus(err)
+	}
+	resp := &pb.ListSeriesResponse{}
+	for _, s := range series {
+		resp.Series = append(resp.Series, toProtoSeries(s))
+	}
+	return resp, nil
+}
+
+func (h *MetadataHandler) UpdateSeries(ctx context.Context, req *pb.UpdateSeriesRequest) (*pb.Series, error) {
+	if len(req.GetUpdateMask().GetPaths()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "update_mask is required")
+	}
+	update := &domain.SeriesUpdate{}
+	for _, p := range req.UpdateMask.Paths {
+		switch p {
+		case "title":
+			update.Title = &req.Title
+		case "description":
+			update.Description = &req.Description
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "cannot update %q", p)
+		}
+	}
+
+	series, err := h.Series.Update(ctx, req.Id, callerFrom(ctx), update)
+	if err != nil {
+		return nil, seriesStatus(err)
+	}
+	return toProtoSeries(series), nil
+}
+
+func (h *MetadataHandler) DeleteSeries(ctx context.Context, req *pb.DeleteSeriesRequest) (*pb.DeleteSeriesResponse, error) {
+	if err := h.Series.Delete(ctx, req.Id, callerFrom(ctx)); err != nil {
+		return nil, seriesStatus(err)
+	}
+	return &pb.DeleteSeriesResponse{Status: "success"}, nil
+}
+
+func (h *MetadataHandler) SetEpisode(ctx context.Context, req *pb.SetEpisodeRequest) (*pb.Series, error) {
+	series, err := h.Series.SetEpisode(ctx, req.Id, callerFrom(ctx), req.VideoId, int(req.Season), int(req.Episode))
+	if err != nil {
+		return nil, seriesStatus(err)
+	}
+	return toProtoSeries(series), nil
+}
+
+func (h *MetadataHandler) RemoveEpisode(ctx context.Context, req *pb.RemoveEpisodeRequest) (*pb.Series, error) {
+	series, err := h.Series.RemoveEpisode(ctx, req.Id, callerFrom(ctx), req.VideoId)
+	if err != nil {
+		return nil, seriesStatus(err)
+	}
+	return toProtoSeries(series), nil
+}
+
+func (h *MetadataHandler) GetNextEpisode(ctx context.Context, req *pb.GetNextEpisodeRequest) (*common.Video, error) {
+	viewerID := callerFrom(ctx).UserID
+	if req.Viewer != nil {
+		viewerID = req.Viewer.UserId
+	}
+	v, err := h.Series.NextEpisode(ctx, req.Id, req.AfterVideoId, viewerID)
+	if err != nil {
+		return nil, seriesStatus(err)
+	}
+	return toProtoVideo(v), nil
+}
+
+func (h *MetadataHandler) SetEpisodeCompleted(ctx context.Context, req *pb.SetEpisodeCompletedRequest) (*pb.SetEpisodeCompletedResponse, error) {
+	if err := h.Series.SetCompleted(ctx, req.Id, callerFrom(ctx), req.VideoId, req.Completed); err != nil {
+		return nil, seriesStatus(err)
+	}
+	return &pb.SetEpisodeCompletedResponse{Status: "success"}, nil
+}
+
+func seriesStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrSeriesNotFound), errors.Is(err, domain.ErrVideoNotFound),
+		errors.Is(err, domain.ErrNotEpisode), errors.Is(err, domain.ErrNoNextEpisode):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrNotSeriesOwner), errors.Is(err, domain.ErrForbidden):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrInvalidSeries):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrEpisodeTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	return err
+}
+
+func toProtoSeries(s *domain.Series) *pb.Series {
+	resp := &pb.Series{
+		Id:                s.ID,
+		OwnerId:           s.OwnerID,
+		Title:             s.Title,
+		Description:       s.Description,
+		EpisodeCount:      int32(s.EpisodeCount),
+		CreatedAt:         s.CreatedAt.UTC().Format(time.RFC3339),
+		CompletedVideoIds: s.CompletedVideoIDs,
+	}
+	for _, v := range s.Episodes {
+		resp.Episodes = append(resp.Episodes, toProtoVideo(v))
+	}
+	return resp
+}
@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+)
+
+// Publisher lists scheduled videos once their publish_at has passed.
+type Publisher struct {
+	usecase  domain.VideoUsecase
+	interval time.Duration
+}
+
+func NewPublisher(u domain.VideoUsecase, interval time.Duration) *Publisher {
+	return &Publisher{usecase: u, interval: interval}
+}
+
+// Run checks for due videos every interval until ctx is done. Publication can lag
+// publish_at by up to one interval.
+func (p *Publisher) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		n, err := p.usecase.PublishDue(ctx, time.Now())
+		if err != nil {
+			log.Printf("failed to publish scheduled videos: %v", err)
+		} else if n > 0 {
+			log.Printf("published %d scheduled videos", n)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPublisher_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	uc := mocks.NewMockVideoUsecase(ctrl)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Publishes on start, keeps going after a failed run, and stops once ctx is done
+	start := time.Now()
+	calls := 0
+	uc.EXPECT().PublishDue(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, now time.Time) (int, error) {
+		calls++
+		if now.Before(start) {
+			t.Errorf("PublishDue() now = %v, before the run started", now)
+		}
+		switch calls {
+		case 1:
+			return 0, errors.New("database is locked")
+		case 2:
+			return 2, nil
+		}
+		cancel()
+		return 0, nil
+	}).Times(3)
+
+	done := make(chan struct{})
+	go func() {
+		NewPublisher(uc, time.Millisecond).Run(ctx)
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() did not return after ctx was cancelled")
+	}
+}
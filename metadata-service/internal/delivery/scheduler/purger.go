@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+)
+
+// Purger permanently deletes videos that have been in the trash longer than the retention.
+type Purger struct {
+	usecase   domain.VideoUsecase
+	interval  time.Duration
+	retention time.Duration
+}
+
+func NewPurger(u domain.VideoUsecase, interval, retention time.Duration) *Purger {
+	return &Purger{usecase: u, interval: interval, retention: retention}
+}
+
+// Run purges expired trash every interval until ctx is done.
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		n, err := p.usecase.PurgeTrash(ctx, time.Now().Add(-p.retention))
+		if err != nil {
+			log.Printf("failed to purge trashed videos: %v", err)
+		} else if n > 0 {
+			log.Printf("purged %d trashed videos", n)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
@@ -4,29 +4,585 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"time"
 )
 
+// Live status of videos created by live ingest; uploads have none.
+const (
+	LiveStatusLive  = "live"
+	LiveStatusEnded = "ended"
+)
+
+// Visibility of a video. Only public videos are listed; unlisted ones are reachable by ID and
+// private ones only by their owner and users granted access.
+const (
+	VisibilityPublic   = "public"
+	VisibilityUnlisted = "unlisted"
+	VisibilityPrivate  = "private"
+)
+
+var (
+	ErrStreamKeyNotFound    = errors.New("stream key not found")
+	ErrInvalidLiveStatus    = errors.New("invalid live status")
+	ErrPremiereNoSchedule   = errors.New("premiere requires publish_at")
+	ErrVideoNotFound        = errors.New("video not found")
+	ErrForbidden            = errors.New("not allowed on this video")
+	ErrVideoLive            = errors.New("end the live stream first")
+	ErrInvalidUpdate        = errors.New("invalid update")
+	ErrPrivateVideo         = errors.New("this video is private")
+	ErrShareLinkNotFound    = errors.New("share link not found or no longer valid")
+	ErrSharePassword        = errors.New("wrong share link password")
+	ErrInvalidShareLink     = errors.New("invalid share link")
+	ErrChannelNotFound      = errors.New("channel not found")
+	ErrHandleTaken          = errors.New("handle is already taken")
+	ErrInvalidChannel       = errors.New("invalid channel")
+	ErrNotChannelMember     = errors.New("only the channel's owner and members can post to it")
+	ErrNotChannelOwner      = errors.New("only the channel's owner or an admin can do that")
+	ErrPlaylistNotFound     = errors.New("playlist not found")
+	ErrPlaylistItemNotFound = errors.New("playlist item not found")
+	ErrInvalidPlaylist      = errors.New("invalid playlist")
+	ErrNotPlaylistOwner     = errors.New("only the playlist's owner or an admin can do that")
+	ErrNotPlaylistEditor    = errors.New("only the playlist's owner and collaborators can edit its videos")
+	ErrSeriesNotFound       = errors.New("series not found")
+	ErrInvalidSeries        = errors.New("invalid series")
+	ErrNotSeriesOwner       = errors.New("only the series' owner or an admin can do that")
+	ErrEpisodeTaken         = errors.New("another video of the series has that season and episode")
+	ErrNotEpisode           = errors.New("the video is not an episode of this series")
+	ErrNoNextEpisode        = errors.New("no next episode")
+	ErrInvalidPageToken     = errors.New("invalid page token")
+	// ErrPositionTaken is returned when another item took a playlist position first.
+	ErrPositionTaken = errors.New("playlist position is taken")
+)
+
+// Roles users can hold. The user service assigns them and the gateway passes them along
+// with every call it makes for a user.
+const (
+	RoleAdmin     = "admin"
+	RoleModerator = "moderator"
+)
+
+// Permission is something a caller may do to a video, channel, playlist or series. Owners
+// may do everything to their own; roles grant permissions on everyone's.
+type Permission string
+
+const (
+	PermEditVideo      Permission = "video:edit"      // metadata, access list and share links
+	PermDeleteVideo    Permission = "video:delete"    // trash and restore
+	PermUnpublishVideo Permission = "video:unpublish" // make private
+	PermEditChannel    Permission = "channel:edit"    // details, members and deletion
+	PermEditPlaylist   Permission = "playlist:edit"   // everything, including private ones
+	PermEditSeries     Permission = "series:edit"     // details, episodes and deletion
+)
+
+// RolePermissions lists what each role may do to what its holder doesn't own.
+var RolePermissions = map[string][]Permission{
+	RoleAdmin:     {PermEditVideo, PermDeleteVideo, PermUnpublishVideo, PermEditChannel, PermEditPlaylist, PermEditSeries},
+	RoleModerator: {PermUnpublishVideo},
+}
+
+// Caller is who an RPC is made by: a signed-in user, calling through the gateway, or
+// another service calling for itself. Anonymous callers have neither.
+type Caller struct {
+	UserID  string
+	Roles   []string
+	Service string
+}
+
+// Can reports whether the caller may do p to something owned by ownerID, which is empty for
+// videos without an owner. Those are left to roles.
+func (c *Caller) Can(p Permission, ownerID string) bool {
+	if c.UserID != "" && c.UserID == ownerID {
+		return true
+	}
+	for _, role := range c.Roles {
+		if slices.Contains(RolePermissions[role], p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Limits on the metadata owners can edit. Tags and categories are stored normalized:
+// trimmed, lowercase and with runs of whitespace collapsed to one space.
+const (
+	MaxTitleLength       = 100
+	MaxDescriptionLength = 5000
+	MaxTags              = 30
+	MaxTagLength         = 30
+	MaxCategoryLength    = 50
+)
+
+// Limits on channel details. Handles are stored lowercase and may only contain letters,
+// digits, '_', '-' and '.'.
+const (
+	MinHandleLength             = 3
+	MaxHandleLength             = 30
+	MaxDisplayNameLength        = 50
+	MaxChannelDescriptionLength = 1000
+)
+
+// Limits on playlists. Titles and descriptions share the videos' limits.
+const MaxPlaylistItems = 5000
+
+// Limits on series. Titles and descriptions share the videos' limits; seasons and episodes
+// are numbered from 1.
+const MaxEpisodeNumber = 9999
+
+// Feeds are paged; pages hold DefaultFeedPageSize videos unless asked for up to
+// MaxFeedPageSize.
+const (
+	DefaultFeedPageSize = 20
+	MaxFeedPageSize     = 100
+)
+
+// Kinds of channel images.
+const (
+	ChannelImageAvatar = "avatar"
+	ChannelImageBanner = "banner"
+)
+
+// Share links expire DefaultShareLinkTTL after creation unless given an expiry, which may be
+// at most MaxShareLinkTTL away.
+const (
+	DefaultShareLinkTTL = 7 * 24 * time.Hour
+	MaxShareLinkTTL     = 365 * 24 * time.Hour
+)
+
+// EventVideoPublished is emitted when a video becomes publicly listed at its publish_at.
+const EventVideoPublished = "video.published"
+
 type Video struct {
+	ID            string
+	Title         string
+	Description   string
+	Tags          []string
+	Category      string
+	Visibility    string
+	BucketName    string
+	ObjectKey     string
+	Status        string
+	OwnerID       string
+	ChannelID     string // empty for videos posted outside a channel
+	SeriesID      string // empty unless the video is an episode of a series
+	Season        int    // the episode's numbers within its series
+	Episode       int
+	AllowDownload bool
+	LiveStatus    string
+	// DurationSeconds is known for processed live recordings
+	DurationSeconds float64
+	// PublishAt schedules publication; zero publishes right away. Until then the video is unlisted.
+	PublishAt time.Time
+	// Premiere plays the video as a synchronized pseudo-live stream starting at PublishAt
+	Premiere  bool
+	CreatedAt time.Time
+	// DeletedAt is set while the video is in the trash; zero otherwise
+	DeletedAt time.Time
+}
+
+// Trashed reports whether the video was deleted and awaits restore or purge.
+func (v *Video) Trashed() bool {
+	return !v.DeletedAt.IsZero()
+}
+
+// Playable reports whether the video is processed and, unless scheduled for later, published
+// at now.
+func (v *Video) Playable(now time.Time) bool {
+	return v.Status == "ready" && (v.PublishAt.IsZero() || !now.Before(v.PublishAt))
+}
+
+// VideoUpdate holds the fields to change; nil fields are left as they are.
+type VideoUpdate struct {
+	Title       *string
+	Description *string
+	Tags        *[]string
+	Category    *string
+	Visibility  *string
+}
+
+// VideoFilter narrows List to videos with a tag, in a category and/or posted to a channel;
+// empty fields match all.
+type VideoFilter struct {
+	Tag       string
+	Category  string
+	ChannelID string
+}
+
+type Event struct {
+	Type       string    `json:"type"`
+	VideoID    string    `json:"video_id"`
+	OwnerID    string    `json:"owner_id"`
+	Title      string    `json:"title"`
+	Premiere   bool      `json:"premiere"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event *Event) error
+}
+
+// StreamKey lets its owner publish live streams, to a channel if set; every publish creates
+// a new video.
+type StreamKey struct {
+	Key       string
+	OwnerID   string
+	ChannelID string
+	Title     string
+	CreatedAt time.Time
+}
+
+// Channel groups videos under a unique handle. Its owner manages it; its members may post
+// videos to it.
+type Channel struct {
 	ID          string
-	Title       string
+	Handle      string
+	DisplayName string
 	Description string
-	BucketName  string
-	ObjectKey   string
-	Status      string
-	CreatedAt   time.Time
+	OwnerID     string
+	MemberIDs   []string
+	// Avatar and Banner are nil until uploaded
+	Avatar    *ChannelImage
+	Banner    *ChannelImage
+	CreatedAt time.Time
+	// SubscriberCount is kept up to date as viewers subscribe and unsubscribe
+	SubscriberCount int
+}
+
+// CanPost reports whether userID may post videos to the channel.
+func (c *Channel) CanPost(userID string) bool {
+	return userID != "" && (userID == c.OwnerID || slices.Contains(c.MemberIDs, userID))
+}
+
+// ChannelImage is an avatar or banner in object storage.
+type ChannelImage struct {
+	Bucket    string
+	ObjectKey string
+}
+
+// FeedCursor marks where a feed page ended: the next page starts with the videos published
+// before PublishedAt, or in the same second with an ID below VideoID.
+type FeedCursor struct {
+	PublishedAt time.Time
+	VideoID     string
+}
+
+// ChannelUpdate holds the channel details to change; nil fields are left as they are.
+type ChannelUpdate struct {
+	DisplayName *string
+	Description *string
+}
+
+// Playlist is an ordered list of videos. Its owner manages it; collaborators may add, remove
+// and reorder its videos. Visibility works as for videos.
+type Playlist struct {
+	ID              string
+	OwnerID         string
+	Title           string
+	Description     string
+	Visibility      string
+	CollaboratorIDs []string
+	// Items are in playlist order; they are only loaded by GetPlaylist
+	Items     []*PlaylistItem
+	ItemCount int
+	CreatedAt time.Time
+}
+
+// CanView reports whether caller may see the playlist.
+func (p *Playlist) CanView(caller *Caller) bool {
+	return p.Visibility != VisibilityPrivate || p.CanEditItems(caller)
+}
+
+// CanEditItems reports whether caller may add, remove and reorder the playlist's videos.
+func (p *Playlist) CanEditItems(caller *Caller) bool {
+	return caller.Can(PermEditPlaylist, p.OwnerID) || (caller.UserID != "" && slices.Contains(p.CollaboratorIDs, caller.UserID))
+}
+
+// PlaylistItem is a video in a playlist. Items sort by Position, a fractional key that
+// leaves room between any two items, so moving one never renumbers the others.
+type PlaylistItem struct {
+	ID       string
+	VideoID  string
+	Position string
+	AddedBy  string
+	AddedAt  time.Time
+}
+
+// PlaylistUpdate holds the playlist details to change; nil fields are left as they are.
+type PlaylistUpdate struct {
+	Title       *string
+	Description *string
+	Visibility  *string
+}
+
+// Series orders videos into seasons and episodes, such as the parts of a course. Its owner
+// manages it; each video is an episode of at most one series.
+type Series struct {
+	ID           string
+	OwnerID      string
+	Title        string
+	Description  string
+	EpisodeCount int
+	// Episodes are by season and episode, and CompletedVideoIDs those the caller completed;
+	// both are only loaded when getting a single series
+	Episodes          []*Video
+	CompletedVideoIDs []string
+	CreatedAt         time.Time
+}
+
+// SeriesUpdate holds the series details to change; nil fields are left as they are.
+type SeriesUpdate struct {
+	Title       *string
+	Description *string
+}
+
+// ShareLink plays one video, whatever its visibility, for anyone holding its token until it
+// expires, runs out of views or is revoked.
+type ShareLink struct {
+	Token     string
+	VideoID   string
+	CreatedBy string
+	ExpiresAt time.Time
+	// MaxViews caps how many times the link resolves; 0 for unlimited
+	MaxViews int
+	Views    int
+	// PasswordHash is the bcrypt hash of the link's password; empty without one
+	PasswordHash string
+	// RevokedAt is set once the owner revoked the link; zero otherwise
+	RevokedAt time.Time
+	CreatedAt time.Time
+}
+
+// Usable reports whether the link still resolves at now.
+func (l *ShareLink) Usable(now time.Time) bool {
+	return l.RevokedAt.IsZero() && now.Before(l.ExpiresAt) && (l.MaxViews == 0 || l.Views < l.MaxViews)
+}
+
+// StorageCleanup removes a deleted video's objects from storage: its source and everything
+// derived from it under Prefix, or only the object keyed Prefix when it doesn't end in a
+// slash. Replaced and deleted channel images are cleaned up the same way, with VideoID set
+// to the image's object key. Failed attempts are retried until they succeed.
+type StorageCleanup struct {
+	VideoID       string
+	Bucket        string
+	Prefix        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
 }
 
 type VideoRepository interface {
 	Create(ctx context.Context, video *Video) error
 	Get(ctx context.Context, id string) (*Video, error)
-	List(ctx context.Context, query string) ([]*Video, error)
+	List(ctx context.Context, query string, filter VideoFilter) ([]*Video, error)
+	// Update applies the non-nil fields of update; tags replace the video's tags.
+	Update(ctx context.Context, id string, update *VideoUpdate) error
+	// UpdateStatus sets a video's status; a published video becoming ready for the first
+	// time is published then.
 	UpdateStatus(ctx context.Context, id string, status string) error
+	UpdateDuration(ctx context.Context, id string, seconds float64) error
+	UpdateLiveStatus(ctx context.Context, id string, liveStatus string) error
+	// UpdateRecording points a live video at its recording in the bucket.
+	UpdateRecording(ctx context.Context, id string, seconds float64, bucket, objectKey string) error
+	// ListDueForPublish returns ready, unpublished videos whose publish_at is at or before now.
+	ListDueForPublish(ctx context.Context, now time.Time) ([]*Video, error)
+	// MarkPublished lists a scheduled video as published now; it reports false if it was
+	// already published.
+	MarkPublished(ctx context.Context, id string) (bool, error)
+	CreateStreamKey(ctx context.Context, key *StreamKey) error
+	GetStreamKey(ctx context.Context, key string) (*StreamKey, error)
+	// Trash hides a video from Get and List until it is restored or purged.
+	Trash(ctx context.Context, id string, at time.Time) error
+	// GetTrashed returns a video only while it is in the trash.
+	GetTrashed(ctx context.Context, id string) (*Video, error)
+	Restore(ctx context.Context, id string) error
+	// ListTrash returns ownerID's trashed videos, most recently deleted first.
+	ListTrash(ctx context.Context, ownerID string) ([]*Video, error)
+	// ListTrashedBefore returns videos trashed before the cutoff, oldest first.
+	ListTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*Video, error)
+	GrantAccess(ctx context.Context, videoID, userID string) error
+	RevokeAccess(ctx context.Context, videoID, userID string) error
+	HasAccess(ctx context.Context, videoID, userID string) (bool, error)
+	ListAccess(ctx context.Context, videoID string) ([]string, error)
+	CreateShareLink(ctx context.Context, link *ShareLink) error
+	GetShareLink(ctx context.Context, token string) (*ShareLink, error)
+	// ListShareLinks returns a video's share links, newest first.
+	ListShareLinks(ctx context.Context, videoID string) ([]*ShareLink, error)
+	RevokeShareLink(ctx context.Context, videoID, token string, at time.Time) error
+	// ConsumeShareLinkView counts a view if the link is still usable at now, reporting
+	// whether it was, so concurrent views cannot exceed the link's limit.
+	ConsumeShareLinkView(ctx context.Context, token string, now time.Time) (bool, error)
+	// Delete removes a video and queues cleanup, when not nil, in the same transaction.
+	Delete(ctx context.Context, id string, cleanup *StorageCleanup) error
+	GetStorageCleanup(ctx context.Context, videoID string) (*StorageCleanup, error)
+	// ListStorageCleanups returns cleanups whose next attempt is at or before now, oldest first.
+	ListStorageCleanups(ctx context.Context, now time.Time, limit int) ([]*StorageCleanup, error)
+	DeleteStorageCleanup(ctx context.Context, videoID string) error
+	// RescheduleStorageCleanup records a failed attempt and when to try again.
+	RescheduleStorageCleanup(ctx context.Context, videoID string, next time.Time, lastError string) error
+	// CreateChannel fails with ErrHandleTaken when another channel has the handle.
+	CreateChannel(ctx context.Context, channel *Channel) error
+	GetChannel(ctx context.Context, id string) (*Channel, error)
+	GetChannelByHandle(ctx context.Context, handle string) (*Channel, error)
+	UpdateChannel(ctx context.Context, id string, update *ChannelUpdate) error
+	// DeleteChannel removes a channel with its members and takes its videos out of it;
+	// its avatar and banner are queued for removal from storage.
+	DeleteChannel(ctx context.Context, id string) error
+	AddChannelMember(ctx context.Context, channelID, userID string) error
+	RemoveChannelMember(ctx context.Context, channelID, userID string) error
+	// SetChannelImage records a channel's avatar or banner and queues the image it
+	// replaces for removal from storage.
+	SetChannelImage(ctx context.Context, channelID, kind string, image *ChannelImage) error
+	// Subscribe and Unsubscribe keep the channel's subscriber count; repeating either is a no-op.
+	Subscribe(ctx context.Context, userID, channelID string, at time.Time) error
+	Unsubscribe(ctx context.Context, userID, channelID string) error
+	// ListSubscriptions returns the channels userID subscribed to without their members, most
+	// recently subscribed first.
+	ListSubscriptions(ctx context.Context, userID string) ([]*Channel, error)
+	// ListSubscriptionFeed returns up to limit listed videos of the channels userID subscribed
+	// to, newest first, starting after the cursor or from the newest when it is nil. The
+	// returned cursor is nil on the last page.
+	ListSubscriptionFeed(ctx context.Context, userID string, after *FeedCursor, limit int) ([]*Video, *FeedCursor, error)
+	CreatePlaylist(ctx context.Context, playlist *Playlist) error
+	// GetPlaylist returns a playlist with its collaborators and items.
+	GetPlaylist(ctx context.Context, id string) (*Playlist, error)
+	// ListPlaylists returns ownerID's playlists without items, newest first.
+	ListPlaylists(ctx context.Context, ownerID string) ([]*Playlist, error)
+	UpdatePlaylist(ctx context.Context, id string, update *PlaylistUpdate) error
+	// DeletePlaylist removes a playlist with its items and collaborators.
+	DeletePlaylist(ctx context.Context, id string) error
+	// AddPlaylistItem and MovePlaylistItem fail with ErrPositionTaken when another item of
+	// the playlist has the position.
+	AddPlaylistItem(ctx context.Context, playlistID string, item *PlaylistItem) error
+	MovePlaylistItem(ctx context.Context, playlistID, itemID, position string) error
+	RemovePlaylistItem(ctx context.Context, playlistID, itemID string) error
+	AddPlaylistCollaborator(ctx context.Context, playlistID, userID string) error
+	RemovePlaylistCollaborator(ctx context.Context, playlistID, userID string) error
+	CreateSeries(ctx context.Context, series *Series) error
+	// GetSeries returns a series without its episodes.
+	GetSeries(ctx context.Context, id string) (*Series, error)
+	// ListSeries returns ownerID's series, newest first.
+	ListSeries(ctx context.Context, ownerID string) ([]*Series, error)
+	UpdateSeries(ctx context.Context, id string, update *SeriesUpdate) error
+	// DeleteSeries removes a series and takes its videos out of it.
+	DeleteSeries(ctx context.Context, id string) error
+	// ListEpisodes returns a series' videos that aren't in the trash, by season and episode.
+	ListEpisodes(ctx context.Context, seriesID string) ([]*Video, error)
+	// SetEpisode moves a video into a series under the given numbers, failing with
+	// ErrEpisodeTaken when another video of the series has them.
+	SetEpisode(ctx context.Context, videoID, seriesID string, season, episode int) error
+	// RemoveEpisode takes a video out of a series, or fails with ErrNotEpisode.
+	RemoveEpisode(ctx context.Context, seriesID, videoID string) error
+	// SetEpisodeCompleted records whether userID completed a video.
+	SetEpisodeCompleted(ctx context.Context, userID, videoID string, completed bool, at time.Time) error
+	// ListCompletedEpisodes returns the videos of a series userID completed.
+	ListCompletedEpisodes(ctx context.Context, userID, seriesID string) ([]string, error)
 }
 
 type VideoUsecase interface {
-	Create(ctx context.Context, title, bucket, objectKey string) (string, error)
+	// Create registers an upload owned by ownerID, posted to channelID unless it is empty.
+	Create(ctx context.Context, ownerID, channelID, title, bucket, objectKey string, allowDownload bool, publishAt time.Time, premiere bool) (string, error)
 	Get(ctx context.Context, id string) (*Video, error)
-	List(ctx context.Context, query string) ([]*Video, error)
-	UpdateStatus(ctx context.Context, id string, status string) error
+	// GetForViewer returns a video userID may watch; private videos need the owner or a grant.
+	GetForViewer(ctx context.Context, id, userID string) (*Video, error)
+	List(ctx context.Context, query string, filter VideoFilter) ([]*Video, error)
+	// Update edits a video's metadata for caller and returns the result.
+	Update(ctx context.Context, id string, caller *Caller, update *VideoUpdate) (*Video, error)
+	// UpdateStatus sets a video's status, along with its duration when durationSeconds is known.
+	UpdateStatus(ctx context.Context, id string, status string, durationSeconds float64) error
+	// CreateStreamKey issues a key for ownerID, who must be able to post to channelID if set.
+	CreateStreamKey(ctx context.Context, ownerID, channelID, title string) (*StreamKey, error)
+	StartLiveStream(ctx context.Context, streamKey string) (*Video, error)
+	UpdateLiveStatus(ctx context.Context, id string, liveStatus string) error
+	CompleteRecording(ctx context.Context, id string, durationSeconds float64, bucket, objectKey string) error
+	// PublishDue publishes scheduled videos whose time has come and returns how many it published.
+	PublishDue(ctx context.Context, now time.Time) (int, error)
+	// Delete moves a video to the trash for caller.
+	Delete(ctx context.Context, id string, caller *Caller) error
+	// Restore takes a video out of the trash for caller.
+	Restore(ctx context.Context, id string, caller *Caller) error
+	// Unpublish makes a video private for caller.
+	Unpublish(ctx context.Context, id string, caller *Caller) error
+	ListTrash(ctx context.Context, userID string) ([]*Video, error)
+	// GrantAccess lets granteeID watch the private video id; caller must own it or be an admin.
+	GrantAccess(ctx context.Context, id string, caller *Caller, granteeID string) error
+	RevokeAccess(ctx context.Context, id string, caller *Caller, granteeID string) error
+	ListAccess(ctx context.Context, id string, caller *Caller) ([]string, error)
+	// CreateShareLink creates a link to the video id for caller, who must own it or be an
+	// admin. A zero expiresAt uses DefaultShareLinkTTL; maxViews 0 allows unlimited views.
+	CreateShareLink(ctx context.Context, id string, caller *Caller, expiresAt time.Time, maxViews int, password string) (*ShareLink, error)
+	ListShareLinks(ctx context.Context, id string, caller *Caller) ([]*ShareLink, error)
+	RevokeShareLink(ctx context.Context, id string, caller *Caller, token string) error
+	// ResolveShareLink returns the video a share link plays and counts a view against it.
+	ResolveShareLink(ctx context.Context, token, password string) (*Video, error)
+	// PurgeTrash permanently deletes videos trashed before the cutoff and queues the removal
+	// of their objects from storage. It returns how many it purged.
+	PurgeTrash(ctx context.Context, cutoff time.Time) (int, error)
+	ListStorageCleanups(ctx context.Context, limit int) ([]*StorageCleanup, error)
+	// CompleteStorageCleanup finishes a cleanup, or schedules a retry when errMsg is set.
+	CompleteStorageCleanup(ctx context.Context, videoID, errMsg string) error
+}
+
+type ChannelUsecase interface {
+	// Create creates a channel owned by caller.
+	Create(ctx context.Context, caller *Caller, handle, displayName, description string) (*Channel, error)
+	// Get looks a channel up by id, or by handle when id is empty.
+	Get(ctx context.Context, id, handle string) (*Channel, error)
+	// Update edits a channel's details for caller and returns the result.
+	Update(ctx context.Context, id string, caller *Caller, update *ChannelUpdate) (*Channel, error)
+	Delete(ctx context.Context, id string, caller *Caller) error
+	AddMember(ctx context.Context, id string, caller *Caller, memberID string) error
+	RemoveMember(ctx context.Context, id string, caller *Caller, memberID string) error
+	// SetImage replaces a channel's avatar or banner.
+	SetImage(ctx context.Context, id, kind string, image *ChannelImage) error
+}
+
+type SubscriptionUsecase interface {
+	// Subscribe makes caller follow a channel's videos.
+	Subscribe(ctx context.Context, channelID string, caller *Caller) error
+	Unsubscribe(ctx context.Context, channelID string, caller *Caller) error
+	// List returns the channels caller subscribed to.
+	List(ctx context.Context, caller *Caller) ([]*Channel, error)
+	// Feed returns a page of the videos of caller's subscriptions, newest first, and the token
+	// of the next page, empty on the last one. pageSize defaults to DefaultFeedPageSize and is
+	// capped at MaxFeedPageSize.
+	Feed(ctx context.Context, caller *Caller, pageToken string, pageSize int) ([]*Video, string, error)
+}
+
+type PlaylistUsecase interface {
+	// Create creates an empty playlist owned by caller.
+	Create(ctx context.Context, caller *Caller, title, description, visibility string) (*Playlist, error)
+	// Get returns a playlist caller may see, with the items caller may watch; its owner and
+	// admins see them all.
+	Get(ctx context.Context, id string, caller *Caller) (*Playlist, error)
+	// List returns ownerID's playlists that caller may see.
+	List(ctx context.Context, ownerID string, caller *Caller) ([]*Playlist, error)
+	// Update edits a playlist's details for caller and returns the result.
+	Update(ctx context.Context, id string, caller *Caller, update *PlaylistUpdate) (*Playlist, error)
+	Delete(ctx context.Context, id string, caller *Caller) error
+	// AddItem appends a video caller may watch to the end of the playlist.
+	AddItem(ctx context.Context, id string, caller *Caller, videoID string) (*PlaylistItem, error)
+	RemoveItem(ctx context.Context, id string, caller *Caller, itemID string) error
+	// MoveItem moves an item right after afterItemID, or to the start when it is empty.
+	MoveItem(ctx context.Context, id string, caller *Caller, itemID, afterItemID string) (*PlaylistItem, error)
+	AddCollaborator(ctx context.Context, id string, caller *Caller, userID string) error
+	RemoveCollaborator(ctx context.Context, id string, caller *Caller, userID string) error
+}
+
+type SeriesUsecase interface {
+	// Create creates an empty series owned by caller.
+	Create(ctx context.Context, caller *Caller, title, description string) (*Series, error)
+	// Get returns a series with the episodes caller may watch and those caller completed.
+	Get(ctx context.Context, id string, caller *Caller) (*Series, error)
+	List(ctx context.Context, ownerID string) ([]*Series, error)
+	// Update edits a series' details for caller and returns the result.
+	Update(ctx context.Context, id string, caller *Caller, update *SeriesUpdate) (*Series, error)
+	Delete(ctx context.Context, id string, caller *Caller) error
+	// SetEpisode numbers a video as an episode of the series and returns the updated series.
+	SetEpisode(ctx context.Context, id string, caller *Caller, videoID string, season, episode int) (*Series, error)
+	RemoveEpisode(ctx context.Context, id string, caller *Caller, videoID string) (*Series, error)
+	// NextEpisode returns the episode viewerID should watch after afterVideoID, or their first
+	// one not completed yet when it is empty. It fails with ErrNoNextEpisode at the end.
+	NextEpisode(ctx context.Context, id, afterVideoID, viewerID string) (*Video, error)
+	SetCompleted(ctx context.Context, id string, caller *Caller, videoID string, completed bool) error
 }
@@ -0,0 +1,57 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+)
+
+type webhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookPublisher POSTs every event as JSON to url.
+func NewWebhookPublisher(url string) domain.EventPublisher {
+	return &webhookPublisher{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (p *webhookPublisher) Publish(ctx context.Context, event *domain.Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
+
+type logPublisher struct{}
+
+// NewLogPublisher only logs events, for deployments without a webhook.
+func NewLogPublisher() domain.EventPublisher {
+	return logPublisher{}
+}
+
+func (logPublisher) Publish(ctx context.Context, event *domain.Event) error {
+	log.Printf("event %s: video %s", event.Type, event.VideoID)
+	return nil
+}
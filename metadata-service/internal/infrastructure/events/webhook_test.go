@@ -0,0 +1,67 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+)
+
+func TestWebhookPublisher_Publish(t *testing.T) {
+	event := &domain.Event{
+		Type:       domain.EventVideoPublished,
+		VideoID:    "video-1",
+		OwnerID:    "owner-1",
+		Title:      "Launch",
+		Premiere:   true,
+		OccurredAt: time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC),
+	}
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{name: "success - delivered", status: http.StatusNoContent},
+		{name: "error - webhook rejects the event", status: http.StatusInternalServerError, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got domain.Event
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Errorf("method = %s, want POST", r.Method)
+				}
+				if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+					t.Errorf("Content-Type = %q, want application/json", ct)
+				}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Errorf("failed to decode event: %v", err)
+				}
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			err := NewWebhookPublisher(srv.URL).Publish(context.Background(), event)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Publish() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Type != event.Type || got.VideoID != event.VideoID || !got.Premiere || !got.OccurredAt.Equal(event.OccurredAt) {
+				t.Errorf("webhook received %+v, want %+v", got, *event)
+			}
+		})
+	}
+}
+
+func TestWebhookPublisher_Unreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+
+	if err := NewWebhookPublisher(srv.URL).Publish(context.Background(), &domain.Event{Type: domain.EventVideoPublished}); err == nil {
+		t.Error("Publish() to a closed server succeeded, want an error")
+	}
+}
@@ -12,11 +12,50 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/athandoan/youtube/metadata-service/internal/domain"
 	gomock "go.uber.org/mock/gomock"
 )
 
+// MockEventPublisher is a mock of EventPublisher interface.
+type MockEventPublisher struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventPublisherMockRecorder
+	isgomock struct{}
+}
+
+// MockEventPublisherMockRecorder is the mock recorder for MockEventPublisher.
+type MockEventPublisherMockRecorder struct {
+	mock *MockEventPublisher
+}
+
+// NewMockEventPublisher creates a new mock instance.
+func NewMockEventPublisher(ctrl *gomock.Controller) *MockEventPublisher {
+	mock := &MockEventPublisher{ctrl: ctrl}
+	mock.recorder = &MockEventPublisherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventPublisher) EXPECT() *MockEventPublisherMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockEventPublisher) Publish(ctx context.Context, event *domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, event)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockEventPublisherMockRecorder) Publish(ctx, event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockEventPublisher)(nil).Publish), ctx, event)
+}
+
 // MockVideoRepository is a mock of VideoRepository interface.
 type MockVideoRepository struct {
 	ctrl     *gomock.Controller
@@ -41,6 +80,63 @@ func (m *MockVideoRepository) EXPECT() *MockVideoRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddChannelMember mocks base method.
+func (m *MockVideoRepository) AddChannelMember(ctx context.Context, channelID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChannelMember", ctx, channelID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChannelMember indicates an expected call of AddChannelMember.
+func (mr *MockVideoRepositoryMockRecorder) AddChannelMember(ctx, channelID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChannelMember", reflect.TypeOf((*MockVideoRepository)(nil).AddChannelMember), ctx, channelID, userID)
+}
+
+// AddPlaylistCollaborator mocks base method.
+func (m *MockVideoRepository) AddPlaylistCollaborator(ctx context.Context, playlistID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlaylistCollaborator", ctx, playlistID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPlaylistCollaborator indicates an expected call of AddPlaylistCollaborator.
+func (mr *MockVideoRepositoryMockRecorder) AddPlaylistCollaborator(ctx, playlistID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlaylistCollaborator", reflect.TypeOf((*MockVideoRepository)(nil).AddPlaylistCollaborator), ctx, playlistID, userID)
+}
+
+// AddPlaylistItem mocks base method.
+func (m *MockVideoRepository) AddPlaylistItem(ctx context.Context, playlistID string, item *domain.PlaylistItem) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPlaylistItem", ctx, playlistID, item)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPlaylistItem indicates an expected call of AddPlaylistItem.
+func (mr *MockVideoRepositoryMockRecorder) AddPlaylistItem(ctx, playlistID, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPlaylistItem", reflect.TypeOf((*MockVideoRepository)(nil).AddPlaylistItem), ctx, playlistID, item)
+}
+
+// ConsumeShareLinkView mocks base method.
+func (m *MockVideoRepository) ConsumeShareLinkView(ctx context.Context, token string, now time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeShareLinkView", ctx, token, now)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeShareLinkView indicates an expected call of ConsumeShareLinkView.
+func (mr *MockVideoRepositoryMockRecorder) ConsumeShareLinkView(ctx, token, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeShareLinkView", reflect.TypeOf((*MockVideoRepository)(nil).ConsumeShareLinkView), ctx, token, now)
+}
+
 // Create mocks base method.
 func (m *MockVideoRepository) Create(ctx context.Context, video *domain.Video) error {
 	m.ctrl.T.Helper()
@@ -55,6 +151,146 @@ func (mr *MockVideoRepositoryMockRecorder) Create(ctx, video any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVideoRepository)(nil).Create), ctx, video)
 }
 
+// CreateChannel mocks base method.
+func (m *MockVideoRepository) CreateChannel(ctx context.Context, channel *domain.Channel) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannel", ctx, channel)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateChannel indicates an expected call of CreateChannel.
+func (mr *MockVideoRepositoryMockRecorder) CreateChannel(ctx, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockVideoRepository)(nil).CreateChannel), ctx, channel)
+}
+
+// CreatePlaylist mocks base method.
+func (m *MockVideoRepository) CreatePlaylist(ctx context.Context, playlist *domain.Playlist) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlaylist", ctx, playlist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePlaylist indicates an expected call of CreatePlaylist.
+func (mr *MockVideoRepositoryMockRecorder) CreatePlaylist(ctx, playlist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylist", reflect.TypeOf((*MockVideoRepository)(nil).CreatePlaylist), ctx, playlist)
+}
+
+// CreateSeries mocks base method.
+func (m *MockVideoRepository) CreateSeries(ctx context.Context, series *domain.Series) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSeries", ctx, series)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateSeries indicates an expected call of CreateSeries.
+func (mr *MockVideoRepositoryMockRecorder) CreateSeries(ctx, series any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSeries", reflect.TypeOf((*MockVideoRepository)(nil).CreateSeries), ctx, series)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockVideoRepository) CreateShareLink(ctx context.Context, link *domain.ShareLink) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", ctx, link)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockVideoRepositoryMockRecorder) CreateShareLink(ctx, link any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockVideoRepository)(nil).CreateShareLink), ctx, link)
+}
+
+// CreateStreamKey mocks base method.
+func (m *MockVideoRepository) CreateStreamKey(ctx context.Context, key *domain.StreamKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStreamKey", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStreamKey indicates an expected call of CreateStreamKey.
+func (mr *MockVideoRepositoryMockRecorder) CreateStreamKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreamKey", reflect.TypeOf((*MockVideoRepository)(nil).CreateStreamKey), ctx, key)
+}
+
+// Delete mocks base method.
+func (m *MockVideoRepository) Delete(ctx context.Context, id string, cleanup *domain.StorageCleanup) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, cleanup)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVideoRepositoryMockRecorder) Delete(ctx, id, cleanup any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVideoRepository)(nil).Delete), ctx, id, cleanup)
+}
+
+// DeleteChannel mocks base method.
+func (m *MockVideoRepository) DeleteChannel(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChannel", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannel indicates an expected call of DeleteChannel.
+func (mr *MockVideoRepositoryMockRecorder) DeleteChannel(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockVideoRepository)(nil).DeleteChannel), ctx, id)
+}
+
+// DeletePlaylist mocks base method.
+func (m *MockVideoRepository) DeletePlaylist(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlaylist", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlaylist indicates an expected call of DeletePlaylist.
+func (mr *MockVideoRepositoryMockRecorder) DeletePlaylist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlaylist", reflect.TypeOf((*MockVideoRepository)(nil).DeletePlaylist), ctx, id)
+}
+
+// DeleteSeries mocks base method.
+func (m *MockVideoRepository) DeleteSeries(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeries", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeries indicates an expected call of DeleteSeries.
+func (mr *MockVideoRepositoryMockRecorder) DeleteSeries(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeries", reflect.TypeOf((*MockVideoRepository)(nil).DeleteSeries), ctx, id)
+}
+
+// DeleteStorageCleanup mocks base method.
+func (m *MockVideoRepository) DeleteStorageCleanup(ctx context.Context, videoID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStorageCleanup", ctx, videoID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStorageCleanup indicates an expected call of DeleteStorageCleanup.
+func (mr *MockVideoRepositoryMockRecorder) DeleteStorageCleanup(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageCleanup", reflect.TypeOf((*MockVideoRepository)(nil).DeleteStorageCleanup), ctx, videoID)
+}
+
 // Get mocks base method.
 func (m *MockVideoRepository) Get(ctx context.Context, id string) (*domain.Video, error) {
 	m.ctrl.T.Helper()
@@ -70,114 +306,1608 @@ func (mr *MockVideoRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVideoRepository)(nil).Get), ctx, id)
 }
 
-// List mocks base method.
-func (m *MockVideoRepository) List(ctx context.Context, query string) ([]*domain.Video, error) {
+// GetChannel mocks base method.
+func (m *MockVideoRepository) GetChannel(ctx context.Context, id string) (*domain.Channel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, query)
-	ret0, _ := ret[0].([]*domain.Video)
+	ret := m.ctrl.Call(m, "GetChannel", ctx, id)
+	ret0, _ := ret[0].(*domain.Channel)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// List indicates an expected call of List.
-func (mr *MockVideoRepositoryMockRecorder) List(ctx, query any) *gomock.Call {
+// GetChannel indicates an expected call of GetChannel.
+func (mr *MockVideoRepositoryMockRecorder) GetChannel(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVideoRepository)(nil).List), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannel", reflect.TypeOf((*MockVideoRepository)(nil).GetChannel), ctx, id)
 }
 
-// UpdateStatus mocks base method.
-func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id, status string) error {
+// GetChannelByHandle mocks base method.
+func (m *MockVideoRepository) GetChannelByHandle(ctx context.Context, handle string) (*domain.Channel, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetChannelByHandle", ctx, handle)
+	ret0, _ := ret[0].(*domain.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockVideoRepositoryMockRecorder) UpdateStatus(ctx, id, status any) *gomock.Call {
+// GetChannelByHandle indicates an expected call of GetChannelByHandle.
+func (mr *MockVideoRepositoryMockRecorder) GetChannelByHandle(ctx, handle any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockVideoRepository)(nil).UpdateStatus), ctx, id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelByHandle", reflect.TypeOf((*MockVideoRepository)(nil).GetChannelByHandle), ctx, handle)
 }
 
-// MockVideoUsecase is a mock of VideoUsecase interface.
-type MockVideoUsecase struct {
-	ctrl     *gomock.Controller
-	recorder *MockVideoUsecaseMockRecorder
-	isgomock struct{}
+// GetPlaylist mocks base method.
+func (m *MockVideoRepository) GetPlaylist(ctx context.Context, id string) (*domain.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylist", ctx, id)
+	ret0, _ := ret[0].(*domain.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// MockVideoUsecaseMockRecorder is the mock recorder for MockVideoUsecase.
-type MockVideoUsecaseMockRecorder struct {
-	mock *MockVideoUsecase
+// GetPlaylist indicates an expected call of GetPlaylist.
+func (mr *MockVideoRepositoryMockRecorder) GetPlaylist(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylist", reflect.TypeOf((*MockVideoRepository)(nil).GetPlaylist), ctx, id)
 }
 
-// NewMockVideoUsecase creates a new mock instance.
-func NewMockVideoUsecase(ctrl *gomock.Controller) *MockVideoUsecase {
-	mock := &MockVideoUsecase{ctrl: ctrl}
-	mock.recorder = &MockVideoUsecaseMockRecorder{mock}
-	return mock
+// GetSeries mocks base method.
+func (m *MockVideoRepository) GetSeries(ctx context.Context, id string) (*domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeries", ctx, id)
+	ret0, _ := ret[0].(*domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockVideoUsecase) EXPECT() *MockVideoUsecaseMockRecorder {
-	return m.recorder
+// GetSeries indicates an expected call of GetSeries.
+func (mr *MockVideoRepositoryMockRecorder) GetSeries(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeries", reflect.TypeOf((*MockVideoRepository)(nil).GetSeries), ctx, id)
 }
 
-// Create mocks base method.
-func (m *MockVideoUsecase) Create(ctx context.Context, title, bucket, objectKey string) (string, error) {
+// GetShareLink mocks base method.
+func (m *MockVideoRepository) GetShareLink(ctx context.Context, token string) (*domain.ShareLink, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, title, bucket, objectKey)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetShareLink", ctx, token)
+	ret0, _ := ret[0].(*domain.ShareLink)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockVideoUsecaseMockRecorder) Create(ctx, title, bucket, objectKey any) *gomock.Call {
+// GetShareLink indicates an expected call of GetShareLink.
+func (mr *MockVideoRepositoryMockRecorder) GetShareLink(ctx, token any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVideoUsecase)(nil).Create), ctx, title, bucket, objectKey)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetShareLink", reflect.TypeOf((*MockVideoRepository)(nil).GetShareLink), ctx, token)
 }
 
-// Get mocks base method.
-func (m *MockVideoUsecase) Get(ctx context.Context, id string) (*domain.Video, error) {
+// GetStorageCleanup mocks base method.
+func (m *MockVideoRepository) GetStorageCleanup(ctx context.Context, videoID string) (*domain.StorageCleanup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret := m.ctrl.Call(m, "GetStorageCleanup", ctx, videoID)
+	ret0, _ := ret[0].(*domain.StorageCleanup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageCleanup indicates an expected call of GetStorageCleanup.
+func (mr *MockVideoRepositoryMockRecorder) GetStorageCleanup(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageCleanup", reflect.TypeOf((*MockVideoRepository)(nil).GetStorageCleanup), ctx, videoID)
+}
+
+// GetStreamKey mocks base method.
+func (m *MockVideoRepository) GetStreamKey(ctx context.Context, key string) (*domain.StreamKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamKey", ctx, key)
+	ret0, _ := ret[0].(*domain.StreamKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamKey indicates an expected call of GetStreamKey.
+func (mr *MockVideoRepositoryMockRecorder) GetStreamKey(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamKey", reflect.TypeOf((*MockVideoRepository)(nil).GetStreamKey), ctx, key)
+}
+
+// GetTrashed mocks base method.
+func (m *MockVideoRepository) GetTrashed(ctx context.Context, id string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrashed", ctx, id)
 	ret0, _ := ret[0].(*domain.Video)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Get indicates an expected call of Get.
-func (mr *MockVideoUsecaseMockRecorder) Get(ctx, id any) *gomock.Call {
+// GetTrashed indicates an expected call of GetTrashed.
+func (mr *MockVideoRepositoryMockRecorder) GetTrashed(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVideoUsecase)(nil).Get), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrashed", reflect.TypeOf((*MockVideoRepository)(nil).GetTrashed), ctx, id)
+}
+
+// GrantAccess mocks base method.
+func (m *MockVideoRepository) GrantAccess(ctx context.Context, videoID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantAccess", ctx, videoID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantAccess indicates an expected call of GrantAccess.
+func (mr *MockVideoRepositoryMockRecorder) GrantAccess(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccess", reflect.TypeOf((*MockVideoRepository)(nil).GrantAccess), ctx, videoID, userID)
+}
+
+// HasAccess mocks base method.
+func (m *MockVideoRepository) HasAccess(ctx context.Context, videoID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasAccess", ctx, videoID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasAccess indicates an expected call of HasAccess.
+func (mr *MockVideoRepositoryMockRecorder) HasAccess(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasAccess", reflect.TypeOf((*MockVideoRepository)(nil).HasAccess), ctx, videoID, userID)
 }
 
 // List mocks base method.
-func (m *MockVideoUsecase) List(ctx context.Context, query string) ([]*domain.Video, error) {
+func (m *MockVideoRepository) List(ctx context.Context, query string, filter domain.VideoFilter) ([]*domain.Video, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, query)
+	ret := m.ctrl.Call(m, "List", ctx, query, filter)
 	ret0, _ := ret[0].([]*domain.Video)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // List indicates an expected call of List.
-func (mr *MockVideoUsecaseMockRecorder) List(ctx, query any) *gomock.Call {
+func (mr *MockVideoRepositoryMockRecorder) List(ctx, query, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVideoUsecase)(nil).List), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVideoRepository)(nil).List), ctx, query, filter)
 }
 
-// UpdateStatus mocks base method.
-func (m *MockVideoUsecase) UpdateStatus(ctx context.Context, id, status string) error {
+// ListAccess mocks base method.
+func (m *MockVideoRepository) ListAccess(ctx context.Context, videoID string) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "ListAccess", ctx, videoID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// UpdateStatus indicates an expected call of UpdateStatus.
-func (mr *MockVideoUsecaseMockRecorder) UpdateStatus(ctx, id, status any) *gomock.Call {
+// ListAccess indicates an expected call of ListAccess.
+func (mr *MockVideoRepositoryMockRecorder) ListAccess(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccess", reflect.TypeOf((*MockVideoRepository)(nil).ListAccess), ctx, videoID)
+}
+
+// ListCompletedEpisodes mocks base method.
+func (m *MockVideoRepository) ListCompletedEpisodes(ctx context.Context, userID, seriesID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCompletedEpisodes", ctx, userID, seriesID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCompletedEpisodes indicates an expected call of ListCompletedEpisodes.
+func (mr *MockVideoRepositoryMockRecorder) ListCompletedEpisodes(ctx, userID, seriesID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCompletedEpisodes", reflect.TypeOf((*MockVideoRepository)(nil).ListCompletedEpisodes), ctx, userID, seriesID)
+}
+
+// ListDueForPublish mocks base method.
+func (m *MockVideoRepository) ListDueForPublish(ctx context.Context, now time.Time) ([]*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDueForPublish", ctx, now)
+	ret0, _ := ret[0].([]*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDueForPublish indicates an expected call of ListDueForPublish.
+func (mr *MockVideoRepositoryMockRecorder) ListDueForPublish(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDueForPublish", reflect.TypeOf((*MockVideoRepository)(nil).ListDueForPublish), ctx, now)
+}
+
+// ListEpisodes mocks base method.
+func (m *MockVideoRepository) ListEpisodes(ctx context.Context, seriesID string) ([]*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEpisodes", ctx, seriesID)
+	ret0, _ := ret[0].([]*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEpisodes indicates an expected call of ListEpisodes.
+func (mr *MockVideoRepositoryMockRecorder) ListEpisodes(ctx, seriesID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEpisodes", reflect.TypeOf((*MockVideoRepository)(nil).ListEpisodes), ctx, seriesID)
+}
+
+// ListPlaylists mocks base method.
+func (m *MockVideoRepository) ListPlaylists(ctx context.Context, ownerID string) ([]*domain.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlaylists", ctx, ownerID)
+	ret0, _ := ret[0].([]*domain.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlaylists indicates an expected call of ListPlaylists.
+func (mr *MockVideoRepositoryMockRecorder) ListPlaylists(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlaylists", reflect.TypeOf((*MockVideoRepository)(nil).ListPlaylists), ctx, ownerID)
+}
+
+// ListSeries mocks base method.
+func (m *MockVideoRepository) ListSeries(ctx context.Context, ownerID string) ([]*domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSeries", ctx, ownerID)
+	ret0, _ := ret[0].([]*domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSeries indicates an expected call of ListSeries.
+func (mr *MockVideoRepositoryMockRecorder) ListSeries(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSeries", reflect.TypeOf((*MockVideoRepository)(nil).ListSeries), ctx, ownerID)
+}
+
+// ListShareLinks mocks base method.
+func (m *MockVideoRepository) ListShareLinks(ctx context.Context, videoID string) ([]*domain.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShareLinks", ctx, videoID)
+	ret0, _ := ret[0].([]*domain.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShareLinks indicates an expected call of ListShareLinks.
+func (mr *MockVideoRepositoryMockRecorder) ListShareLinks(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShareLinks", reflect.TypeOf((*MockVideoRepository)(nil).ListShareLinks), ctx, videoID)
+}
+
+// ListStorageCleanups mocks base method.
+func (m *MockVideoRepository) ListStorageCleanups(ctx context.Context, now time.Time, limit int) ([]*domain.StorageCleanup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageCleanups", ctx, now, limit)
+	ret0, _ := ret[0].([]*domain.StorageCleanup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageCleanups indicates an expected call of ListStorageCleanups.
+func (mr *MockVideoRepositoryMockRecorder) ListStorageCleanups(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageCleanups", reflect.TypeOf((*MockVideoRepository)(nil).ListStorageCleanups), ctx, now, limit)
+}
+
+// ListSubscriptionFeed mocks base method.
+func (m *MockVideoRepository) ListSubscriptionFeed(ctx context.Context, userID string, after *domain.FeedCursor, limit int) ([]*domain.Video, *domain.FeedCursor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptionFeed", ctx, userID, after, limit)
+	ret0, _ := ret[0].([]*domain.Video)
+	ret1, _ := ret[1].(*domain.FeedCursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListSubscriptionFeed indicates an expected call of ListSubscriptionFeed.
+func (mr *MockVideoRepositoryMockRecorder) ListSubscriptionFeed(ctx, userID, after, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptionFeed", reflect.TypeOf((*MockVideoRepository)(nil).ListSubscriptionFeed), ctx, userID, after, limit)
+}
+
+// ListSubscriptions mocks base method.
+func (m *MockVideoRepository) ListSubscriptions(ctx context.Context, userID string) ([]*domain.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSubscriptions", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSubscriptions indicates an expected call of ListSubscriptions.
+func (mr *MockVideoRepositoryMockRecorder) ListSubscriptions(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSubscriptions", reflect.TypeOf((*MockVideoRepository)(nil).ListSubscriptions), ctx, userID)
+}
+
+// ListTrash mocks base method.
+func (m *MockVideoRepository) ListTrash(ctx context.Context, ownerID string) ([]*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, ownerID)
+	ret0, _ := ret[0].([]*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockVideoRepositoryMockRecorder) ListTrash(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockVideoRepository)(nil).ListTrash), ctx, ownerID)
+}
+
+// ListTrashedBefore mocks base method.
+func (m *MockVideoRepository) ListTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrashedBefore", ctx, cutoff, limit)
+	ret0, _ := ret[0].([]*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrashedBefore indicates an expected call of ListTrashedBefore.
+func (mr *MockVideoRepositoryMockRecorder) ListTrashedBefore(ctx, cutoff, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrashedBefore", reflect.TypeOf((*MockVideoRepository)(nil).ListTrashedBefore), ctx, cutoff, limit)
+}
+
+// MarkPublished mocks base method.
+func (m *MockVideoRepository) MarkPublished(ctx context.Context, id string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPublished", ctx, id)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPublished indicates an expected call of MarkPublished.
+func (mr *MockVideoRepositoryMockRecorder) MarkPublished(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockVideoRepository)(nil).MarkPublished), ctx, id)
+}
+
+// MovePlaylistItem mocks base method.
+func (m *MockVideoRepository) MovePlaylistItem(ctx context.Context, playlistID, itemID, position string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePlaylistItem", ctx, playlistID, itemID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MovePlaylistItem indicates an expected call of MovePlaylistItem.
+func (mr *MockVideoRepositoryMockRecorder) MovePlaylistItem(ctx, playlistID, itemID, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePlaylistItem", reflect.TypeOf((*MockVideoRepository)(nil).MovePlaylistItem), ctx, playlistID, itemID, position)
+}
+
+// RemoveChannelMember mocks base method.
+func (m *MockVideoRepository) RemoveChannelMember(ctx context.Context, channelID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChannelMember", ctx, channelID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveChannelMember indicates an expected call of RemoveChannelMember.
+func (mr *MockVideoRepositoryMockRecorder) RemoveChannelMember(ctx, channelID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChannelMember", reflect.TypeOf((*MockVideoRepository)(nil).RemoveChannelMember), ctx, channelID, userID)
+}
+
+// RemoveEpisode mocks base method.
+func (m *MockVideoRepository) RemoveEpisode(ctx context.Context, seriesID, videoID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEpisode", ctx, seriesID, videoID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveEpisode indicates an expected call of RemoveEpisode.
+func (mr *MockVideoRepositoryMockRecorder) RemoveEpisode(ctx, seriesID, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEpisode", reflect.TypeOf((*MockVideoRepository)(nil).RemoveEpisode), ctx, seriesID, videoID)
+}
+
+// RemovePlaylistCollaborator mocks base method.
+func (m *MockVideoRepository) RemovePlaylistCollaborator(ctx context.Context, playlistID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePlaylistCollaborator", ctx, playlistID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePlaylistCollaborator indicates an expected call of RemovePlaylistCollaborator.
+func (mr *MockVideoRepositoryMockRecorder) RemovePlaylistCollaborator(ctx, playlistID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePlaylistCollaborator", reflect.TypeOf((*MockVideoRepository)(nil).RemovePlaylistCollaborator), ctx, playlistID, userID)
+}
+
+// RemovePlaylistItem mocks base method.
+func (m *MockVideoRepository) RemovePlaylistItem(ctx context.Context, playlistID, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePlaylistItem", ctx, playlistID, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePlaylistItem indicates an expected call of RemovePlaylistItem.
+func (mr *MockVideoRepositoryMockRecorder) RemovePlaylistItem(ctx, playlistID, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePlaylistItem", reflect.TypeOf((*MockVideoRepository)(nil).RemovePlaylistItem), ctx, playlistID, itemID)
+}
+
+// RescheduleStorageCleanup mocks base method.
+func (m *MockVideoRepository) RescheduleStorageCleanup(ctx context.Context, videoID string, next time.Time, lastError string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RescheduleStorageCleanup", ctx, videoID, next, lastError)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RescheduleStorageCleanup indicates an expected call of RescheduleStorageCleanup.
+func (mr *MockVideoRepositoryMockRecorder) RescheduleStorageCleanup(ctx, videoID, next, lastError any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RescheduleStorageCleanup", reflect.TypeOf((*MockVideoRepository)(nil).RescheduleStorageCleanup), ctx, videoID, next, lastError)
+}
+
+// Restore mocks base method.
+func (m *MockVideoRepository) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockVideoRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockVideoRepository)(nil).Restore), ctx, id)
+}
+
+// RevokeAccess mocks base method.
+func (m *MockVideoRepository) RevokeAccess(ctx context.Context, videoID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccess", ctx, videoID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccess indicates an expected call of RevokeAccess.
+func (mr *MockVideoRepositoryMockRecorder) RevokeAccess(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccess", reflect.TypeOf((*MockVideoRepository)(nil).RevokeAccess), ctx, videoID, userID)
+}
+
+// RevokeShareLink mocks base method.
+func (m *MockVideoRepository) RevokeShareLink(ctx context.Context, videoID, token string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShareLink", ctx, videoID, token, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockVideoRepositoryMockRecorder) RevokeShareLink(ctx, videoID, token, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockVideoRepository)(nil).RevokeShareLink), ctx, videoID, token, at)
+}
+
+// SetChannelImage mocks base method.
+func (m *MockVideoRepository) SetChannelImage(ctx context.Context, channelID, kind string, image *domain.ChannelImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChannelImage", ctx, channelID, kind, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChannelImage indicates an expected call of SetChannelImage.
+func (mr *MockVideoRepositoryMockRecorder) SetChannelImage(ctx, channelID, kind, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelImage", reflect.TypeOf((*MockVideoRepository)(nil).SetChannelImage), ctx, channelID, kind, image)
+}
+
+// SetEpisode mocks base method.
+func (m *MockVideoRepository) SetEpisode(ctx context.Context, videoID, seriesID string, season, episode int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEpisode", ctx, videoID, seriesID, season, episode)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEpisode indicates an expected call of SetEpisode.
+func (mr *MockVideoRepositoryMockRecorder) SetEpisode(ctx, videoID, seriesID, season, episode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEpisode", reflect.TypeOf((*MockVideoRepository)(nil).SetEpisode), ctx, videoID, seriesID, season, episode)
+}
+
+// SetEpisodeCompleted mocks base method.
+func (m *MockVideoRepository) SetEpisodeCompleted(ctx context.Context, userID, videoID string, completed bool, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEpisodeCompleted", ctx, userID, videoID, completed, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEpisodeCompleted indicates an expected call of SetEpisodeCompleted.
+func (mr *MockVideoRepositoryMockRecorder) SetEpisodeCompleted(ctx, userID, videoID, completed, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEpisodeCompleted", reflect.TypeOf((*MockVideoRepository)(nil).SetEpisodeCompleted), ctx, userID, videoID, completed, at)
+}
+
+// Subscribe mocks base method.
+func (m *MockVideoRepository) Subscribe(ctx context.Context, userID, channelID string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, userID, channelID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockVideoRepositoryMockRecorder) Subscribe(ctx, userID, channelID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockVideoRepository)(nil).Subscribe), ctx, userID, channelID, at)
+}
+
+// Trash mocks base method.
+func (m *MockVideoRepository) Trash(ctx context.Context, id string, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trash", ctx, id, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Trash indicates an expected call of Trash.
+func (mr *MockVideoRepositoryMockRecorder) Trash(ctx, id, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trash", reflect.TypeOf((*MockVideoRepository)(nil).Trash), ctx, id, at)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockVideoRepository) Unsubscribe(ctx context.Context, userID, channelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, userID, channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockVideoRepositoryMockRecorder) Unsubscribe(ctx, userID, channelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockVideoRepository)(nil).Unsubscribe), ctx, userID, channelID)
+}
+
+// Update mocks base method.
+func (m *MockVideoRepository) Update(ctx context.Context, id string, update *domain.VideoUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVideoRepositoryMockRecorder) Update(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVideoRepository)(nil).Update), ctx, id, update)
+}
+
+// UpdateChannel mocks base method.
+func (m *MockVideoRepository) UpdateChannel(ctx context.Context, id string, update *domain.ChannelUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateChannel", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateChannel indicates an expected call of UpdateChannel.
+func (mr *MockVideoRepositoryMockRecorder) UpdateChannel(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateChannel", reflect.TypeOf((*MockVideoRepository)(nil).UpdateChannel), ctx, id, update)
+}
+
+// UpdateDuration mocks base method.
+func (m *MockVideoRepository) UpdateDuration(ctx context.Context, id string, seconds float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDuration", ctx, id, seconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDuration indicates an expected call of UpdateDuration.
+func (mr *MockVideoRepositoryMockRecorder) UpdateDuration(ctx, id, seconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDuration", reflect.TypeOf((*MockVideoRepository)(nil).UpdateDuration), ctx, id, seconds)
+}
+
+// UpdateLiveStatus mocks base method.
+func (m *MockVideoRepository) UpdateLiveStatus(ctx context.Context, id, liveStatus string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLiveStatus", ctx, id, liveStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLiveStatus indicates an expected call of UpdateLiveStatus.
+func (mr *MockVideoRepositoryMockRecorder) UpdateLiveStatus(ctx, id, liveStatus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLiveStatus", reflect.TypeOf((*MockVideoRepository)(nil).UpdateLiveStatus), ctx, id, liveStatus)
+}
+
+// UpdatePlaylist mocks base method.
+func (m *MockVideoRepository) UpdatePlaylist(ctx context.Context, id string, update *domain.PlaylistUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlaylist", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlaylist indicates an expected call of UpdatePlaylist.
+func (mr *MockVideoRepositoryMockRecorder) UpdatePlaylist(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlaylist", reflect.TypeOf((*MockVideoRepository)(nil).UpdatePlaylist), ctx, id, update)
+}
+
+// UpdateRecording mocks base method.
+func (m *MockVideoRepository) UpdateRecording(ctx context.Context, id string, seconds float64, bucket, objectKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRecording", ctx, id, seconds, bucket, objectKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRecording indicates an expected call of UpdateRecording.
+func (mr *MockVideoRepositoryMockRecorder) UpdateRecording(ctx, id, seconds, bucket, objectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRecording", reflect.TypeOf((*MockVideoRepository)(nil).UpdateRecording), ctx, id, seconds, bucket, objectKey)
+}
+
+// UpdateSeries mocks base method.
+func (m *MockVideoRepository) UpdateSeries(ctx context.Context, id string, update *domain.SeriesUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSeries", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSeries indicates an expected call of UpdateSeries.
+func (mr *MockVideoRepositoryMockRecorder) UpdateSeries(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSeries", reflect.TypeOf((*MockVideoRepository)(nil).UpdateSeries), ctx, id, update)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockVideoRepository) UpdateStatus(ctx context.Context, id, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockVideoRepositoryMockRecorder) UpdateStatus(ctx, id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockVideoRepository)(nil).UpdateStatus), ctx, id, status)
+}
+
+// MockVideoUsecase is a mock of VideoUsecase interface.
+type MockVideoUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockVideoUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockVideoUsecaseMockRecorder is the mock recorder for MockVideoUsecase.
+type MockVideoUsecaseMockRecorder struct {
+	mock *MockVideoUsecase
+}
+
+// NewMockVideoUsecase creates a new mock instance.
+func NewMockVideoUsecase(ctrl *gomock.Controller) *MockVideoUsecase {
+	mock := &MockVideoUsecase{ctrl: ctrl}
+	mock.recorder = &MockVideoUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVideoUsecase) EXPECT() *MockVideoUsecaseMockRecorder {
+	return m.recorder
+}
+
+// CompleteRecording mocks base method.
+func (m *MockVideoUsecase) CompleteRecording(ctx context.Context, id string, durationSeconds float64, bucket, objectKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteRecording", ctx, id, durationSeconds, bucket, objectKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteRecording indicates an expected call of CompleteRecording.
+func (mr *MockVideoUsecaseMockRecorder) CompleteRecording(ctx, id, durationSeconds, bucket, objectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteRecording", reflect.TypeOf((*MockVideoUsecase)(nil).CompleteRecording), ctx, id, durationSeconds, bucket, objectKey)
+}
+
+// CompleteStorageCleanup mocks base method.
+func (m *MockVideoUsecase) CompleteStorageCleanup(ctx context.Context, videoID, errMsg string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteStorageCleanup", ctx, videoID, errMsg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteStorageCleanup indicates an expected call of CompleteStorageCleanup.
+func (mr *MockVideoUsecaseMockRecorder) CompleteStorageCleanup(ctx, videoID, errMsg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteStorageCleanup", reflect.TypeOf((*MockVideoUsecase)(nil).CompleteStorageCleanup), ctx, videoID, errMsg)
+}
+
+// Create mocks base method.
+func (m *MockVideoUsecase) Create(ctx context.Context, ownerID, channelID, title, bucket, objectKey string, allowDownload bool, publishAt time.Time, premiere bool) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, ownerID, channelID, title, bucket, objectKey, allowDownload, publishAt, premiere)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockVideoUsecaseMockRecorder) Create(ctx, ownerID, channelID, title, bucket, objectKey, allowDownload, publishAt, premiere any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVideoUsecase)(nil).Create), ctx, ownerID, channelID, title, bucket, objectKey, allowDownload, publishAt, premiere)
+}
+
+// CreateShareLink mocks base method.
+func (m *MockVideoUsecase) CreateShareLink(ctx context.Context, id string, caller *domain.Caller, expiresAt time.Time, maxViews int, password string) (*domain.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateShareLink", ctx, id, caller, expiresAt, maxViews, password)
+	ret0, _ := ret[0].(*domain.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateShareLink indicates an expected call of CreateShareLink.
+func (mr *MockVideoUsecaseMockRecorder) CreateShareLink(ctx, id, caller, expiresAt, maxViews, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateShareLink", reflect.TypeOf((*MockVideoUsecase)(nil).CreateShareLink), ctx, id, caller, expiresAt, maxViews, password)
+}
+
+// CreateStreamKey mocks base method.
+func (m *MockVideoUsecase) CreateStreamKey(ctx context.Context, ownerID, channelID, title string) (*domain.StreamKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStreamKey", ctx, ownerID, channelID, title)
+	ret0, _ := ret[0].(*domain.StreamKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStreamKey indicates an expected call of CreateStreamKey.
+func (mr *MockVideoUsecaseMockRecorder) CreateStreamKey(ctx, ownerID, channelID, title any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreamKey", reflect.TypeOf((*MockVideoUsecase)(nil).CreateStreamKey), ctx, ownerID, channelID, title)
+}
+
+// Delete mocks base method.
+func (m *MockVideoUsecase) Delete(ctx context.Context, id string, caller *domain.Caller) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, caller)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockVideoUsecaseMockRecorder) Delete(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVideoUsecase)(nil).Delete), ctx, id, caller)
+}
+
+// Get mocks base method.
+func (m *MockVideoUsecase) Get(ctx context.Context, id string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockVideoUsecaseMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockVideoUsecase)(nil).Get), ctx, id)
+}
+
+// GetForViewer mocks base method.
+func (m *MockVideoUsecase) GetForViewer(ctx context.Context, id, userID string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetForViewer", ctx, id, userID)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetForViewer indicates an expected call of GetForViewer.
+func (mr *MockVideoUsecaseMockRecorder) GetForViewer(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetForViewer", reflect.TypeOf((*MockVideoUsecase)(nil).GetForViewer), ctx, id, userID)
+}
+
+// GrantAccess mocks base method.
+func (m *MockVideoUsecase) GrantAccess(ctx context.Context, id string, caller *domain.Caller, granteeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GrantAccess", ctx, id, caller, granteeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// GrantAccess indicates an expected call of GrantAccess.
+func (mr *MockVideoUsecaseMockRecorder) GrantAccess(ctx, id, caller, granteeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GrantAccess", reflect.TypeOf((*MockVideoUsecase)(nil).GrantAccess), ctx, id, caller, granteeID)
+}
+
+// List mocks base method.
+func (m *MockVideoUsecase) List(ctx context.Context, query string, filter domain.VideoFilter) ([]*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, query, filter)
+	ret0, _ := ret[0].([]*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockVideoUsecaseMockRecorder) List(ctx, query, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockVideoUsecase)(nil).List), ctx, query, filter)
+}
+
+// ListAccess mocks base method.
+func (m *MockVideoUsecase) ListAccess(ctx context.Context, id string, caller *domain.Caller) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccess", ctx, id, caller)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccess indicates an expected call of ListAccess.
+func (mr *MockVideoUsecaseMockRecorder) ListAccess(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccess", reflect.TypeOf((*MockVideoUsecase)(nil).ListAccess), ctx, id, caller)
+}
+
+// ListShareLinks mocks base method.
+func (m *MockVideoUsecase) ListShareLinks(ctx context.Context, id string, caller *domain.Caller) ([]*domain.ShareLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListShareLinks", ctx, id, caller)
+	ret0, _ := ret[0].([]*domain.ShareLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListShareLinks indicates an expected call of ListShareLinks.
+func (mr *MockVideoUsecaseMockRecorder) ListShareLinks(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShareLinks", reflect.TypeOf((*MockVideoUsecase)(nil).ListShareLinks), ctx, id, caller)
+}
+
+// ListStorageCleanups mocks base method.
+func (m *MockVideoUsecase) ListStorageCleanups(ctx context.Context, limit int) ([]*domain.StorageCleanup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageCleanups", ctx, limit)
+	ret0, _ := ret[0].([]*domain.StorageCleanup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageCleanups indicates an expected call of ListStorageCleanups.
+func (mr *MockVideoUsecaseMockRecorder) ListStorageCleanups(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageCleanups", reflect.TypeOf((*MockVideoUsecase)(nil).ListStorageCleanups), ctx, limit)
+}
+
+// ListTrash mocks base method.
+func (m *MockVideoUsecase) ListTrash(ctx context.Context, userID string) ([]*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrash", ctx, userID)
+	ret0, _ := ret[0].([]*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrash indicates an expected call of ListTrash.
+func (mr *MockVideoUsecaseMockRecorder) ListTrash(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrash", reflect.TypeOf((*MockVideoUsecase)(nil).ListTrash), ctx, userID)
+}
+
+// PublishDue mocks base method.
+func (m *MockVideoUsecase) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishDue", ctx, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishDue indicates an expected call of PublishDue.
+func (mr *MockVideoUsecaseMockRecorder) PublishDue(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishDue", reflect.TypeOf((*MockVideoUsecase)(nil).PublishDue), ctx, now)
+}
+
+// PurgeTrash mocks base method.
+func (m *MockVideoUsecase) PurgeTrash(ctx context.Context, cutoff time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeTrash", ctx, cutoff)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeTrash indicates an expected call of PurgeTrash.
+func (mr *MockVideoUsecaseMockRecorder) PurgeTrash(ctx, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeTrash", reflect.TypeOf((*MockVideoUsecase)(nil).PurgeTrash), ctx, cutoff)
+}
+
+// ResolveShareLink mocks base method.
+func (m *MockVideoUsecase) ResolveShareLink(ctx context.Context, token, password string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveShareLink", ctx, token, password)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveShareLink indicates an expected call of ResolveShareLink.
+func (mr *MockVideoUsecaseMockRecorder) ResolveShareLink(ctx, token, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveShareLink", reflect.TypeOf((*MockVideoUsecase)(nil).ResolveShareLink), ctx, token, password)
+}
+
+// Restore mocks base method.
+func (m *MockVideoUsecase) Restore(ctx context.Context, id string, caller *domain.Caller) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, caller)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockVideoUsecaseMockRecorder) Restore(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockVideoUsecase)(nil).Restore), ctx, id, caller)
+}
+
+// RevokeAccess mocks base method.
+func (m *MockVideoUsecase) RevokeAccess(ctx context.Context, id string, caller *domain.Caller, granteeID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccess", ctx, id, caller, granteeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccess indicates an expected call of RevokeAccess.
+func (mr *MockVideoUsecaseMockRecorder) RevokeAccess(ctx, id, caller, granteeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccess", reflect.TypeOf((*MockVideoUsecase)(nil).RevokeAccess), ctx, id, caller, granteeID)
+}
+
+// RevokeShareLink mocks base method.
+func (m *MockVideoUsecase) RevokeShareLink(ctx context.Context, id string, caller *domain.Caller, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeShareLink", ctx, id, caller, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeShareLink indicates an expected call of RevokeShareLink.
+func (mr *MockVideoUsecaseMockRecorder) RevokeShareLink(ctx, id, caller, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeShareLink", reflect.TypeOf((*MockVideoUsecase)(nil).RevokeShareLink), ctx, id, caller, token)
+}
+
+// StartLiveStream mocks base method.
+func (m *MockVideoUsecase) StartLiveStream(ctx context.Context, streamKey string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartLiveStream", ctx, streamKey)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartLiveStream indicates an expected call of StartLiveStream.
+func (mr *MockVideoUsecaseMockRecorder) StartLiveStream(ctx, streamKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartLiveStream", reflect.TypeOf((*MockVideoUsecase)(nil).StartLiveStream), ctx, streamKey)
+}
+
+// Unpublish mocks base method.
+func (m *MockVideoUsecase) Unpublish(ctx context.Context, id string, caller *domain.Caller) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unpublish", ctx, id, caller)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unpublish indicates an expected call of Unpublish.
+func (mr *MockVideoUsecaseMockRecorder) Unpublish(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unpublish", reflect.TypeOf((*MockVideoUsecase)(nil).Unpublish), ctx, id, caller)
+}
+
+// Update mocks base method.
+func (m *MockVideoUsecase) Update(ctx context.Context, id string, caller *domain.Caller, update *domain.VideoUpdate) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, caller, update)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockVideoUsecaseMockRecorder) Update(ctx, id, caller, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVideoUsecase)(nil).Update), ctx, id, caller, update)
+}
+
+// UpdateLiveStatus mocks base method.
+func (m *MockVideoUsecase) UpdateLiveStatus(ctx context.Context, id, liveStatus string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLiveStatus", ctx, id, liveStatus)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLiveStatus indicates an expected call of UpdateLiveStatus.
+func (mr *MockVideoUsecaseMockRecorder) UpdateLiveStatus(ctx, id, liveStatus any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLiveStatus", reflect.TypeOf((*MockVideoUsecase)(nil).UpdateLiveStatus), ctx, id, liveStatus)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockVideoUsecase) UpdateStatus(ctx context.Context, id, status string, durationSeconds float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, id, status, durationSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockVideoUsecaseMockRecorder) UpdateStatus(ctx, id, status, durationSeconds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockVideoUsecase)(nil).UpdateStatus), ctx, id, status, durationSeconds)
+}
+
+// MockChannelUsecase is a mock of ChannelUsecase interface.
+type MockChannelUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChannelUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChannelUsecaseMockRecorder is the mock recorder for MockChannelUsecase.
+type MockChannelUsecaseMockRecorder struct {
+	mock *MockChannelUsecase
+}
+
+// NewMockChannelUsecase creates a new mock instance.
+func NewMockChannelUsecase(ctrl *gomock.Controller) *MockChannelUsecase {
+	mock := &MockChannelUsecase{ctrl: ctrl}
+	mock.recorder = &MockChannelUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChannelUsecase) EXPECT() *MockChannelUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m *MockChannelUsecase) AddMember(ctx context.Context, id string, caller *domain.Caller, memberID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMember", ctx, id, caller, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockChannelUsecaseMockRecorder) AddMember(ctx, id, caller, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockChannelUsecase)(nil).AddMember), ctx, id, caller, memberID)
+}
+
+// Create mocks base method.
+func (m *MockChannelUsecase) Create(ctx context.Context, caller *domain.Caller, handle, displayName, description string) (*domain.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, caller, handle, displayName, description)
+	ret0, _ := ret[0].(*domain.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockChannelUsecaseMockRecorder) Create(ctx, caller, handle, displayName, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockChannelUsecase)(nil).Create), ctx, caller, handle, displayName, description)
+}
+
+// Delete mocks base method.
+func (m *MockChannelUsecase) Delete(ctx context.Context, id string, caller *domain.Caller) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, caller)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockChannelUsecaseMockRecorder) Delete(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockChannelUsecase)(nil).Delete), ctx, id, caller)
+}
+
+// Get mocks base method.
+func (m *MockChannelUsecase) Get(ctx context.Context, id, handle string) (*domain.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, handle)
+	ret0, _ := ret[0].(*domain.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockChannelUsecaseMockRecorder) Get(ctx, id, handle any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockChannelUsecase)(nil).Get), ctx, id, handle)
+}
+
+// RemoveMember mocks base method.
+func (m *MockChannelUsecase) RemoveMember(ctx context.Context, id string, caller *domain.Caller, memberID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMember", ctx, id, caller, memberID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMember indicates an expected call of RemoveMember.
+func (mr *MockChannelUsecaseMockRecorder) RemoveMember(ctx, id, caller, memberID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMember", reflect.TypeOf((*MockChannelUsecase)(nil).RemoveMember), ctx, id, caller, memberID)
+}
+
+// SetImage mocks base method.
+func (m *MockChannelUsecase) SetImage(ctx context.Context, id, kind string, image *domain.ChannelImage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetImage", ctx, id, kind, image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetImage indicates an expected call of SetImage.
+func (mr *MockChannelUsecaseMockRecorder) SetImage(ctx, id, kind, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetImage", reflect.TypeOf((*MockChannelUsecase)(nil).SetImage), ctx, id, kind, image)
+}
+
+// Update mocks base method.
+func (m *MockChannelUsecase) Update(ctx context.Context, id string, caller *domain.Caller, update *domain.ChannelUpdate) (*domain.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, caller, update)
+	ret0, _ := ret[0].(*domain.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockChannelUsecaseMockRecorder) Update(ctx, id, caller, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockChannelUsecase)(nil).Update), ctx, id, caller, update)
+}
+
+// MockSubscriptionUsecase is a mock of SubscriptionUsecase interface.
+type MockSubscriptionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSubscriptionUsecaseMockRecorder is the mock recorder for MockSubscriptionUsecase.
+type MockSubscriptionUsecaseMockRecorder struct {
+	mock *MockSubscriptionUsecase
+}
+
+// NewMockSubscriptionUsecase creates a new mock instance.
+func NewMockSubscriptionUsecase(ctrl *gomock.Controller) *MockSubscriptionUsecase {
+	mock := &MockSubscriptionUsecase{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscriptionUsecase) EXPECT() *MockSubscriptionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Feed mocks base method.
+func (m *MockSubscriptionUsecase) Feed(ctx context.Context, caller *domain.Caller, pageToken string, pageSize int) ([]*domain.Video, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Feed", ctx, caller, pageToken, pageSize)
+	ret0, _ := ret[0].([]*domain.Video)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Feed indicates an expected call of Feed.
+func (mr *MockSubscriptionUsecaseMockRecorder) Feed(ctx, caller, pageToken, pageSize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Feed", reflect.TypeOf((*MockSubscriptionUsecase)(nil).Feed), ctx, caller, pageToken, pageSize)
+}
+
+// List mocks base method.
+func (m *MockSubscriptionUsecase) List(ctx context.Context, caller *domain.Caller) ([]*domain.Channel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, caller)
+	ret0, _ := ret[0].([]*domain.Channel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSubscriptionUsecaseMockRecorder) List(ctx, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSubscriptionUsecase)(nil).List), ctx, caller)
+}
+
+// Subscribe mocks base method.
+func (m *MockSubscriptionUsecase) Subscribe(ctx context.Context, channelID string, caller *domain.Caller) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, channelID, caller)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockSubscriptionUsecaseMockRecorder) Subscribe(ctx, channelID, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockSubscriptionUsecase)(nil).Subscribe), ctx, channelID, caller)
+}
+
+// Unsubscribe mocks base method.
+func (m *MockSubscriptionUsecase) Unsubscribe(ctx context.Context, channelID string, caller *domain.Caller) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unsubscribe", ctx, channelID, caller)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unsubscribe indicates an expected call of Unsubscribe.
+func (mr *MockSubscriptionUsecaseMockRecorder) Unsubscribe(ctx, channelID, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unsubscribe", reflect.TypeOf((*MockSubscriptionUsecase)(nil).Unsubscribe), ctx, channelID, caller)
+}
+
+// MockPlaylistUsecase is a mock of PlaylistUsecase interface.
+type MockPlaylistUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlaylistUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockPlaylistUsecaseMockRecorder is the mock recorder for MockPlaylistUsecase.
+type MockPlaylistUsecaseMockRecorder struct {
+	mock *MockPlaylistUsecase
+}
+
+// NewMockPlaylistUsecase creates a new mock instance.
+func NewMockPlaylistUsecase(ctrl *gomock.Controller) *MockPlaylistUsecase {
+	mock := &MockPlaylistUsecase{ctrl: ctrl}
+	mock.recorder = &MockPlaylistUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlaylistUsecase) EXPECT() *MockPlaylistUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AddCollaborator mocks base method.
+func (m *MockPlaylistUsecase) AddCollaborator(ctx context.Context, id string, caller *domain.Caller, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollaborator", ctx, id, caller, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCollaborator indicates an expected call of AddCollaborator.
+func (mr *MockPlaylistUsecaseMockRecorder) AddCollaborator(ctx, id, caller, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollaborator", reflect.TypeOf((*MockPlaylistUsecase)(nil).AddCollaborator), ctx, id, caller, userID)
+}
+
+// AddItem mocks base method.
+func (m *MockPlaylistUsecase) AddItem(ctx context.Context, id string, caller *domain.Caller, videoID string) (*domain.PlaylistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, id, caller, videoID)
+	ret0, _ := ret[0].(*domain.PlaylistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockPlaylistUsecaseMockRecorder) AddItem(ctx, id, caller, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockPlaylistUsecase)(nil).AddItem), ctx, id, caller, videoID)
+}
+
+// Create mocks base method.
+func (m *MockPlaylistUsecase) Create(ctx context.Context, caller *domain.Caller, title, description, visibility string) (*domain.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, caller, title, description, visibility)
+	ret0, _ := ret[0].(*domain.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPlaylistUsecaseMockRecorder) Create(ctx, caller, title, description, visibility any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPlaylistUsecase)(nil).Create), ctx, caller, title, description, visibility)
+}
+
+// Delete mocks base method.
+func (m *MockPlaylistUsecase) Delete(ctx context.Context, id string, caller *domain.Caller) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, caller)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockPlaylistUsecaseMockRecorder) Delete(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockPlaylistUsecase)(nil).Delete), ctx, id, caller)
+}
+
+// Get mocks base method.
+func (m *MockPlaylistUsecase) Get(ctx context.Context, id string, caller *domain.Caller) (*domain.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, caller)
+	ret0, _ := ret[0].(*domain.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockPlaylistUsecaseMockRecorder) Get(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockPlaylistUsecase)(nil).Get), ctx, id, caller)
+}
+
+// List mocks base method.
+func (m *MockPlaylistUsecase) List(ctx context.Context, ownerID string, caller *domain.Caller) ([]*domain.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ownerID, caller)
+	ret0, _ := ret[0].([]*domain.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockPlaylistUsecaseMockRecorder) List(ctx, ownerID, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockPlaylistUsecase)(nil).List), ctx, ownerID, caller)
+}
+
+// MoveItem mocks base method.
+func (m *MockPlaylistUsecase) MoveItem(ctx context.Context, id string, caller *domain.Caller, itemID, afterItemID string) (*domain.PlaylistItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveItem", ctx, id, caller, itemID, afterItemID)
+	ret0, _ := ret[0].(*domain.PlaylistItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveItem indicates an expected call of MoveItem.
+func (mr *MockPlaylistUsecaseMockRecorder) MoveItem(ctx, id, caller, itemID, afterItemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveItem", reflect.TypeOf((*MockPlaylistUsecase)(nil).MoveItem), ctx, id, caller, itemID, afterItemID)
+}
+
+// RemoveCollaborator mocks base method.
+func (m *MockPlaylistUsecase) RemoveCollaborator(ctx context.Context, id string, caller *domain.Caller, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCollaborator", ctx, id, caller, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveCollaborator indicates an expected call of RemoveCollaborator.
+func (mr *MockPlaylistUsecaseMockRecorder) RemoveCollaborator(ctx, id, caller, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCollaborator", reflect.TypeOf((*MockPlaylistUsecase)(nil).RemoveCollaborator), ctx, id, caller, userID)
+}
+
+// RemoveItem mocks base method.
+func (m *MockPlaylistUsecase) RemoveItem(ctx context.Context, id string, caller *domain.Caller, itemID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, id, caller, itemID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockPlaylistUsecaseMockRecorder) RemoveItem(ctx, id, caller, itemID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockPlaylistUsecase)(nil).RemoveItem), ctx, id, caller, itemID)
+}
+
+// Update mocks base method.
+func (m *MockPlaylistUsecase) Update(ctx context.Context, id string, caller *domain.Caller, update *domain.PlaylistUpdate) (*domain.Playlist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, caller, update)
+	ret0, _ := ret[0].(*domain.Playlist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockPlaylistUsecaseMockRecorder) Update(ctx, id, caller, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockPlaylistUsecase)(nil).Update), ctx, id, caller, update)
+}
+
+// MockSeriesUsecase is a mock of SeriesUsecase interface.
+type MockSeriesUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockSeriesUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSeriesUsecaseMockRecorder is the mock recorder for MockSeriesUsecase.
+type MockSeriesUsecaseMockRecorder struct {
+	mock *MockSeriesUsecase
+}
+
+// NewMockSeriesUsecase creates a new mock instance.
+func NewMockSeriesUsecase(ctrl *gomock.Controller) *MockSeriesUsecase {
+	mock := &MockSeriesUsecase{ctrl: ctrl}
+	mock.recorder = &MockSeriesUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSeriesUsecase) EXPECT() *MockSeriesUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockSeriesUsecase) Create(ctx context.Context, caller *domain.Caller, title, description string) (*domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, caller, title, description)
+	ret0, _ := ret[0].(*domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockSeriesUsecaseMockRecorder) Create(ctx, caller, title, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSeriesUsecase)(nil).Create), ctx, caller, title, description)
+}
+
+// Delete mocks base method.
+func (m *MockSeriesUsecase) Delete(ctx context.Context, id string, caller *domain.Caller) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id, caller)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSeriesUsecaseMockRecorder) Delete(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSeriesUsecase)(nil).Delete), ctx, id, caller)
+}
+
+// Get mocks base method.
+func (m *MockSeriesUsecase) Get(ctx context.Context, id string, caller *domain.Caller) (*domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id, caller)
+	ret0, _ := ret[0].(*domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSeriesUsecaseMockRecorder) Get(ctx, id, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSeriesUsecase)(nil).Get), ctx, id, caller)
+}
+
+// List mocks base method.
+func (m *MockSeriesUsecase) List(ctx context.Context, ownerID string) ([]*domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, ownerID)
+	ret0, _ := ret[0].([]*domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockSeriesUsecaseMockRecorder) List(ctx, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockSeriesUsecase)(nil).List), ctx, ownerID)
+}
+
+// NextEpisode mocks base method.
+func (m *MockSeriesUsecase) NextEpisode(ctx context.Context, id, afterVideoID, viewerID string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextEpisode", ctx, id, afterVideoID, viewerID)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextEpisode indicates an expected call of NextEpisode.
+func (mr *MockSeriesUsecaseMockRecorder) NextEpisode(ctx, id, afterVideoID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextEpisode", reflect.TypeOf((*MockSeriesUsecase)(nil).NextEpisode), ctx, id, afterVideoID, viewerID)
+}
+
+// RemoveEpisode mocks base method.
+func (m *MockSeriesUsecase) RemoveEpisode(ctx context.Context, id string, caller *domain.Caller, videoID string) (*domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveEpisode", ctx, id, caller, videoID)
+	ret0, _ := ret[0].(*domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveEpisode indicates an expected call of RemoveEpisode.
+func (mr *MockSeriesUsecaseMockRecorder) RemoveEpisode(ctx, id, caller, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveEpisode", reflect.TypeOf((*MockSeriesUsecase)(nil).RemoveEpisode), ctx, id, caller, videoID)
+}
+
+// SetCompleted mocks base method.
+func (m *MockSeriesUsecase) SetCompleted(ctx context.Context, id string, caller *domain.Caller, videoID string, completed bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCompleted", ctx, id, caller, videoID, completed)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCompleted indicates an expected call of SetCompleted.
+func (mr *MockSeriesUsecaseMockRecorder) SetCompleted(ctx, id, caller, videoID, completed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCompleted", reflect.TypeOf((*MockSeriesUsecase)(nil).SetCompleted), ctx, id, caller, videoID, completed)
+}
+
+// SetEpisode mocks base method.
+func (m *MockSeriesUsecase) SetEpisode(ctx context.Context, id string, caller *domain.Caller, videoID string, season, episode int) (*domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEpisode", ctx, id, caller, videoID, season, episode)
+	ret0, _ := ret[0].(*domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetEpisode indicates an expected call of SetEpisode.
+func (mr *MockSeriesUsecaseMockRecorder) SetEpisode(ctx, id, caller, videoID, season, episode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEpisode", reflect.TypeOf((*MockSeriesUsecase)(nil).SetEpisode), ctx, id, caller, videoID, season, episode)
+}
+
+// Update mocks base method.
+func (m *MockSeriesUsecase) Update(ctx context.Context, id string, caller *domain.Caller, update *domain.SeriesUpdate) (*domain.Series, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, caller, update)
+	ret0, _ := ret[0].(*domain.Series)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSeriesUsecaseMockRecorder) Update(ctx, id, caller, update any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockVideoUsecase)(nil).UpdateStatus), ctx, id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSeriesUsecase)(nil).Update), ctx, id, caller, update)
 }
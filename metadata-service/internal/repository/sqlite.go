@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"log"
+	"strings"
+	"time"
 
 	"github.com/athandoan/youtube/metadata-service/internal/domain"
-	_ "github.com/mattn/go-sqlite3"
+	"github.com/mattn/go-sqlite3"
 )
 
 type sqliteRepo struct {
@@ -32,7 +34,7 @@ func NewSQLiteRepository(dbPath string) (domain.VideoRepository, error) {
 		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
 	);
 
-	CREATE VIRTUAL TABLE IF NOT EXISTS videos_fts USING fts5(id UNINDEXED, title, description);
+	CREATE VIRTUAL TABLE IF NOT EXISTS videos_fts USING fts5(id UNINDEXED, title, description, tags);
 
 	CREATE TRIGGER IF NOT EXISTS videos_ai AFTER INSERT ON videos BEGIN
 		INSERT INTO videos_fts(id, title, description) VALUES (new.id, new.title, new.description);
@@ -46,6 +48,142 @@ func NewSQLiteRepository(dbPath string) (domain.VideoRepository, error) {
 		UPDATE videos_fts SET title = new.title, description = new.description WHERE id = new.id;
 	END;
 
+	CREATE TABLE IF NOT EXISTS video_tags (
+		video_id TEXT NOT NULL,
+		tag TEXT NOT NULL,
+		position INTEGER NOT NULL,
+		PRIMARY KEY (video_id, tag)
+	);
+
+	CREATE INDEX IF NOT EXISTS video_tags_tag ON video_tags(tag);
+
+	CREATE TRIGGER IF NOT EXISTS videos_ad_tags AFTER DELETE ON videos BEGIN
+		DELETE FROM video_tags WHERE video_id = old.id;
+	END;
+
+	CREATE TABLE IF NOT EXISTS video_access (
+		video_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (video_id, user_id)
+	);
+
+	CREATE TRIGGER IF NOT EXISTS videos_ad_access AFTER DELETE ON videos BEGIN
+		DELETE FROM video_access WHERE video_id = old.id;
+	END;
+
+	CREATE TABLE IF NOT EXISTS share_links (
+		token TEXT PRIMARY KEY,
+		video_id TEXT NOT NULL,
+		created_by TEXT NOT NULL,
+		expires_at INTEGER NOT NULL, -- unix seconds
+		max_views INTEGER NOT NULL DEFAULT 0,
+		views INTEGER NOT NULL DEFAULT 0,
+		password_hash TEXT NOT NULL DEFAULT '',
+		revoked_at INTEGER NOT NULL DEFAULT 0, -- unix seconds; 0 unless revoked
+		created_at INTEGER NOT NULL -- unix seconds
+	);
+
+	CREATE INDEX IF NOT EXISTS share_links_video ON share_links(video_id);
+
+	CREATE TRIGGER IF NOT EXISTS videos_ad_share_links AFTER DELETE ON videos BEGIN
+		DELETE FROM share_links WHERE video_id = old.id;
+	END;
+
+	CREATE TABLE IF NOT EXISTS storage_cleanups (
+		video_id TEXT PRIMARY KEY,
+		bucket TEXT NOT NULL,
+		prefix TEXT NOT NULL,
+		attempts INTEGER NOT NULL DEFAULT 0,
+		next_attempt_at INTEGER NOT NULL DEFAULT 0, -- unix seconds
+		last_error TEXT NOT NULL DEFAULT ''
+	);
+
+	CREATE TABLE IF NOT EXISTS stream_keys (
+		key TEXT PRIMARY KEY,
+		channel_id TEXT NOT NULL,
+		title TEXT NOT NULL DEFAULT '',
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP
+	);
+
+	CREATE TABLE IF NOT EXISTS channels (
+		id TEXT PRIMARY KEY,
+		handle TEXT NOT NULL UNIQUE,
+		display_name TEXT NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		owner_id TEXT NOT NULL,
+		avatar_bucket TEXT NOT NULL DEFAULT '',
+		avatar_key TEXT NOT NULL DEFAULT '', -- empty without an avatar
+		banner_bucket TEXT NOT NULL DEFAULT '',
+		banner_key TEXT NOT NULL DEFAULT '', -- empty without a banner
+		created_at INTEGER NOT NULL -- unix seconds
+	);
+
+	CREATE TABLE IF NOT EXISTS channel_members (
+		channel_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (channel_id, user_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS playlists (
+		id TEXT PRIMARY KEY,
+		owner_id TEXT NOT NULL,
+		title TEXT NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		visibility TEXT NOT NULL DEFAULT 'public',
+		created_at INTEGER NOT NULL -- unix seconds
+	);
+
+	CREATE INDEX IF NOT EXISTS playlists_owner ON playlists(owner_id, created_at);
+
+	-- Positions are unique per playlist, so concurrent edits that pick the same one fail
+	-- instead of leaving an order that depends on tie-breaking
+	CREATE TABLE IF NOT EXISTS playlist_items (
+		id TEXT PRIMARY KEY,
+		playlist_id TEXT NOT NULL,
+		video_id TEXT NOT NULL,
+		position TEXT NOT NULL,
+		added_by TEXT NOT NULL,
+		added_at INTEGER NOT NULL, -- unix seconds
+		UNIQUE (playlist_id, position)
+	);
+
+	CREATE INDEX IF NOT EXISTS playlist_items_video ON playlist_items(video_id);
+
+	CREATE TABLE IF NOT EXISTS playlist_collaborators (
+		playlist_id TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		created_at DATETIME DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (playlist_id, user_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS series (
+		id TEXT PRIMARY KEY,
+		owner_id TEXT NOT NULL,
+		title TEXT NOT NULL,
+		description TEXT NOT NULL DEFAULT '',
+		created_at INTEGER NOT NULL -- unix seconds
+	);
+
+	CREATE INDEX IF NOT EXISTS series_owner ON series(owner_id, created_at);
+
+	CREATE TABLE IF NOT EXISTS episode_completions (
+		user_id TEXT NOT NULL,
+		video_id TEXT NOT NULL,
+		completed_at INTEGER NOT NULL, -- unix seconds
+		PRIMARY KEY (user_id, video_id)
+	);
+
+	CREATE TABLE IF NOT EXISTS subscriptions (
+		user_id TEXT NOT NULL,
+		channel_id TEXT NOT NULL,
+		created_at INTEGER NOT NULL, -- unix seconds
+		PRIMARY KEY (user_id, channel_id)
+	);
+
+	CREATE INDEX IF NOT EXISTS subscriptions_channel ON subscriptions(channel_id);
+
 	INSERT INTO videos_fts(id, title, description) 
 	SELECT id, title, description FROM videos 
 	WHERE id NOT IN (SELECT id FROM videos_fts);
@@ -54,51 +192,265 @@ func NewSQLiteRepository(dbPath string) (domain.VideoRepository, error) {
 		return nil, fmt.Errorf("failed to create schema: %w", err)
 	}
 
+	// Columns added after the initial schema; existing databases are migrated in place.
+	columns := []struct{ name, definition string }{
+		{"owner_id", "TEXT NOT NULL DEFAULT ''"},
+		{"allow_download", "INTEGER NOT NULL DEFAULT 0"},
+		{"live_status", "TEXT NOT NULL DEFAULT ''"},
+		{"duration_seconds", "REAL NOT NULL DEFAULT 0"},
+		{"publish_at", "INTEGER NOT NULL DEFAULT 0"}, // unix seconds, 0 when published on creation
+		{"premiere", "INTEGER NOT NULL DEFAULT 0"},
+		{"published", "INTEGER NOT NULL DEFAULT 1"},
+		{"deleted_at", "INTEGER NOT NULL DEFAULT 0"}, // unix seconds, 0 unless trashed
+		{"category", "TEXT NOT NULL DEFAULT ''"},
+		{"visibility", "TEXT NOT NULL DEFAULT 'public'"},
+		{"channel_id", "TEXT NOT NULL DEFAULT ''"},
+		{"series_id", "TEXT NOT NULL DEFAULT ''"},
+		{"season", "INTEGER NOT NULL DEFAULT 0"},
+		{"episode", "INTEGER NOT NULL DEFAULT 0"},
+		{"published_at", "INTEGER NOT NULL DEFAULT 0"}, // unix seconds the video was first listed at, 0 until then
+	}
+	for _, c := range columns {
+		if err := addColumnIfMissing(db, "videos", c.name, c.definition); err != nil {
+			return nil, fmt.Errorf("failed to migrate schema: %w", err)
+		}
+	}
+	if err := addColumnIfMissing(db, "channels", "subscriber_count", "INTEGER NOT NULL DEFAULT 0"); err != nil {
+		return nil, fmt.Errorf("failed to migrate schema: %w", err)
+	}
+	if err := addColumnIfMissing(db, "stream_keys", "owner_id", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return nil, fmt.Errorf("failed to migrate schema: %w", err)
+	}
+	// Keys from before owner_id were issued to users under their user ID, outside any channel
+	if _, err := db.Exec("UPDATE stream_keys SET owner_id = channel_id, channel_id = '' WHERE owner_id = ''"); err != nil {
+		return nil, fmt.Errorf("failed to migrate schema: %w", err)
+	}
+	// Videos listed before published_at count as published at their publish_at, or on creation
+	if _, err := db.Exec("UPDATE videos SET published_at = CASE WHEN publish_at != 0 THEN publish_at ELSE CAST(strftime('%s', created_at) AS INTEGER) END WHERE published_at = 0 AND status = 'ready' AND published = 1"); err != nil {
+		return nil, fmt.Errorf("failed to migrate schema: %w", err)
+	}
+	for _, index := range []string{
+		"CREATE INDEX IF NOT EXISTS videos_category ON videos(category)",
+		"CREATE INDEX IF NOT EXISTS videos_channel ON videos(channel_id)",
+		// Also lists a series' episodes in order
+		"CREATE UNIQUE INDEX IF NOT EXISTS videos_episode ON videos(series_id, season, episode) WHERE series_id != ''",
+		// Only holds listed videos, newest last per channel, so feeds read just the rows they return
+		"CREATE INDEX IF NOT EXISTS videos_channel_feed ON videos(channel_id, published_at, id) WHERE " + listedVideos,
+	} {
+		if _, err := db.Exec(index); err != nil {
+			return nil, fmt.Errorf("failed to migrate schema: %w", err)
+		}
+	}
+	if err := migrateSearchIndex(db); err != nil {
+		return nil, fmt.Errorf("failed to migrate search index: %w", err)
+	}
+
 	return &sqliteRepo{DB: db}, nil
 }
 
-func (r *sqliteRepo) List(ctx context.Context, query string) ([]*domain.Video, error) {
-	sqlQuery := "SELECT id, title, status, created_at, bucket_name, object_key FROM videos WHERE status = 'ready'"
-	var rows *sql.Rows
-	var err error
+func addColumnIfMissing(db *sql.DB, table, column, definition string) error {
+	rows, err := db.Query(fmt.Sprintf("SELECT name FROM pragma_table_info('%s')", table))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		if name == column {
+			return nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	_, err = db.Exec(fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s %s", table, column, definition))
+	return err
+}
+
+// migrateSearchIndex rebuilds a search index created before tags were searchable. FTS5
+// tables can't gain columns, so the index is recreated and refilled from the videos.
+func migrateSearchIndex(db *sql.DB) error {
+	var n int
+	if err := db.QueryRow("SELECT COUNT(*) FROM pragma_table_info('videos_fts') WHERE name = 'tags'").Scan(&n); err != nil {
+		return err
+	}
+	if n > 0 {
+		return nil
+	}
+
+	_, err := db.Exec(`
+	DROP TABLE videos_fts;
+	CREATE VIRTUAL TABLE videos_fts USING fts5(id UNINDEXED, title, description, tags);
+	INSERT INTO videos_fts(id, title, description) SELECT id, title, description FROM videos;
+	`)
+	return err
+}
+
+// videoColumns qualifies every column so the search query can join videos_fts, which has
+// columns of the same names. Tags come back comma-separated in the owner's order.
+const videoColumns = `videos.id, videos.title, videos.status, videos.created_at, videos.bucket_name, videos.object_key,
+	videos.owner_id, videos.allow_download, videos.live_status, videos.duration_seconds, videos.publish_at, videos.premiere,
+	videos.deleted_at, COALESCE(videos.description, ''), videos.category, videos.visibility, videos.channel_id,
+	videos.series_id, videos.season, videos.episode,
+	COALESCE((SELECT group_concat(tag, ',') FROM (SELECT tag FROM video_tags WHERE video_id = videos.id ORDER BY position)), '')`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanVideo(row scanner) (*domain.Video, error) {
+	var v domain.Video
+	var publishAt, deletedAt int64
+	var tags string
+	err := row.Scan(&v.ID, &v.Title, &v.Status, &v.CreatedAt, &v.BucketName, &v.ObjectKey, &v.OwnerID, &v.AllowDownload, &v.LiveStatus, &v.DurationSeconds, &publishAt, &v.Premiere, &deletedAt,
+		&v.Description, &v.Category, &v.Visibility, &v.ChannelID, &v.SeriesID, &v.Season, &v.Episode, &tags)
+	if err != nil {
+		return nil, err
+	}
+	if tags != "" {
+		v.Tags = strings.Split(tags, ",")
+	}
+	if publishAt != 0 {
+		v.PublishAt = time.Unix(publishAt, 0).UTC()
+	}
+	if deletedAt != 0 {
+		v.DeletedAt = time.Unix(deletedAt, 0).UTC()
+	}
+	return &v, nil
+}
+
+// listedVideos matches the videos listings show. Scheduled videos stay out of listings until
+// the publisher lists them; trashed, unlisted and private ones for good.
+const listedVideos = "status = 'ready' AND published = 1 AND deleted_at = 0 AND visibility = 'public'"
 
+func (r *sqliteRepo) List(ctx context.Context, query string, filter domain.VideoFilter) ([]*domain.Video, error) {
+	from := " FROM videos"
+	where := " WHERE " + listedVideos
+	var args []any
+	if filter.Tag != "" {
+		where += " AND videos.id IN (SELECT video_id FROM video_tags WHERE tag = ?)"
+		args = append(args, filter.Tag)
+	}
+	if filter.Category != "" {
+		where += " AND videos.category = ?"
+		args = append(args, filter.Category)
+	}
+	if filter.ChannelID != "" {
+		where += " AND videos.channel_id = ?"
+		args = append(args, filter.ChannelID)
+	}
+	order := ""
 	if query != "" {
-		sqlQuery = `
-			SELECT v.id, v.title, v.status, v.created_at, v.bucket_name, v.object_key 
-			FROM videos v 
-			JOIN videos_fts f ON v.id = f.id 
-			WHERE v.status = 'ready' AND videos_fts MATCH ? 
-			ORDER BY rank`
-		rows, err = r.DB.QueryContext(ctx, sqlQuery, query)
-	} else {
-		rows, err = r.DB.QueryContext(ctx, sqlQuery)
+		from += " JOIN videos_fts ON videos.id = videos_fts.id"
+		where += " AND videos_fts MATCH ?"
+		args = append(args, query)
+		order = " ORDER BY rank"
 	}
 
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+videoColumns+from+where+order, args...)
 	if err != nil {
 		return nil, err
 	}
+	return scanVideos(rows)
+}
+
+func scanVideos(rows *sql.Rows) ([]*domain.Video, error) {
 	defer func() { _ = rows.Close() }()
 
 	var videos []*domain.Video
 	for rows.Next() {
-		var v domain.Video
-		if err := rows.Scan(&v.ID, &v.Title, &v.Status, &v.CreatedAt, &v.BucketName, &v.ObjectKey); err != nil {
+		v, err := scanVideo(rows)
+		if err != nil {
 			log.Println("Scan error:", err)
 			continue
 		}
-		videos = append(videos, &v)
+		videos = append(videos, v)
 	}
 	return videos, nil
 }
 
 func (r *sqliteRepo) Create(ctx context.Context, v *domain.Video) error {
-	_, err := r.DB.ExecContext(ctx, "INSERT INTO videos (id, title, bucket_name, object_key, status) VALUES (?, ?, ?, ?, 'pending')",
-		v.ID, v.Title, v.BucketName, v.ObjectKey)
+	visibility := v.Visibility
+	if visibility == "" {
+		visibility = domain.VisibilityPublic
+	}
+	// Uploads are published once they are ready and scheduled videos once they are due; only
+	// live streams are listed on creation
+	var publishAt, publishedAt int64
+	if !v.PublishAt.IsZero() {
+		publishAt = v.PublishAt.Unix()
+	} else if v.Status == "ready" {
+		publishedAt = time.Now().Unix()
+	}
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO videos (id, title, bucket_name, object_key, status, owner_id, channel_id, allow_download, live_status, publish_at, premiere, published, visibility, published_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		v.ID, v.Title, v.BucketName, v.ObjectKey, v.Status, v.OwnerID, v.ChannelID, v.AllowDownload, v.LiveStatus, publishAt, v.Premiere, v.PublishAt.IsZero(), visibility, publishedAt)
 	return err
 }
 
+func (r *sqliteRepo) Update(ctx context.Context, id string, u *domain.VideoUpdate) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// videos_au keeps the search index in step with title and description
+	var sets []string
+	var args []any
+	if u.Title != nil {
+		sets = append(sets, "title = ?")
+		args = append(args, *u.Title)
+	}
+	if u.Description != nil {
+		sets = append(sets, "description = ?")
+		args = append(args, *u.Description)
+	}
+	if u.Category != nil {
+		sets = append(sets, "category = ?")
+		args = append(args, *u.Category)
+	}
+	if u.Visibility != nil {
+		sets = append(sets, "visibility = ?")
+		args = append(args, *u.Visibility)
+	}
+	if len(sets) > 0 {
+		res, err := tx.ExecContext(ctx, "UPDATE videos SET "+strings.Join(sets, ", ")+" WHERE id = ? AND deleted_at = 0", append(args, id)...)
+		if err != nil {
+			return err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return domain.ErrVideoNotFound
+		}
+	}
+
+	if u.Tags != nil {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM video_tags WHERE video_id = ?", id); err != nil {
+			return err
+		}
+		for i, tag := range *u.Tags {
+			if _, err := tx.ExecContext(ctx, "INSERT INTO video_tags (video_id, tag, position) VALUES (?, ?, ?)", id, tag, i); err != nil {
+				return err
+			}
+		}
+		if _, err := tx.ExecContext(ctx, "UPDATE videos_fts SET tags = ? WHERE id = ?", strings.Join(*u.Tags, " "), id); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func (r *sqliteRepo) UpdateStatus(ctx context.Context, id string, status string) error {
-	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET status = ? WHERE id = ?", status, id)
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET status = ?, published_at = CASE WHEN ? = 'ready' AND published = 1 AND published_at = 0 THEN ? ELSE published_at END WHERE id = ?",
+		status, status, time.Now().Unix(), id)
 	if err != nil {
 		return err
 	}
@@ -107,20 +459,1007 @@ func (r *sqliteRepo) UpdateStatus(ctx context.Context, id string, status string)
 		return err
 	}
 	if rows == 0 {
-		return fmt.Errorf("video with id %s not found", id)
+		return domain.ErrVideoNotFound
 	}
 	return nil
 }
 
 func (r *sqliteRepo) Get(ctx context.Context, id string) (*domain.Video, error) {
-	var v domain.Video
-	err := r.DB.QueryRowContext(ctx, "SELECT id, title, status, created_at, bucket_name, object_key FROM videos WHERE id = ?", id).
-		Scan(&v.ID, &v.Title, &v.Status, &v.CreatedAt, &v.BucketName, &v.ObjectKey)
+	v, err := scanVideo(r.DB.QueryRowContext(ctx, "SELECT "+videoColumns+" FROM videos WHERE id = ? AND deleted_at = 0", id))
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("video not found")
+			return nil, domain.ErrVideoNotFound
 		}
 		return nil, err
 	}
-	return &v, nil
+	return v, nil
+}
+
+func (r *sqliteRepo) UpdateLiveStatus(ctx context.Context, id string, liveStatus string) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET live_status = ? WHERE id = ?", liveStatus, id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return fmt.Errorf("video with id %s not found", id)
+	}
+	return nil
+}
+
+func (r *sqliteRepo) UpdateDuration(ctx context.Context, id string, seconds float64) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET duration_seconds = ? WHERE id = ?", seconds, id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrVideoNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) UpdateRecording(ctx context.Context, id string, seconds float64, bucket, objectKey string) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET duration_seconds = ?, bucket_name = ?, object_key = ? WHERE id = ?", seconds, bucket, objectKey, id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrVideoNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) ListDueForPublish(ctx context.Context, now time.Time) ([]*domain.Video, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+videoColumns+" FROM videos WHERE published = 0 AND status = 'ready' AND deleted_at = 0 AND publish_at <= ? ORDER BY publish_at", now.Unix())
+	if err != nil {
+		return nil, err
+	}
+	return scanVideos(rows)
+}
+
+func (r *sqliteRepo) MarkPublished(ctx context.Context, id string) (bool, error) {
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET published = 1, published_at = ? WHERE id = ? AND published = 0", time.Now().Unix(), id)
+	if err != nil {
+		return false, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *sqliteRepo) CreateStreamKey(ctx context.Context, k *domain.StreamKey) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO stream_keys (key, owner_id, channel_id, title) VALUES (?, ?, ?, ?)", k.Key, k.OwnerID, k.ChannelID, k.Title)
+	return err
+}
+
+func (r *sqliteRepo) GetStreamKey(ctx context.Context, key string) (*domain.StreamKey, error) {
+	var k domain.StreamKey
+	err := r.DB.QueryRowContext(ctx, "SELECT key, owner_id, channel_id, title, created_at FROM stream_keys WHERE key = ?", key).
+		Scan(&k.Key, &k.OwnerID, &k.ChannelID, &k.Title, &k.CreatedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrStreamKeyNotFound
+		}
+		return nil, err
+	}
+	return &k, nil
+}
+
+func (r *sqliteRepo) Trash(ctx context.Context, id string, at time.Time) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET deleted_at = ? WHERE id = ? AND deleted_at = 0", at.Unix(), id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrVideoNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) GetTrashed(ctx context.Context, id string) (*domain.Video, error) {
+	v, err := scanVideo(r.DB.QueryRowContext(ctx, "SELECT "+videoColumns+" FROM videos WHERE id = ? AND deleted_at != 0", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrVideoNotFound
+		}
+		return nil, err
+	}
+	return v, nil
+}
+
+func (r *sqliteRepo) Restore(ctx context.Context, id string) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET deleted_at = 0 WHERE id = ? AND deleted_at != 0", id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrVideoNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) ListTrash(ctx context.Context, ownerID string) ([]*domain.Video, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+videoColumns+" FROM videos WHERE owner_id = ? AND deleted_at != 0 ORDER BY deleted_at DESC", ownerID)
+	if err != nil {
+		return nil, err
+	}
+	return scanVideos(rows)
+}
+
+func (r *sqliteRepo) ListTrashedBefore(ctx context.Context, cutoff time.Time, limit int) ([]*domain.Video, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+videoColumns+" FROM videos WHERE deleted_at != 0 AND deleted_at < ? ORDER BY deleted_at LIMIT ?", cutoff.Unix(), limit)
+	if err != nil {
+		return nil, err
+	}
+	return scanVideos(rows)
+}
+
+func (r *sqliteRepo) GrantAccess(ctx context.Context, videoID, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT OR IGNORE INTO video_access (video_id, user_id) VALUES (?, ?)", videoID, userID)
+	return err
+}
+
+func (r *sqliteRepo) RevokeAccess(ctx context.Context, videoID, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM video_access WHERE video_id = ? AND user_id = ?", videoID, userID)
+	return err
+}
+
+func (r *sqliteRepo) HasAccess(ctx context.Context, videoID, userID string) (bool, error) {
+	var n int
+	err := r.DB.QueryRowContext(ctx, "SELECT COUNT(*) FROM video_access WHERE video_id = ? AND user_id = ?", videoID, userID).Scan(&n)
+	return n > 0, err
+}
+
+func (r *sqliteRepo) ListAccess(ctx context.Context, videoID string) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT user_id FROM video_access WHERE video_id = ? ORDER BY created_at, user_id", videoID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var users []string
+	for rows.Next() {
+		var u string
+		if err := rows.Scan(&u); err != nil {
+			return nil, err
+		}
+		users = append(users, u)
+	}
+	return users, rows.Err()
+}
+
+const shareLinkColumns = "token, video_id, created_by, expires_at, max_views, views, password_hash, revoked_at, created_at"
+
+func scanShareLink(row scanner) (*domain.ShareLink, error) {
+	var l domain.ShareLink
+	var expiresAt, revokedAt, createdAt int64
+	if err := row.Scan(&l.Token, &l.VideoID, &l.CreatedBy, &expiresAt, &l.MaxViews, &l.Views, &l.PasswordHash, &revokedAt, &createdAt); err != nil {
+		return nil, err
+	}
+	l.ExpiresAt = time.Unix(expiresAt, 0).UTC()
+	if revokedAt != 0 {
+		l.RevokedAt = time.Unix(revokedAt, 0).UTC()
+	}
+	l.CreatedAt = time.Unix(createdAt, 0).UTC()
+	return &l, nil
+}
+
+func (r *sqliteRepo) CreateShareLink(ctx context.Context, l *domain.ShareLink) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO share_links ("+shareLinkColumns+") VALUES (?, ?, ?, ?, ?, 0, ?, 0, ?)",
+		l.Token, l.VideoID, l.CreatedBy, l.ExpiresAt.Unix(), l.MaxViews, l.PasswordHash, l.CreatedAt.Unix())
+	return err
+}
+
+func (r *sqliteRepo) GetShareLink(ctx context.Context, token string) (*domain.ShareLink, error) {
+	l, err := scanShareLink(r.DB.QueryRowContext(ctx, "SELECT "+shareLinkColumns+" FROM share_links WHERE token = ?", token))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrShareLinkNotFound
+	}
+	return l, err
+}
+
+func (r *sqliteRepo) ListShareLinks(ctx context.Context, videoID string) ([]*domain.ShareLink, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+shareLinkColumns+" FROM share_links WHERE video_id = ? ORDER BY created_at DESC, token", videoID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var links []*domain.ShareLink
+	for rows.Next() {
+		l, err := scanShareLink(rows)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, l)
+	}
+	return links, rows.Err()
+}
+
+func (r *sqliteRepo) RevokeShareLink(ctx context.Context, videoID, token string, at time.Time) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE share_links SET revoked_at = ? WHERE token = ? AND video_id = ? AND revoked_at = 0", at.Unix(), token, videoID)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrShareLinkNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) ConsumeShareLinkView(ctx context.Context, token string, now time.Time) (bool, error) {
+	res, err := r.DB.ExecContext(ctx, `UPDATE share_links SET views = views + 1
+		WHERE token = ? AND revoked_at = 0 AND expires_at > ? AND (max_views = 0 OR views < max_views)`, token, now.Unix())
+	if err != nil {
+		return false, err
+	}
+	rows, err := res.RowsAffected()
+	return rows > 0, err
+}
+
+func (r *sqliteRepo) Delete(ctx context.Context, id string, cleanup *domain.StorageCleanup) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	// videos_ad drops the search entry along with the row
+	res, err := tx.ExecContext(ctx, "DELETE FROM videos WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrVideoNotFound
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM playlist_items WHERE video_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM episode_completions WHERE video_id = ?", id); err != nil {
+		return err
+	}
+
+	if cleanup != nil {
+		_, err := tx.ExecContext(ctx, "INSERT INTO storage_cleanups (video_id, bucket, prefix, next_attempt_at) VALUES (?, ?, ?, ?)",
+			cleanup.VideoID, cleanup.Bucket, cleanup.Prefix, cleanup.NextAttemptAt.Unix())
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+const cleanupColumns = "video_id, bucket, prefix, attempts, next_attempt_at, last_error"
+
+func scanStorageCleanup(row scanner) (*domain.StorageCleanup, error) {
+	var c domain.StorageCleanup
+	var next int64
+	if err := row.Scan(&c.VideoID, &c.Bucket, &c.Prefix, &c.Attempts, &next, &c.LastError); err != nil {
+		return nil, err
+	}
+	c.NextAttemptAt = time.Unix(next, 0).UTC()
+	return &c, nil
+}
+
+func (r *sqliteRepo) GetStorageCleanup(ctx context.Context, videoID string) (*domain.StorageCleanup, error) {
+	c, err := scanStorageCleanup(r.DB.QueryRowContext(ctx, "SELECT "+cleanupColumns+" FROM storage_cleanups WHERE video_id = ?", videoID))
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("no storage cleanup for video %s", videoID)
+	}
+	return c, err
+}
+
+func (r *sqliteRepo) ListStorageCleanups(ctx context.Context, now time.Time, limit int) ([]*domain.StorageCleanup, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+cleanupColumns+" FROM storage_cleanups WHERE next_attempt_at <= ? ORDER BY next_attempt_at LIMIT ?", now.Unix(), limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var cleanups []*domain.StorageCleanup
+	for rows.Next() {
+		c, err := scanStorageCleanup(rows)
+		if err != nil {
+			return nil, err
+		}
+		cleanups = append(cleanups, c)
+	}
+	return cleanups, rows.Err()
+}
+
+func (r *sqliteRepo) DeleteStorageCleanup(ctx context.Context, videoID string) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM storage_cleanups WHERE video_id = ?", videoID)
+	return err
+}
+
+func (r *sqliteRepo) RescheduleStorageCleanup(ctx context.Context, videoID string, next time.Time, lastError string) error {
+	_, err := r.DB.ExecContext(ctx, "UPDATE storage_cleanups SET attempts = attempts + 1, next_attempt_at = ?, last_error = ? WHERE video_id = ?",
+		next.Unix(), lastError, videoID)
+	return err
+}
+
+const channelColumns = "id, handle, display_name, description, owner_id, avatar_bucket, avatar_key, banner_bucket, banner_key, created_at, subscriber_count"
+
+func scanChannel(row scanner) (*domain.Channel, error) {
+	var c domain.Channel
+	var avatar, banner domain.ChannelImage
+	var createdAt int64
+	err := row.Scan(&c.ID, &c.Handle, &c.DisplayName, &c.Description, &c.OwnerID, &avatar.Bucket, &avatar.ObjectKey,
+		&banner.Bucket, &banner.ObjectKey, &createdAt, &c.SubscriberCount)
+	if err != nil {
+		return nil, err
+	}
+	if avatar.ObjectKey != "" {
+		c.Avatar = &avatar
+	}
+	if banner.ObjectKey != "" {
+		c.Banner = &banner
+	}
+	c.CreatedAt = time.Unix(createdAt, 0).UTC()
+	return &c, nil
+}
+
+func (r *sqliteRepo) CreateChannel(ctx context.Context, c *domain.Channel) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO channels (id, handle, display_name, description, owner_id, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		c.ID, c.Handle, c.DisplayName, c.Description, c.OwnerID, c.CreatedAt.Unix())
+	if isUniqueViolation(err) {
+		return domain.ErrHandleTaken
+	}
+	return err
+}
+
+func (r *sqliteRepo) GetChannel(ctx context.Context, id string) (*domain.Channel, error) {
+	return r.getChannel(ctx, "id = ?", id)
+}
+
+func (r *sqliteRepo) GetChannelByHandle(ctx context.Context, handle string) (*domain.Channel, error) {
+	return r.getChannel(ctx, "handle = ?", handle)
+}
+
+func (r *sqliteRepo) getChannel(ctx context.Context, where string, arg any) (*domain.Channel, error) {
+	c, err := scanChannel(r.DB.QueryRowContext(ctx, "SELECT "+channelColumns+" FROM channels WHERE "+where, arg))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrChannelNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, "SELECT user_id FROM channel_members WHERE channel_id = ? ORDER BY created_at, user_id", c.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var u string
+		if err := rows.Scan(&u); err != nil {
+			return nil, err
+		}
+		c.MemberIDs = append(c.MemberIDs, u)
+	}
+	return c, rows.Err()
+}
+
+func (r *sqliteRepo) UpdateChannel(ctx context.Context, id string, u *domain.ChannelUpdate) error {
+	var sets []string
+	var args []any
+	if u.DisplayName != nil {
+		sets = append(sets, "display_name = ?")
+		args = append(args, *u.DisplayName)
+	}
+	if u.Description != nil {
+		sets = append(sets, "description = ?")
+		args = append(args, *u.Description)
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	res, err := r.DB.ExecContext(ctx, "UPDATE channels SET "+strings.Join(sets, ", ")+" WHERE id = ?", append(args, id)...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrChannelNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) DeleteChannel(ctx context.Context, id string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var avatarBucket, avatarKey, bannerBucket, bannerKey string
+	err = tx.QueryRowContext(ctx, "SELECT avatar_bucket, avatar_key, banner_bucket, banner_key FROM channels WHERE id = ?", id).
+		Scan(&avatarBucket, &avatarKey, &bannerBucket, &bannerKey)
+	if err == sql.ErrNoRows {
+		return domain.ErrChannelNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if err := queueImageCleanup(ctx, tx, avatarBucket, avatarKey); err != nil {
+		return err
+	}
+	if err := queueImageCleanup(ctx, tx, bannerBucket, bannerKey); err != nil {
+		return err
+	}
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM channels WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrChannelNotFound
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM channel_members WHERE channel_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM subscriptions WHERE channel_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE videos SET channel_id = '' WHERE channel_id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *sqliteRepo) AddChannelMember(ctx context.Context, channelID, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT OR IGNORE INTO channel_members (channel_id, user_id) VALUES (?, ?)", channelID, userID)
+	return err
+}
+
+func (r *sqliteRepo) RemoveChannelMember(ctx context.Context, channelID, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM channel_members WHERE channel_id = ? AND user_id = ?", channelID, userID)
+	return err
+}
+
+func (r *sqliteRepo) SetChannelImage(ctx context.Context, channelID, kind string, image *domain.ChannelImage) error {
+	var bucketColumn, keyColumn string
+	switch kind {
+	case domain.ChannelImageAvatar:
+		bucketColumn, keyColumn = "avatar_bucket", "avatar_key"
+	case domain.ChannelImageBanner:
+		bucketColumn, keyColumn = "banner_bucket", "banner_key"
+	default:
+		return fmt.Errorf("%w: unknown image kind %q", domain.ErrInvalidChannel, kind)
+	}
+
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var oldBucket, oldKey string
+	err = tx.QueryRowContext(ctx, "SELECT "+bucketColumn+", "+keyColumn+" FROM channels WHERE id = ?", channelID).
+		Scan(&oldBucket, &oldKey)
+	if err == sql.ErrNoRows {
+		return domain.ErrChannelNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE channels SET "+bucketColumn+" = ?, "+keyColumn+" = ? WHERE id = ?",
+		image.Bucket, image.ObjectKey, channelID); err != nil {
+		return err
+	}
+	if oldBucket != image.Bucket || oldKey != image.ObjectKey {
+		if err := queueImageCleanup(ctx, tx, oldBucket, oldKey); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+// queueImageCleanup schedules a channel image that is no longer used for removal from
+// storage. The cleanup is keyed by the object key, which has no trailing slash, so only
+// that object is removed; it is due right away.
+func queueImageCleanup(ctx context.Context, tx *sql.Tx, bucket, key string) error {
+	if key == "" {
+		return nil
+	}
+	_, err := tx.ExecContext(ctx, "INSERT OR REPLACE INTO storage_cleanups (video_id, bucket, prefix) VALUES (?, ?, ?)", key, bucket, key)
+	return err
+}
+
+func (r *sqliteRepo) Subscribe(ctx context.Context, userID, channelID string, at time.Time) error {
+	return r.updateSubscription(ctx, channelID, 1, "INSERT OR IGNORE INTO subscriptions (user_id, channel_id, created_at) VALUES (?, ?, ?)",
+		userID, channelID, at.Unix())
+}
+
+func (r *sqliteRepo) Unsubscribe(ctx context.Context, userID, channelID string) error {
+	return r.updateSubscription(ctx, channelID, -1, "DELETE FROM subscriptions WHERE user_id = ? AND channel_id = ?", userID, channelID)
+}
+
+// updateSubscription runs a statement adding or removing a subscription and, if it changed
+// anything, moves the channel's subscriber count by delta in the same transaction. Counting
+// the subscriptions on every read would get slow for popular channels.
+func (r *sqliteRepo) updateSubscription(ctx context.Context, channelID string, delta int, query string, args ...any) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return nil
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE channels SET subscriber_count = subscriber_count + ? WHERE id = ?", delta, channelID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *sqliteRepo) ListSubscriptions(ctx context.Context, userID string) ([]*domain.Channel, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+channelColumns+" FROM channels JOIN (SELECT channel_id, created_at AS subscribed_at FROM subscriptions WHERE user_id = ?) AS s ON s.channel_id = channels.id ORDER BY s.subscribed_at DESC, channels.id",
+		userID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var channels []*domain.Channel
+	for rows.Next() {
+		c, err := scanChannel(rows)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, c)
+	}
+	return channels, rows.Err()
+}
+
+// ListSubscriptionFeed takes the newest limit+1 videos after the cursor from each subscribed
+// channel, reading them off videos_channel_feed, and merges those. The work is bounded by the
+// number of subscriptions times the page size, however many videos the channels have; the
+// extra video tells whether another page follows.
+func (r *sqliteRepo) ListSubscriptionFeed(ctx context.Context, userID string, after *domain.FeedCursor, limit int) ([]*domain.Video, *domain.FeedCursor, error) {
+	where := "channel_id = subscriptions.channel_id AND " + listedVideos
+	var args []any
+	if after != nil {
+		where += " AND (published_at, id) < (?, ?)"
+		args = append(args, after.PublishedAt.Unix(), after.VideoID)
+	}
+	query := "SELECT " + videoColumns + ", videos.published_at FROM subscriptions JOIN videos ON videos.id IN (" +
+		"SELECT id FROM videos AS feed WHERE " + where + " ORDER BY published_at DESC, id DESC LIMIT ?)" +
+		" WHERE subscriptions.user_id = ? ORDER BY videos.published_at DESC, videos.id DESC LIMIT ?"
+	rows, err := r.DB.QueryContext(ctx, query, append(args, limit+1, userID, limit+1)...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var videos []*domain.Video
+	var next *domain.FeedCursor
+	var publishedAt int64
+	for rows.Next() {
+		if len(videos) == limit {
+			next = &domain.FeedCursor{PublishedAt: time.Unix(publishedAt, 0).UTC(), VideoID: videos[len(videos)-1].ID}
+			break
+		}
+		v, err := scanVideo(extraColumns{rows, []any{&publishedAt}})
+		if err != nil {
+			return nil, nil, err
+		}
+		videos = append(videos, v)
+	}
+	return videos, next, rows.Err()
+}
+
+// extraColumns scans the columns selected after videoColumns into extra.
+type extraColumns struct {
+	row   scanner
+	extra []any
+}
+
+func (s extraColumns) Scan(dest ...any) error {
+	return s.row.Scan(append(dest, s.extra...)...)
+}
+
+const playlistColumns = "id, owner_id, title, description, visibility, created_at, (SELECT COUNT(*) FROM playlist_items WHERE playlist_id = playlists.id)"
+
+func scanPlaylist(row scanner) (*domain.Playlist, error) {
+	var p domain.Playlist
+	var createdAt int64
+	if err := row.Scan(&p.ID, &p.OwnerID, &p.Title, &p.Description, &p.Visibility, &createdAt, &p.ItemCount); err != nil {
+		return nil, err
+	}
+	p.CreatedAt = time.Unix(createdAt, 0).UTC()
+	return &p, nil
+}
+
+func (r *sqliteRepo) CreatePlaylist(ctx context.Context, p *domain.Playlist) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO playlists (id, owner_id, title, description, visibility, created_at) VALUES (?, ?, ?, ?, ?, ?)",
+		p.ID, p.OwnerID, p.Title, p.Description, p.Visibility, p.CreatedAt.Unix())
+	return err
+}
+
+func (r *sqliteRepo) GetPlaylist(ctx context.Context, id string) (*domain.Playlist, error) {
+	p, err := scanPlaylist(r.DB.QueryRowContext(ctx, "SELECT "+playlistColumns+" FROM playlists WHERE id = ?", id))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrPlaylistNotFound
+		}
+		return nil, err
+	}
+
+	rows, err := r.DB.QueryContext(ctx, "SELECT user_id FROM playlist_collaborators WHERE playlist_id = ? ORDER BY created_at, user_id", id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+	for rows.Next() {
+		var u string
+		if err := rows.Scan(&u); err != nil {
+			return nil, err
+		}
+		p.CollaboratorIDs = append(p.CollaboratorIDs, u)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	items, err := r.DB.QueryContext(ctx, "SELECT id, video_id, position, added_by, added_at FROM playlist_items WHERE playlist_id = ? ORDER BY position", id)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = items.Close() }()
+	for items.Next() {
+		var item domain.PlaylistItem
+		var addedAt int64
+		if err := items.Scan(&item.ID, &item.VideoID, &item.Position, &item.AddedBy, &addedAt); err != nil {
+			return nil, err
+		}
+		item.AddedAt = time.Unix(addedAt, 0).UTC()
+		p.Items = append(p.Items, &item)
+	}
+	return p, items.Err()
+}
+
+func (r *sqliteRepo) ListPlaylists(ctx context.Context, ownerID string) ([]*domain.Playlist, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+playlistColumns+" FROM playlists WHERE owner_id = ? ORDER BY created_at DESC, id", ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var playlists []*domain.Playlist
+	for rows.Next() {
+		p, err := scanPlaylist(rows)
+		if err != nil {
+			return nil, err
+		}
+		playlists = append(playlists, p)
+	}
+	return playlists, rows.Err()
+}
+
+func (r *sqliteRepo) UpdatePlaylist(ctx context.Context, id string, u *domain.PlaylistUpdate) error {
+	var sets []string
+	var args []any
+	if u.Title != nil {
+		sets = append(sets, "title = ?")
+		args = append(args, *u.Title)
+	}
+	if u.Description != nil {
+		sets = append(sets, "description = ?")
+		args = append(args, *u.Description)
+	}
+	if u.Visibility != nil {
+		sets = append(sets, "visibility = ?")
+		args = append(args, *u.Visibility)
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	res, err := r.DB.ExecContext(ctx, "UPDATE playlists SET "+strings.Join(sets, ", ")+" WHERE id = ?", append(args, id)...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrPlaylistNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) DeletePlaylist(ctx context.Context, id string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM playlists WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrPlaylistNotFound
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM playlist_items WHERE playlist_id = ?", id); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM playlist_collaborators WHERE playlist_id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *sqliteRepo) AddPlaylistItem(ctx context.Context, playlistID string, item *domain.PlaylistItem) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO playlist_items (id, playlist_id, video_id, position, added_by, added_at) VALUES (?, ?, ?, ?, ?, ?)",
+		item.ID, playlistID, item.VideoID, item.Position, item.AddedBy, item.AddedAt.Unix())
+	if isUniqueViolation(err) {
+		return domain.ErrPositionTaken
+	}
+	return err
+}
+
+func (r *sqliteRepo) MovePlaylistItem(ctx context.Context, playlistID, itemID, position string) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE playlist_items SET position = ? WHERE playlist_id = ? AND id = ?", position, playlistID, itemID)
+	if isUniqueViolation(err) {
+		return domain.ErrPositionTaken
+	}
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrPlaylistItemNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) RemovePlaylistItem(ctx context.Context, playlistID, itemID string) error {
+	res, err := r.DB.ExecContext(ctx, "DELETE FROM playlist_items WHERE playlist_id = ? AND id = ?", playlistID, itemID)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrPlaylistItemNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) AddPlaylistCollaborator(ctx context.Context, playlistID, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT OR IGNORE INTO playlist_collaborators (playlist_id, user_id) VALUES (?, ?)", playlistID, userID)
+	return err
+}
+
+func (r *sqliteRepo) RemovePlaylistCollaborator(ctx context.Context, playlistID, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM playlist_collaborators WHERE playlist_id = ? AND user_id = ?", playlistID, userID)
+	return err
+}
+
+const seriesColumns = "id, owner_id, title, description, created_at, (SELECT COUNT(*) FROM videos WHERE series_id = series.id AND deleted_at = 0)"
+
+func scanSeries(row scanner) (*domain.Series, error) {
+	var s domain.Series
+	var createdAt int64
+	if err := row.Scan(&s.ID, &s.OwnerID, &s.Title, &s.Description, &createdAt, &s.EpisodeCount); err != nil {
+		return nil, err
+	}
+	s.CreatedAt = time.Unix(createdAt, 0).UTC()
+	return &s, nil
+}
+
+func (r *sqliteRepo) CreateSeries(ctx context.Context, s *domain.Series) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO series (id, owner_id, title, description, created_at) VALUES (?, ?, ?, ?, ?)",
+		s.ID, s.OwnerID, s.Title, s.Description, s.CreatedAt.Unix())
+	return err
+}
+
+func (r *sqliteRepo) GetSeries(ctx context.Context, id string) (*domain.Series, error) {
+	s, err := scanSeries(r.DB.QueryRowContext(ctx, "SELECT "+seriesColumns+" FROM series WHERE id = ?", id))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrSeriesNotFound
+	}
+	return s, err
+}
+
+func (r *sqliteRepo) ListSeries(ctx context.Context, ownerID string) ([]*domain.Series, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+seriesColumns+" FROM series WHERE owner_id = ? ORDER BY created_at DESC, id", ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var series []*domain.Series
+	for rows.Next() {
+		s, err := scanSeries(rows)
+		if err != nil {
+			return nil, err
+		}
+		series = append(series, s)
+	}
+	return series, rows.Err()
+}
+
+func (r *sqliteRepo) UpdateSeries(ctx context.Context, id string, u *domain.SeriesUpdate) error {
+	var sets []string
+	var args []any
+	if u.Title != nil {
+		sets = append(sets, "title = ?")
+		args = append(args, *u.Title)
+	}
+	if u.Description != nil {
+		sets = append(sets, "description = ?")
+		args = append(args, *u.Description)
+	}
+	if len(sets) == 0 {
+		return nil
+	}
+	res, err := r.DB.ExecContext(ctx, "UPDATE series SET "+strings.Join(sets, ", ")+" WHERE id = ?", append(args, id)...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrSeriesNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) DeleteSeries(ctx context.Context, id string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.ExecContext(ctx, "DELETE FROM series WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrSeriesNotFound
+	}
+	if _, err := tx.ExecContext(ctx, "UPDATE videos SET series_id = '', season = 0, episode = 0 WHERE series_id = ?", id); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *sqliteRepo) ListEpisodes(ctx context.Context, seriesID string) ([]*domain.Video, error) {
+	rows, err := r.DB.QueryContext(ctx, "SELECT "+videoColumns+" FROM videos WHERE series_id = ? AND deleted_at = 0 ORDER BY season, episode", seriesID)
+	if err != nil {
+		return nil, err
+	}
+	return scanVideos(rows)
+}
+
+func (r *sqliteRepo) SetEpisode(ctx context.Context, videoID, seriesID string, season, episode int) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET series_id = ?, season = ?, episode = ? WHERE id = ? AND deleted_at = 0", seriesID, season, episode, videoID)
+	if isUniqueViolation(err) {
+		return domain.ErrEpisodeTaken
+	}
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrVideoNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) RemoveEpisode(ctx context.Context, seriesID, videoID string) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE videos SET series_id = '', season = 0, episode = 0 WHERE id = ? AND series_id = ?", videoID, seriesID)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrNotEpisode
+	}
+	return nil
+}
+
+func (r *sqliteRepo) SetEpisodeCompleted(ctx context.Context, userID, videoID string, completed bool, at time.Time) error {
+	if !completed {
+		_, err := r.DB.ExecContext(ctx, "DELETE FROM episode_completions WHERE user_id = ? AND video_id = ?", userID, videoID)
+		return err
+	}
+	// Completing again keeps the first completion time
+	_, err := r.DB.ExecContext(ctx, "INSERT OR IGNORE INTO episode_completions (user_id, video_id, completed_at) VALUES (?, ?, ?)", userID, videoID, at.Unix())
+	return err
+}
+
+func (r *sqliteRepo) ListCompletedEpisodes(ctx context.Context, userID, seriesID string) ([]string, error) {
+	rows, err := r.DB.QueryContext(ctx, `SELECT c.video_id FROM episode_completions c JOIN videos ON videos.id = c.video_id
+		WHERE c.user_id = ? AND videos.series_id = ? AND videos.deleted_at = 0 ORDER BY videos.season, videos.episode`, userID, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func isUniqueViolation(err error) bool {
+	sqliteErr, ok := err.(sqlite3.Error)
+	return ok && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique
 }
@@ -0,0 +1,154 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+var handlePattern = regexp.MustCompile(`^[a-z0-9_.-]+$`)
+
+type channelUsecase struct {
+	repo domain.VideoRepository
+}
+
+func NewChannelUsecase(repo domain.VideoRepository) domain.ChannelUsecase {
+	return &channelUsecase{repo: repo}
+}
+
+// Create validates the channel's details and makes caller its owner. Handles are compared
+// case-insensitively, so they are stored lowercase.
+func (u *channelUsecase) Create(ctx context.Context, caller *domain.Caller, handle, displayName, description string) (*domain.Channel, error) {
+	if caller.UserID == "" {
+		return nil, fmt.Errorf("%w: channels are owned by users", domain.ErrInvalidChannel)
+	}
+	handle = normalizeHandle(handle)
+	if n := utf8.RuneCountInString(handle); n < domain.MinHandleLength || n > domain.MaxHandleLength {
+		return nil, fmt.Errorf("%w: handle must be %d to %d characters", domain.ErrInvalidChannel, domain.MinHandleLength, domain.MaxHandleLength)
+	}
+	if !handlePattern.MatchString(handle) {
+		return nil, fmt.Errorf("%w: handle may only contain letters, digits, '_', '-' and '.'", domain.ErrInvalidChannel)
+	}
+	details, err := normalizeChannelUpdate(&domain.ChannelUpdate{DisplayName: &displayName, Description: &description})
+	if err != nil {
+		return nil, err
+	}
+
+	channel := &domain.Channel{
+		ID:          uuid.New().String(),
+		Handle:      handle,
+		DisplayName: *details.DisplayName,
+		Description: *details.Description,
+		OwnerID:     caller.UserID,
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+	if err := u.repo.CreateChannel(ctx, channel); err != nil {
+		return nil, err
+	}
+	return channel, nil
+}
+
+func (u *channelUsecase) Get(ctx context.Context, id, handle string) (*domain.Channel, error) {
+	switch {
+	case id != "":
+		return u.repo.GetChannel(ctx, id)
+	case handle != "":
+		return u.repo.GetChannelByHandle(ctx, normalizeHandle(handle))
+	default:
+		return nil, fmt.Errorf("%w: id or handle is required", domain.ErrInvalidChannel)
+	}
+}
+
+func (u *channelUsecase) Update(ctx context.Context, id string, caller *domain.Caller, update *domain.ChannelUpdate) (*domain.Channel, error) {
+	if update.DisplayName == nil && update.Description == nil {
+		return nil, fmt.Errorf("%w: nothing to update", domain.ErrInvalidChannel)
+	}
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	normalized, err := normalizeChannelUpdate(update)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.repo.UpdateChannel(ctx, id, normalized); err != nil {
+		return nil, err
+	}
+	return u.repo.GetChannel(ctx, id)
+}
+
+// normalizeChannelUpdate trims the display name and checks the changed details' limits.
+func normalizeChannelUpdate(update *domain.ChannelUpdate) (*domain.ChannelUpdate, error) {
+	normalized := &domain.ChannelUpdate{Description: update.Description}
+	if update.DisplayName != nil {
+		name := strings.TrimSpace(*update.DisplayName)
+		if name == "" {
+			return nil, fmt.Errorf("%w: display_name is required", domain.ErrInvalidChannel)
+		}
+		if utf8.RuneCountInString(name) > domain.MaxDisplayNameLength {
+			return nil, fmt.Errorf("%w: display_name is longer than %d characters", domain.ErrInvalidChannel, domain.MaxDisplayNameLength)
+		}
+		normalized.DisplayName = &name
+	}
+	if update.Description != nil && utf8.RuneCountInString(*update.Description) > domain.MaxChannelDescriptionLength {
+		return nil, fmt.Errorf("%w: description is longer than %d characters", domain.ErrInvalidChannel, domain.MaxChannelDescriptionLength)
+	}
+	return normalized, nil
+}
+
+// Delete removes a channel; its videos stay with their owners.
+func (u *channelUsecase) Delete(ctx context.Context, id string, caller *domain.Caller) error {
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.DeleteChannel(ctx, id)
+}
+
+func (u *channelUsecase) AddMember(ctx context.Context, id string, caller *domain.Caller, memberID string) error {
+	channel, err := u.getManaged(ctx, id, caller)
+	if err != nil {
+		return err
+	}
+	if memberID == channel.OwnerID {
+		return fmt.Errorf("%w: the owner can already post to the channel", domain.ErrInvalidChannel)
+	}
+	return u.repo.AddChannelMember(ctx, id, memberID)
+}
+
+func (u *channelUsecase) RemoveMember(ctx context.Context, id string, caller *domain.Caller, memberID string) error {
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.RemoveChannelMember(ctx, id, memberID)
+}
+
+func (u *channelUsecase) SetImage(ctx context.Context, id, kind string, image *domain.ChannelImage) error {
+	if kind != domain.ChannelImageAvatar && kind != domain.ChannelImageBanner {
+		return fmt.Errorf("%w: image kind must be avatar or banner", domain.ErrInvalidChannel)
+	}
+	if image == nil || image.Bucket == "" || image.ObjectKey == "" {
+		return fmt.Errorf("%w: image bucket and object_key are required", domain.ErrInvalidChannel)
+	}
+	return u.repo.SetChannelImage(ctx, id, kind, image)
+}
+
+// getManaged returns the channel if caller may manage it: its owner or an admin.
+func (u *channelUsecase) getManaged(ctx context.Context, id string, caller *domain.Caller) (*domain.Channel, error) {
+	channel, err := u.repo.GetChannel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.Can(domain.PermEditChannel, channel.OwnerID) {
+		return nil, domain.ErrNotChannelOwner
+	}
+	return channel, nil
+}
+
+func normalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimSpace(handle))
+}
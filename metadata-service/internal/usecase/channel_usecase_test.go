@@ -0,0 +1,201 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/athandoan/youtube/metadata-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChannelUsecase_Create(t *testing.T) {
+	owner := &domain.Caller{UserID: "user-1"}
+	tests := []struct {
+		name        string
+		caller      *domain.Caller
+		handle      string
+		displayName string
+		setupMock   func(m *mocks.MockVideoRepository)
+		wantErr     error
+	}{
+		{
+			name:        "success - stores the handle lowercase",
+			caller:      owner,
+			handle:      " GoTube.Dev ",
+			displayName: "  GoTube  ",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().CreateChannel(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, c *domain.Channel) error {
+						if c.Handle != "gotube.dev" || c.DisplayName != "GoTube" || c.OwnerID != "user-1" {
+							t.Errorf("CreateChannel() got %+v", c)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:        "error - handle taken",
+			caller:      owner,
+			handle:      "gotube",
+			displayName: "GoTube",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().CreateChannel(gomock.Any(), gomock.Any()).Return(domain.ErrHandleTaken)
+			},
+			wantErr: domain.ErrHandleTaken,
+		},
+		{name: "error - handle too short", caller: owner, handle: "go", displayName: "GoTube", wantErr: domain.ErrInvalidChannel},
+		{name: "error - handle with spaces", caller: owner, handle: "go tube", displayName: "GoTube", wantErr: domain.ErrInvalidChannel},
+		{name: "error - no display name", caller: owner, handle: "gotube", displayName: "  ", wantErr: domain.ErrInvalidChannel},
+		{name: "error - display name too long", caller: owner, handle: "gotube", displayName: strings.Repeat("a", domain.MaxDisplayNameLength+1), wantErr: domain.ErrInvalidChannel},
+		{name: "error - anonymous", caller: anonymous, handle: "gotube", displayName: "GoTube", wantErr: domain.ErrInvalidChannel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			uc := NewChannelUsecase(mockRepo)
+			_, err := uc.Create(context.Background(), tt.caller, tt.handle, tt.displayName, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Create() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChannelUsecase_Update(t *testing.T) {
+	name := "New name"
+	channel := &domain.Channel{ID: "ch-1", Handle: "gotube", OwnerID: "user-1", MemberIDs: []string{"user-2"}}
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:   "success - owner renames",
+			caller: &domain.Caller{UserID: "user-1"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").Return(channel, nil).Times(2)
+				m.EXPECT().UpdateChannel(gomock.Any(), "ch-1", &domain.ChannelUpdate{DisplayName: &name}).Return(nil)
+			},
+		},
+		{
+			name:   "success - admin renames anyone's channel",
+			caller: admin,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").Return(channel, nil).Times(2)
+				m.EXPECT().UpdateChannel(gomock.Any(), "ch-1", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "error - members can't manage the channel",
+			caller: &domain.Caller{UserID: "user-2"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").Return(channel, nil)
+			},
+			wantErr: domain.ErrNotChannelOwner,
+		},
+		{
+			name:   "error - moderators can't either",
+			caller: moderator,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").Return(channel, nil)
+			},
+			wantErr: domain.ErrNotChannelOwner,
+		},
+		{
+			name:   "error - unknown channel",
+			caller: admin,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").Return(nil, domain.ErrChannelNotFound)
+			},
+			wantErr: domain.ErrChannelNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewChannelUsecase(mockRepo)
+			_, err := uc.Update(context.Background(), "ch-1", tt.caller, &domain.ChannelUpdate{DisplayName: &name})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Update() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChannelUsecase_AddMember(t *testing.T) {
+	channel := &domain.Channel{ID: "ch-1", OwnerID: "user-1"}
+	tests := []struct {
+		name      string
+		memberID  string
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:     "success - adds a member",
+			memberID: "user-2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").Return(channel, nil)
+				m.EXPECT().AddChannelMember(gomock.Any(), "ch-1", "user-2").Return(nil)
+			},
+		},
+		{
+			name:     "error - the owner is no member",
+			memberID: "user-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").Return(channel, nil)
+			},
+			wantErr: domain.ErrInvalidChannel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewChannelUsecase(mockRepo)
+			err := uc.AddMember(context.Background(), "ch-1", &domain.Caller{UserID: "user-1"}, tt.memberID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AddMember() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestChannelUsecase_SetImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockVideoRepository(ctrl)
+	image := &domain.ChannelImage{Bucket: "videos", ObjectKey: "channels/ch-1/avatar.png"}
+	mockRepo.EXPECT().SetChannelImage(gomock.Any(), "ch-1", domain.ChannelImageAvatar, image).Return(nil)
+
+	uc := NewChannelUsecase(mockRepo)
+	if err := uc.SetImage(context.Background(), "ch-1", domain.ChannelImageAvatar, image); err != nil {
+		t.Errorf("SetImage() error = %v", err)
+	}
+	if err := uc.SetImage(context.Background(), "ch-1", "thumbnail", image); !errors.Is(err, domain.ErrInvalidChannel) {
+		t.Errorf("SetImage() with an unknown kind error = %v, want %v", err, domain.ErrInvalidChannel)
+	}
+}
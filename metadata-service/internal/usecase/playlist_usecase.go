@@ -0,0 +1,406 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+// positionRetries bounds how often an item edit is retried after a concurrent edit took the
+// position it picked.
+const positionRetries = 3
+
+type playlistUsecase struct {
+	repo domain.VideoRepository
+}
+
+func NewPlaylistUsecase(repo domain.VideoRepository) domain.PlaylistUsecase {
+	return &playlistUsecase{repo: repo}
+}
+
+func (u *playlistUsecase) Create(ctx context.Context, caller *domain.Caller, title, description, visibility string) (*domain.Playlist, error) {
+	if caller.UserID == "" {
+		return nil, fmt.Errorf("%w: playlists are owned by users", domain.ErrInvalidPlaylist)
+	}
+	if visibility == "" {
+		visibility = domain.VisibilityPublic
+	}
+	details, err := normalizePlaylistUpdate(&domain.PlaylistUpdate{Title: &title, Description: &description, Visibility: &visibility})
+	if err != nil {
+		return nil, err
+	}
+
+	playlist := &domain.Playlist{
+		ID:          uuid.New().String(),
+		OwnerID:     caller.UserID,
+		Title:       *details.Title,
+		Description: *details.Description,
+		Visibility:  *details.Visibility,
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+	if err := u.repo.CreatePlaylist(ctx, playlist); err != nil {
+		return nil, err
+	}
+	return playlist, nil
+}
+
+// Get lists every item to those managing the playlist. Everyone else only sees the items
+// they can play: processed, published and, if private, shared with them, even if the video
+// went private after it was added.
+func (u *playlistUsecase) Get(ctx context.Context, id string, caller *domain.Caller) (*domain.Playlist, error) {
+	playlist, err := u.get(ctx, id, caller)
+	if err != nil {
+		return nil, err
+	}
+	if caller.Can(domain.PermEditPlaylist, playlist.OwnerID) {
+		return playlist, nil
+	}
+	now := time.Now()
+	var watchable []*domain.PlaylistItem
+	for _, item := range playlist.Items {
+		ok, err := u.canWatch(ctx, item.VideoID, caller.UserID, now)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			watchable = append(watchable, item)
+		}
+	}
+	playlist.Items = watchable
+	playlist.ItemCount = len(watchable)
+	return playlist, nil
+}
+
+// get returns the playlist with all its items if caller may see it.
+func (u *playlistUsecase) get(ctx context.Context, id string, caller *domain.Caller) (*domain.Playlist, error) {
+	playlist, err := u.repo.GetPlaylist(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	// Private playlists look the same as missing ones to those who can't see them
+	if !playlist.CanView(caller) {
+		return nil, domain.ErrPlaylistNotFound
+	}
+	return playlist, nil
+}
+
+// canWatch reports whether userID may play the video at now. Videos that were deleted since
+// they were added can't be played.
+func (u *playlistUsecase) canWatch(ctx context.Context, videoID, userID string, now time.Time) (bool, error) {
+	v, err := u.repo.Get(ctx, videoID)
+	if errors.Is(err, domain.ErrVideoNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if !v.Playable(now) {
+		return false, nil
+	}
+	if v.Visibility != domain.VisibilityPrivate || (userID != "" && userID == v.OwnerID) {
+		return true, nil
+	}
+	if userID == "" {
+		return false, nil
+	}
+	return u.repo.HasAccess(ctx, v.ID, userID)
+}
+
+// List returns ownerID's playlists; everyone but the owner and admins only sees public ones.
+func (u *playlistUsecase) List(ctx context.Context, ownerID string, caller *domain.Caller) ([]*domain.Playlist, error) {
+	if ownerID == "" {
+		return nil, fmt.Errorf("%w: owner_id is required", domain.ErrInvalidPlaylist)
+	}
+	playlists, err := u.repo.ListPlaylists(ctx, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if caller.Can(domain.PermEditPlaylist, ownerID) {
+		return playlists, nil
+	}
+	return slices.DeleteFunc(playlists, func(p *domain.Playlist) bool {
+		return p.Visibility != domain.VisibilityPublic
+	}), nil
+}
+
+func (u *playlistUsecase) Update(ctx context.Context, id string, caller *domain.Caller, update *domain.PlaylistUpdate) (*domain.Playlist, error) {
+	if update.Title == nil && update.Description == nil && update.Visibility == nil {
+		return nil, fmt.Errorf("%w: nothing to update", domain.ErrInvalidPlaylist)
+	}
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	normalized, err := normalizePlaylistUpdate(update)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.repo.UpdatePlaylist(ctx, id, normalized); err != nil {
+		return nil, err
+	}
+	return u.repo.GetPlaylist(ctx, id)
+}
+
+// normalizePlaylistUpdate trims the title and checks the changed details.
+func normalizePlaylistUpdate(update *domain.PlaylistUpdate) (*domain.PlaylistUpdate, error) {
+	normalized := &domain.PlaylistUpdate{Description: update.Description}
+	if update.Title != nil {
+		title := strings.TrimSpace(*update.Title)
+		if title == "" {
+			return nil, fmt.Errorf("%w: title is required", domain.ErrInvalidPlaylist)
+		}
+		if utf8.RuneCountInString(title) > domain.MaxTitleLength {
+			return nil, fmt.Errorf("%w: title is longer than %d characters", domain.ErrInvalidPlaylist, domain.MaxTitleLength)
+		}
+		normalized.Title = &title
+	}
+	if update.Description != nil && utf8.RuneCountInString(*update.Description) > domain.MaxDescriptionLength {
+		return nil, fmt.Errorf("%w: description is longer than %d characters", domain.ErrInvalidPlaylist, domain.MaxDescriptionLength)
+	}
+	if update.Visibility != nil {
+		switch *update.Visibility {
+		case domain.VisibilityPublic, domain.VisibilityUnlisted, domain.VisibilityPrivate:
+		default:
+			return nil, fmt.Errorf("%w: visibility must be public, unlisted or private", domain.ErrInvalidPlaylist)
+		}
+		normalized.Visibility = update.Visibility
+	}
+	return normalized, nil
+}
+
+func (u *playlistUsecase) Delete(ctx context.Context, id string, caller *domain.Caller) error {
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.DeletePlaylist(ctx, id)
+}
+
+// AddItem appends the video after the playlist's last item. Concurrent appends pick the same
+// position, so whichever loses retries against the updated playlist.
+func (u *playlistUsecase) AddItem(ctx context.Context, id string, caller *domain.Caller, videoID string) (*domain.PlaylistItem, error) {
+	if err := u.checkWatchable(ctx, videoID, caller); err != nil {
+		return nil, err
+	}
+	for attempt := 0; ; attempt++ {
+		playlist, err := u.getEditable(ctx, id, caller)
+		if err != nil {
+			return nil, err
+		}
+		if len(playlist.Items) >= domain.MaxPlaylistItems {
+			return nil, fmt.Errorf("%w: playlists hold at most %d videos", domain.ErrInvalidPlaylist, domain.MaxPlaylistItems)
+		}
+		if slices.ContainsFunc(playlist.Items, func(item *domain.PlaylistItem) bool { return item.VideoID == videoID }) {
+			return nil, fmt.Errorf("%w: the video is already in the playlist", domain.ErrInvalidPlaylist)
+		}
+
+		last := ""
+		if n := len(playlist.Items); n > 0 {
+			last = playlist.Items[n-1].Position
+		}
+		item := &domain.PlaylistItem{
+			ID:       uuid.New().String(),
+			VideoID:  videoID,
+			Position: positionBetween(last, ""),
+			AddedBy:  caller.UserID,
+			AddedAt:  time.Now().UTC().Truncate(time.Second),
+		}
+		err = u.repo.AddPlaylistItem(ctx, id, item)
+		if errors.Is(err, domain.ErrPositionTaken) && attempt < positionRetries {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return item, nil
+	}
+}
+
+// checkWatchable fails unless caller may watch the video, so private videos can't be added
+// by those who only know their ID.
+func (u *playlistUsecase) checkWatchable(ctx context.Context, videoID string, caller *domain.Caller) error {
+	if videoID == "" {
+		return fmt.Errorf("%w: video_id is required", domain.ErrInvalidPlaylist)
+	}
+	v, err := u.repo.Get(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if v.Visibility != domain.VisibilityPrivate || (caller.UserID != "" && caller.UserID == v.OwnerID) {
+		return nil
+	}
+	ok, err := u.repo.HasAccess(ctx, videoID, caller.UserID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrPrivateVideo
+	}
+	return nil
+}
+
+func (u *playlistUsecase) RemoveItem(ctx context.Context, id string, caller *domain.Caller, itemID string) error {
+	if _, err := u.getEditable(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.RemovePlaylistItem(ctx, id, itemID)
+}
+
+// MoveItem gives the item a position between its new neighbours; no other item changes.
+func (u *playlistUsecase) MoveItem(ctx context.Context, id string, caller *domain.Caller, itemID, afterItemID string) (*domain.PlaylistItem, error) {
+	if itemID == afterItemID {
+		return nil, fmt.Errorf("%w: an item can't be moved after itself", domain.ErrInvalidPlaylist)
+	}
+	for attempt := 0; ; attempt++ {
+		playlist, err := u.getEditable(ctx, id, caller)
+		if err != nil {
+			return nil, err
+		}
+		i := slices.IndexFunc(playlist.Items, func(item *domain.PlaylistItem) bool { return item.ID == itemID })
+		if i < 0 {
+			return nil, domain.ErrPlaylistItemNotFound
+		}
+		item := playlist.Items[i]
+		others := slices.Delete(slices.Clone(playlist.Items), i, i+1)
+
+		// The new neighbours: the item after which it goes, and the one that follows it
+		next := 0
+		before := ""
+		if afterItemID != "" {
+			j := slices.IndexFunc(others, func(item *domain.PlaylistItem) bool { return item.ID == afterItemID })
+			if j < 0 {
+				return nil, domain.ErrPlaylistItemNotFound
+			}
+			before, next = others[j].Position, j+1
+		}
+		after := ""
+		if next < len(others) {
+			after = others[next].Position
+		}
+		if next == i {
+			return item, nil // already there
+		}
+
+		position := positionBetween(before, after)
+		err = u.repo.MovePlaylistItem(ctx, id, itemID, position)
+		if errors.Is(err, domain.ErrPositionTaken) && attempt < positionRetries {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		item.Position = position
+		return item, nil
+	}
+}
+
+func (u *playlistUsecase) AddCollaborator(ctx context.Context, id string, caller *domain.Caller, userID string) error {
+	playlist, err := u.getManaged(ctx, id, caller)
+	if err != nil {
+		return err
+	}
+	if userID == playlist.OwnerID {
+		return fmt.Errorf("%w: the owner can already edit the playlist", domain.ErrInvalidPlaylist)
+	}
+	return u.repo.AddPlaylistCollaborator(ctx, id, userID)
+}
+
+func (u *playlistUsecase) RemoveCollaborator(ctx context.Context, id string, caller *domain.Caller, userID string) error {
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.RemovePlaylistCollaborator(ctx, id, userID)
+}
+
+// getManaged returns the playlist if caller may manage it: its owner or an admin.
+func (u *playlistUsecase) getManaged(ctx context.Context, id string, caller *domain.Caller) (*domain.Playlist, error) {
+	playlist, err := u.get(ctx, id, caller)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.Can(domain.PermEditPlaylist, playlist.OwnerID) {
+		return nil, domain.ErrNotPlaylistOwner
+	}
+	return playlist, nil
+}
+
+// getEditable returns the playlist if caller may edit its items.
+func (u *playlistUsecase) getEditable(ctx context.Context, id string, caller *domain.Caller) (*domain.Playlist, error) {
+	playlist, err := u.get(ctx, id, caller)
+	if err != nil {
+		return nil, err
+	}
+	if !playlist.CanEditItems(caller) {
+		return nil, domain.ErrNotPlaylistEditor
+	}
+	return playlist, nil
+}
+
+// positionDigits are the digits of playlist positions, in ascending byte order so positions
+// sort as plain strings.
+const positionDigits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// positionBetween returns a position that sorts strictly between a and b, where an empty a
+// is the start of the playlist and an empty b its end. Positions are fractions in base 62
+// without the leading "0."; they never end in the lowest digit, so there is always room
+// before them too.
+func positionBetween(a, b string) string {
+	if a != "" && b == "" {
+		// Appends are the common case; step the first digit to keep positions short
+		if i := strings.IndexByte(positionDigits, a[0]); i+1 < len(positionDigits) {
+			return positionDigits[i+1 : i+2]
+		}
+	}
+	return midpoint(a, b)
+}
+
+// midpoint returns a position roughly halfway between a and b.
+func midpoint(a, b string) string {
+	if b != "" {
+		// Keep the digits both share, reading a as padded with zeroes
+		n := 0
+		for n < len(b) && positionDigit(a, n) == b[n] {
+			n++
+		}
+		if n > 0 {
+			rest := ""
+			if n < len(a) {
+				rest = a[n:]
+			}
+			return b[:n] + midpoint(rest, b[n:])
+		}
+	}
+
+	lo := 0
+	if a != "" {
+		lo = strings.IndexByte(positionDigits, a[0])
+	}
+	hi := len(positionDigits)
+	if b != "" {
+		hi = strings.IndexByte(positionDigits, b[0])
+	}
+	if hi-lo > 1 {
+		return positionDigits[(lo+hi)/2 : (lo+hi)/2+1]
+	}
+	// The first digits are adjacent: b's alone fits if b goes on, else extend a
+	if len(b) > 1 {
+		return b[:1]
+	}
+	rest := ""
+	if a != "" {
+		rest = a[1:]
+	}
+	return positionDigits[lo:lo+1] + midpoint(rest, "")
+}
+
+func positionDigit(s string, i int) byte {
+	if i < len(s) {
+		return s[i]
+	}
+	return positionDigits[0]
+}
@@ -0,0 +1,349 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/athandoan/youtube/metadata-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPositionBetween(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+	}{
+		{name: "empty playlist", a: "", b: ""},
+		{name: "append", a: "5", b: ""},
+		{name: "append after the last digit", a: "z", b: ""},
+		{name: "prepend", a: "", b: "5"},
+		{name: "prepend before the first position", a: "", b: "1"},
+		{name: "between with room", a: "1", b: "5"},
+		{name: "between adjacent digits", a: "1", b: "2"},
+		{name: "between a key and its extension", a: "1", b: "11"},
+		{name: "between long keys", a: "1zz", b: "2"},
+		{name: "between keys with a shared prefix", a: "a1", b: "a2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := positionBetween(tt.a, tt.b)
+			if got <= tt.a || (tt.b != "" && got >= tt.b) {
+				t.Errorf("positionBetween(%q, %q) = %q, not between them", tt.a, tt.b, got)
+			}
+			if got[len(got)-1] == positionDigits[0] {
+				t.Errorf("positionBetween(%q, %q) = %q ends in the lowest digit", tt.a, tt.b, got)
+			}
+		})
+	}
+
+	// Repeated inserts at the same spot keep finding room
+	a, b := "1", "2"
+	for i := 0; i < 200; i++ {
+		mid := positionBetween(a, b)
+		if mid <= a || mid >= b {
+			t.Fatalf("insert %d: positionBetween(%q, %q) = %q, not between them", i, a, b, mid)
+		}
+		b = mid
+	}
+}
+
+func TestPlaylistUsecase_Get(t *testing.T) {
+	private := &domain.Playlist{ID: "pl-1", OwnerID: "user-1", Visibility: domain.VisibilityPrivate, CollaboratorIDs: []string{"user-2"}}
+	tests := []struct {
+		name    string
+		caller  *domain.Caller
+		wantErr error
+	}{
+		{name: "success - owner", caller: &domain.Caller{UserID: "user-1"}},
+		{name: "success - collaborator", caller: &domain.Caller{UserID: "user-2"}},
+		{name: "success - admin", caller: &domain.Caller{UserID: "admin-1", Roles: []string{domain.RoleAdmin}}},
+		{name: "error - hidden from others", caller: &domain.Caller{UserID: "user-3"}, wantErr: domain.ErrPlaylistNotFound},
+		{name: "error - hidden from anonymous", caller: anonymous, wantErr: domain.ErrPlaylistNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			mockRepo.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(private, nil)
+
+			uc := NewPlaylistUsecase(mockRepo)
+			_, err := uc.Get(context.Background(), "pl-1", tt.caller)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Get() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPlaylistUsecase_Get_Items(t *testing.T) {
+	playlist := func() *domain.Playlist {
+		return &domain.Playlist{ID: "pl-1", OwnerID: "user-1", Visibility: domain.VisibilityPublic, ItemCount: 3,
+			Items: []*domain.PlaylistItem{
+				{ID: "item-1", VideoID: "video-1", Position: "1"},
+				{ID: "item-2", VideoID: "video-2", Position: "2"},
+				{ID: "item-3", VideoID: "video-3", Position: "3"},
+			}}
+	}
+	// video-2 went private after it was added; video-3 was deleted since
+	videos := map[string]*domain.Video{
+		"video-1": {ID: "video-1", OwnerID: "user-9", Status: "ready", Visibility: domain.VisibilityPublic},
+		"video-2": {ID: "video-2", OwnerID: "user-9", Status: "ready", Visibility: domain.VisibilityPrivate},
+	}
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		setupMock func(m *mocks.MockVideoRepository)
+		wantItems []string
+	}{
+		{
+			name:      "owner sees every item",
+			caller:    &domain.Caller{UserID: "user-1"},
+			setupMock: func(m *mocks.MockVideoRepository) {},
+			wantItems: []string{"item-1", "item-2", "item-3"},
+		},
+		{
+			name:   "viewer only sees the videos they can watch",
+			caller: &domain.Caller{UserID: "user-3"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().HasAccess(gomock.Any(), "video-2", "user-3").Return(false, nil)
+			},
+			wantItems: []string{"item-1"},
+		},
+		{
+			name:   "viewer the private video is shared with",
+			caller: &domain.Caller{UserID: "user-4"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().HasAccess(gomock.Any(), "video-2", "user-4").Return(true, nil)
+			},
+			wantItems: []string{"item-1", "item-2"},
+		},
+		{
+			name:      "anonymous",
+			caller:    anonymous,
+			setupMock: func(m *mocks.MockVideoRepository) {},
+			wantItems: []string{"item-1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			mockRepo.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+			mockRepo.EXPECT().Get(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, id string) (*domain.Video, error) {
+				if v, ok := videos[id]; ok {
+					return v, nil
+				}
+				return nil, domain.ErrVideoNotFound
+			}).AnyTimes()
+			tt.setupMock(mockRepo)
+
+			uc := NewPlaylistUsecase(mockRepo)
+			got, err := uc.Get(context.Background(), "pl-1", tt.caller)
+			if err != nil {
+				t.Fatalf("Get() error = %v", err)
+			}
+			var ids []string
+			for _, item := range got.Items {
+				ids = append(ids, item.ID)
+			}
+			if !slices.Equal(ids, tt.wantItems) || got.ItemCount != len(tt.wantItems) {
+				t.Errorf("Get() items = %v (count %d), want %v", ids, got.ItemCount, tt.wantItems)
+			}
+		})
+	}
+}
+
+func TestPlaylistUsecase_AddItem(t *testing.T) {
+	collaborator := &domain.Caller{UserID: "user-2"}
+	playlist := func() *domain.Playlist {
+		return &domain.Playlist{ID: "pl-1", OwnerID: "user-1", Visibility: domain.VisibilityPublic, CollaboratorIDs: []string{"user-2"},
+			Items: []*domain.PlaylistItem{{ID: "item-1", VideoID: "video-1", Position: "1"}}}
+	}
+	publicVideo := &domain.Video{ID: "video-2", OwnerID: "user-9", Visibility: domain.VisibilityPublic}
+	tests := []struct {
+		name         string
+		caller       *domain.Caller
+		videoID      string
+		setupMock    func(m *mocks.MockVideoRepository)
+		wantPosition string
+		wantErr      error
+	}{
+		{
+			name:    "success - appends after the last item",
+			caller:  collaborator,
+			videoID: "video-2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-2").Return(publicVideo, nil)
+				m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+				m.EXPECT().AddPlaylistItem(gomock.Any(), "pl-1", gomock.Any()).Return(nil)
+			},
+			wantPosition: "2",
+		},
+		{
+			name:    "success - retries when a concurrent append took the position",
+			caller:  collaborator,
+			videoID: "video-2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-2").Return(publicVideo, nil)
+				taken := playlist()
+				taken.Items = append(taken.Items, &domain.PlaylistItem{ID: "item-2", VideoID: "video-3", Position: "2"})
+				gomock.InOrder(
+					m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil),
+					m.EXPECT().AddPlaylistItem(gomock.Any(), "pl-1", gomock.Any()).Return(domain.ErrPositionTaken),
+					m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(taken, nil),
+					m.EXPECT().AddPlaylistItem(gomock.Any(), "pl-1", gomock.Any()).Return(nil),
+				)
+			},
+			wantPosition: "3",
+		},
+		{
+			name:    "error - already in the playlist",
+			caller:  collaborator,
+			videoID: "video-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-1").Return(&domain.Video{ID: "video-1", Visibility: domain.VisibilityPublic}, nil)
+				m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+			},
+			wantErr: domain.ErrInvalidPlaylist,
+		},
+		{
+			name:    "error - not a collaborator",
+			caller:  &domain.Caller{UserID: "user-3"},
+			videoID: "video-2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-2").Return(publicVideo, nil)
+				m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+			},
+			wantErr: domain.ErrNotPlaylistEditor,
+		},
+		{
+			name:    "error - someone else's private video",
+			caller:  collaborator,
+			videoID: "video-2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-2").Return(&domain.Video{ID: "video-2", OwnerID: "user-9", Visibility: domain.VisibilityPrivate}, nil)
+				m.EXPECT().HasAccess(gomock.Any(), "video-2", "user-2").Return(false, nil)
+			},
+			wantErr: domain.ErrPrivateVideo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewPlaylistUsecase(mockRepo)
+			item, err := uc.AddItem(context.Background(), "pl-1", tt.caller, tt.videoID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("AddItem() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && item.Position != tt.wantPosition {
+				t.Errorf("AddItem() position = %q, want %q", item.Position, tt.wantPosition)
+			}
+		})
+	}
+}
+
+func TestPlaylistUsecase_MoveItem(t *testing.T) {
+	owner := &domain.Caller{UserID: "user-1"}
+	playlist := func() *domain.Playlist {
+		return &domain.Playlist{ID: "pl-1", OwnerID: "user-1", Visibility: domain.VisibilityPublic, Items: []*domain.PlaylistItem{
+			{ID: "item-1", Position: "1"},
+			{ID: "item-2", Position: "2"},
+			{ID: "item-3", Position: "3"},
+		}}
+	}
+	tests := []struct {
+		name         string
+		itemID       string
+		afterItemID  string
+		setupMock    func(m *mocks.MockVideoRepository)
+		wantPosition string
+		wantErr      error
+	}{
+		{
+			name:        "success - moves to the start",
+			itemID:      "item-3",
+			afterItemID: "",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+				m.EXPECT().MovePlaylistItem(gomock.Any(), "pl-1", "item-3", "0V").Return(nil)
+			},
+			wantPosition: "0V",
+		},
+		{
+			name:        "success - moves between two items",
+			itemID:      "item-1",
+			afterItemID: "item-2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+				m.EXPECT().MovePlaylistItem(gomock.Any(), "pl-1", "item-1", "2V").Return(nil)
+			},
+			wantPosition: "2V",
+		},
+		{
+			name:        "success - moves to the end",
+			itemID:      "item-1",
+			afterItemID: "item-3",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+				m.EXPECT().MovePlaylistItem(gomock.Any(), "pl-1", "item-1", "4").Return(nil)
+			},
+			wantPosition: "4",
+		},
+		{
+			name:        "success - already in place",
+			itemID:      "item-2",
+			afterItemID: "item-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+			},
+			wantPosition: "2",
+		},
+		{
+			name:        "error - unknown item",
+			itemID:      "item-9",
+			afterItemID: "item-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetPlaylist(gomock.Any(), "pl-1").Return(playlist(), nil)
+			},
+			wantErr: domain.ErrPlaylistItemNotFound,
+		},
+		{name: "error - after itself", itemID: "item-1", afterItemID: "item-1", wantErr: domain.ErrInvalidPlaylist},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			uc := NewPlaylistUsecase(mockRepo)
+			item, err := uc.MoveItem(context.Background(), "pl-1", owner, tt.itemID, tt.afterItemID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("MoveItem() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && item.Position != tt.wantPosition {
+				t.Errorf("MoveItem() position = %q, want %q", item.Position, tt.wantPosition)
+			}
+		})
+	}
+}
@@ -0,0 +1,246 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+type seriesUsecase struct {
+	repo domain.VideoRepository
+}
+
+func NewSeriesUsecase(repo domain.VideoRepository) domain.SeriesUsecase {
+	return &seriesUsecase{repo: repo}
+}
+
+func (u *seriesUsecase) Create(ctx context.Context, caller *domain.Caller, title, description string) (*domain.Series, error) {
+	if caller.UserID == "" {
+		return nil, fmt.Errorf("%w: series are owned by users", domain.ErrInvalidSeries)
+	}
+	details, err := normalizeSeriesUpdate(&domain.SeriesUpdate{Title: &title, Description: &description})
+	if err != nil {
+		return nil, err
+	}
+
+	series := &domain.Series{
+		ID:          uuid.New().String(),
+		OwnerID:     caller.UserID,
+		Title:       *details.Title,
+		Description: *details.Description,
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+	if err := u.repo.CreateSeries(ctx, series); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// Get lists every episode to those managing the series. Everyone else only sees the episodes
+// they can play: processed, published and, if private, shared with them.
+func (u *seriesUsecase) Get(ctx context.Context, id string, caller *domain.Caller) (*domain.Series, error) {
+	series, err := u.repo.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	episodes, err := u.repo.ListEpisodes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.Can(domain.PermEditSeries, series.OwnerID) {
+		now := time.Now()
+		var watchable []*domain.Video
+		for _, v := range episodes {
+			ok, err := u.canWatch(ctx, v, caller.UserID, now)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				watchable = append(watchable, v)
+			}
+		}
+		episodes = watchable
+	}
+	series.Episodes = episodes
+
+	if caller.UserID != "" {
+		if series.CompletedVideoIDs, err = u.repo.ListCompletedEpisodes(ctx, caller.UserID, id); err != nil {
+			return nil, err
+		}
+	}
+	return series, nil
+}
+
+func (u *seriesUsecase) List(ctx context.Context, ownerID string) ([]*domain.Series, error) {
+	if ownerID == "" {
+		return nil, fmt.Errorf("%w: owner_id is required", domain.ErrInvalidSeries)
+	}
+	return u.repo.ListSeries(ctx, ownerID)
+}
+
+func (u *seriesUsecase) Update(ctx context.Context, id string, caller *domain.Caller, update *domain.SeriesUpdate) (*domain.Series, error) {
+	if update.Title == nil && update.Description == nil {
+		return nil, fmt.Errorf("%w: nothing to update", domain.ErrInvalidSeries)
+	}
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	normalized, err := normalizeSeriesUpdate(update)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.repo.UpdateSeries(ctx, id, normalized); err != nil {
+		return nil, err
+	}
+	return u.repo.GetSeries(ctx, id)
+}
+
+// normalizeSeriesUpdate trims the title and checks the changed details.
+func normalizeSeriesUpdate(update *domain.SeriesUpdate) (*domain.SeriesUpdate, error) {
+	normalized := &domain.SeriesUpdate{Description: update.Description}
+	if update.Title != nil {
+		title := strings.TrimSpace(*update.Title)
+		if title == "" {
+			return nil, fmt.Errorf("%w: title is required", domain.ErrInvalidSeries)
+		}
+		if utf8.RuneCountInString(title) > domain.MaxTitleLength {
+			return nil, fmt.Errorf("%w: title is longer than %d characters", domain.ErrInvalidSeries, domain.MaxTitleLength)
+		}
+		normalized.Title = &title
+	}
+	if update.Description != nil && utf8.RuneCountInString(*update.Description) > domain.MaxDescriptionLength {
+		return nil, fmt.Errorf("%w: description is longer than %d characters", domain.ErrInvalidSeries, domain.MaxDescriptionLength)
+	}
+	return normalized, nil
+}
+
+func (u *seriesUsecase) Delete(ctx context.Context, id string, caller *domain.Caller) error {
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.DeleteSeries(ctx, id)
+}
+
+// SetEpisode needs the caller to manage both the series and the video, so nobody can pull
+// someone else's video into their series. A video in another series moves to this one.
+func (u *seriesUsecase) SetEpisode(ctx context.Context, id string, caller *domain.Caller, videoID string, season, episode int) (*domain.Series, error) {
+	if season < 1 || season > domain.MaxEpisodeNumber || episode < 1 || episode > domain.MaxEpisodeNumber {
+		return nil, fmt.Errorf("%w: season and episode must be between 1 and %d", domain.ErrInvalidSeries, domain.MaxEpisodeNumber)
+	}
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	v, err := u.repo.Get(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.Can(domain.PermEditVideo, v.OwnerID) {
+		return nil, domain.ErrForbidden
+	}
+	if err := u.repo.SetEpisode(ctx, videoID, id, season, episode); err != nil {
+		return nil, err
+	}
+	return u.Get(ctx, id, caller)
+}
+
+func (u *seriesUsecase) RemoveEpisode(ctx context.Context, id string, caller *domain.Caller, videoID string) (*domain.Series, error) {
+	if _, err := u.getManaged(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	if err := u.repo.RemoveEpisode(ctx, id, videoID); err != nil {
+		return nil, err
+	}
+	return u.Get(ctx, id, caller)
+}
+
+// NextEpisode walks the episodes in order, skipping those the viewer can't play yet.
+func (u *seriesUsecase) NextEpisode(ctx context.Context, id, afterVideoID, viewerID string) (*domain.Video, error) {
+	if _, err := u.repo.GetSeries(ctx, id); err != nil {
+		return nil, err
+	}
+	episodes, err := u.repo.ListEpisodes(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*domain.Video
+	switch {
+	case afterVideoID != "":
+		i := slices.IndexFunc(episodes, func(v *domain.Video) bool { return v.ID == afterVideoID })
+		if i < 0 {
+			return nil, domain.ErrNotEpisode
+		}
+		candidates = episodes[i+1:]
+	case viewerID != "":
+		// Resume where the viewer left off: the first episode they haven't completed
+		completed, err := u.repo.ListCompletedEpisodes(ctx, viewerID, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range episodes {
+			if !slices.Contains(completed, v.ID) {
+				candidates = append(candidates, v)
+			}
+		}
+	default:
+		candidates = episodes
+	}
+
+	now := time.Now()
+	for _, v := range candidates {
+		ok, err := u.canWatch(ctx, v, viewerID, now)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			return v, nil
+		}
+	}
+	return nil, domain.ErrNoNextEpisode
+}
+
+func (u *seriesUsecase) SetCompleted(ctx context.Context, id string, caller *domain.Caller, videoID string, completed bool) error {
+	if caller.UserID == "" {
+		return fmt.Errorf("%w: sign in to track completion", domain.ErrInvalidSeries)
+	}
+	v, err := u.repo.Get(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	if v.SeriesID != id {
+		return domain.ErrNotEpisode
+	}
+	return u.repo.SetEpisodeCompleted(ctx, caller.UserID, videoID, completed, time.Now().UTC())
+}
+
+// canWatch reports whether userID may play the episode at now.
+func (u *seriesUsecase) canWatch(ctx context.Context, v *domain.Video, userID string, now time.Time) (bool, error) {
+	if !v.Playable(now) {
+		return false, nil
+	}
+	if v.Visibility != domain.VisibilityPrivate || (userID != "" && userID == v.OwnerID) {
+		return true, nil
+	}
+	if userID == "" {
+		return false, nil
+	}
+	return u.repo.HasAccess(ctx, v.ID, userID)
+}
+
+// getManaged returns the series if caller may manage it: its owner or an admin.
+func (u *seriesUsecase) getManaged(ctx context.Context, id string, caller *domain.Caller) (*domain.Series, error) {
+	series, err := u.repo.GetSeries(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.Can(domain.PermEditSeries, series.OwnerID) {
+		return nil, domain.ErrNotSeriesOwner
+	}
+	return series, nil
+}
@@ -0,0 +1,177 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/athandoan/youtube/metadata-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSeriesUsecase_NextEpisode(t *testing.T) {
+	series := &domain.Series{ID: "series-1", OwnerID: "user-1"}
+	episodes := func() []*domain.Video {
+		return []*domain.Video{
+			{ID: "ep-1", OwnerID: "user-1", Status: "ready", Visibility: domain.VisibilityPublic, SeriesID: "series-1", Season: 1, Episode: 1},
+			{ID: "ep-2", OwnerID: "user-1", Status: "ready", Visibility: domain.VisibilityPrivate, SeriesID: "series-1", Season: 1, Episode: 2},
+			{ID: "ep-3", OwnerID: "user-1", Status: "processing", Visibility: domain.VisibilityPublic, SeriesID: "series-1", Season: 1, Episode: 3},
+			{ID: "ep-4", OwnerID: "user-1", Status: "ready", Visibility: domain.VisibilityPublic, SeriesID: "series-1", Season: 2, Episode: 1},
+			{ID: "ep-5", OwnerID: "user-1", Status: "ready", Visibility: domain.VisibilityPublic, SeriesID: "series-1", Season: 2, Episode: 2,
+				PublishAt: time.Now().Add(time.Hour)},
+		}
+	}
+	tests := []struct {
+		name         string
+		afterVideoID string
+		viewerID     string
+		setupMock    func(m *mocks.MockVideoRepository)
+		wantID       string
+		wantErr      error
+	}{
+		{
+			name:         "success - private episode shared with the viewer",
+			afterVideoID: "ep-1",
+			viewerID:     "user-2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().HasAccess(gomock.Any(), "ep-2", "user-2").Return(true, nil)
+			},
+			wantID: "ep-2",
+		},
+		{
+			name:         "success - skips private and unprocessed episodes across seasons",
+			afterVideoID: "ep-1",
+			viewerID:     "",
+			wantID:       "ep-4",
+		},
+		{
+			name:     "success - resumes at the first episode not completed",
+			viewerID: "user-2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().ListCompletedEpisodes(gomock.Any(), "user-2", "series-1").Return([]string{"ep-1", "ep-2"}, nil)
+			},
+			wantID: "ep-4",
+		},
+		{
+			name:     "success - anonymous viewers start at the beginning",
+			viewerID: "",
+			wantID:   "ep-1",
+		},
+		{
+			name:         "error - only a scheduled episode follows",
+			afterVideoID: "ep-4",
+			viewerID:     "user-1",
+			wantErr:      domain.ErrNoNextEpisode,
+		},
+		{
+			name:         "error - not an episode",
+			afterVideoID: "video-9",
+			wantErr:      domain.ErrNotEpisode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			mockRepo.EXPECT().GetSeries(gomock.Any(), "series-1").Return(series, nil)
+			mockRepo.EXPECT().ListEpisodes(gomock.Any(), "series-1").Return(episodes(), nil)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			uc := NewSeriesUsecase(mockRepo)
+			v, err := uc.NextEpisode(context.Background(), "series-1", tt.afterVideoID, tt.viewerID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NextEpisode() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && v.ID != tt.wantID {
+				t.Errorf("NextEpisode() = %s, want %s", v.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestSeriesUsecase_SetEpisode(t *testing.T) {
+	series := &domain.Series{ID: "series-1", OwnerID: "user-1"}
+	owner := &domain.Caller{UserID: "user-1"}
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		season    int
+		episode   int
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:    "success",
+			caller:  owner,
+			season:  1,
+			episode: 3,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetSeries(gomock.Any(), "series-1").Return(series, nil).Times(2)
+				m.EXPECT().Get(gomock.Any(), "video-1").Return(&domain.Video{ID: "video-1", OwnerID: "user-1"}, nil)
+				m.EXPECT().SetEpisode(gomock.Any(), "video-1", "series-1", 1, 3).Return(nil)
+				m.EXPECT().ListEpisodes(gomock.Any(), "series-1").Return(nil, nil)
+				m.EXPECT().ListCompletedEpisodes(gomock.Any(), "user-1", "series-1").Return(nil, nil)
+			},
+		},
+		{
+			name:    "error - numbers taken",
+			caller:  owner,
+			season:  1,
+			episode: 1,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetSeries(gomock.Any(), "series-1").Return(series, nil)
+				m.EXPECT().Get(gomock.Any(), "video-1").Return(&domain.Video{ID: "video-1", OwnerID: "user-1"}, nil)
+				m.EXPECT().SetEpisode(gomock.Any(), "video-1", "series-1", 1, 1).Return(domain.ErrEpisodeTaken)
+			},
+			wantErr: domain.ErrEpisodeTaken,
+		},
+		{
+			name:    "error - someone else's video",
+			caller:  owner,
+			season:  1,
+			episode: 1,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetSeries(gomock.Any(), "series-1").Return(series, nil)
+				m.EXPECT().Get(gomock.Any(), "video-1").Return(&domain.Video{ID: "video-1", OwnerID: "user-2"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:    "error - someone else's series",
+			caller:  &domain.Caller{UserID: "user-2"},
+			season:  1,
+			episode: 1,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetSeries(gomock.Any(), "series-1").Return(series, nil)
+			},
+			wantErr: domain.ErrNotSeriesOwner,
+		},
+		{name: "error - season zero", caller: owner, season: 0, episode: 1, wantErr: domain.ErrInvalidSeries},
+		{name: "error - episode out of range", caller: owner, season: 1, episode: domain.MaxEpisodeNumber + 1, wantErr: domain.ErrInvalidSeries},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			uc := NewSeriesUsecase(mockRepo)
+			_, err := uc.SetEpisode(context.Background(), "series-1", tt.caller, "video-1", tt.season, tt.episode)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SetEpisode() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+)
+
+type subscriptionUsecase struct {
+	repo domain.VideoRepository
+}
+
+func NewSubscriptionUsecase(repo domain.VideoRepository) domain.SubscriptionUsecase {
+	return &subscriptionUsecase{repo: repo}
+}
+
+func (u *subscriptionUsecase) Subscribe(ctx context.Context, channelID string, caller *domain.Caller) error {
+	if caller.UserID == "" {
+		return fmt.Errorf("%w: sign in to subscribe", domain.ErrInvalidChannel)
+	}
+	if _, err := u.repo.GetChannel(ctx, channelID); err != nil {
+		return err
+	}
+	return u.repo.Subscribe(ctx, caller.UserID, channelID, time.Now().UTC())
+}
+
+// Unsubscribe doesn't look the channel up, so viewers can always drop a subscription.
+func (u *subscriptionUsecase) Unsubscribe(ctx context.Context, channelID string, caller *domain.Caller) error {
+	if caller.UserID == "" {
+		return fmt.Errorf("%w: sign in to unsubscribe", domain.ErrInvalidChannel)
+	}
+	return u.repo.Unsubscribe(ctx, caller.UserID, channelID)
+}
+
+func (u *subscriptionUsecase) List(ctx context.Context, caller *domain.Caller) ([]*domain.Channel, error) {
+	if caller.UserID == "" {
+		return nil, nil
+	}
+	return u.repo.ListSubscriptions(ctx, caller.UserID)
+}
+
+func (u *subscriptionUsecase) Feed(ctx context.Context, caller *domain.Caller, pageToken string, pageSize int) ([]*domain.Video, string, error) {
+	if caller.UserID == "" {
+		return nil, "", nil
+	}
+	switch {
+	case pageSize <= 0:
+		pageSize = domain.DefaultFeedPageSize
+	case pageSize > domain.MaxFeedPageSize:
+		pageSize = domain.MaxFeedPageSize
+	}
+	var after *domain.FeedCursor
+	if pageToken != "" {
+		var err error
+		if after, err = decodeFeedCursor(pageToken); err != nil {
+			return nil, "", err
+		}
+	}
+
+	videos, next, err := u.repo.ListSubscriptionFeed(ctx, caller.UserID, after, pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	if next == nil {
+		return videos, "", nil
+	}
+	return videos, encodeFeedCursor(next), nil
+}
+
+// Page tokens are opaque to clients; they hold the cursor as "{unix seconds}:{video ID}".
+func encodeFeedCursor(c *domain.FeedCursor) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(c.PublishedAt.Unix(), 10) + ":" + c.VideoID))
+}
+
+func decodeFeedCursor(token string) (*domain.FeedCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, domain.ErrInvalidPageToken
+	}
+	seconds, videoID, ok := strings.Cut(string(raw), ":")
+	if !ok || videoID == "" {
+		return nil, domain.ErrInvalidPageToken
+	}
+	unix, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		return nil, domain.ErrInvalidPageToken
+	}
+	return &domain.FeedCursor{PublishedAt: time.Unix(unix, 0).UTC(), VideoID: videoID}, nil
+}
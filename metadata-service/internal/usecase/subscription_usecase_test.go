@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/metadata-service/internal/domain"
+	"github.com/athandoan/youtube/metadata-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubscriptionUsecase_Subscribe(t *testing.T) {
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:   "success",
+			caller: &domain.Caller{UserID: "user-1"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "channel-1").Return(&domain.Channel{ID: "channel-1"}, nil)
+				m.EXPECT().Subscribe(gomock.Any(), "user-1", "channel-1", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "error - channel not found",
+			caller: &domain.Caller{UserID: "user-1"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "channel-1").Return(nil, domain.ErrChannelNotFound)
+			},
+			wantErr: domain.ErrChannelNotFound,
+		},
+		{
+			name:    "error - anonymous",
+			caller:  &domain.Caller{},
+			wantErr: domain.ErrInvalidChannel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			uc := NewSubscriptionUsecase(mockRepo)
+			err := uc.Subscribe(context.Background(), "channel-1", tt.caller)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Subscribe() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSubscriptionUsecase_Feed(t *testing.T) {
+	caller := &domain.Caller{UserID: "user-1"}
+	cursor := &domain.FeedCursor{PublishedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC), VideoID: "video-20"}
+	tests := []struct {
+		name      string
+		pageToken string
+		pageSize  int
+		setupMock func(m *mocks.MockVideoRepository)
+		wantNext  string
+		wantErr   error
+	}{
+		{
+			name:     "success - first page with a next page",
+			pageSize: 20,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().ListSubscriptionFeed(gomock.Any(), "user-1", nil, 20).Return([]*domain.Video{{ID: "video-1"}}, cursor, nil)
+			},
+			wantNext: encodeFeedCursor(cursor),
+		},
+		{
+			name:      "success - page token resumes after its cursor",
+			pageToken: encodeFeedCursor(cursor),
+			pageSize:  20,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().ListSubscriptionFeed(gomock.Any(), "user-1", cursor, 20).Return([]*domain.Video{{ID: "video-21"}}, nil, nil)
+			},
+		},
+		{
+			name: "success - page size defaults",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().ListSubscriptionFeed(gomock.Any(), "user-1", nil, domain.DefaultFeedPageSize).Return(nil, nil, nil)
+			},
+		},
+		{
+			name:     "success - page size is capped",
+			pageSize: domain.MaxFeedPageSize + 1,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().ListSubscriptionFeed(gomock.Any(), "user-1", nil, domain.MaxFeedPageSize).Return(nil, nil, nil)
+			},
+		},
+		{
+			name:      "error - malformed page token",
+			pageToken: "not a token",
+			wantErr:   domain.ErrInvalidPageToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			if tt.setupMock != nil {
+				tt.setupMock(mockRepo)
+			}
+
+			uc := NewSubscriptionUsecase(mockRepo)
+			_, next, err := uc.Feed(context.Background(), caller, tt.pageToken, tt.pageSize)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Feed() error = %v, want %v", err, tt.wantErr)
+			}
+			if next != tt.wantNext {
+				t.Errorf("Feed() next = %q, want %q", next, tt.wantNext)
+			}
+		})
+	}
+}
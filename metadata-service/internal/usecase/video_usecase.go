@@ -2,27 +2,62 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/athandoan/youtube/metadata-service/internal/domain"
 	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	// Failed storage cleanups are retried after cleanupRetryBase, doubling up to cleanupRetryMax.
+	cleanupRetryBase = 30 * time.Second
+	cleanupRetryMax  = time.Hour
+	// purgeBatchSize caps the videos PurgeTrash deletes per run.
+	purgeBatchSize = 100
 )
 
 type videoUsecase struct {
-	repo domain.VideoRepository
+	repo   domain.VideoRepository
+	events domain.EventPublisher
 }
 
-func NewVideoUsecase(repo domain.VideoRepository) domain.VideoUsecase {
-	return &videoUsecase{repo: repo}
+func NewVideoUsecase(repo domain.VideoRepository, events domain.EventPublisher) domain.VideoUsecase {
+	return &videoUsecase{repo: repo, events: events}
 }
 
-func (u *videoUsecase) Create(ctx context.Context, title, bucket, objectKey string) (string, error) {
+// Create registers an upload. A video with publishAt set stays unlisted until the publisher
+// lists it; a premiere additionally starts playing as pseudo-live at that time. Only the
+// owner and members of a channel can post to it.
+func (u *videoUsecase) Create(ctx context.Context, ownerID, channelID, title, bucket, objectKey string, allowDownload bool, publishAt time.Time, premiere bool) (string, error) {
+	if premiere && publishAt.IsZero() {
+		return "", domain.ErrPremiereNoSchedule
+	}
+	if err := u.checkCanPost(ctx, channelID, ownerID); err != nil {
+		return "", err
+	}
 	id := uuid.New().String()
 	video := &domain.Video{
-		ID:         id,
-		Title:      title,
-		BucketName: bucket,
-		ObjectKey:  objectKey,
-		Status:     "pending",
+		ID:            id,
+		Title:         title,
+		BucketName:    bucket,
+		ObjectKey:     objectKey,
+		Status:        "pending",
+		OwnerID:       ownerID,
+		ChannelID:     channelID,
+		AllowDownload: allowDownload,
+		PublishAt:     publishAt,
+		Premiere:      premiere,
+		Visibility:    domain.VisibilityPublic,
 	}
 	if err := u.repo.Create(ctx, video); err != nil {
 		return "", err
@@ -30,14 +65,509 @@ func (u *videoUsecase) Create(ctx context.Context, title, bucket, objectKey stri
 	return id, nil
 }
 
+// checkCanPost returns ErrNotChannelMember unless userID may post to channelID, if set.
+func (u *videoUsecase) checkCanPost(ctx context.Context, channelID, userID string) error {
+	if channelID == "" {
+		return nil
+	}
+	channel, err := u.repo.GetChannel(ctx, channelID)
+	if err != nil {
+		return err
+	}
+	if !channel.CanPost(userID) {
+		return domain.ErrNotChannelMember
+	}
+	return nil
+}
+
 func (u *videoUsecase) Get(ctx context.Context, id string) (*domain.Video, error) {
 	return u.repo.Get(ctx, id)
 }
 
-func (u *videoUsecase) List(ctx context.Context, query string) ([]*domain.Video, error) {
-	return u.repo.List(ctx, query)
+func (u *videoUsecase) GetForViewer(ctx context.Context, id, userID string) (*domain.Video, error) {
+	v, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if v.Visibility != domain.VisibilityPrivate || (userID != "" && userID == v.OwnerID) {
+		return v, nil
+	}
+	if userID == "" {
+		return nil, domain.ErrPrivateVideo
+	}
+	ok, err := u.repo.HasAccess(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, domain.ErrPrivateVideo
+	}
+	return v, nil
+}
+
+func (u *videoUsecase) List(ctx context.Context, query string, filter domain.VideoFilter) ([]*domain.Video, error) {
+	filter.Tag = normalizeLabel(filter.Tag)
+	filter.Category = normalizeLabel(filter.Category)
+	return u.repo.List(ctx, query, filter)
+}
+
+// Update validates and normalizes the changed fields before applying them. Only the owner
+// and admins can edit a video.
+func (u *videoUsecase) Update(ctx context.Context, id string, caller *domain.Caller, update *domain.VideoUpdate) (*domain.Video, error) {
+	if update.Title == nil && update.Description == nil && update.Tags == nil && update.Category == nil && update.Visibility == nil {
+		return nil, fmt.Errorf("%w: nothing to update", domain.ErrInvalidUpdate)
+	}
+
+	v, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !caller.Can(domain.PermEditVideo, v.OwnerID) {
+		return nil, domain.ErrForbidden
+	}
+
+	normalized := &domain.VideoUpdate{Description: update.Description}
+	if update.Title != nil {
+		title := strings.TrimSpace(*update.Title)
+		if title == "" {
+			return nil, fmt.Errorf("%w: title is required", domain.ErrInvalidUpdate)
+		}
+		if utf8.RuneCountInString(title) > domain.MaxTitleLength {
+			return nil, fmt.Errorf("%w: title is longer than %d characters", domain.ErrInvalidUpdate, domain.MaxTitleLength)
+		}
+		normalized.Title = &title
+	}
+	if update.Description != nil && utf8.RuneCountInString(*update.Description) > domain.MaxDescriptionLength {
+		return nil, fmt.Errorf("%w: description is longer than %d characters", domain.ErrInvalidUpdate, domain.MaxDescriptionLength)
+	}
+	if update.Tags != nil {
+		tags, err := normalizeTags(*update.Tags)
+		if err != nil {
+			return nil, err
+		}
+		normalized.Tags = &tags
+	}
+	if update.Category != nil {
+		category := normalizeLabel(*update.Category)
+		if utf8.RuneCountInString(category) > domain.MaxCategoryLength {
+			return nil, fmt.Errorf("%w: category is longer than %d characters", domain.ErrInvalidUpdate, domain.MaxCategoryLength)
+		}
+		normalized.Category = &category
+	}
+	if update.Visibility != nil {
+		switch *update.Visibility {
+		case domain.VisibilityPublic, domain.VisibilityUnlisted:
+		case domain.VisibilityPrivate:
+			// Nobody could watch a private video without an owner to grant access
+			if v.OwnerID == "" {
+				return nil, fmt.Errorf("%w: only videos with an owner can be private", domain.ErrInvalidUpdate)
+			}
+		default:
+			return nil, fmt.Errorf("%w: visibility must be public, unlisted or private", domain.ErrInvalidUpdate)
+		}
+		normalized.Visibility = update.Visibility
+	}
+
+	if err := u.repo.Update(ctx, id, normalized); err != nil {
+		return nil, err
+	}
+	return u.repo.Get(ctx, id)
+}
+
+// normalizeTags normalizes each tag and drops empty and repeated ones, keeping the order.
+func normalizeTags(raw []string) ([]string, error) {
+	tags := make([]string, 0, len(raw))
+	seen := make(map[string]bool)
+	for _, t := range raw {
+		tag := normalizeLabel(t)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		// Tags are stored comma-separated alongside the video
+		if strings.Contains(tag, ",") {
+			return nil, fmt.Errorf("%w: tags cannot contain commas", domain.ErrInvalidUpdate)
+		}
+		if utf8.RuneCountInString(tag) > domain.MaxTagLength {
+			return nil, fmt.Errorf("%w: tag %q is longer than %d characters", domain.ErrInvalidUpdate, tag, domain.MaxTagLength)
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	if len(tags) > domain.MaxTags {
+		return nil, fmt.Errorf("%w: at most %d tags", domain.ErrInvalidUpdate, domain.MaxTags)
+	}
+	return tags, nil
 }
 
-func (u *videoUsecase) UpdateStatus(ctx context.Context, id string, status string) error {
+// normalizeLabel lowercases a tag or category and collapses its whitespace, so "Go  Lang "
+// and "go lang" are the same tag.
+func normalizeLabel(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+func (u *videoUsecase) UpdateStatus(ctx context.Context, id string, status string, durationSeconds float64) error {
+	if durationSeconds > 0 {
+		if err := u.repo.UpdateDuration(ctx, id, durationSeconds); err != nil {
+			return err
+		}
+	}
 	return u.repo.UpdateStatus(ctx, id, status)
 }
+
+func (u *videoUsecase) CreateStreamKey(ctx context.Context, ownerID, channelID, title string) (*domain.StreamKey, error) {
+	if err := u.checkCanPost(ctx, channelID, ownerID); err != nil {
+		return nil, err
+	}
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	key := &domain.StreamKey{
+		Key:       hex.EncodeToString(b),
+		OwnerID:   ownerID,
+		ChannelID: channelID,
+		Title:     title,
+	}
+	if err := u.repo.CreateStreamKey(ctx, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// StartLiveStream authenticates a publish by stream key and creates the video viewers watch it
+// through. Live videos are listed right away; there is no upload to wait for. Keys stop
+// working once their owner can no longer post to the key's channel.
+func (u *videoUsecase) StartLiveStream(ctx context.Context, streamKey string) (*domain.Video, error) {
+	key, err := u.repo.GetStreamKey(ctx, streamKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkCanPost(ctx, key.ChannelID, key.OwnerID); err != nil {
+		return nil, err
+	}
+
+	title := key.Title
+	if title == "" {
+		title = "Live " + time.Now().UTC().Format("2006-01-02 15:04")
+	}
+	video := &domain.Video{
+		ID:         uuid.New().String(),
+		Title:      title,
+		Status:     "ready",
+		OwnerID:    key.OwnerID,
+		ChannelID:  key.ChannelID,
+		LiveStatus: domain.LiveStatusLive,
+		Visibility: domain.VisibilityPublic,
+		CreatedAt:  time.Now(),
+	}
+	if err := u.repo.Create(ctx, video); err != nil {
+		return nil, err
+	}
+	return video, nil
+}
+
+// UpdateLiveStatus records a live video going live or ending. An ended stream stays out of
+// listings while its recording is processed into a regular video.
+func (u *videoUsecase) UpdateLiveStatus(ctx context.Context, id string, liveStatus string) error {
+	if liveStatus != domain.LiveStatusLive && liveStatus != domain.LiveStatusEnded {
+		return domain.ErrInvalidLiveStatus
+	}
+	if err := u.repo.UpdateLiveStatus(ctx, id, liveStatus); err != nil {
+		return err
+	}
+	if liveStatus == domain.LiveStatusEnded {
+		return u.repo.UpdateStatus(ctx, id, "processing")
+	}
+	return nil
+}
+
+// CompleteRecording publishes the processed recording of an ended stream, stored at objectKey
+// in bucket. A stream that recorded nothing fails instead.
+func (u *videoUsecase) CompleteRecording(ctx context.Context, id string, durationSeconds float64, bucket, objectKey string) error {
+	if durationSeconds <= 0 || objectKey == "" {
+		return u.repo.UpdateStatus(ctx, id, "failed")
+	}
+	if err := u.repo.UpdateRecording(ctx, id, durationSeconds, bucket, objectKey); err != nil {
+		return err
+	}
+	return u.repo.UpdateStatus(ctx, id, "ready")
+}
+
+func (u *videoUsecase) PublishDue(ctx context.Context, now time.Time) (int, error) {
+	videos, err := u.repo.ListDueForPublish(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	published := 0
+	for _, v := range videos {
+		ok, err := u.repo.MarkPublished(ctx, v.ID)
+		if err != nil {
+			return published, err
+		}
+		if !ok {
+			continue
+		}
+		published++
+
+		// The video is listed either way; a lost event must not hold publication back
+		event := &domain.Event{
+			Type:       domain.EventVideoPublished,
+			VideoID:    v.ID,
+			OwnerID:    v.OwnerID,
+			Title:      v.Title,
+			Premiere:   v.Premiere,
+			OccurredAt: now,
+		}
+		if err := u.events.Publish(ctx, event); err != nil {
+			log.Printf("failed to emit %s for video %s: %v", event.Type, v.ID, err)
+		}
+	}
+	return published, nil
+}
+
+// Delete moves a video to the trash, where it stays restorable until PurgeTrash removes it
+// and its objects for good.
+func (u *videoUsecase) Delete(ctx context.Context, id string, caller *domain.Caller) error {
+	v, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !caller.Can(domain.PermDeleteVideo, v.OwnerID) {
+		return domain.ErrForbidden
+	}
+	if v.LiveStatus == domain.LiveStatusLive {
+		return domain.ErrVideoLive
+	}
+	return u.repo.Trash(ctx, id, time.Now())
+}
+
+func (u *videoUsecase) Restore(ctx context.Context, id string, caller *domain.Caller) error {
+	v, err := u.repo.GetTrashed(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !caller.Can(domain.PermDeleteVideo, v.OwnerID) {
+		return domain.ErrForbidden
+	}
+	return u.repo.Restore(ctx, id)
+}
+
+// Unpublish takes a video out of public view by making it private, which moderators can do
+// to anyone's video. Unlike owners editing visibility, they may do so to videos without an
+// owner, which then only admins can make public again.
+func (u *videoUsecase) Unpublish(ctx context.Context, id string, caller *domain.Caller) error {
+	v, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !caller.Can(domain.PermUnpublishVideo, v.OwnerID) {
+		return domain.ErrForbidden
+	}
+	private := domain.VisibilityPrivate
+	return u.repo.Update(ctx, id, &domain.VideoUpdate{Visibility: &private})
+}
+
+// ListTrash returns userID's trashed videos; without a user, the trashed videos nobody owns.
+func (u *videoUsecase) ListTrash(ctx context.Context, userID string) ([]*domain.Video, error) {
+	return u.repo.ListTrash(ctx, userID)
+}
+
+func (u *videoUsecase) GrantAccess(ctx context.Context, id string, caller *domain.Caller, granteeID string) error {
+	if err := u.checkManager(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.GrantAccess(ctx, id, granteeID)
+}
+
+func (u *videoUsecase) RevokeAccess(ctx context.Context, id string, caller *domain.Caller, granteeID string) error {
+	if err := u.checkManager(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.RevokeAccess(ctx, id, granteeID)
+}
+
+func (u *videoUsecase) ListAccess(ctx context.Context, id string, caller *domain.Caller) ([]string, error) {
+	if err := u.checkManager(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	return u.repo.ListAccess(ctx, id)
+}
+
+// CreateShareLink creates a link anyone can play the video through without an account. The
+// token is random and only the password's hash is stored.
+func (u *videoUsecase) CreateShareLink(ctx context.Context, id string, caller *domain.Caller, expiresAt time.Time, maxViews int, password string) (*domain.ShareLink, error) {
+	if err := u.checkManager(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(domain.DefaultShareLinkTTL)
+	}
+	if !expiresAt.After(now) {
+		return nil, fmt.Errorf("%w: expires_at must be in the future", domain.ErrInvalidShareLink)
+	}
+	if expiresAt.Sub(now) > domain.MaxShareLinkTTL {
+		return nil, fmt.Errorf("%w: expires_at must be within %s", domain.ErrInvalidShareLink, domain.MaxShareLinkTTL)
+	}
+	if maxViews < 0 {
+		return nil, fmt.Errorf("%w: max_views must not be negative", domain.ErrInvalidShareLink)
+	}
+
+	var hash string
+	if password != "" {
+		h, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			if errors.Is(err, bcrypt.ErrPasswordTooLong) {
+				return nil, fmt.Errorf("%w: password is longer than 72 bytes", domain.ErrInvalidShareLink)
+			}
+			return nil, err
+		}
+		hash = string(h)
+	}
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	link := &domain.ShareLink{
+		Token:        base64.RawURLEncoding.EncodeToString(b),
+		VideoID:      id,
+		CreatedBy:    caller.UserID,
+		ExpiresAt:    expiresAt.Truncate(time.Second),
+		MaxViews:     maxViews,
+		PasswordHash: hash,
+		CreatedAt:    now.Truncate(time.Second),
+	}
+	if err := u.repo.CreateShareLink(ctx, link); err != nil {
+		return nil, err
+	}
+	return link, nil
+}
+
+func (u *videoUsecase) ListShareLinks(ctx context.Context, id string, caller *domain.Caller) ([]*domain.ShareLink, error) {
+	if err := u.checkManager(ctx, id, caller); err != nil {
+		return nil, err
+	}
+	return u.repo.ListShareLinks(ctx, id)
+}
+
+func (u *videoUsecase) RevokeShareLink(ctx context.Context, id string, caller *domain.Caller, token string) error {
+	if err := u.checkManager(ctx, id, caller); err != nil {
+		return err
+	}
+	return u.repo.RevokeShareLink(ctx, id, token, time.Now())
+}
+
+// ResolveShareLink checks the link and its password before counting the view, so wrong
+// passwords and links to trashed videos do not use views up.
+func (u *videoUsecase) ResolveShareLink(ctx context.Context, token, password string) (*domain.Video, error) {
+	link, err := u.repo.GetShareLink(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if !link.Usable(now) {
+		return nil, domain.ErrShareLinkNotFound
+	}
+	if link.PasswordHash != "" && bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(password)) != nil {
+		return nil, domain.ErrSharePassword
+	}
+	v, err := u.repo.Get(ctx, link.VideoID)
+	if err != nil {
+		if errors.Is(err, domain.ErrVideoNotFound) {
+			return nil, domain.ErrShareLinkNotFound
+		}
+		return nil, err
+	}
+	ok, err := u.repo.ConsumeShareLinkView(ctx, token, now)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// Used up or revoked since we read it
+		return nil, domain.ErrShareLinkNotFound
+	}
+	return v, nil
+}
+
+// checkManager requires caller to manage who can watch the video: its owner or an admin.
+func (u *videoUsecase) checkManager(ctx context.Context, id string, caller *domain.Caller) error {
+	v, err := u.repo.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !caller.Can(domain.PermEditVideo, v.OwnerID) {
+		return domain.ErrForbidden
+	}
+	return nil
+}
+
+// PurgeTrash deletes videos for good and leaves their objects to the storage cleanup, so a
+// storage outage cannot hold the purge back. Recordings the live service hasn't completed yet
+// have no objects here; it drops them once CompleteRecording finds the video gone.
+func (u *videoUsecase) PurgeTrash(ctx context.Context, cutoff time.Time) (int, error) {
+	videos, err := u.repo.ListTrashedBefore(ctx, cutoff, purgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, v := range videos {
+		var cleanup *domain.StorageCleanup
+		if v.BucketName != "" && v.ObjectKey != "" {
+			cleanup = &domain.StorageCleanup{
+				VideoID:       v.ID,
+				Bucket:        v.BucketName,
+				Prefix:        storagePrefix(v.ObjectKey),
+				NextAttemptAt: time.Now(),
+			}
+		}
+		if err := u.repo.Delete(ctx, v.ID, cleanup); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// storagePrefix returns the prefix holding an upload and everything derived from it,
+// e.g. "uuid/video.mp4" -> "uuid/", which also covers "uuid/renditions/720p/video.mp4".
+// Prefixes end in a slash; a key outside a directory is returned as is, to be removed alone.
+func storagePrefix(objectKey string) string {
+	dir := path.Dir(objectKey)
+	if dir == "." || dir == "/" {
+		// Not under a per-video prefix; only the object itself is the video's, not siblings
+		// such as "video.mp4.bak" that share it as a prefix
+		return objectKey
+	}
+	return dir + "/"
+}
+
+func (u *videoUsecase) ListStorageCleanups(ctx context.Context, limit int) ([]*domain.StorageCleanup, error) {
+	if limit <= 0 {
+		limit = 10
+	}
+	return u.repo.ListStorageCleanups(ctx, time.Now(), limit)
+}
+
+func (u *videoUsecase) CompleteStorageCleanup(ctx context.Context, videoID, errMsg string) error {
+	if errMsg == "" {
+		return u.repo.DeleteStorageCleanup(ctx, videoID)
+	}
+
+	c, err := u.repo.GetStorageCleanup(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	log.Printf("storage cleanup of video %s failed (attempt %d): %s", videoID, c.Attempts+1, errMsg)
+	return u.repo.RescheduleStorageCleanup(ctx, videoID, time.Now().Add(cleanupBackoff(c.Attempts)), errMsg)
+}
+
+// cleanupBackoff returns how long to wait after the attempts+1st failure.
+func cleanupBackoff(attempts int) time.Duration {
+	d := cleanupRetryBase
+	for i := 0; i < attempts && d < cleanupRetryMax; i++ {
+		d *= 2
+	}
+	return min(d, cleanupRetryMax)
+}
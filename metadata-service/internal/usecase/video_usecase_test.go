@@ -4,24 +4,38 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/athandoan/youtube/metadata-service/internal/domain"
 	"github.com/athandoan/youtube/metadata-service/internal/mocks"
 	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	anonymous = &domain.Caller{}
+	admin     = &domain.Caller{UserID: "admin-1", Roles: []string{domain.RoleAdmin}}
+	moderator = &domain.Caller{UserID: "mod-1", Roles: []string{domain.RoleModerator}}
 )
 
 func TestVideoUsecase_Create(t *testing.T) {
 	tests := []struct {
-		name      string
-		title     string
-		bucket    string
-		objectKey string
-		setupMock func(m *mocks.MockVideoRepository)
-		wantErr   bool
-		wantIDLen int
+		name          string
+		ownerID       string
+		channelID     string
+		title         string
+		bucket        string
+		objectKey     string
+		allowDownload bool
+		publishAt     time.Time
+		premiere      bool
+		setupMock     func(m *mocks.MockVideoRepository)
+		wantErr       bool
+		wantIDLen     int
 	}{
 		{
 			name:      "success - creates video with valid data",
+			ownerID:   "channel-1",
 			title:     "Test Video",
 			bucket:    "videos",
 			objectKey: "uuid/test.mp4",
@@ -29,6 +43,9 @@ func TestVideoUsecase_Create(t *testing.T) {
 				m.EXPECT().
 					Create(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, v *domain.Video) error {
+						if v.OwnerID != "channel-1" {
+							t.Errorf("expected owner 'channel-1', got %s", v.OwnerID)
+						}
 						if v.Title != "Test Video" {
 							t.Errorf("expected title 'Test Video', got %s", v.Title)
 						}
@@ -47,6 +64,101 @@ func TestVideoUsecase_Create(t *testing.T) {
 			wantErr:   false,
 			wantIDLen: 36, // UUID length
 		},
+		{
+			name:          "success - records download permission",
+			title:         "Test Video",
+			bucket:        "videos",
+			objectKey:     "uuid/test.mp4",
+			allowDownload: true,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, v *domain.Video) error {
+						if !v.AllowDownload {
+							t.Errorf("expected AllowDownload to be true")
+						}
+						return nil
+					})
+			},
+			wantErr:   false,
+			wantIDLen: 36,
+		},
+		{
+			name:      "success - schedules a premiere",
+			title:     "Test Video",
+			bucket:    "videos",
+			objectKey: "uuid/test.mp4",
+			publishAt: time.Date(2030, 1, 1, 18, 0, 0, 0, time.UTC),
+			premiere:  true,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, v *domain.Video) error {
+						if !v.PublishAt.Equal(time.Date(2030, 1, 1, 18, 0, 0, 0, time.UTC)) || !v.Premiere {
+							t.Errorf("expected a premiere at 2030-01-01 18:00, got %v premiere=%v", v.PublishAt, v.Premiere)
+						}
+						return nil
+					})
+			},
+			wantErr:   false,
+			wantIDLen: 36,
+		},
+		{
+			name:      "error - premiere without publish_at",
+			title:     "Test Video",
+			bucket:    "videos",
+			objectKey: "uuid/test.mp4",
+			premiere:  true,
+			setupMock: func(m *mocks.MockVideoRepository) {},
+			wantErr:   true,
+		},
+		{
+			name:      "success - a member posts to a channel",
+			ownerID:   "user-2",
+			channelID: "ch-1",
+			title:     "Test Video",
+			bucket:    "videos",
+			objectKey: "uuid/test.mp4",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").
+					Return(&domain.Channel{ID: "ch-1", OwnerID: "user-1", MemberIDs: []string{"user-2"}}, nil)
+				m.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, v *domain.Video) error {
+						if v.ChannelID != "ch-1" || v.OwnerID != "user-2" {
+							t.Errorf("expected user-2's video in ch-1, got owner %s channel %s", v.OwnerID, v.ChannelID)
+						}
+						return nil
+					})
+			},
+			wantErr:   false,
+			wantIDLen: 36,
+		},
+		{
+			name:      "error - not a member of the channel",
+			ownerID:   "user-3",
+			channelID: "ch-1",
+			title:     "Test Video",
+			bucket:    "videos",
+			objectKey: "uuid/test.mp4",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "ch-1").
+					Return(&domain.Channel{ID: "ch-1", OwnerID: "user-1", MemberIDs: []string{"user-2"}}, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name:      "error - unknown channel",
+			ownerID:   "user-1",
+			channelID: "missing",
+			title:     "Test Video",
+			bucket:    "videos",
+			objectKey: "uuid/test.mp4",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "missing").Return(nil, domain.ErrChannelNotFound)
+			},
+			wantErr: true,
+		},
 		{
 			name:      "error - repository fails",
 			title:     "Test Video",
@@ -69,8 +181,8 @@ func TestVideoUsecase_Create(t *testing.T) {
 			mockRepo := mocks.NewMockVideoRepository(ctrl)
 			tt.setupMock(mockRepo)
 
-			uc := NewVideoUsecase(mockRepo)
-			id, err := uc.Create(context.Background(), tt.title, tt.bucket, tt.objectKey)
+			uc := NewVideoUsecase(mockRepo, nil)
+			id, err := uc.Create(context.Background(), tt.ownerID, tt.channelID, tt.title, tt.bucket, tt.objectKey, tt.allowDownload, tt.publishAt, tt.premiere)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Create() error = %v, wantErr %v", err, tt.wantErr)
@@ -136,7 +248,7 @@ func TestVideoUsecase_Get(t *testing.T) {
 			mockRepo := mocks.NewMockVideoRepository(ctrl)
 			tt.setupMock(mockRepo)
 
-			uc := NewVideoUsecase(mockRepo)
+			uc := NewVideoUsecase(mockRepo, nil)
 			got, err := uc.Get(context.Background(), tt.id)
 
 			if (err != nil) != tt.wantErr {
@@ -160,6 +272,7 @@ func TestVideoUsecase_List(t *testing.T) {
 	tests := []struct {
 		name      string
 		query     string
+		filter    domain.VideoFilter
 		setupMock func(m *mocks.MockVideoRepository)
 		wantCount int
 		wantErr   bool
@@ -169,7 +282,7 @@ func TestVideoUsecase_List(t *testing.T) {
 			query: "",
 			setupMock: func(m *mocks.MockVideoRepository) {
 				m.EXPECT().
-					List(gomock.Any(), "").
+					List(gomock.Any(), "", domain.VideoFilter{}).
 					Return([]*domain.Video{
 						{ID: "video-1", Title: "Video 1"},
 						{ID: "video-2", Title: "Video 2"},
@@ -183,7 +296,7 @@ func TestVideoUsecase_List(t *testing.T) {
 			query: "golang",
 			setupMock: func(m *mocks.MockVideoRepository) {
 				m.EXPECT().
-					List(gomock.Any(), "golang").
+					List(gomock.Any(), "golang", domain.VideoFilter{}).
 					Return([]*domain.Video{
 						{ID: "video-1", Title: "Golang Tutorial"},
 					}, nil)
@@ -196,18 +309,32 @@ func TestVideoUsecase_List(t *testing.T) {
 			query: "nonexistent",
 			setupMock: func(m *mocks.MockVideoRepository) {
 				m.EXPECT().
-					List(gomock.Any(), "nonexistent").
+					List(gomock.Any(), "nonexistent", domain.VideoFilter{}).
 					Return([]*domain.Video{}, nil)
 			},
 			wantCount: 0,
 			wantErr:   false,
 		},
+		{
+			name:   "success - normalizes the tag and category filters",
+			query:  "",
+			filter: domain.VideoFilter{Tag: " Go  Lang", Category: "Education"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().
+					List(gomock.Any(), "", domain.VideoFilter{Tag: "go lang", Category: "education"}).
+					Return([]*domain.Video{
+						{ID: "video-1", Title: "Golang Tutorial", Tags: []string{"go lang"}, Category: "education"},
+					}, nil)
+			},
+			wantCount: 1,
+			wantErr:   false,
+		},
 		{
 			name:  "error - repository fails",
 			query: "",
 			setupMock: func(m *mocks.MockVideoRepository) {
 				m.EXPECT().
-					List(gomock.Any(), "").
+					List(gomock.Any(), "", domain.VideoFilter{}).
 					Return(nil, errors.New("database error"))
 			},
 			wantErr: true,
@@ -222,8 +349,8 @@ func TestVideoUsecase_List(t *testing.T) {
 			mockRepo := mocks.NewMockVideoRepository(ctrl)
 			tt.setupMock(mockRepo)
 
-			uc := NewVideoUsecase(mockRepo)
-			got, err := uc.List(context.Background(), tt.query)
+			uc := NewVideoUsecase(mockRepo, nil)
+			got, err := uc.List(context.Background(), tt.query, tt.filter)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("List() error = %v, wantErr %v", err, tt.wantErr)
@@ -237,46 +364,128 @@ func TestVideoUsecase_List(t *testing.T) {
 	}
 }
 
-func TestVideoUsecase_UpdateStatus(t *testing.T) {
+func TestVideoUsecase_Update(t *testing.T) {
+	ptr := func(s string) *string { return &s }
+
 	tests := []struct {
 		name      string
-		id        string
-		status    string
+		caller    *domain.Caller
+		update    *domain.VideoUpdate
 		setupMock func(m *mocks.MockVideoRepository)
-		wantErr   bool
+		wantErr   error
 	}{
 		{
-			name:   "success - updates status to ready",
-			id:     "video-123",
-			status: "ready",
+			name:   "success - owner updates title, tags and category",
+			caller: &domain.Caller{UserID: "channel-1"},
+			update: &domain.VideoUpdate{
+				Title:    ptr("  Go Tutorial "),
+				Tags:     &[]string{"Go", "  Back  End", "go", ""},
+				Category: ptr("Education"),
+			},
 			setupMock: func(m *mocks.MockVideoRepository) {
-				m.EXPECT().
-					UpdateStatus(gomock.Any(), "video-123", "ready").
-					Return(nil)
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+				m.EXPECT().Update(gomock.Any(), "video-123", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id string, u *domain.VideoUpdate) error {
+						if *u.Title != "Go Tutorial" || *u.Category != "education" || u.Description != nil {
+							t.Errorf("unexpected update %+v", u)
+						}
+						if got := *u.Tags; len(got) != 2 || got[0] != "go" || got[1] != "back end" {
+							t.Errorf("tags = %q, want [go back end]", got)
+						}
+						return nil
+					})
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", Title: "Go Tutorial"}, nil)
 			},
-			wantErr: false,
 		},
 		{
-			name:   "success - updates status to error",
-			id:     "video-123",
-			status: "error",
+			name:   "success - admin clears the description of a video nobody owns",
+			caller: admin,
+			update: &domain.VideoUpdate{Description: ptr("")},
 			setupMock: func(m *mocks.MockVideoRepository) {
-				m.EXPECT().
-					UpdateStatus(gomock.Any(), "video-123", "error").
-					Return(nil)
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", Description: "old"}, nil)
+				m.EXPECT().Update(gomock.Any(), "video-123", &domain.VideoUpdate{Description: ptr("")}).Return(nil)
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123"}, nil)
 			},
-			wantErr: false,
 		},
 		{
-			name:   "error - video not found",
-			id:     "nonexistent-id",
-			status: "ready",
+			name:   "success - owner makes the video private",
+			caller: &domain.Caller{UserID: "channel-1"},
+			update: &domain.VideoUpdate{Visibility: ptr(domain.VisibilityPrivate)},
 			setupMock: func(m *mocks.MockVideoRepository) {
-				m.EXPECT().
-					UpdateStatus(gomock.Any(), "nonexistent-id", "ready").
-					Return(errors.New("video not found"))
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+				m.EXPECT().Update(gomock.Any(), "video-123", &domain.VideoUpdate{Visibility: ptr(domain.VisibilityPrivate)}).Return(nil)
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", Visibility: domain.VisibilityPrivate}, nil)
 			},
-			wantErr: true,
+		},
+		{
+			name:   "error - private video without an owner",
+			caller: admin,
+			update: &domain.VideoUpdate{Visibility: ptr(domain.VisibilityPrivate)},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123"}, nil)
+			},
+			wantErr: domain.ErrInvalidUpdate,
+		},
+		{
+			name:   "error - unknown visibility",
+			caller: admin,
+			update: &domain.VideoUpdate{Visibility: ptr("hidden")},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123"}, nil)
+			},
+			wantErr: domain.ErrInvalidUpdate,
+		},
+		{
+			name:      "error - nothing to update",
+			caller:    admin,
+			update:    &domain.VideoUpdate{},
+			setupMock: func(m *mocks.MockVideoRepository) {},
+			wantErr:   domain.ErrInvalidUpdate,
+		},
+		{
+			name:   "error - blank title",
+			caller: admin,
+			update: &domain.VideoUpdate{Title: ptr("   ")},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123"}, nil)
+			},
+			wantErr: domain.ErrInvalidUpdate,
+		},
+		{
+			name:   "error - tag with a comma",
+			caller: admin,
+			update: &domain.VideoUpdate{Tags: &[]string{"a,b"}},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123"}, nil)
+			},
+			wantErr: domain.ErrInvalidUpdate,
+		},
+		{
+			name:   "error - not the owner",
+			caller: &domain.Caller{UserID: "someone-else"},
+			update: &domain.VideoUpdate{Title: ptr("Mine now")},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "error - moderators can't edit",
+			caller: moderator,
+			update: &domain.VideoUpdate{Title: ptr("Moderated")},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "error - only admins edit videos nobody owns",
+			caller: &domain.Caller{UserID: "someone-else"},
+			update: &domain.VideoUpdate{Title: ptr("Mine now")},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
 		},
 	}
 
@@ -288,11 +497,958 @@ func TestVideoUsecase_UpdateStatus(t *testing.T) {
 			mockRepo := mocks.NewMockVideoRepository(ctrl)
 			tt.setupMock(mockRepo)
 
-			uc := NewVideoUsecase(mockRepo)
-			err := uc.UpdateStatus(context.Background(), tt.id, tt.status)
+			uc := NewVideoUsecase(mockRepo, nil)
+			_, err := uc.Update(context.Background(), "video-123", tt.caller, tt.update)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Update() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
 
-			if (err != nil) != tt.wantErr {
-				t.Errorf("UpdateStatus() error = %v, wantErr %v", err, tt.wantErr)
+func TestVideoUsecase_GetForViewer(t *testing.T) {
+	private := &domain.Video{ID: "video-123", OwnerID: "channel-1", Visibility: domain.VisibilityPrivate}
+
+	tests := []struct {
+		name      string
+		userID    string
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:   "success - anyone can watch an unlisted video by ID",
+			userID: "",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", OwnerID: "channel-1", Visibility: domain.VisibilityUnlisted}, nil)
+			},
+		},
+		{
+			name:   "success - owner watches their private video",
+			userID: "channel-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(private, nil)
+			},
+		},
+		{
+			name:   "success - user granted access",
+			userID: "friend",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(private, nil)
+				m.EXPECT().HasAccess(gomock.Any(), "video-123", "friend").Return(true, nil)
+			},
+		},
+		{
+			name:   "error - user without a grant",
+			userID: "stranger",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(private, nil)
+				m.EXPECT().HasAccess(gomock.Any(), "video-123", "stranger").Return(false, nil)
+			},
+			wantErr: domain.ErrPrivateVideo,
+		},
+		{
+			name:   "error - anonymous viewer",
+			userID: "",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(private, nil)
+			},
+			wantErr: domain.ErrPrivateVideo,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			_, err := uc.GetForViewer(context.Background(), "video-123", tt.userID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetForViewer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_GrantAccess(t *testing.T) {
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:   "success - owner grants access",
+			caller: &domain.Caller{UserID: "channel-1"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+				m.EXPECT().GrantAccess(gomock.Any(), "video-123", "friend").Return(nil)
+			},
+		},
+		{
+			name:   "success - admin grants access to someone else's video",
+			caller: admin,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+				m.EXPECT().GrantAccess(gomock.Any(), "video-123", "friend").Return(nil)
+			},
+		},
+		{
+			name:   "error - not the owner",
+			caller: &domain.Caller{UserID: "friend"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "error - video without an owner",
+			caller: anonymous,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			err := uc.GrantAccess(context.Background(), "video-123", tt.caller, "friend")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GrantAccess() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_CreateShareLink(t *testing.T) {
+	owned := &domain.Video{ID: "video-123", OwnerID: "channel-1"}
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		expiresAt time.Time
+		maxViews  int
+		password  string
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:     "success - defaults the expiry and hashes the password",
+			caller:   &domain.Caller{UserID: "channel-1"},
+			password: "hunter2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(owned, nil)
+				m.EXPECT().CreateShareLink(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, l *domain.ShareLink) error {
+					if l.Token == "" || l.VideoID != "video-123" || l.CreatedBy != "channel-1" {
+						t.Errorf("unexpected link %+v", l)
+					}
+					if ttl := time.Until(l.ExpiresAt); ttl < domain.DefaultShareLinkTTL-time.Minute || ttl > domain.DefaultShareLinkTTL {
+						t.Errorf("ExpiresAt = %v, want about %v from now", l.ExpiresAt, domain.DefaultShareLinkTTL)
+					}
+					if l.PasswordHash == "" || l.PasswordHash == "hunter2" {
+						t.Errorf("PasswordHash = %q, want a hash", l.PasswordHash)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name:      "error - expiry in the past",
+			caller:    &domain.Caller{UserID: "channel-1"},
+			expiresAt: time.Now().Add(-time.Hour),
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(owned, nil)
+			},
+			wantErr: domain.ErrInvalidShareLink,
+		},
+		{
+			name:      "error - expiry too far away",
+			caller:    &domain.Caller{UserID: "channel-1"},
+			expiresAt: time.Now().Add(domain.MaxShareLinkTTL + time.Hour),
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(owned, nil)
+			},
+			wantErr: domain.ErrInvalidShareLink,
+		},
+		{
+			name:     "error - negative view limit",
+			caller:   &domain.Caller{UserID: "channel-1"},
+			maxViews: -1,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(owned, nil)
+			},
+			wantErr: domain.ErrInvalidShareLink,
+		},
+		{
+			name:   "error - not the owner",
+			caller: &domain.Caller{UserID: "friend"},
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(owned, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			_, err := uc.CreateShareLink(context.Background(), "video-123", tt.caller, tt.expiresAt, tt.maxViews, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CreateShareLink() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_ResolveShareLink(t *testing.T) {
+	hash, err := bcrypt.GenerateFromPassword([]byte("hunter2"), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	video := &domain.Video{ID: "video-123", OwnerID: "channel-1", Visibility: domain.VisibilityPrivate}
+
+	tests := []struct {
+		name      string
+		password  string
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name: "success - counts a view",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetShareLink(gomock.Any(), "token").Return(&domain.ShareLink{Token: "token", VideoID: "video-123", ExpiresAt: future}, nil)
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(video, nil)
+				m.EXPECT().ConsumeShareLinkView(gomock.Any(), "token", gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name:     "success - right password",
+			password: "hunter2",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetShareLink(gomock.Any(), "token").Return(&domain.ShareLink{Token: "token", VideoID: "video-123", ExpiresAt: future, PasswordHash: string(hash)}, nil)
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(video, nil)
+				m.EXPECT().ConsumeShareLinkView(gomock.Any(), "token", gomock.Any()).Return(true, nil)
+			},
+		},
+		{
+			name:     "error - wrong password does not use a view",
+			password: "guess",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetShareLink(gomock.Any(), "token").Return(&domain.ShareLink{Token: "token", VideoID: "video-123", ExpiresAt: future, PasswordHash: string(hash)}, nil)
+			},
+			wantErr: domain.ErrSharePassword,
+		},
+		{
+			name: "error - expired",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetShareLink(gomock.Any(), "token").Return(&domain.ShareLink{Token: "token", VideoID: "video-123", ExpiresAt: time.Now().Add(-time.Minute)}, nil)
+			},
+			wantErr: domain.ErrShareLinkNotFound,
+		},
+		{
+			name: "error - revoked",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetShareLink(gomock.Any(), "token").Return(&domain.ShareLink{Token: "token", VideoID: "video-123", ExpiresAt: future, RevokedAt: time.Now()}, nil)
+			},
+			wantErr: domain.ErrShareLinkNotFound,
+		},
+		{
+			name: "error - views used up",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetShareLink(gomock.Any(), "token").Return(&domain.ShareLink{Token: "token", VideoID: "video-123", ExpiresAt: future, MaxViews: 3, Views: 3}, nil)
+			},
+			wantErr: domain.ErrShareLinkNotFound,
+		},
+		{
+			name: "error - last view taken concurrently",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetShareLink(gomock.Any(), "token").Return(&domain.ShareLink{Token: "token", VideoID: "video-123", ExpiresAt: future, MaxViews: 3, Views: 2}, nil)
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(video, nil)
+				m.EXPECT().ConsumeShareLinkView(gomock.Any(), "token", gomock.Any()).Return(false, nil)
+			},
+			wantErr: domain.ErrShareLinkNotFound,
+		},
+		{
+			name: "error - video in the trash",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetShareLink(gomock.Any(), "token").Return(&domain.ShareLink{Token: "token", VideoID: "video-123", ExpiresAt: future}, nil)
+				m.EXPECT().Get(gomock.Any(), "video-123").Return(nil, domain.ErrVideoNotFound)
+			},
+			wantErr: domain.ErrShareLinkNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			v, err := uc.ResolveShareLink(context.Background(), "token", tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResolveShareLink() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && v.ID != "video-123" {
+				t.Errorf("ResolveShareLink() video = %v, want video-123", v.ID)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		status    string
+		duration  float64
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   bool
+	}{
+		{
+			name:   "success - updates status to ready",
+			id:     "video-123",
+			status: "ready",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().
+					UpdateStatus(gomock.Any(), "video-123", "ready").
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:     "success - records the probed duration",
+			id:       "video-123",
+			status:   "ready",
+			duration: 93.5,
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().UpdateDuration(gomock.Any(), "video-123", 93.5).Return(nil)
+				m.EXPECT().UpdateStatus(gomock.Any(), "video-123", "ready").Return(nil)
+			},
+		},
+		{
+			name:   "success - updates status to error",
+			id:     "video-123",
+			status: "error",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().
+					UpdateStatus(gomock.Any(), "video-123", "error").
+					Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:   "error - video not found",
+			id:     "nonexistent-id",
+			status: "ready",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().
+					UpdateStatus(gomock.Any(), "nonexistent-id", "ready").
+					Return(errors.New("video not found"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			err := uc.UpdateStatus(context.Background(), tt.id, tt.status, tt.duration)
+
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UpdateStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_CreateStreamKey(t *testing.T) {
+	channel := &domain.Channel{ID: "channel-1", OwnerID: "owner-1", MemberIDs: []string{"member-1"}}
+	tests := []struct {
+		name      string
+		ownerID   string
+		channelID string
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:      "success - channel member",
+			ownerID:   "member-1",
+			channelID: "channel-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "channel-1").Return(channel, nil)
+				m.EXPECT().
+					CreateStreamKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, k *domain.StreamKey) error {
+						if k.OwnerID != "member-1" || k.ChannelID != "channel-1" || k.Title != "Weekly show" {
+							t.Errorf("unexpected stream key %+v", k)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:    "success - outside any channel",
+			ownerID: "user-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().CreateStreamKey(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:      "error - someone else's channel",
+			ownerID:   "user-1",
+			channelID: "channel-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "channel-1").Return(channel, nil)
+			},
+			wantErr: domain.ErrNotChannelMember,
+		},
+		{
+			name:      "error - unknown channel",
+			ownerID:   "user-1",
+			channelID: "channel-1",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetChannel(gomock.Any(), "channel-1").Return(nil, domain.ErrChannelNotFound)
+			},
+			wantErr: domain.ErrChannelNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			key, err := uc.CreateStreamKey(context.Background(), tt.ownerID, tt.channelID, "Weekly show")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateStreamKey() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && len(key.Key) != 40 {
+				t.Errorf("CreateStreamKey() key length = %d, want 40", len(key.Key))
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_StartLiveStream(t *testing.T) {
+	key := &domain.StreamKey{Key: "key-123", OwnerID: "member-1", ChannelID: "channel-1", Title: "Weekly show"}
+	tests := []struct {
+		name      string
+		setupMock func(m *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name: "success - creates a live video owned by the key's creator in its channel",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetStreamKey(gomock.Any(), "key-123").Return(key, nil)
+				m.EXPECT().GetChannel(gomock.Any(), "channel-1").
+					Return(&domain.Channel{ID: "channel-1", OwnerID: "owner-1", MemberIDs: []string{"member-1"}}, nil)
+				m.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, v *domain.Video) error {
+						if v.Title != "Weekly show" || v.OwnerID != "member-1" || v.ChannelID != "channel-1" {
+							t.Errorf("unexpected video %+v", v)
+						}
+						if v.Status != "ready" || v.LiveStatus != domain.LiveStatusLive {
+							t.Errorf("expected ready live video, got status %q live status %q", v.Status, v.LiveStatus)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name: "error - the key's creator left the channel",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().GetStreamKey(gomock.Any(), "key-123").Return(key, nil)
+				m.EXPECT().GetChannel(gomock.Any(), "channel-1").Return(&domain.Channel{ID: "channel-1", OwnerID: "owner-1"}, nil)
+			},
+			wantErr: domain.ErrNotChannelMember,
+		},
+		{
+			name: "error - unknown stream key",
+			setupMock: func(m *mocks.MockVideoRepository) {
+				m.EXPECT().
+					GetStreamKey(gomock.Any(), "key-123").
+					Return(nil, domain.ErrStreamKeyNotFound)
+			},
+			wantErr: domain.ErrStreamKeyNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			_, err := uc.StartLiveStream(context.Background(), "key-123")
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("StartLiveStream() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_UpdateLiveStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := mocks.NewMockVideoRepository(ctrl)
+	mockRepo.EXPECT().UpdateLiveStatus(gomock.Any(), "video-123", domain.LiveStatusEnded).Return(nil)
+	mockRepo.EXPECT().UpdateStatus(gomock.Any(), "video-123", "processing").Return(nil)
+
+	uc := NewVideoUsecase(mockRepo, nil)
+	if err := uc.UpdateLiveStatus(context.Background(), "video-123", domain.LiveStatusEnded); err != nil {
+		t.Errorf("UpdateLiveStatus() unexpected error: %v", err)
+	}
+	if err := uc.UpdateLiveStatus(context.Background(), "video-123", "paused"); !errors.Is(err, domain.ErrInvalidLiveStatus) {
+		t.Errorf("UpdateLiveStatus() error = %v, want %v", err, domain.ErrInvalidLiveStatus)
+	}
+}
+
+func TestVideoUsecase_CompleteRecording(t *testing.T) {
+	tests := []struct {
+		name      string
+		duration  float64
+		setupMock func(mockRepo *mocks.MockVideoRepository)
+		wantErr   bool
+	}{
+		{
+			name:     "success - recording becomes ready",
+			duration: 3600.5,
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().UpdateRecording(gomock.Any(), "video-123", 3600.5, "videos", "video-123/recording.mp4").Return(nil)
+				mockRepo.EXPECT().UpdateStatus(gomock.Any(), "video-123", "ready").Return(nil)
+			},
+		},
+		{
+			name:     "empty recording fails the video",
+			duration: 0,
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().UpdateStatus(gomock.Any(), "video-123", "failed").Return(nil)
+			},
+		},
+		{
+			name:     "error - repository error",
+			duration: 12,
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().UpdateRecording(gomock.Any(), "video-123", float64(12), "videos", "video-123/recording.mp4").Return(errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			err := uc.CompleteRecording(context.Background(), "video-123", tt.duration, "videos", "video-123/recording.mp4")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("CompleteRecording() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_PublishDue(t *testing.T) {
+	now := time.Date(2030, 1, 1, 18, 0, 0, 0, time.UTC)
+	due := []*domain.Video{
+		{ID: "video-1", Title: "Premiere", OwnerID: "channel-1", Premiere: true},
+		{ID: "video-2", Title: "Scheduled", OwnerID: "channel-1"},
+	}
+
+	tests := []struct {
+		name          string
+		setupMock     func(mockRepo *mocks.MockVideoRepository, mockEvents *mocks.MockEventPublisher)
+		wantPublished int
+		wantErr       bool
+	}{
+		{
+			name: "success - publishes due videos and emits events",
+			setupMock: func(mockRepo *mocks.MockVideoRepository, mockEvents *mocks.MockEventPublisher) {
+				mockRepo.EXPECT().ListDueForPublish(gomock.Any(), now).Return(due, nil)
+				mockRepo.EXPECT().MarkPublished(gomock.Any(), "video-1").Return(true, nil)
+				mockRepo.EXPECT().MarkPublished(gomock.Any(), "video-2").Return(true, nil)
+				mockEvents.EXPECT().Publish(gomock.Any(), &domain.Event{
+					Type: domain.EventVideoPublished, VideoID: "video-1", OwnerID: "channel-1", Title: "Premiere", Premiere: true, OccurredAt: now,
+				}).Return(nil)
+				mockEvents.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("webhook down"))
+			},
+			wantPublished: 2,
+		},
+		{
+			name: "skips videos another publisher already listed",
+			setupMock: func(mockRepo *mocks.MockVideoRepository, mockEvents *mocks.MockEventPublisher) {
+				mockRepo.EXPECT().ListDueForPublish(gomock.Any(), now).Return(due[:1], nil)
+				mockRepo.EXPECT().MarkPublished(gomock.Any(), "video-1").Return(false, nil)
+			},
+			wantPublished: 0,
+		},
+		{
+			name: "error - repository error",
+			setupMock: func(mockRepo *mocks.MockVideoRepository, mockEvents *mocks.MockEventPublisher) {
+				mockRepo.EXPECT().ListDueForPublish(gomock.Any(), now).Return(nil, errors.New("db error"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			mockEvents := mocks.NewMockEventPublisher(ctrl)
+			tt.setupMock(mockRepo, mockEvents)
+
+			uc := NewVideoUsecase(mockRepo, mockEvents)
+			n, err := uc.PublishDue(context.Background(), now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PublishDue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if n != tt.wantPublished {
+				t.Errorf("PublishDue() = %d, want %d", n, tt.wantPublished)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_Delete(t *testing.T) {
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		setupMock func(mockRepo *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:   "success - admin moves a video nobody owns to the trash",
+			caller: admin,
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().Get(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", BucketName: "videos", ObjectKey: "uuid/video.mp4"}, nil)
+				mockRepo.EXPECT().Trash(gomock.Any(), "video-123", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "success - owner trashes an ended live recording",
+			caller: &domain.Caller{UserID: "channel-1"},
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().Get(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", OwnerID: "channel-1", LiveStatus: domain.LiveStatusEnded}, nil)
+				mockRepo.EXPECT().Trash(gomock.Any(), "video-123", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:   "error - not the owner",
+			caller: &domain.Caller{UserID: "someone-else"},
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().Get(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "error - only admins delete videos nobody owns",
+			caller: &domain.Caller{UserID: "someone-else"},
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().Get(gomock.Any(), "video-123").Return(&domain.Video{ID: "video-123"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "error - moderators can't delete",
+			caller: moderator,
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().Get(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", OwnerID: "channel-1"}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "error - stream still live",
+			caller: &domain.Caller{UserID: "channel-1"},
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().Get(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", OwnerID: "channel-1", LiveStatus: domain.LiveStatusLive}, nil)
+			},
+			wantErr: domain.ErrVideoLive,
+		},
+		{
+			name:   "error - video not found",
+			caller: anonymous,
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().Get(gomock.Any(), "video-123").Return(nil, domain.ErrVideoNotFound)
+			},
+			wantErr: domain.ErrVideoNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			err := uc.Delete(context.Background(), "video-123", tt.caller)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Delete() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_Restore(t *testing.T) {
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		setupMock func(mockRepo *mocks.MockVideoRepository)
+		wantErr   error
+	}{
+		{
+			name:   "success - owner restores their video",
+			caller: &domain.Caller{UserID: "channel-1"},
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().GetTrashed(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", OwnerID: "channel-1", DeletedAt: time.Now()}, nil)
+				mockRepo.EXPECT().Restore(gomock.Any(), "video-123").Return(nil)
+			},
+		},
+		{
+			name:   "success - admin restores someone else's video",
+			caller: admin,
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().GetTrashed(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", OwnerID: "channel-1", DeletedAt: time.Now()}, nil)
+				mockRepo.EXPECT().Restore(gomock.Any(), "video-123").Return(nil)
+			},
+		},
+		{
+			name:   "error - not the owner",
+			caller: &domain.Caller{UserID: "someone-else"},
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().GetTrashed(gomock.Any(), "video-123").
+					Return(&domain.Video{ID: "video-123", OwnerID: "channel-1", DeletedAt: time.Now()}, nil)
+			},
+			wantErr: domain.ErrForbidden,
+		},
+		{
+			name:   "error - not in the trash",
+			caller: &domain.Caller{UserID: "channel-1"},
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().GetTrashed(gomock.Any(), "video-123").Return(nil, domain.ErrVideoNotFound)
+			},
+			wantErr: domain.ErrVideoNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			err := uc.Restore(context.Background(), "video-123", tt.caller)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Restore() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_Unpublish(t *testing.T) {
+	private := domain.VisibilityPrivate
+	tests := []struct {
+		name    string
+		caller  *domain.Caller
+		video   *domain.Video
+		wantErr error
+	}{
+		{name: "success - owner", caller: &domain.Caller{UserID: "channel-1"}, video: &domain.Video{ID: "video-123", OwnerID: "channel-1"}},
+		{name: "success - moderator", caller: moderator, video: &domain.Video{ID: "video-123", OwnerID: "channel-1"}},
+		{name: "success - moderator unpublishes a video nobody owns", caller: moderator, video: &domain.Video{ID: "video-123"}},
+		{name: "success - admin", caller: admin, video: &domain.Video{ID: "video-123", OwnerID: "channel-1"}},
+		{name: "error - another user", caller: &domain.Caller{UserID: "someone-else"}, video: &domain.Video{ID: "video-123", OwnerID: "channel-1"}, wantErr: domain.ErrForbidden},
+		{name: "error - anonymous", caller: anonymous, video: &domain.Video{ID: "video-123"}, wantErr: domain.ErrForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			mockRepo.EXPECT().Get(gomock.Any(), "video-123").Return(tt.video, nil)
+			if tt.wantErr == nil {
+				mockRepo.EXPECT().Update(gomock.Any(), "video-123", &domain.VideoUpdate{Visibility: &private}).Return(nil)
+			}
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			if err := uc.Unpublish(context.Background(), "video-123", tt.caller); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Unpublish() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_PurgeTrash(t *testing.T) {
+	cutoff := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		setupMock  func(mockRepo *mocks.MockVideoRepository)
+		wantPurged int
+		wantErr    bool
+	}{
+		{
+			name: "success - deletes expired videos and queues their storage prefix",
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().ListTrashedBefore(gomock.Any(), cutoff, gomock.Any()).Return([]*domain.Video{
+					{ID: "video-1", BucketName: "videos", ObjectKey: "uuid/video.mp4"},
+					{ID: "video-2", LiveStatus: domain.LiveStatusEnded},
+					{ID: "video-3", BucketName: "videos", ObjectKey: "video.mp4"},
+				}, nil)
+				mockRepo.EXPECT().Delete(gomock.Any(), "video-1", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id string, cleanup *domain.StorageCleanup) error {
+						if cleanup == nil || cleanup.Bucket != "videos" || cleanup.Prefix != "uuid/" {
+							t.Errorf("unexpected cleanup %+v", cleanup)
+						}
+						return nil
+					})
+				// Recordings the live service hasn't completed have no objects in storage yet
+				mockRepo.EXPECT().Delete(gomock.Any(), "video-2", nil).Return(nil)
+				// A key outside a directory is removed alone, not as the prefix of its siblings
+				mockRepo.EXPECT().Delete(gomock.Any(), "video-3", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, id string, cleanup *domain.StorageCleanup) error {
+						if cleanup == nil || cleanup.Prefix != "video.mp4" {
+							t.Errorf("unexpected cleanup %+v", cleanup)
+						}
+						return nil
+					})
+			},
+			wantPurged: 3,
+		},
+		{
+			name: "error - stops at the first failed delete",
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().ListTrashedBefore(gomock.Any(), cutoff, gomock.Any()).Return([]*domain.Video{
+					{ID: "video-1", BucketName: "videos", ObjectKey: "uuid/video.mp4"},
+					{ID: "video-2", BucketName: "videos", ObjectKey: "uuid2/video.mp4"},
+				}, nil)
+				mockRepo.EXPECT().Delete(gomock.Any(), "video-1", gomock.Any()).Return(errors.New("db error"))
+			},
+			wantPurged: 0,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			n, err := uc.PurgeTrash(context.Background(), cutoff)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PurgeTrash() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if n != tt.wantPurged {
+				t.Errorf("PurgeTrash() = %d, want %d", n, tt.wantPurged)
+			}
+		})
+	}
+}
+
+func TestVideoUsecase_CompleteStorageCleanup(t *testing.T) {
+	tests := []struct {
+		name      string
+		errMsg    string
+		setupMock func(mockRepo *mocks.MockVideoRepository)
+	}{
+		{
+			name:   "success - finished cleanup is dropped",
+			errMsg: "",
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().DeleteStorageCleanup(gomock.Any(), "video-123").Return(nil)
+			},
+		},
+		{
+			name:   "failed cleanup is retried with backoff",
+			errMsg: "connection refused",
+			setupMock: func(mockRepo *mocks.MockVideoRepository) {
+				mockRepo.EXPECT().GetStorageCleanup(gomock.Any(), "video-123").
+					Return(&domain.StorageCleanup{VideoID: "video-123", Attempts: 2}, nil)
+				mockRepo.EXPECT().RescheduleStorageCleanup(gomock.Any(), "video-123", gomock.Any(), "connection refused").
+					DoAndReturn(func(ctx context.Context, videoID string, next time.Time, lastError string) error {
+						// Third failure: 30s doubled twice
+						if wait := time.Until(next); wait < 110*time.Second || wait > 120*time.Second {
+							t.Errorf("expected a retry in 2 minutes, got %v", wait)
+						}
+						return nil
+					})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRepo := mocks.NewMockVideoRepository(ctrl)
+			tt.setupMock(mockRepo)
+
+			uc := NewVideoUsecase(mockRepo, nil)
+			if err := uc.CompleteStorageCleanup(context.Background(), "video-123", tt.errMsg); err != nil {
+				t.Errorf("CompleteStorageCleanup() unexpected error: %v", err)
 			}
 		})
 	}
@@ -0,0 +1,638 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.33.1
+// source: proto/analytics/analytics.proto
+
+package analytics
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Beacon struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	SessionId     string                 `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // startup, rebuffer, bitrate_switch, error, fatal
+	Rendition     string                 `protobuf:"bytes,4,opt,name=rendition,proto3" json:"rendition,omitempty"`
+	ValueMs       float64                `protobuf:"fixed64,5,opt,name=value_ms,json=valueMs,proto3" json:"value_ms,omitempty"` // startup time or rebuffer duration
+	Bitrate       int64                  `protobuf:"varint,6,opt,name=bitrate,proto3" json:"bitrate,omitempty"`                 // new bitrate for bitrate_switch
+	ErrorCode     string                 `protobuf:"bytes,7,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	Message       string                 `protobuf:"bytes,8,opt,name=message,proto3" json:"message,omitempty"`
+	ClientTimeMs  int64                  `protobuf:"varint,9,opt,name=client_time_ms,json=clientTimeMs,proto3" json:"client_time_ms,omitempty"` // player clock, informational only
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Beacon) Reset() {
+	*x = Beacon{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Beacon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Beacon) ProtoMessage() {}
+
+func (x *Beacon) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Beacon.ProtoReflect.Descriptor instead.
+func (*Beacon) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Beacon) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *Beacon) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *Beacon) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Beacon) GetRendition() string {
+	if x != nil {
+		return x.Rendition
+	}
+	return ""
+}
+
+func (x *Beacon) GetValueMs() float64 {
+	if x != nil {
+		return x.ValueMs
+	}
+	return 0
+}
+
+func (x *Beacon) GetBitrate() int64 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *Beacon) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *Beacon) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Beacon) GetClientTimeMs() int64 {
+	if x != nil {
+		return x.ClientTimeMs
+	}
+	return 0
+}
+
+type IngestBeaconsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Beacons       []*Beacon              `protobuf:"bytes,1,rep,name=beacons,proto3" json:"beacons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestBeaconsRequest) Reset() {
+	*x = IngestBeaconsRequest{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestBeaconsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestBeaconsRequest) ProtoMessage() {}
+
+func (x *IngestBeaconsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestBeaconsRequest.ProtoReflect.Descriptor instead.
+func (*IngestBeaconsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *IngestBeaconsRequest) GetBeacons() []*Beacon {
+	if x != nil {
+		return x.Beacons
+	}
+	return nil
+}
+
+type IngestBeaconsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected      int32                  `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IngestBeaconsResponse) Reset() {
+	*x = IngestBeaconsResponse{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IngestBeaconsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IngestBeaconsResponse) ProtoMessage() {}
+
+func (x *IngestBeaconsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IngestBeaconsResponse.ProtoReflect.Descriptor instead.
+func (*IngestBeaconsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *IngestBeaconsResponse) GetAccepted() int32 {
+	if x != nil {
+		return x.Accepted
+	}
+	return 0
+}
+
+func (x *IngestBeaconsResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+type GetPlaybackStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"` // RFC 3339, defaults to 24h before `to`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`     // RFC 3339, defaults to now
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlaybackStatsRequest) Reset() {
+	*x = GetPlaybackStatsRequest{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaybackStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaybackStatsRequest) ProtoMessage() {}
+
+func (x *GetPlaybackStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaybackStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPlaybackStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPlaybackStatsRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetPlaybackStatsRequest) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetPlaybackStatsRequest) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type Percentiles struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	P50           float64                `protobuf:"fixed64,1,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90           float64                `protobuf:"fixed64,2,opt,name=p90,proto3" json:"p90,omitempty"`
+	P99           float64                `protobuf:"fixed64,3,opt,name=p99,proto3" json:"p99,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Percentiles) Reset() {
+	*x = Percentiles{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Percentiles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Percentiles) ProtoMessage() {}
+
+func (x *Percentiles) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Percentiles.ProtoReflect.Descriptor instead.
+func (*Percentiles) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Percentiles) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *Percentiles) GetP90() float64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+func (x *Percentiles) GetP99() float64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+type PlaybackStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Rendition       string                 `protobuf:"bytes,1,opt,name=rendition,proto3" json:"rendition,omitempty"` // empty for all renditions
+	Sessions        int64                  `protobuf:"varint,2,opt,name=sessions,proto3" json:"sessions,omitempty"`
+	StartupMs       *Percentiles           `protobuf:"bytes,3,opt,name=startup_ms,json=startupMs,proto3" json:"startup_ms,omitempty"`
+	RebufferMs      *Percentiles           `protobuf:"bytes,4,opt,name=rebuffer_ms,json=rebufferMs,proto3" json:"rebuffer_ms,omitempty"` // total rebuffering per session
+	RebufferEvents  int64                  `protobuf:"varint,5,opt,name=rebuffer_events,json=rebufferEvents,proto3" json:"rebuffer_events,omitempty"`
+	BitrateSwitches int64                  `protobuf:"varint,6,opt,name=bitrate_switches,json=bitrateSwitches,proto3" json:"bitrate_switches,omitempty"`
+	Errors          int64                  `protobuf:"varint,7,opt,name=errors,proto3" json:"errors,omitempty"`
+	FatalSessions   int64                  `protobuf:"varint,8,opt,name=fatal_sessions,json=fatalSessions,proto3" json:"fatal_sessions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PlaybackStats) Reset() {
+	*x = PlaybackStats{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlaybackStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlaybackStats) ProtoMessage() {}
+
+func (x *PlaybackStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlaybackStats.ProtoReflect.Descriptor instead.
+func (*PlaybackStats) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PlaybackStats) GetRendition() string {
+	if x != nil {
+		return x.Rendition
+	}
+	return ""
+}
+
+func (x *PlaybackStats) GetSessions() int64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *PlaybackStats) GetStartupMs() *Percentiles {
+	if x != nil {
+		return x.StartupMs
+	}
+	return nil
+}
+
+func (x *PlaybackStats) GetRebufferMs() *Percentiles {
+	if x != nil {
+		return x.RebufferMs
+	}
+	return nil
+}
+
+func (x *PlaybackStats) GetRebufferEvents() int64 {
+	if x != nil {
+		return x.RebufferEvents
+	}
+	return 0
+}
+
+func (x *PlaybackStats) GetBitrateSwitches() int64 {
+	if x != nil {
+		return x.BitrateSwitches
+	}
+	return 0
+}
+
+func (x *PlaybackStats) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *PlaybackStats) GetFatalSessions() int64 {
+	if x != nil {
+		return x.FatalSessions
+	}
+	return 0
+}
+
+type GetPlaybackStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Overall       *PlaybackStats         `protobuf:"bytes,4,opt,name=overall,proto3" json:"overall,omitempty"`
+	Renditions    []*PlaybackStats       `protobuf:"bytes,5,rep,name=renditions,proto3" json:"renditions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPlaybackStatsResponse) Reset() {
+	*x = GetPlaybackStatsResponse{}
+	mi := &file_proto_analytics_analytics_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPlaybackStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPlaybackStatsResponse) ProtoMessage() {}
+
+func (x *GetPlaybackStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_analytics_analytics_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPlaybackStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPlaybackStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_analytics_analytics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPlaybackStatsResponse) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetPlaybackStatsResponse) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *GetPlaybackStatsResponse) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *GetPlaybackStatsResponse) GetOverall() *PlaybackStats {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+func (x *GetPlaybackStatsResponse) GetRenditions() []*PlaybackStats {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+var File_proto_analytics_analytics_proto protoreflect.FileDescriptor
+
+const file_proto_analytics_analytics_proto_rawDesc = "" +
+	"\n" +
+	"\x1fproto/analytics/analytics.proto\x12\tanalytics\"\x88\x02\n" +
+	"\x06Beacon\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1d\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tR\tsessionId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1c\n" +
+	"\trendition\x18\x04 \x01(\tR\trendition\x12\x19\n" +
+	"\bvalue_ms\x18\x05 \x01(\x01R\avalueMs\x12\x18\n" +
+	"\abitrate\x18\x06 \x01(\x03R\abitrate\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\a \x01(\tR\terrorCode\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\x12$\n" +
+	"\x0eclient_time_ms\x18\t \x01(\x03R\fclientTimeMs\"C\n" +
+	"\x14IngestBeaconsRequest\x12+\n" +
+	"\abeacons\x18\x01 \x03(\v2\x11.analytics.BeaconR\abeacons\"O\n" +
+	"\x15IngestBeaconsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\x05R\brejected\"X\n" +
+	"\x17GetPlaybackStatsRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"C\n" +
+	"\vPercentiles\x12\x10\n" +
+	"\x03p50\x18\x01 \x01(\x01R\x03p50\x12\x10\n" +
+	"\x03p90\x18\x02 \x01(\x01R\x03p90\x12\x10\n" +
+	"\x03p99\x18\x03 \x01(\x01R\x03p99\"\xcc\x02\n" +
+	"\rPlaybackStats\x12\x1c\n" +
+	"\trendition\x18\x01 \x01(\tR\trendition\x12\x1a\n" +
+	"\bsessions\x18\x02 \x01(\x03R\bsessions\x125\n" +
+	"\n" +
+	"startup_ms\x18\x03 \x01(\v2\x16.analytics.PercentilesR\tstartupMs\x127\n" +
+	"\vrebuffer_ms\x18\x04 \x01(\v2\x16.analytics.PercentilesR\n" +
+	"rebufferMs\x12'\n" +
+	"\x0frebuffer_events\x18\x05 \x01(\x03R\x0erebufferEvents\x12)\n" +
+	"\x10bitrate_switches\x18\x06 \x01(\x03R\x0fbitrateSwitches\x12\x16\n" +
+	"\x06errors\x18\a \x01(\x03R\x06errors\x12%\n" +
+	"\x0efatal_sessions\x18\b \x01(\x03R\rfatalSessions\"\xc7\x01\n" +
+	"\x18GetPlaybackStatsResponse\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\x122\n" +
+	"\aoverall\x18\x04 \x01(\v2\x18.analytics.PlaybackStatsR\aoverall\x128\n" +
+	"\n" +
+	"renditions\x18\x05 \x03(\v2\x18.analytics.PlaybackStatsR\n" +
+	"renditions2\xc3\x01\n" +
+	"\x10AnalyticsService\x12R\n" +
+	"\rIngestBeacons\x12\x1f.analytics.IngestBeaconsRequest\x1a .analytics.IngestBeaconsResponse\x12[\n" +
+	"\x10GetPlaybackStats\x12\".analytics.GetPlaybackStatsRequest\x1a#.analytics.GetPlaybackStatsResponseB.Z,github.com/athandoan/youtube/proto/analyticsb\x06proto3"
+
+var (
+	file_proto_analytics_analytics_proto_rawDescOnce sync.Once
+	file_proto_analytics_analytics_proto_rawDescData []byte
+)
+
+func file_proto_analytics_analytics_proto_rawDescGZIP() []byte {
+	file_proto_analytics_analytics_proto_rawDescOnce.Do(func() {
+		file_proto_analytics_analytics_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_analytics_analytics_proto_rawDesc), len(file_proto_analytics_analytics_proto_rawDesc)))
+	})
+	return file_proto_analytics_analytics_proto_rawDescData
+}
+
+var file_proto_analytics_analytics_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_analytics_analytics_proto_goTypes = []any{
+	(*Beacon)(nil),                   // 0: analytics.Beacon
+	(*IngestBeaconsRequest)(nil),     // 1: analytics.IngestBeaconsRequest
+	(*IngestBeaconsResponse)(nil),    // 2: analytics.IngestBeaconsResponse
+	(*GetPlaybackStatsRequest)(nil),  // 3: analytics.GetPlaybackStatsRequest
+	(*Percentiles)(nil),              // 4: analytics.Percentiles
+	(*PlaybackStats)(nil),            // 5: analytics.PlaybackStats
+	(*GetPlaybackStatsResponse)(nil), // 6: analytics.GetPlaybackStatsResponse
+}
+var file_proto_analytics_analytics_proto_depIdxs = []int32{
+	0, // 0: analytics.IngestBeaconsRequest.beacons:type_name -> analytics.Beacon
+	4, // 1: analytics.PlaybackStats.startup_ms:type_name -> analytics.Percentiles
+	4, // 2: analytics.PlaybackStats.rebuffer_ms:type_name -> analytics.Percentiles
+	5, // 3: analytics.GetPlaybackStatsResponse.overall:type_name -> analytics.PlaybackStats
+	5, // 4: analytics.GetPlaybackStatsResponse.renditions:type_name -> analytics.PlaybackStats
+	1, // 5: analytics.AnalyticsService.IngestBeacons:input_type -> analytics.IngestBeaconsRequest
+	3, // 6: analytics.AnalyticsService.GetPlaybackStats:input_type -> analytics.GetPlaybackStatsRequest
+	2, // 7: analytics.AnalyticsService.IngestBeacons:output_type -> analytics.IngestBeaconsResponse
+	6, // 8: analytics.AnalyticsService.GetPlaybackStats:output_type -> analytics.GetPlaybackStatsResponse
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_proto_analytics_analytics_proto_init() }
+func file_proto_analytics_analytics_proto_init() {
+	if File_proto_analytics_analytics_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_analytics_analytics_proto_rawDesc), len(file_proto_analytics_analytics_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_analytics_analytics_proto_goTypes,
+		DependencyIndexes: file_proto_analytics_analytics_proto_depIdxs,
+		MessageInfos:      file_proto_analytics_analytics_proto_msgTypes,
+	}.Build()
+	File_proto_analytics_analytics_proto = out.File
+	file_proto_analytics_analytics_proto_goTypes = nil
+	file_proto_analytics_analytics_proto_depIdxs = nil
+}
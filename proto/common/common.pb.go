@@ -22,15 +22,30 @@ const (
 )
 
 type Video struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending, ready, failed
-	CreatedAt     string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	BucketName    string                 `protobuf:"bytes,5,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
-	ObjectKey     string                 `protobuf:"bytes,6,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status          string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending, processing, ready, failed
+	CreatedAt       string                 `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	BucketName      string                 `protobuf:"bytes,5,opt,name=bucket_name,json=bucketName,proto3" json:"bucket_name,omitempty"`
+	ObjectKey       string                 `protobuf:"bytes,6,opt,name=object_key,json=objectKey,proto3" json:"object_key,omitempty"`
+	OwnerId         string                 `protobuf:"bytes,7,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`
+	AllowDownload   bool                   `protobuf:"varint,8,opt,name=allow_download,json=allowDownload,proto3" json:"allow_download,omitempty"`
+	LiveStatus      string                 `protobuf:"bytes,9,opt,name=live_status,json=liveStatus,proto3" json:"live_status,omitempty"`                   // empty for uploads, live, ended
+	DurationSeconds float64                `protobuf:"fixed64,10,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"` // known once the upload or live recording has been probed
+	PublishAt       string                 `protobuf:"bytes,11,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`                     // RFC 3339; empty when published right away
+	Premiere        bool                   `protobuf:"varint,12,opt,name=premiere,proto3" json:"premiere,omitempty"`                                       // plays as a synchronized pseudo-live stream from publish_at
+	DeletedAt       string                 `protobuf:"bytes,13,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`                     // RFC 3339; set while the video is in the trash
+	Description     string                 `protobuf:"bytes,14,opt,name=description,proto3" json:"description,omitempty"`
+	Tags            []string               `protobuf:"bytes,15,rep,name=tags,proto3" json:"tags,omitempty"` // normalized: lowercase, in the order the owner gave them
+	Category        string                 `protobuf:"bytes,16,opt,name=category,proto3" json:"category,omitempty"`
+	Visibility      string                 `protobuf:"bytes,17,opt,name=visibility,proto3" json:"visibility,omitempty"`                // public, unlisted (reachable by id only) or private (owner and granted users)
+	ChannelId       string                 `protobuf:"bytes,18,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // empty for videos posted outside a channel
+	SeriesId        string                 `protobuf:"bytes,19,opt,name=series_id,json=seriesId,proto3" json:"series_id,omitempty"`    // empty unless the video is an episode of a series
+	Season          int32                  `protobuf:"varint,20,opt,name=season,proto3" json:"season,omitempty"`
+	Episode         int32                  `protobuf:"varint,21,opt,name=episode,proto3" json:"episode,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Video) Reset() {
@@ -105,11 +120,116 @@ func (x *Video) GetObjectKey() string {
 	return ""
 }
 
+func (x *Video) GetOwnerId() string {
+	if x != nil {
+		return x.OwnerId
+	}
+	return ""
+}
+
+func (x *Video) GetAllowDownload() bool {
+	if x != nil {
+		return x.AllowDownload
+	}
+	return false
+}
+
+func (x *Video) GetLiveStatus() string {
+	if x != nil {
+		return x.LiveStatus
+	}
+	return ""
+}
+
+func (x *Video) GetDurationSeconds() float64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *Video) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+func (x *Video) GetPremiere() bool {
+	if x != nil {
+		return x.Premiere
+	}
+	return false
+}
+
+func (x *Video) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *Video) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Video) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Video) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Video) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *Video) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *Video) GetSeriesId() string {
+	if x != nil {
+		return x.SeriesId
+	}
+	return ""
+}
+
+func (x *Video) GetSeason() int32 {
+	if x != nil {
+		return x.Season
+	}
+	return 0
+}
+
+func (x *Video) GetEpisode() int32 {
+	if x != nil {
+		return x.Episode
+	}
+	return 0
+}
+
 var File_proto_common_common_proto protoreflect.FileDescriptor
 
 const file_proto_common_common_proto_rawDesc = "" +
 	"\n" +
-	"\x19proto/common/common.proto\x12\x06common\"\xa4\x01\n" +
+	"\x19proto/common/common.proto\x12\x06common\"\xec\x04\n" +
 	"\x05Video\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
@@ -119,7 +239,29 @@ const file_proto_common_common_proto_rawDesc = "" +
 	"\vbucket_name\x18\x05 \x01(\tR\n" +
 	"bucketName\x12\x1d\n" +
 	"\n" +
-	"object_key\x18\x06 \x01(\tR\tobjectKeyB+Z)github.com/athandoan/youtube/proto/commonb\x06proto3"
+	"object_key\x18\x06 \x01(\tR\tobjectKey\x12\x19\n" +
+	"\bowner_id\x18\a \x01(\tR\aownerId\x12%\n" +
+	"\x0eallow_download\x18\b \x01(\bR\rallowDownload\x12\x1f\n" +
+	"\vlive_status\x18\t \x01(\tR\n" +
+	"liveStatus\x12)\n" +
+	"\x10duration_seconds\x18\n" +
+	" \x01(\x01R\x0fdurationSeconds\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\v \x01(\tR\tpublishAt\x12\x1a\n" +
+	"\bpremiere\x18\f \x01(\bR\bpremiere\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\r \x01(\tR\tdeletedAt\x12 \n" +
+	"\vdescription\x18\x0e \x01(\tR\vdescription\x12\x12\n" +
+	"\x04tags\x18\x0f \x03(\tR\x04tags\x12\x1a\n" +
+	"\bcategory\x18\x10 \x01(\tR\bcategory\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x11 \x01(\tR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x12 \x01(\tR\tchannelId\x12\x1b\n" +
+	"\tseries_id\x18\x13 \x01(\tR\bseriesId\x12\x16\n" +
+	"\x06season\x18\x14 \x01(\x05R\x06season\x12\x18\n" +
+	"\aepisode\x18\x15 \x01(\x05R\aepisodeB+Z)github.com/athandoan/youtube/proto/commonb\x06proto3"
 
 var (
 	file_proto_common_common_proto_rawDescOnce sync.Once
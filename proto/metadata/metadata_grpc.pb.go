@@ -20,20 +20,168 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	MetadataService_GetVideo_FullMethodName          = "/metadata.MetadataService/GetVideo"
-	MetadataService_ListVideos_FullMethodName        = "/metadata.MetadataService/ListVideos"
-	MetadataService_CreateVideo_FullMethodName       = "/metadata.MetadataService/CreateVideo"
-	MetadataService_UpdateVideoStatus_FullMethodName = "/metadata.MetadataService/UpdateVideoStatus"
+	MetadataService_GetVideo_FullMethodName                   = "/metadata.MetadataService/GetVideo"
+	MetadataService_ListVideos_FullMethodName                 = "/metadata.MetadataService/ListVideos"
+	MetadataService_CreateVideo_FullMethodName                = "/metadata.MetadataService/CreateVideo"
+	MetadataService_UpdateVideo_FullMethodName                = "/metadata.MetadataService/UpdateVideo"
+	MetadataService_UpdateVideoStatus_FullMethodName          = "/metadata.MetadataService/UpdateVideoStatus"
+	MetadataService_CreateStreamKey_FullMethodName            = "/metadata.MetadataService/CreateStreamKey"
+	MetadataService_StartLiveStream_FullMethodName            = "/metadata.MetadataService/StartLiveStream"
+	MetadataService_UpdateLiveStatus_FullMethodName           = "/metadata.MetadataService/UpdateLiveStatus"
+	MetadataService_CompleteRecording_FullMethodName          = "/metadata.MetadataService/CompleteRecording"
+	MetadataService_DeleteVideo_FullMethodName                = "/metadata.MetadataService/DeleteVideo"
+	MetadataService_RestoreVideo_FullMethodName               = "/metadata.MetadataService/RestoreVideo"
+	MetadataService_UnpublishVideo_FullMethodName             = "/metadata.MetadataService/UnpublishVideo"
+	MetadataService_ListTrash_FullMethodName                  = "/metadata.MetadataService/ListTrash"
+	MetadataService_GrantVideoAccess_FullMethodName           = "/metadata.MetadataService/GrantVideoAccess"
+	MetadataService_RevokeVideoAccess_FullMethodName          = "/metadata.MetadataService/RevokeVideoAccess"
+	MetadataService_ListVideoAccess_FullMethodName            = "/metadata.MetadataService/ListVideoAccess"
+	MetadataService_CreateShareLink_FullMethodName            = "/metadata.MetadataService/CreateShareLink"
+	MetadataService_ListShareLinks_FullMethodName             = "/metadata.MetadataService/ListShareLinks"
+	MetadataService_RevokeShareLink_FullMethodName            = "/metadata.MetadataService/RevokeShareLink"
+	MetadataService_ResolveShareLink_FullMethodName           = "/metadata.MetadataService/ResolveShareLink"
+	MetadataService_ListStorageCleanups_FullMethodName        = "/metadata.MetadataService/ListStorageCleanups"
+	MetadataService_CompleteStorageCleanup_FullMethodName     = "/metadata.MetadataService/CompleteStorageCleanup"
+	MetadataService_CreateChannel_FullMethodName              = "/metadata.MetadataService/CreateChannel"
+	MetadataService_GetChannel_FullMethodName                 = "/metadata.MetadataService/GetChannel"
+	MetadataService_UpdateChannel_FullMethodName              = "/metadata.MetadataService/UpdateChannel"
+	MetadataService_DeleteChannel_FullMethodName              = "/metadata.MetadataService/DeleteChannel"
+	MetadataService_AddChannelMember_FullMethodName           = "/metadata.MetadataService/AddChannelMember"
+	MetadataService_RemoveChannelMember_FullMethodName        = "/metadata.MetadataService/RemoveChannelMember"
+	MetadataService_SetChannelImage_FullMethodName            = "/metadata.MetadataService/SetChannelImage"
+	MetadataService_Subscribe_FullMethodName                  = "/metadata.MetadataService/Subscribe"
+	MetadataService_Unsubscribe_FullMethodName                = "/metadata.MetadataService/Unsubscribe"
+	MetadataService_ListSubscriptions_FullMethodName          = "/metadata.MetadataService/ListSubscriptions"
+	MetadataService_GetSubscriptionFeed_FullMethodName        = "/metadata.MetadataService/GetSubscriptionFeed"
+	MetadataService_CreatePlaylist_FullMethodName             = "/metadata.MetadataService/CreatePlaylist"
+	MetadataService_GetPlaylist_FullMethodName                = "/metadata.MetadataService/GetPlaylist"
+	MetadataService_ListPlaylists_FullMethodName              = "/metadata.MetadataService/ListPlaylists"
+	MetadataService_UpdatePlaylist_FullMethodName             = "/metadata.MetadataService/UpdatePlaylist"
+	MetadataService_DeletePlaylist_FullMethodName             = "/metadata.MetadataService/DeletePlaylist"
+	MetadataService_AddPlaylistItem_FullMethodName            = "/metadata.MetadataService/AddPlaylistItem"
+	MetadataService_RemovePlaylistItem_FullMethodName         = "/metadata.MetadataService/RemovePlaylistItem"
+	MetadataService_MovePlaylistItem_FullMethodName           = "/metadata.MetadataService/MovePlaylistItem"
+	MetadataService_AddPlaylistCollaborator_FullMethodName    = "/metadata.MetadataService/AddPlaylistCollaborator"
+	MetadataService_RemovePlaylistCollaborator_FullMethodName = "/metadata.MetadataService/RemovePlaylistCollaborator"
+	MetadataService_CreateSeries_FullMethodName               = "/metadata.MetadataService/CreateSeries"
+	MetadataService_GetSeries_FullMethodName                  = "/metadata.MetadataService/GetSeries"
+	MetadataService_ListSeries_FullMethodName                 = "/metadata.MetadataService/ListSeries"
+	MetadataService_UpdateSeries_FullMethodName               = "/metadata.MetadataService/UpdateSeries"
+	MetadataService_DeleteSeries_FullMethodName               = "/metadata.MetadataService/DeleteSeries"
+	MetadataService_SetEpisode_FullMethodName                 = "/metadata.MetadataService/SetEpisode"
+	MetadataService_RemoveEpisode_FullMethodName              = "/metadata.MetadataService/RemoveEpisode"
+	MetadataService_GetNextEpisode_FullMethodName             = "/metadata.MetadataService/GetNextEpisode"
+	MetadataService_SetEpisodeCompleted_FullMethodName        = "/metadata.MetadataService/SetEpisodeCompleted"
 )
 
 // MetadataServiceClient is the client API for MetadataService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Callers are identified by gRPC metadata: the gateway sends x-user-id and x-user-roles for
+// the signed-in user it calls for, and other services send x-service with their name. Calls
+// with neither are anonymous. Requests naming a user_id must name the caller.
 type MetadataServiceClient interface {
 	GetVideo(ctx context.Context, in *GetVideoRequest, opts ...grpc.CallOption) (*common.Video, error)
 	ListVideos(ctx context.Context, in *ListVideosRequest, opts ...grpc.CallOption) (*ListVideosResponse, error)
 	CreateVideo(ctx context.Context, in *CreateVideoRequest, opts ...grpc.CallOption) (*CreateVideoResponse, error)
+	// UpdateVideo changes the fields named in update_mask and returns the updated video.
+	UpdateVideo(ctx context.Context, in *UpdateVideoRequest, opts ...grpc.CallOption) (*common.Video, error)
 	UpdateVideoStatus(ctx context.Context, in *UpdateVideoStatusRequest, opts ...grpc.CallOption) (*UpdateVideoStatusResponse, error)
+	CreateStreamKey(ctx context.Context, in *CreateStreamKeyRequest, opts ...grpc.CallOption) (*CreateStreamKeyResponse, error)
+	StartLiveStream(ctx context.Context, in *StartLiveStreamRequest, opts ...grpc.CallOption) (*common.Video, error)
+	UpdateLiveStatus(ctx context.Context, in *UpdateLiveStatusRequest, opts ...grpc.CallOption) (*UpdateLiveStatusResponse, error)
+	CompleteRecording(ctx context.Context, in *CompleteRecordingRequest, opts ...grpc.CallOption) (*CompleteRecordingResponse, error)
+	// DeleteVideo moves a video to the trash. Trashed videos are purged after the retention
+	// period, which queues the removal of their objects from storage.
+	DeleteVideo(ctx context.Context, in *DeleteVideoRequest, opts ...grpc.CallOption) (*DeleteVideoResponse, error)
+	// RestoreVideo takes a video out of the trash before it is purged.
+	RestoreVideo(ctx context.Context, in *RestoreVideoRequest, opts ...grpc.CallOption) (*RestoreVideoResponse, error)
+	// UnpublishVideo makes a video private; its owner, moderators and admins can unpublish it.
+	UnpublishVideo(ctx context.Context, in *UnpublishVideoRequest, opts ...grpc.CallOption) (*UnpublishVideoResponse, error)
+	// ListTrash returns the caller's trashed videos, most recently deleted first.
+	ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error)
+	// GrantVideoAccess lets a user watch a private video; only the owner and admins can grant access.
+	GrantVideoAccess(ctx context.Context, in *VideoAccessRequest, opts ...grpc.CallOption) (*VideoAccessResponse, error)
+	RevokeVideoAccess(ctx context.Context, in *VideoAccessRequest, opts ...grpc.CallOption) (*VideoAccessResponse, error)
+	// ListVideoAccess returns the users granted access to a video, for its owner and admins.
+	ListVideoAccess(ctx context.Context, in *ListVideoAccessRequest, opts ...grpc.CallOption) (*ListVideoAccessResponse, error)
+	// CreateShareLink creates an expiring link that plays a video without an account; only the
+	// owner and admins can create, list or revoke share links.
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error)
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
+	// ResolveShareLink returns the video a share link plays and counts a view against it.
+	ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*common.Video, error)
+	// ListStorageCleanups returns queued storage removals that are due.
+	ListStorageCleanups(ctx context.Context, in *ListStorageCleanupsRequest, opts ...grpc.CallOption) (*ListStorageCleanupsResponse, error)
+	// CompleteStorageCleanup reports a removal attempt; failed ones are retried with backoff.
+	CompleteStorageCleanup(ctx context.Context, in *CompleteStorageCleanupRequest, opts ...grpc.CallOption) (*CompleteStorageCleanupResponse, error)
+	// CreateChannel creates a channel owned by the caller. Handles are unique.
+	CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*Channel, error)
+	// GetChannel looks a channel up by id or handle.
+	GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*Channel, error)
+	// UpdateChannel changes the fields named in update_mask; only the owner and admins can.
+	UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Channel, error)
+	// DeleteChannel deletes a channel. Its videos stay with their owners, outside any channel.
+	DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error)
+	// AddChannelMember lets a user post videos to a channel; the owner and admins manage members.
+	AddChannelMember(ctx context.Context, in *ChannelMemberRequest, opts ...grpc.CallOption) (*ChannelMemberResponse, error)
+	RemoveChannelMember(ctx context.Context, in *ChannelMemberRequest, opts ...grpc.CallOption) (*ChannelMemberResponse, error)
+	// SetChannelImage points a channel's avatar or banner at an uploaded object. The upload
+	// service calls it when it hands out the image's upload URL.
+	SetChannelImage(ctx context.Context, in *SetChannelImageRequest, opts ...grpc.CallOption) (*SetChannelImageResponse, error)
+	// Subscribe makes the caller follow a channel's videos; Unsubscribe stops it. Both are
+	// idempotent.
+	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	Unsubscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error)
+	// ListSubscriptions returns the channels the caller subscribed to, most recent first.
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// GetSubscriptionFeed pages through the listed videos of the caller's subscriptions,
+	// newest first.
+	GetSubscriptionFeed(ctx context.Context, in *GetSubscriptionFeedRequest, opts ...grpc.CallOption) (*GetSubscriptionFeedResponse, error)
+	// CreatePlaylist creates an empty playlist owned by the caller.
+	CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error)
+	// GetPlaylist returns a playlist with its items in order. Private playlists are only
+	// visible to their owner, collaborators and admins.
+	GetPlaylist(ctx context.Context, in *GetPlaylistRequest, opts ...grpc.CallOption) (*Playlist, error)
+	// ListPlaylists returns a user's playlists without their items; others only see the
+	// public ones.
+	ListPlaylists(ctx context.Context, in *ListPlaylistsRequest, opts ...grpc.CallOption) (*ListPlaylistsResponse, error)
+	// UpdatePlaylist changes the fields named in update_mask; only the owner and admins can.
+	UpdatePlaylist(ctx context.Context, in *UpdatePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error)
+	DeletePlaylist(ctx context.Context, in *DeletePlaylistRequest, opts ...grpc.CallOption) (*DeletePlaylistResponse, error)
+	// AddPlaylistItem appends a video; the owner and collaborators edit a playlist's items.
+	AddPlaylistItem(ctx context.Context, in *AddPlaylistItemRequest, opts ...grpc.CallOption) (*PlaylistItem, error)
+	RemovePlaylistItem(ctx context.Context, in *RemovePlaylistItemRequest, opts ...grpc.CallOption) (*RemovePlaylistItemResponse, error)
+	// MovePlaylistItem moves an item right after another, or to the start. Only the moved
+	// item's position changes, so concurrent edits elsewhere in the playlist don't conflict.
+	MovePlaylistItem(ctx context.Context, in *MovePlaylistItemRequest, opts ...grpc.CallOption) (*PlaylistItem, error)
+	// AddPlaylistCollaborator lets a user edit a playlist's items; the owner and admins
+	// manage collaborators.
+	AddPlaylistCollaborator(ctx context.Context, in *PlaylistCollaboratorRequest, opts ...grpc.CallOption) (*PlaylistCollaboratorResponse, error)
+	RemovePlaylistCollaborator(ctx context.Context, in *PlaylistCollaboratorRequest, opts ...grpc.CallOption) (*PlaylistCollaboratorResponse, error)
+	// CreateSeries creates an empty series owned by the caller.
+	CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	// GetSeries returns a series with the episodes the caller may watch, in order, and which
+	// of them the caller completed.
+	GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	// ListSeries returns a user's series without their episodes.
+	ListSeries(ctx context.Context, in *ListSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error)
+	// UpdateSeries changes the fields named in update_mask; only the owner and admins can.
+	UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*Series, error)
+	// DeleteSeries takes the series' videos out of it; the videos stay.
+	DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResponse, error)
+	// SetEpisode makes a video an episode of a series, or renumbers it. The caller must manage
+	// both the series and the video. It returns the updated series.
+	SetEpisode(ctx context.Context, in *SetEpisodeRequest, opts ...grpc.CallOption) (*Series, error)
+	RemoveEpisode(ctx context.Context, in *RemoveEpisodeRequest, opts ...grpc.CallOption) (*Series, error)
+	// GetNextEpisode returns the episode to play after after_video_id, or the viewer's first
+	// episode they haven't completed when it is empty. It skips episodes the viewer can't
+	// watch and fails with NOT_FOUND when there is none.
+	GetNextEpisode(ctx context.Context, in *GetNextEpisodeRequest, opts ...grpc.CallOption) (*common.Video, error)
+	// SetEpisodeCompleted marks an episode completed or not for the caller.
+	SetEpisodeCompleted(ctx context.Context, in *SetEpisodeCompletedRequest, opts ...grpc.CallOption) (*SetEpisodeCompletedResponse, error)
 }
 
 type metadataServiceClient struct {
@@ -51,158 +199,1746 @@ func (c *metadataServiceClient) GetVideo(ctx context.Context, in *GetVideoReques
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListVideos(ctx context.Context, in *ListVideosRequest, opts ...grpc.CallOption) (*ListVideosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVideosResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) CreateVideo(ctx context.Context, in *CreateVideoRequest, opts ...grpc.CallOption) (*CreateVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateVideoResponse)
+	err := c.cc.Invoke(ctx, MetadataService_CreateVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) UpdateVideo(ctx context.Context, in *UpdateVideoRequest, opts ...grpc.CallOption) (*common.Video, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Video)
+	err := c.cc.Invoke(ctx, MetadataService_UpdateVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) UpdateVideoStatus(ctx context.Context, in *UpdateVideoStatusRequest, opts ...grpc.CallOption) (*UpdateVideoStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateVideoStatusResponse)
+	err := c.cc.Invoke(ctx, MetadataService_UpdateVideoStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) CreateStreamKey(ctx context.Context, in *CreateStreamKeyRequest, opts ...grpc.CallOption) (*CreateStreamKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStreamKeyResponse)
+	err := c.cc.Invoke(ctx, MetadataService_CreateStreamKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) StartLiveStream(ctx context.Context, in *StartLiveStreamRequest, opts ...grpc.CallOption) (*common.Video, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Video)
+	err := c.cc.Invoke(ctx, MetadataService_StartLiveStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) UpdateLiveStatus(ctx context.Context, in *UpdateLiveStatusRequest, opts ...grpc.CallOption) (*UpdateLiveStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLiveStatusResponse)
+	err := c.cc.Invoke(ctx, MetadataService_UpdateLiveStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) CompleteRecording(ctx context.Context, in *CompleteRecordingRequest, opts ...grpc.CallOption) (*CompleteRecordingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteRecordingResponse)
+	err := c.cc.Invoke(ctx, MetadataService_CompleteRecording_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) DeleteVideo(ctx context.Context, in *DeleteVideoRequest, opts ...grpc.CallOption) (*DeleteVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteVideoResponse)
+	err := c.cc.Invoke(ctx, MetadataService_DeleteVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RestoreVideo(ctx context.Context, in *RestoreVideoRequest, opts ...grpc.CallOption) (*RestoreVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreVideoResponse)
+	err := c.cc.Invoke(ctx, MetadataService_RestoreVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) UnpublishVideo(ctx context.Context, in *UnpublishVideoRequest, opts ...grpc.CallOption) (*UnpublishVideoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnpublishVideoResponse)
+	err := c.cc.Invoke(ctx, MetadataService_UnpublishVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListTrash(ctx context.Context, in *ListTrashRequest, opts ...grpc.CallOption) (*ListTrashResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTrashResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListTrash_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GrantVideoAccess(ctx context.Context, in *VideoAccessRequest, opts ...grpc.CallOption) (*VideoAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoAccessResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GrantVideoAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RevokeVideoAccess(ctx context.Context, in *VideoAccessRequest, opts ...grpc.CallOption) (*VideoAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoAccessResponse)
+	err := c.cc.Invoke(ctx, MetadataService_RevokeVideoAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListVideoAccess(ctx context.Context, in *ListVideoAccessRequest, opts ...grpc.CallOption) (*ListVideoAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVideoAccessResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListVideoAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*ShareLink, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ShareLink)
+	err := c.cc.Invoke(ctx, MetadataService_CreateShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListShareLinksResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListShareLinks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, MetadataService_RevokeShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ResolveShareLink(ctx context.Context, in *ResolveShareLinkRequest, opts ...grpc.CallOption) (*common.Video, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Video)
+	err := c.cc.Invoke(ctx, MetadataService_ResolveShareLink_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListStorageCleanups(ctx context.Context, in *ListStorageCleanupsRequest, opts ...grpc.CallOption) (*ListStorageCleanupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStorageCleanupsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListStorageCleanups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) CompleteStorageCleanup(ctx context.Context, in *CompleteStorageCleanupRequest, opts ...grpc.CallOption) (*CompleteStorageCleanupResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteStorageCleanupResponse)
+	err := c.cc.Invoke(ctx, MetadataService_CompleteStorageCleanup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) CreateChannel(ctx context.Context, in *CreateChannelRequest, opts ...grpc.CallOption) (*Channel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Channel)
+	err := c.cc.Invoke(ctx, MetadataService_CreateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetChannel(ctx context.Context, in *GetChannelRequest, opts ...grpc.CallOption) (*Channel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Channel)
+	err := c.cc.Invoke(ctx, MetadataService_GetChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) UpdateChannel(ctx context.Context, in *UpdateChannelRequest, opts ...grpc.CallOption) (*Channel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Channel)
+	err := c.cc.Invoke(ctx, MetadataService_UpdateChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) DeleteChannel(ctx context.Context, in *DeleteChannelRequest, opts ...grpc.CallOption) (*DeleteChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteChannelResponse)
+	err := c.cc.Invoke(ctx, MetadataService_DeleteChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) AddChannelMember(ctx context.Context, in *ChannelMemberRequest, opts ...grpc.CallOption) (*ChannelMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelMemberResponse)
+	err := c.cc.Invoke(ctx, MetadataService_AddChannelMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RemoveChannelMember(ctx context.Context, in *ChannelMemberRequest, opts ...grpc.CallOption) (*ChannelMemberResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChannelMemberResponse)
+	err := c.cc.Invoke(ctx, MetadataService_RemoveChannelMember_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SetChannelImage(ctx context.Context, in *SetChannelImageRequest, opts ...grpc.CallOption) (*SetChannelImageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetChannelImageResponse)
+	err := c.cc.Invoke(ctx, MetadataService_SetChannelImage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, MetadataService_Subscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) Unsubscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (*SubscriptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscriptionResponse)
+	err := c.cc.Invoke(ctx, MetadataService_Unsubscribe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListSubscriptions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetSubscriptionFeed(ctx context.Context, in *GetSubscriptionFeedRequest, opts ...grpc.CallOption) (*GetSubscriptionFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSubscriptionFeedResponse)
+	err := c.cc.Invoke(ctx, MetadataService_GetSubscriptionFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) CreatePlaylist(ctx context.Context, in *CreatePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, MetadataService_CreatePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetPlaylist(ctx context.Context, in *GetPlaylistRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, MetadataService_GetPlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListPlaylists(ctx context.Context, in *ListPlaylistsRequest, opts ...grpc.CallOption) (*ListPlaylistsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPlaylistsResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListPlaylists_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) UpdatePlaylist(ctx context.Context, in *UpdatePlaylistRequest, opts ...grpc.CallOption) (*Playlist, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Playlist)
+	err := c.cc.Invoke(ctx, MetadataService_UpdatePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) DeletePlaylist(ctx context.Context, in *DeletePlaylistRequest, opts ...grpc.CallOption) (*DeletePlaylistResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePlaylistResponse)
+	err := c.cc.Invoke(ctx, MetadataService_DeletePlaylist_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) AddPlaylistItem(ctx context.Context, in *AddPlaylistItemRequest, opts ...grpc.CallOption) (*PlaylistItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaylistItem)
+	err := c.cc.Invoke(ctx, MetadataService_AddPlaylistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RemovePlaylistItem(ctx context.Context, in *RemovePlaylistItemRequest, opts ...grpc.CallOption) (*RemovePlaylistItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemovePlaylistItemResponse)
+	err := c.cc.Invoke(ctx, MetadataService_RemovePlaylistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) MovePlaylistItem(ctx context.Context, in *MovePlaylistItemRequest, opts ...grpc.CallOption) (*PlaylistItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaylistItem)
+	err := c.cc.Invoke(ctx, MetadataService_MovePlaylistItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) AddPlaylistCollaborator(ctx context.Context, in *PlaylistCollaboratorRequest, opts ...grpc.CallOption) (*PlaylistCollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaylistCollaboratorResponse)
+	err := c.cc.Invoke(ctx, MetadataService_AddPlaylistCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RemovePlaylistCollaborator(ctx context.Context, in *PlaylistCollaboratorRequest, opts ...grpc.CallOption) (*PlaylistCollaboratorResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PlaylistCollaboratorResponse)
+	err := c.cc.Invoke(ctx, MetadataService_RemovePlaylistCollaborator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) CreateSeries(ctx context.Context, in *CreateSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, MetadataService_CreateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetSeries(ctx context.Context, in *GetSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, MetadataService_GetSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) ListSeries(ctx context.Context, in *ListSeriesRequest, opts ...grpc.CallOption) (*ListSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSeriesResponse)
+	err := c.cc.Invoke(ctx, MetadataService_ListSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) UpdateSeries(ctx context.Context, in *UpdateSeriesRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, MetadataService_UpdateSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) DeleteSeries(ctx context.Context, in *DeleteSeriesRequest, opts ...grpc.CallOption) (*DeleteSeriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSeriesResponse)
+	err := c.cc.Invoke(ctx, MetadataService_DeleteSeries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SetEpisode(ctx context.Context, in *SetEpisodeRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, MetadataService_SetEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) RemoveEpisode(ctx context.Context, in *RemoveEpisodeRequest, opts ...grpc.CallOption) (*Series, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Series)
+	err := c.cc.Invoke(ctx, MetadataService_RemoveEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) GetNextEpisode(ctx context.Context, in *GetNextEpisodeRequest, opts ...grpc.CallOption) (*common.Video, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Video)
+	err := c.cc.Invoke(ctx, MetadataService_GetNextEpisode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *metadataServiceClient) SetEpisodeCompleted(ctx context.Context, in *SetEpisodeCompletedRequest, opts ...grpc.CallOption) (*SetEpisodeCompletedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetEpisodeCompletedResponse)
+	err := c.cc.Invoke(ctx, MetadataService_SetEpisodeCompleted_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MetadataServiceServer is the server API for MetadataService service.
+// All implementations must embed UnimplementedMetadataServiceServer
+// for forward compatibility.
+//
+// Callers are identified by gRPC metadata: the gateway sends x-user-id and x-user-roles for
+// the signed-in user it calls for, and other services send x-service with their name. Calls
+// with neither are anonymous. Requests naming a user_id must name the caller.
+type MetadataServiceServer interface {
+	GetVideo(context.Context, *GetVideoRequest) (*common.Video, error)
+	ListVideos(context.Context, *ListVideosRequest) (*ListVideosResponse, error)
+	CreateVideo(context.Context, *CreateVideoRequest) (*CreateVideoResponse, error)
+	// UpdateVideo changes the fields named in update_mask and returns the updated video.
+	UpdateVideo(context.Context, *UpdateVideoRequest) (*common.Video, error)
+	UpdateVideoStatus(context.Context, *UpdateVideoStatusRequest) (*UpdateVideoStatusResponse, error)
+	CreateStreamKey(context.Context, *CreateStreamKeyRequest) (*CreateStreamKeyResponse, error)
+	StartLiveStream(context.Context, *StartLiveStreamRequest) (*common.Video, error)
+	UpdateLiveStatus(context.Context, *UpdateLiveStatusRequest) (*UpdateLiveStatusResponse, error)
+	CompleteRecording(context.Context, *CompleteRecordingRequest) (*CompleteRecordingResponse, error)
+	// DeleteVideo moves a video to the trash. Trashed videos are purged after the retention
+	// period, which queues the removal of their objects from storage.
+	DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoResponse, error)
+	// RestoreVideo takes a video out of the trash before it is purged.
+	RestoreVideo(context.Context, *RestoreVideoRequest) (*RestoreVideoResponse, error)
+	// UnpublishVideo makes a video private; its owner, moderators and admins can unpublish it.
+	UnpublishVideo(context.Context, *UnpublishVideoRequest) (*UnpublishVideoResponse, error)
+	// ListTrash returns the caller's trashed videos, most recently deleted first.
+	ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error)
+	// GrantVideoAccess lets a user watch a private video; only the owner and admins can grant access.
+	GrantVideoAccess(context.Context, *VideoAccessRequest) (*VideoAccessResponse, error)
+	RevokeVideoAccess(context.Context, *VideoAccessRequest) (*VideoAccessResponse, error)
+	// ListVideoAccess returns the users granted access to a video, for its owner and admins.
+	ListVideoAccess(context.Context, *ListVideoAccessRequest) (*ListVideoAccessResponse, error)
+	// CreateShareLink creates an expiring link that plays a video without an account; only the
+	// owner and admins can create, list or revoke share links.
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error)
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+	// ResolveShareLink returns the video a share link plays and counts a view against it.
+	ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*common.Video, error)
+	// ListStorageCleanups returns queued storage removals that are due.
+	ListStorageCleanups(context.Context, *ListStorageCleanupsRequest) (*ListStorageCleanupsResponse, error)
+	// CompleteStorageCleanup reports a removal attempt; failed ones are retried with backoff.
+	CompleteStorageCleanup(context.Context, *CompleteStorageCleanupRequest) (*CompleteStorageCleanupResponse, error)
+	// CreateChannel creates a channel owned by the caller. Handles are unique.
+	CreateChannel(context.Context, *CreateChannelRequest) (*Channel, error)
+	// GetChannel looks a channel up by id or handle.
+	GetChannel(context.Context, *GetChannelRequest) (*Channel, error)
+	// UpdateChannel changes the fields named in update_mask; only the owner and admins can.
+	UpdateChannel(context.Context, *UpdateChannelRequest) (*Channel, error)
+	// DeleteChannel deletes a channel. Its videos stay with their owners, outside any channel.
+	DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error)
+	// AddChannelMember lets a user post videos to a channel; the owner and admins manage members.
+	AddChannelMember(context.Context, *ChannelMemberRequest) (*ChannelMemberResponse, error)
+	RemoveChannelMember(context.Context, *ChannelMemberRequest) (*ChannelMemberResponse, error)
+	// SetChannelImage points a channel's avatar or banner at an uploaded object. The upload
+	// service calls it when it hands out the image's upload URL.
+	SetChannelImage(context.Context, *SetChannelImageRequest) (*SetChannelImageResponse, error)
+	// Subscribe makes the caller follow a channel's videos; Unsubscribe stops it. Both are
+	// idempotent.
+	Subscribe(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error)
+	Unsubscribe(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error)
+	// ListSubscriptions returns the channels the caller subscribed to, most recent first.
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	// GetSubscriptionFeed pages through the listed videos of the caller's subscriptions,
+	// newest first.
+	GetSubscriptionFeed(context.Context, *GetSubscriptionFeedRequest) (*GetSubscriptionFeedResponse, error)
+	// CreatePlaylist creates an empty playlist owned by the caller.
+	CreatePlaylist(context.Context, *CreatePlaylistRequest) (*Playlist, error)
+	// GetPlaylist returns a playlist with its items in order. Private playlists are only
+	// visible to their owner, collaborators and admins.
+	GetPlaylist(context.Context, *GetPlaylistRequest) (*Playlist, error)
+	// ListPlaylists returns a user's playlists without their items; others only see the
+	// public ones.
+	ListPlaylists(context.Context, *ListPlaylistsRequest) (*ListPlaylistsResponse, error)
+	// UpdatePlaylist changes the fields named in update_mask; only the owner and admins can.
+	UpdatePlaylist(context.Context, *UpdatePlaylistRequest) (*Playlist, error)
+	DeletePlaylist(context.Context, *DeletePlaylistRequest) (*DeletePlaylistResponse, error)
+	// AddPlaylistItem appends a video; the owner and collaborators edit a playlist's items.
+	AddPlaylistItem(context.Context, *AddPlaylistItemRequest) (*PlaylistItem, error)
+	RemovePlaylistItem(context.Context, *RemovePlaylistItemRequest) (*RemovePlaylistItemResponse, error)
+	// MovePlaylistItem moves an item right after another, or to the start. Only the moved
+	// item's position changes, so concurrent edits elsewhere in the playlist don't conflict.
+	MovePlaylistItem(context.Context, *MovePlaylistItemRequest) (*PlaylistItem, error)
+	// AddPlaylistCollaborator lets a user edit a playlist's items; the owner and admins
+	// manage collaborators.
+	AddPlaylistCollaborator(context.Context, *PlaylistCollaboratorRequest) (*PlaylistCollaboratorResponse, error)
+	RemovePlaylistCollaborator(context.Context, *PlaylistCollaboratorRequest) (*PlaylistCollaboratorResponse, error)
+	// CreateSeries creates an empty series owned by the caller.
+	CreateSeries(context.Context, *CreateSeriesRequest) (*Series, error)
+	// GetSeries returns a series with the episodes the caller may watch, in order, and which
+	// of them the caller completed.
+	GetSeries(context.Context, *GetSeriesRequest) (*Series, error)
+	// ListSeries returns a user's series without their episodes.
+	ListSeries(context.Context, *ListSeriesRequest) (*ListSeriesResponse, error)
+	// UpdateSeries changes the fields named in update_mask; only the owner and admins can.
+	UpdateSeries(context.Context, *UpdateSeriesRequest) (*Series, error)
+	// DeleteSeries takes the series' videos out of it; the videos stay.
+	DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResponse, error)
+	// SetEpisode makes a video an episode of a series, or renumbers it. The caller must manage
+	// both the series and the video. It returns the updated series.
+	SetEpisode(context.Context, *SetEpisodeRequest) (*Series, error)
+	RemoveEpisode(context.Context, *RemoveEpisodeRequest) (*Series, error)
+	// GetNextEpisode returns the episode to play after after_video_id, or the viewer's first
+	// episode they haven't completed when it is empty. It skips episodes the viewer can't
+	// watch and fails with NOT_FOUND when there is none.
+	GetNextEpisode(context.Context, *GetNextEpisodeRequest) (*common.Video, error)
+	// SetEpisodeCompleted marks an episode completed or not for the caller.
+	SetEpisodeCompleted(context.Context, *SetEpisodeCompletedRequest) (*SetEpisodeCompletedResponse, error)
+	mustEmbedUnimplementedMetadataServiceServer()
+}
+
+// UnimplementedMetadataServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMetadataServiceServer struct{}
+
+func (UnimplementedMetadataServiceServer) GetVideo(context.Context, *GetVideoRequest) (*common.Video, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVideo not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListVideos(context.Context, *ListVideosRequest) (*ListVideosResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVideos not implemented")
+}
+func (UnimplementedMetadataServiceServer) CreateVideo(context.Context, *CreateVideoRequest) (*CreateVideoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVideo not implemented")
+}
+func (UnimplementedMetadataServiceServer) UpdateVideo(context.Context, *UpdateVideoRequest) (*common.Video, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateVideo not implemented")
+}
+func (UnimplementedMetadataServiceServer) UpdateVideoStatus(context.Context, *UpdateVideoStatusRequest) (*UpdateVideoStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateVideoStatus not implemented")
+}
+func (UnimplementedMetadataServiceServer) CreateStreamKey(context.Context, *CreateStreamKeyRequest) (*CreateStreamKeyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateStreamKey not implemented")
+}
+func (UnimplementedMetadataServiceServer) StartLiveStream(context.Context, *StartLiveStreamRequest) (*common.Video, error) {
+	return nil, status.Error(codes.Unimplemented, "method StartLiveStream not implemented")
+}
+func (UnimplementedMetadataServiceServer) UpdateLiveStatus(context.Context, *UpdateLiveStatusRequest) (*UpdateLiveStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateLiveStatus not implemented")
+}
+func (UnimplementedMetadataServiceServer) CompleteRecording(context.Context, *CompleteRecordingRequest) (*CompleteRecordingResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteRecording not implemented")
+}
+func (UnimplementedMetadataServiceServer) DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteVideo not implemented")
+}
+func (UnimplementedMetadataServiceServer) RestoreVideo(context.Context, *RestoreVideoRequest) (*RestoreVideoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreVideo not implemented")
+}
+func (UnimplementedMetadataServiceServer) UnpublishVideo(context.Context, *UnpublishVideoRequest) (*UnpublishVideoResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnpublishVideo not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListTrash(context.Context, *ListTrashRequest) (*ListTrashResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTrash not implemented")
+}
+func (UnimplementedMetadataServiceServer) GrantVideoAccess(context.Context, *VideoAccessRequest) (*VideoAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GrantVideoAccess not implemented")
+}
+func (UnimplementedMetadataServiceServer) RevokeVideoAccess(context.Context, *VideoAccessRequest) (*VideoAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeVideoAccess not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListVideoAccess(context.Context, *ListVideoAccessRequest) (*ListVideoAccessResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVideoAccess not implemented")
+}
+func (UnimplementedMetadataServiceServer) CreateShareLink(context.Context, *CreateShareLinkRequest) (*ShareLink, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListShareLinks not implemented")
+}
+func (UnimplementedMetadataServiceServer) RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (UnimplementedMetadataServiceServer) ResolveShareLink(context.Context, *ResolveShareLinkRequest) (*common.Video, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveShareLink not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListStorageCleanups(context.Context, *ListStorageCleanupsRequest) (*ListStorageCleanupsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListStorageCleanups not implemented")
+}
+func (UnimplementedMetadataServiceServer) CompleteStorageCleanup(context.Context, *CompleteStorageCleanupRequest) (*CompleteStorageCleanupResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompleteStorageCleanup not implemented")
+}
+func (UnimplementedMetadataServiceServer) CreateChannel(context.Context, *CreateChannelRequest) (*Channel, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateChannel not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetChannel(context.Context, *GetChannelRequest) (*Channel, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChannel not implemented")
+}
+func (UnimplementedMetadataServiceServer) UpdateChannel(context.Context, *UpdateChannelRequest) (*Channel, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateChannel not implemented")
+}
+func (UnimplementedMetadataServiceServer) DeleteChannel(context.Context, *DeleteChannelRequest) (*DeleteChannelResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteChannel not implemented")
+}
+func (UnimplementedMetadataServiceServer) AddChannelMember(context.Context, *ChannelMemberRequest) (*ChannelMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddChannelMember not implemented")
+}
+func (UnimplementedMetadataServiceServer) RemoveChannelMember(context.Context, *ChannelMemberRequest) (*ChannelMemberResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveChannelMember not implemented")
+}
+func (UnimplementedMetadataServiceServer) SetChannelImage(context.Context, *SetChannelImageRequest) (*SetChannelImageResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChannelImage not implemented")
+}
+func (UnimplementedMetadataServiceServer) Subscribe(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedMetadataServiceServer) Unsubscribe(context.Context, *SubscriptionRequest) (*SubscriptionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetSubscriptionFeed(context.Context, *GetSubscriptionFeedRequest) (*GetSubscriptionFeedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSubscriptionFeed not implemented")
+}
+func (UnimplementedMetadataServiceServer) CreatePlaylist(context.Context, *CreatePlaylistRequest) (*Playlist, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreatePlaylist not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetPlaylist(context.Context, *GetPlaylistRequest) (*Playlist, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetPlaylist not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListPlaylists(context.Context, *ListPlaylistsRequest) (*ListPlaylistsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListPlaylists not implemented")
+}
+func (UnimplementedMetadataServiceServer) UpdatePlaylist(context.Context, *UpdatePlaylistRequest) (*Playlist, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdatePlaylist not implemented")
+}
+func (UnimplementedMetadataServiceServer) DeletePlaylist(context.Context, *DeletePlaylistRequest) (*DeletePlaylistResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeletePlaylist not implemented")
+}
+func (UnimplementedMetadataServiceServer) AddPlaylistItem(context.Context, *AddPlaylistItemRequest) (*PlaylistItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddPlaylistItem not implemented")
+}
+func (UnimplementedMetadataServiceServer) RemovePlaylistItem(context.Context, *RemovePlaylistItemRequest) (*RemovePlaylistItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemovePlaylistItem not implemented")
+}
+func (UnimplementedMetadataServiceServer) MovePlaylistItem(context.Context, *MovePlaylistItemRequest) (*PlaylistItem, error) {
+	return nil, status.Error(codes.Unimplemented, "method MovePlaylistItem not implemented")
+}
+func (UnimplementedMetadataServiceServer) AddPlaylistCollaborator(context.Context, *PlaylistCollaboratorRequest) (*PlaylistCollaboratorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddPlaylistCollaborator not implemented")
+}
+func (UnimplementedMetadataServiceServer) RemovePlaylistCollaborator(context.Context, *PlaylistCollaboratorRequest) (*PlaylistCollaboratorResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemovePlaylistCollaborator not implemented")
+}
+func (UnimplementedMetadataServiceServer) CreateSeries(context.Context, *CreateSeriesRequest) (*Series, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateSeries not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetSeries(context.Context, *GetSeriesRequest) (*Series, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSeries not implemented")
+}
+func (UnimplementedMetadataServiceServer) ListSeries(context.Context, *ListSeriesRequest) (*ListSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListSeries not implemented")
+}
+func (UnimplementedMetadataServiceServer) UpdateSeries(context.Context, *UpdateSeriesRequest) (*Series, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateSeries not implemented")
+}
+func (UnimplementedMetadataServiceServer) DeleteSeries(context.Context, *DeleteSeriesRequest) (*DeleteSeriesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteSeries not implemented")
+}
+func (UnimplementedMetadataServiceServer) SetEpisode(context.Context, *SetEpisodeRequest) (*Series, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEpisode not implemented")
+}
+func (UnimplementedMetadataServiceServer) RemoveEpisode(context.Context, *RemoveEpisodeRequest) (*Series, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveEpisode not implemented")
+}
+func (UnimplementedMetadataServiceServer) GetNextEpisode(context.Context, *GetNextEpisodeRequest) (*common.Video, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetNextEpisode not implemented")
+}
+func (UnimplementedMetadataServiceServer) SetEpisodeCompleted(context.Context, *SetEpisodeCompletedRequest) (*SetEpisodeCompletedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetEpisodeCompleted not implemented")
+}
+func (UnimplementedMetadataServiceServer) mustEmbedUnimplementedMetadataServiceServer() {}
+func (UnimplementedMetadataServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeMetadataServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MetadataServiceServer will
+// result in compilation errors.
+type UnsafeMetadataServiceServer interface {
+	mustEmbedUnimplementedMetadataServiceServer()
+}
+
+func RegisterMetadataServiceServer(s grpc.ServiceRegistrar, srv MetadataServiceServer) {
+	// If the following call panics, it indicates UnimplementedMetadataServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MetadataService_ServiceDesc, srv)
+}
+
+func _MetadataService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetVideo(ctx, req.(*GetVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListVideos(ctx, req.(*ListVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_CreateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CreateVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CreateVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CreateVideo(ctx, req.(*CreateVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_UpdateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UpdateVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_UpdateVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UpdateVideo(ctx, req.(*UpdateVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_UpdateVideoStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVideoStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UpdateVideoStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_UpdateVideoStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UpdateVideoStatus(ctx, req.(*UpdateVideoStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_CreateStreamKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStreamKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CreateStreamKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CreateStreamKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CreateStreamKey(ctx, req.(*CreateStreamKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_StartLiveStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartLiveStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).StartLiveStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_StartLiveStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).StartLiveStream(ctx, req.(*StartLiveStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_UpdateLiveStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLiveStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UpdateLiveStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_UpdateLiveStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UpdateLiveStatus(ctx, req.(*UpdateLiveStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_CompleteRecording_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRecordingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CompleteRecording(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CompleteRecording_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CompleteRecording(ctx, req.(*CompleteRecordingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_DeleteVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DeleteVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_DeleteVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DeleteVideo(ctx, req.(*DeleteVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RestoreVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RestoreVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_RestoreVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RestoreVideo(ctx, req.(*RestoreVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_UnpublishVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpublishVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UnpublishVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_UnpublishVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UnpublishVideo(ctx, req.(*UnpublishVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListTrash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTrashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListTrash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListTrash_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListTrash(ctx, req.(*ListTrashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GrantVideoAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GrantVideoAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GrantVideoAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GrantVideoAccess(ctx, req.(*VideoAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RevokeVideoAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VideoAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RevokeVideoAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_RevokeVideoAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RevokeVideoAccess(ctx, req.(*VideoAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListVideoAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVideoAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListVideoAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListVideoAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListVideoAccess(ctx, req.(*ListVideoAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CreateShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RevokeShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_RevokeShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ResolveShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ResolveShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ResolveShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ResolveShareLink(ctx, req.(*ResolveShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListStorageCleanups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStorageCleanupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListStorageCleanups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListStorageCleanups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListStorageCleanups(ctx, req.(*ListStorageCleanupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_CompleteStorageCleanup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteStorageCleanupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CompleteStorageCleanup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CompleteStorageCleanup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CompleteStorageCleanup(ctx, req.(*CompleteStorageCleanupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_CreateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CreateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CreateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CreateChannel(ctx, req.(*CreateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetChannel(ctx, req.(*GetChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_UpdateChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UpdateChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_UpdateChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UpdateChannel(ctx, req.(*UpdateChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_DeleteChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DeleteChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_DeleteChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DeleteChannel(ctx, req.(*DeleteChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_AddChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).AddChannelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_AddChannelMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).AddChannelMember(ctx, req.(*ChannelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RemoveChannelMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RemoveChannelMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_RemoveChannelMember_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RemoveChannelMember(ctx, req.(*ChannelMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_SetChannelImage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChannelImageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).SetChannelImage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_SetChannelImage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).SetChannelImage(ctx, req.(*SetChannelImageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_Subscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).Subscribe(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_Unsubscribe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).Unsubscribe(ctx, req.(*SubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetSubscriptionFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubscriptionFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetSubscriptionFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetSubscriptionFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetSubscriptionFeed(ctx, req.(*GetSubscriptionFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_CreatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CreatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CreatePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CreatePlaylist(ctx, req.(*CreatePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_GetPlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetPlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetPlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetPlaylist(ctx, req.(*GetPlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_ListPlaylists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlaylistsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListPlaylists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListPlaylists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListPlaylists(ctx, req.(*ListPlaylistsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_UpdatePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UpdatePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_UpdatePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UpdatePlaylist(ctx, req.(*UpdatePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_DeletePlaylist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlaylistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DeletePlaylist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_DeletePlaylist_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DeletePlaylist(ctx, req.(*DeletePlaylistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_AddPlaylistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPlaylistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).AddPlaylistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_AddPlaylistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).AddPlaylistItem(ctx, req.(*AddPlaylistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_RemovePlaylistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePlaylistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RemovePlaylistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_RemovePlaylistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RemovePlaylistItem(ctx, req.(*RemovePlaylistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MetadataService_MovePlaylistItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePlaylistItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).MovePlaylistItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_MovePlaylistItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).MovePlaylistItem(ctx, req.(*MovePlaylistItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *metadataServiceClient) ListVideos(ctx context.Context, in *ListVideosRequest, opts ...grpc.CallOption) (*ListVideosResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(ListVideosResponse)
-	err := c.cc.Invoke(ctx, MetadataService_ListVideos_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MetadataService_AddPlaylistCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistCollaboratorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).AddPlaylistCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_AddPlaylistCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).AddPlaylistCollaborator(ctx, req.(*PlaylistCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *metadataServiceClient) CreateVideo(ctx context.Context, in *CreateVideoRequest, opts ...grpc.CallOption) (*CreateVideoResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(CreateVideoResponse)
-	err := c.cc.Invoke(ctx, MetadataService_CreateVideo_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MetadataService_RemovePlaylistCollaborator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlaylistCollaboratorRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).RemovePlaylistCollaborator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_RemovePlaylistCollaborator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).RemovePlaylistCollaborator(ctx, req.(*PlaylistCollaboratorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *metadataServiceClient) UpdateVideoStatus(ctx context.Context, in *UpdateVideoStatusRequest, opts ...grpc.CallOption) (*UpdateVideoStatusResponse, error) {
-	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(UpdateVideoStatusResponse)
-	err := c.cc.Invoke(ctx, MetadataService_UpdateVideoStatus_FullMethodName, in, out, cOpts...)
-	if err != nil {
+func _MetadataService_CreateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSeriesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).CreateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_CreateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).CreateSeries(ctx, req.(*CreateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// MetadataServiceServer is the server API for MetadataService service.
-// All implementations must embed UnimplementedMetadataServiceServer
-// for forward compatibility.
-type MetadataServiceServer interface {
-	GetVideo(context.Context, *GetVideoRequest) (*common.Video, error)
-	ListVideos(context.Context, *ListVideosRequest) (*ListVideosResponse, error)
-	CreateVideo(context.Context, *CreateVideoRequest) (*CreateVideoResponse, error)
-	UpdateVideoStatus(context.Context, *UpdateVideoStatusRequest) (*UpdateVideoStatusResponse, error)
-	mustEmbedUnimplementedMetadataServiceServer()
+func _MetadataService_GetSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).GetSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_GetSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).GetSeries(ctx, req.(*GetSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedMetadataServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedMetadataServiceServer struct{}
-
-func (UnimplementedMetadataServiceServer) GetVideo(context.Context, *GetVideoRequest) (*common.Video, error) {
-	return nil, status.Error(codes.Unimplemented, "method GetVideo not implemented")
-}
-func (UnimplementedMetadataServiceServer) ListVideos(context.Context, *ListVideosRequest) (*ListVideosResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method ListVideos not implemented")
-}
-func (UnimplementedMetadataServiceServer) CreateVideo(context.Context, *CreateVideoRequest) (*CreateVideoResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method CreateVideo not implemented")
-}
-func (UnimplementedMetadataServiceServer) UpdateVideoStatus(context.Context, *UpdateVideoStatusRequest) (*UpdateVideoStatusResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "method UpdateVideoStatus not implemented")
+func _MetadataService_ListSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).ListSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_ListSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).ListSeries(ctx, req.(*ListSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMetadataServiceServer) mustEmbedUnimplementedMetadataServiceServer() {}
-func (UnimplementedMetadataServiceServer) testEmbeddedByValue()                         {}
 
-// UnsafeMetadataServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MetadataServiceServer will
-// result in compilation errors.
-type UnsafeMetadataServiceServer interface {
-	mustEmbedUnimplementedMetadataServiceServer()
+func _MetadataService_UpdateSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).UpdateSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_UpdateSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).UpdateSeries(ctx, req.(*UpdateSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterMetadataServiceServer(s grpc.ServiceRegistrar, srv MetadataServiceServer) {
-	// If the following call panics, it indicates UnimplementedMetadataServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _MetadataService_DeleteSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSeriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&MetadataService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(MetadataServiceServer).DeleteSeries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MetadataService_DeleteSeries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MetadataServiceServer).DeleteSeries(ctx, req.(*DeleteSeriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MetadataService_GetVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVideoRequest)
+func _MetadataService_SetEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEpisodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MetadataServiceServer).GetVideo(ctx, in)
+		return srv.(MetadataServiceServer).SetEpisode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MetadataService_GetVideo_FullMethodName,
+		FullMethod: MetadataService_SetEpisode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).GetVideo(ctx, req.(*GetVideoRequest))
+		return srv.(MetadataServiceServer).SetEpisode(ctx, req.(*SetEpisodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MetadataService_ListVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListVideosRequest)
+func _MetadataService_RemoveEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEpisodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MetadataServiceServer).ListVideos(ctx, in)
+		return srv.(MetadataServiceServer).RemoveEpisode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MetadataService_ListVideos_FullMethodName,
+		FullMethod: MetadataService_RemoveEpisode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).ListVideos(ctx, req.(*ListVideosRequest))
+		return srv.(MetadataServiceServer).RemoveEpisode(ctx, req.(*RemoveEpisodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MetadataService_CreateVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateVideoRequest)
+func _MetadataService_GetNextEpisode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextEpisodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MetadataServiceServer).CreateVideo(ctx, in)
+		return srv.(MetadataServiceServer).GetNextEpisode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MetadataService_CreateVideo_FullMethodName,
+		FullMethod: MetadataService_GetNextEpisode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).CreateVideo(ctx, req.(*CreateVideoRequest))
+		return srv.(MetadataServiceServer).GetNextEpisode(ctx, req.(*GetNextEpisodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MetadataService_UpdateVideoStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateVideoStatusRequest)
+func _MetadataService_SetEpisodeCompleted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetEpisodeCompletedRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MetadataServiceServer).UpdateVideoStatus(ctx, in)
+		return srv.(MetadataServiceServer).SetEpisodeCompleted(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MetadataService_UpdateVideoStatus_FullMethodName,
+		FullMethod: MetadataService_SetEpisodeCompleted_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MetadataServiceServer).UpdateVideoStatus(ctx, req.(*UpdateVideoStatusRequest))
+		return srv.(MetadataServiceServer).SetEpisodeCompleted(ctx, req.(*SetEpisodeCompletedRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -226,10 +1962,202 @@ var MetadataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateVideo",
 			Handler:    _MetadataService_CreateVideo_Handler,
 		},
+		{
+			MethodName: "UpdateVideo",
+			Handler:    _MetadataService_UpdateVideo_Handler,
+		},
 		{
 			MethodName: "UpdateVideoStatus",
 			Handler:    _MetadataService_UpdateVideoStatus_Handler,
 		},
+		{
+			MethodName: "CreateStreamKey",
+			Handler:    _MetadataService_CreateStreamKey_Handler,
+		},
+		{
+			MethodName: "StartLiveStream",
+			Handler:    _MetadataService_StartLiveStream_Handler,
+		},
+		{
+			MethodName: "UpdateLiveStatus",
+			Handler:    _MetadataService_UpdateLiveStatus_Handler,
+		},
+		{
+			MethodName: "CompleteRecording",
+			Handler:    _MetadataService_CompleteRecording_Handler,
+		},
+		{
+			MethodName: "DeleteVideo",
+			Handler:    _MetadataService_DeleteVideo_Handler,
+		},
+		{
+			MethodName: "RestoreVideo",
+			Handler:    _MetadataService_RestoreVideo_Handler,
+		},
+		{
+			MethodName: "UnpublishVideo",
+			Handler:    _MetadataService_UnpublishVideo_Handler,
+		},
+		{
+			MethodName: "ListTrash",
+			Handler:    _MetadataService_ListTrash_Handler,
+		},
+		{
+			MethodName: "GrantVideoAccess",
+			Handler:    _MetadataService_GrantVideoAccess_Handler,
+		},
+		{
+			MethodName: "RevokeVideoAccess",
+			Handler:    _MetadataService_RevokeVideoAccess_Handler,
+		},
+		{
+			MethodName: "ListVideoAccess",
+			Handler:    _MetadataService_ListVideoAccess_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _MetadataService_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _MetadataService_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _MetadataService_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "ResolveShareLink",
+			Handler:    _MetadataService_ResolveShareLink_Handler,
+		},
+		{
+			MethodName: "ListStorageCleanups",
+			Handler:    _MetadataService_ListStorageCleanups_Handler,
+		},
+		{
+			MethodName: "CompleteStorageCleanup",
+			Handler:    _MetadataService_CompleteStorageCleanup_Handler,
+		},
+		{
+			MethodName: "CreateChannel",
+			Handler:    _MetadataService_CreateChannel_Handler,
+		},
+		{
+			MethodName: "GetChannel",
+			Handler:    _MetadataService_GetChannel_Handler,
+		},
+		{
+			MethodName: "UpdateChannel",
+			Handler:    _MetadataService_UpdateChannel_Handler,
+		},
+		{
+			MethodName: "DeleteChannel",
+			Handler:    _MetadataService_DeleteChannel_Handler,
+		},
+		{
+			MethodName: "AddChannelMember",
+			Handler:    _MetadataService_AddChannelMember_Handler,
+		},
+		{
+			MethodName: "RemoveChannelMember",
+			Handler:    _MetadataService_RemoveChannelMember_Handler,
+		},
+		{
+			MethodName: "SetChannelImage",
+			Handler:    _MetadataService_SetChannelImage_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _MetadataService_Subscribe_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _MetadataService_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _MetadataService_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetSubscriptionFeed",
+			Handler:    _MetadataService_GetSubscriptionFeed_Handler,
+		},
+		{
+			MethodName: "CreatePlaylist",
+			Handler:    _MetadataService_CreatePlaylist_Handler,
+		},
+		{
+			MethodName: "GetPlaylist",
+			Handler:    _MetadataService_GetPlaylist_Handler,
+		},
+		{
+			MethodName: "ListPlaylists",
+			Handler:    _MetadataService_ListPlaylists_Handler,
+		},
+		{
+			MethodName: "UpdatePlaylist",
+			Handler:    _MetadataService_UpdatePlaylist_Handler,
+		},
+		{
+			MethodName: "DeletePlaylist",
+			Handler:    _MetadataService_DeletePlaylist_Handler,
+		},
+		{
+			MethodName: "AddPlaylistItem",
+			Handler:    _MetadataService_AddPlaylistItem_Handler,
+		},
+		{
+			MethodName: "RemovePlaylistItem",
+			Handler:    _MetadataService_RemovePlaylistItem_Handler,
+		},
+		{
+			MethodName: "MovePlaylistItem",
+			Handler:    _MetadataService_MovePlaylistItem_Handler,
+		},
+		{
+			MethodName: "AddPlaylistCollaborator",
+			Handler:    _MetadataService_AddPlaylistCollaborator_Handler,
+		},
+		{
+			MethodName: "RemovePlaylistCollaborator",
+			Handler:    _MetadataService_RemovePlaylistCollaborator_Handler,
+		},
+		{
+			MethodName: "CreateSeries",
+			Handler:    _MetadataService_CreateSeries_Handler,
+		},
+		{
+			MethodName: "GetSeries",
+			Handler:    _MetadataService_GetSeries_Handler,
+		},
+		{
+			MethodName: "ListSeries",
+			Handler:    _MetadataService_ListSeries_Handler,
+		},
+		{
+			MethodName: "UpdateSeries",
+			Handler:    _MetadataService_UpdateSeries_Handler,
+		},
+		{
+			MethodName: "DeleteSeries",
+			Handler:    _MetadataService_DeleteSeries_Handler,
+		},
+		{
+			MethodName: "SetEpisode",
+			Handler:    _MetadataService_SetEpisode_Handler,
+		},
+		{
+			MethodName: "RemoveEpisode",
+			Handler:    _MetadataService_RemoveEpisode_Handler,
+		},
+		{
+			MethodName: "GetNextEpisode",
+			Handler:    _MetadataService_GetNextEpisode_Handler,
+		},
+		{
+			MethodName: "SetEpisodeCompleted",
+			Handler:    _MetadataService_SetEpisodeCompleted_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/metadata/metadata.proto",
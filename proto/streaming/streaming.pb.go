@@ -22,8 +22,15 @@ const (
 )
 
 type GetStreamURLRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	VideoId   string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Rendition string                 `protobuf:"bytes,2,opt,name=rendition,proto3" json:"rendition,omitempty"`         // empty for the source object
+	Region    string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`               // client region hint used to pick a delivery host
+	UserId    string                 `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // viewer; private videos need the owner or a user granted access
+	// share_token plays the video of a share link instead, whatever its visibility, and counts
+	// a view against the link. video_id and user_id are then ignored.
+	ShareToken    string `protobuf:"bytes,5,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
+	SharePassword string `protobuf:"bytes,6,opt,name=share_password,json=sharePassword,proto3" json:"share_password,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,9 +72,46 @@ func (x *GetStreamURLRequest) GetVideoId() string {
 	return ""
 }
 
+func (x *GetStreamURLRequest) GetRendition() string {
+	if x != nil {
+		return x.Rendition
+	}
+	return ""
+}
+
+func (x *GetStreamURLRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetStreamURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetStreamURLRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
+func (x *GetStreamURLRequest) GetSharePassword() string {
+	if x != nil {
+		return x.SharePassword
+	}
+	return ""
+}
+
 type GetStreamURLResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	VideoId       string                 `protobuf:"bytes,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	NextEpisodeId string                 `protobuf:"bytes,3,opt,name=next_episode_id,json=nextEpisodeId,proto3" json:"next_episode_id,omitempty"` // episode to autoplay next; empty outside a series and for share links
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -109,17 +153,718 @@ func (x *GetStreamURLResponse) GetUrl() string {
 	return ""
 }
 
+func (x *GetStreamURLResponse) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetStreamURLResponse) GetNextEpisodeId() string {
+	if x != nil {
+		return x.NextEpisodeId
+	}
+	return ""
+}
+
+type InvalidateStreamURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateStreamURLRequest) Reset() {
+	*x = InvalidateStreamURLRequest{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateStreamURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateStreamURLRequest) ProtoMessage() {}
+
+func (x *InvalidateStreamURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateStreamURLRequest.ProtoReflect.Descriptor instead.
+func (*InvalidateStreamURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InvalidateStreamURLRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+type InvalidateStreamURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InvalidateStreamURLResponse) Reset() {
+	*x = InvalidateStreamURLResponse{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InvalidateStreamURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvalidateStreamURLResponse) ProtoMessage() {}
+
+func (x *InvalidateStreamURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvalidateStreamURLResponse.ProtoReflect.Descriptor instead.
+func (*InvalidateStreamURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *InvalidateStreamURLResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetDownloadURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // caller, checked against the video owner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadURLRequest) Reset() {
+	*x = GetDownloadURLRequest{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadURLRequest) ProtoMessage() {}
+
+func (x *GetDownloadURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadURLRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDownloadURLRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetDownloadURLRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetDownloadURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadURLResponse) Reset() {
+	*x = GetDownloadURLResponse{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadURLResponse) ProtoMessage() {}
+
+func (x *GetDownloadURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadURLResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDownloadURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type GetVideoMediaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // viewer; private videos need the owner or a user granted access
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoMediaRequest) Reset() {
+	*x = GetVideoMediaRequest{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoMediaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoMediaRequest) ProtoMessage() {}
+
+func (x *GetVideoMediaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoMediaRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoMediaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetVideoMediaRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetVideoMediaRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetVideoMediaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ThumbnailUrl  string                 `protobuf:"bytes,1,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"` // empty when the video has no thumbnail
+	Renditions    []string               `protobuf:"bytes,2,rep,name=renditions,proto3" json:"renditions,omitempty"`                         // e.g. 720p, from lowest to highest
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVideoMediaResponse) Reset() {
+	*x = GetVideoMediaResponse{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVideoMediaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoMediaResponse) ProtoMessage() {}
+
+func (x *GetVideoMediaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoMediaResponse.ProtoReflect.Descriptor instead.
+func (*GetVideoMediaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetVideoMediaResponse) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *GetVideoMediaResponse) GetRenditions() []string {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
+}
+
+type DeliveryHost struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Host          string                 `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Region        string                 `protobuf:"bytes,3,opt,name=region,proto3" json:"region,omitempty"`
+	Weight        int32                  `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	Healthy       bool                   `protobuf:"varint,5,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryHost) Reset() {
+	*x = DeliveryHost{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryHost) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryHost) ProtoMessage() {}
+
+func (x *DeliveryHost) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryHost.ProtoReflect.Descriptor instead.
+func (*DeliveryHost) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DeliveryHost) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeliveryHost) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *DeliveryHost) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DeliveryHost) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *DeliveryHost) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+type ListDeliveryHostsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveryHostsRequest) Reset() {
+	*x = ListDeliveryHostsRequest{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveryHostsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveryHostsRequest) ProtoMessage() {}
+
+func (x *ListDeliveryHostsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveryHostsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveryHostsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{9}
+}
+
+type ListDeliveryHostsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hosts         []*DeliveryHost        `protobuf:"bytes,1,rep,name=hosts,proto3" json:"hosts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveryHostsResponse) Reset() {
+	*x = ListDeliveryHostsResponse{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveryHostsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveryHostsResponse) ProtoMessage() {}
+
+func (x *ListDeliveryHostsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveryHostsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeliveryHostsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListDeliveryHostsResponse) GetHosts() []*DeliveryHost {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+type SetDeliveryHostStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Healthy       bool                   `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDeliveryHostStatusRequest) Reset() {
+	*x = SetDeliveryHostStatusRequest{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeliveryHostStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeliveryHostStatusRequest) ProtoMessage() {}
+
+func (x *SetDeliveryHostStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeliveryHostStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetDeliveryHostStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *SetDeliveryHostStatusRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetDeliveryHostStatusRequest) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+type SetDeliveryHostStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDeliveryHostStatusResponse) Reset() {
+	*x = SetDeliveryHostStatusResponse{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDeliveryHostStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDeliveryHostStatusResponse) ProtoMessage() {}
+
+func (x *SetDeliveryHostStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDeliveryHostStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetDeliveryHostStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SetDeliveryHostStatusResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetChannelImageURLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"` // avatar or banner
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelImageURLRequest) Reset() {
+	*x = GetChannelImageURLRequest{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelImageURLRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelImageURLRequest) ProtoMessage() {}
+
+func (x *GetChannelImageURLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelImageURLRequest.ProtoReflect.Descriptor instead.
+func (*GetChannelImageURLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetChannelImageURLRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetChannelImageURLRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+type GetChannelImageURLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetChannelImageURLResponse) Reset() {
+	*x = GetChannelImageURLResponse{}
+	mi := &file_proto_streaming_streaming_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetChannelImageURLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChannelImageURLResponse) ProtoMessage() {}
+
+func (x *GetChannelImageURLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_streaming_streaming_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChannelImageURLResponse.ProtoReflect.Descriptor instead.
+func (*GetChannelImageURLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_streaming_streaming_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetChannelImageURLResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
 var File_proto_streaming_streaming_proto protoreflect.FileDescriptor
 
 const file_proto_streaming_streaming_proto_rawDesc = "" +
 	"\n" +
-	"\x1fproto/streaming/streaming.proto\x12\tstreaming\"0\n" +
+	"\x1fproto/streaming/streaming.proto\x12\tstreaming\"\xc7\x01\n" +
 	"\x13GetStreamURLRequest\x12\x19\n" +
-	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"(\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x1c\n" +
+	"\trendition\x18\x02 \x01(\tR\trendition\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\tR\x06userId\x12\x1f\n" +
+	"\vshare_token\x18\x05 \x01(\tR\n" +
+	"shareToken\x12%\n" +
+	"\x0eshare_password\x18\x06 \x01(\tR\rsharePassword\"k\n" +
 	"\x14GetStreamURLResponse\x12\x10\n" +
-	"\x03url\x18\x01 \x01(\tR\x03url2c\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\tR\avideoId\x12&\n" +
+	"\x0fnext_episode_id\x18\x03 \x01(\tR\rnextEpisodeId\"7\n" +
+	"\x1aInvalidateStreamURLRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"5\n" +
+	"\x1bInvalidateStreamURLResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"K\n" +
+	"\x15GetDownloadURLRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"*\n" +
+	"\x16GetDownloadURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\"J\n" +
+	"\x14GetVideoMediaRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"\\\n" +
+	"\x15GetVideoMediaResponse\x12#\n" +
+	"\rthumbnail_url\x18\x01 \x01(\tR\fthumbnailUrl\x12\x1e\n" +
+	"\n" +
+	"renditions\x18\x02 \x03(\tR\n" +
+	"renditions\"\x80\x01\n" +
+	"\fDeliveryHost\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04host\x18\x02 \x01(\tR\x04host\x12\x16\n" +
+	"\x06region\x18\x03 \x01(\tR\x06region\x12\x16\n" +
+	"\x06weight\x18\x04 \x01(\x05R\x06weight\x12\x18\n" +
+	"\ahealthy\x18\x05 \x01(\bR\ahealthy\"\x1a\n" +
+	"\x18ListDeliveryHostsRequest\"J\n" +
+	"\x19ListDeliveryHostsResponse\x12-\n" +
+	"\x05hosts\x18\x01 \x03(\v2\x17.streaming.DeliveryHostR\x05hosts\"L\n" +
+	"\x1cSetDeliveryHostStatusRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\ahealthy\x18\x02 \x01(\bR\ahealthy\"7\n" +
+	"\x1dSetDeliveryHostStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"N\n" +
+	"\x19GetChannelImageURLRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\".\n" +
+	"\x1aGetChannelImageURLResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url2\xa3\x05\n" +
 	"\x10StreamingService\x12O\n" +
-	"\fGetStreamURL\x12\x1e.streaming.GetStreamURLRequest\x1a\x1f.streaming.GetStreamURLResponseB.Z,github.com/athandoan/youtube/proto/streamingb\x06proto3"
+	"\fGetStreamURL\x12\x1e.streaming.GetStreamURLRequest\x1a\x1f.streaming.GetStreamURLResponse\x12d\n" +
+	"\x13InvalidateStreamURL\x12%.streaming.InvalidateStreamURLRequest\x1a&.streaming.InvalidateStreamURLResponse\x12U\n" +
+	"\x0eGetDownloadURL\x12 .streaming.GetDownloadURLRequest\x1a!.streaming.GetDownloadURLResponse\x12R\n" +
+	"\rGetVideoMedia\x12\x1f.streaming.GetVideoMediaRequest\x1a .streaming.GetVideoMediaResponse\x12^\n" +
+	"\x11ListDeliveryHosts\x12#.streaming.ListDeliveryHostsRequest\x1a$.streaming.ListDeliveryHostsResponse\x12j\n" +
+	"\x15SetDeliveryHostStatus\x12'.streaming.SetDeliveryHostStatusRequest\x1a(.streaming.SetDeliveryHostStatusResponse\x12a\n" +
+	"\x12GetChannelImageURL\x12$.streaming.GetChannelImageURLRequest\x1a%.streaming.GetChannelImageURLResponseB.Z,github.com/athandoan/youtube/proto/streamingb\x06proto3"
 
 var (
 	file_proto_streaming_streaming_proto_rawDescOnce sync.Once
@@ -133,19 +878,45 @@ func file_proto_streaming_streaming_proto_rawDescGZIP() []byte {
 	return file_proto_streaming_streaming_proto_rawDescData
 }
 
-var file_proto_streaming_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_proto_streaming_streaming_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_proto_streaming_streaming_proto_goTypes = []any{
-	(*GetStreamURLRequest)(nil),  // 0: streaming.GetStreamURLRequest
-	(*GetStreamURLResponse)(nil), // 1: streaming.GetStreamURLResponse
+	(*GetStreamURLRequest)(nil),           // 0: streaming.GetStreamURLRequest
+	(*GetStreamURLResponse)(nil),          // 1: streaming.GetStreamURLResponse
+	(*InvalidateStreamURLRequest)(nil),    // 2: streaming.InvalidateStreamURLRequest
+	(*InvalidateStreamURLResponse)(nil),   // 3: streaming.InvalidateStreamURLResponse
+	(*GetDownloadURLRequest)(nil),         // 4: streaming.GetDownloadURLRequest
+	(*GetDownloadURLResponse)(nil),        // 5: streaming.GetDownloadURLResponse
+	(*GetVideoMediaRequest)(nil),          // 6: streaming.GetVideoMediaRequest
+	(*GetVideoMediaResponse)(nil),         // 7: streaming.GetVideoMediaResponse
+	(*DeliveryHost)(nil),                  // 8: streaming.DeliveryHost
+	(*ListDeliveryHostsRequest)(nil),      // 9: streaming.ListDeliveryHostsRequest
+	(*ListDeliveryHostsResponse)(nil),     // 10: streaming.ListDeliveryHostsResponse
+	(*SetDeliveryHostStatusRequest)(nil),  // 11: streaming.SetDeliveryHostStatusRequest
+	(*SetDeliveryHostStatusResponse)(nil), // 12: streaming.SetDeliveryHostStatusResponse
+	(*GetChannelImageURLRequest)(nil),     // 13: streaming.GetChannelImageURLRequest
+	(*GetChannelImageURLResponse)(nil),    // 14: streaming.GetChannelImageURLResponse
 }
 var file_proto_streaming_streaming_proto_depIdxs = []int32{
-	0, // 0: streaming.StreamingService.GetStreamURL:input_type -> streaming.GetStreamURLRequest
-	1, // 1: streaming.StreamingService.GetStreamURL:output_type -> streaming.GetStreamURLResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	8,  // 0: streaming.ListDeliveryHostsResponse.hosts:type_name -> streaming.DeliveryHost
+	0,  // 1: streaming.StreamingService.GetStreamURL:input_type -> streaming.GetStreamURLRequest
+	2,  // 2: streaming.StreamingService.InvalidateStreamURL:input_type -> streaming.InvalidateStreamURLRequest
+	4,  // 3: streaming.StreamingService.GetDownloadURL:input_type -> streaming.GetDownloadURLRequest
+	6,  // 4: streaming.StreamingService.GetVideoMedia:input_type -> streaming.GetVideoMediaRequest
+	9,  // 5: streaming.StreamingService.ListDeliveryHosts:input_type -> streaming.ListDeliveryHostsRequest
+	11, // 6: streaming.StreamingService.SetDeliveryHostStatus:input_type -> streaming.SetDeliveryHostStatusRequest
+	13, // 7: streaming.StreamingService.GetChannelImageURL:input_type -> streaming.GetChannelImageURLRequest
+	1,  // 8: streaming.StreamingService.GetStreamURL:output_type -> streaming.GetStreamURLResponse
+	3,  // 9: streaming.StreamingService.InvalidateStreamURL:output_type -> streaming.InvalidateStreamURLResponse
+	5,  // 10: streaming.StreamingService.GetDownloadURL:output_type -> streaming.GetDownloadURLResponse
+	7,  // 11: streaming.StreamingService.GetVideoMedia:output_type -> streaming.GetVideoMediaResponse
+	10, // 12: streaming.StreamingService.ListDeliveryHosts:output_type -> streaming.ListDeliveryHostsResponse
+	12, // 13: streaming.StreamingService.SetDeliveryHostStatus:output_type -> streaming.SetDeliveryHostStatusResponse
+	14, // 14: streaming.StreamingService.GetChannelImageURL:output_type -> streaming.GetChannelImageURLResponse
+	8,  // [8:15] is the sub-list for method output_type
+	1,  // [1:8] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_proto_streaming_streaming_proto_init() }
@@ -159,7 +930,7 @@ func file_proto_streaming_streaming_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_streaming_streaming_proto_rawDesc), len(file_proto_streaming_streaming_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,34 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	StreamingService_GetStreamURL_FullMethodName = "/streaming.StreamingService/GetStreamURL"
+	StreamingService_GetStreamURL_FullMethodName          = "/streaming.StreamingService/GetStreamURL"
+	StreamingService_InvalidateStreamURL_FullMethodName   = "/streaming.StreamingService/InvalidateStreamURL"
+	StreamingService_GetDownloadURL_FullMethodName        = "/streaming.StreamingService/GetDownloadURL"
+	StreamingService_GetVideoMedia_FullMethodName         = "/streaming.StreamingService/GetVideoMedia"
+	StreamingService_ListDeliveryHosts_FullMethodName     = "/streaming.StreamingService/ListDeliveryHosts"
+	StreamingService_SetDeliveryHostStatus_FullMethodName = "/streaming.StreamingService/SetDeliveryHostStatus"
+	StreamingService_GetChannelImageURL_FullMethodName    = "/streaming.StreamingService/GetChannelImageURL"
 )
 
 // StreamingServiceClient is the client API for StreamingService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Callers are identified by gRPC metadata like for the metadata service: x-user-id and
+// x-user-roles from the gateway, x-service from other services. Requests naming a user_id
+// must name the caller.
 type StreamingServiceClient interface {
 	GetStreamURL(ctx context.Context, in *GetStreamURLRequest, opts ...grpc.CallOption) (*GetStreamURLResponse, error)
+	InvalidateStreamURL(ctx context.Context, in *InvalidateStreamURLRequest, opts ...grpc.CallOption) (*InvalidateStreamURLResponse, error)
+	GetDownloadURL(ctx context.Context, in *GetDownloadURLRequest, opts ...grpc.CallOption) (*GetDownloadURLResponse, error)
+	// GetVideoMedia lists what is stored next to a video's source: a URL to its thumbnail and
+	// the renditions players may ask GetStreamURL for.
+	GetVideoMedia(ctx context.Context, in *GetVideoMediaRequest, opts ...grpc.CallOption) (*GetVideoMediaResponse, error)
+	// ListDeliveryHosts and SetDeliveryHostStatus are for admins and other services.
+	ListDeliveryHosts(ctx context.Context, in *ListDeliveryHostsRequest, opts ...grpc.CallOption) (*ListDeliveryHostsResponse, error)
+	SetDeliveryHostStatus(ctx context.Context, in *SetDeliveryHostStatusRequest, opts ...grpc.CallOption) (*SetDeliveryHostStatusResponse, error)
+	// GetChannelImageURL returns a URL to a channel's avatar or banner.
+	GetChannelImageURL(ctx context.Context, in *GetChannelImageURLRequest, opts ...grpc.CallOption) (*GetChannelImageURLResponse, error)
 }
 
 type streamingServiceClient struct {
@@ -47,11 +67,85 @@ func (c *streamingServiceClient) GetStreamURL(ctx context.Context, in *GetStream
 	return out, nil
 }
 
+func (c *streamingServiceClient) InvalidateStreamURL(ctx context.Context, in *InvalidateStreamURLRequest, opts ...grpc.CallOption) (*InvalidateStreamURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InvalidateStreamURLResponse)
+	err := c.cc.Invoke(ctx, StreamingService_InvalidateStreamURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetDownloadURL(ctx context.Context, in *GetDownloadURLRequest, opts ...grpc.CallOption) (*GetDownloadURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDownloadURLResponse)
+	err := c.cc.Invoke(ctx, StreamingService_GetDownloadURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetVideoMedia(ctx context.Context, in *GetVideoMediaRequest, opts ...grpc.CallOption) (*GetVideoMediaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVideoMediaResponse)
+	err := c.cc.Invoke(ctx, StreamingService_GetVideoMedia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) ListDeliveryHosts(ctx context.Context, in *ListDeliveryHostsRequest, opts ...grpc.CallOption) (*ListDeliveryHostsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeliveryHostsResponse)
+	err := c.cc.Invoke(ctx, StreamingService_ListDeliveryHosts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) SetDeliveryHostStatus(ctx context.Context, in *SetDeliveryHostStatusRequest, opts ...grpc.CallOption) (*SetDeliveryHostStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetDeliveryHostStatusResponse)
+	err := c.cc.Invoke(ctx, StreamingService_SetDeliveryHostStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *streamingServiceClient) GetChannelImageURL(ctx context.Context, in *GetChannelImageURLRequest, opts ...grpc.CallOption) (*GetChannelImageURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetChannelImageURLResponse)
+	err := c.cc.Invoke(ctx, StreamingService_GetChannelImageURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StreamingServiceServer is the server API for StreamingService service.
 // All implementations must embed UnimplementedStreamingServiceServer
 // for forward compatibility.
+//
+// Callers are identified by gRPC metadata like for the metadata service: x-user-id and
+// x-user-roles from the gateway, x-service from other services. Requests naming a user_id
+// must name the caller.
 type StreamingServiceServer interface {
 	GetStreamURL(context.Context, *GetStreamURLRequest) (*GetStreamURLResponse, error)
+	InvalidateStreamURL(context.Context, *InvalidateStreamURLRequest) (*InvalidateStreamURLResponse, error)
+	GetDownloadURL(context.Context, *GetDownloadURLRequest) (*GetDownloadURLResponse, error)
+	// GetVideoMedia lists what is stored next to a video's source: a URL to its thumbnail and
+	// the renditions players may ask GetStreamURL for.
+	GetVideoMedia(context.Context, *GetVideoMediaRequest) (*GetVideoMediaResponse, error)
+	// ListDeliveryHosts and SetDeliveryHostStatus are for admins and other services.
+	ListDeliveryHosts(context.Context, *ListDeliveryHostsRequest) (*ListDeliveryHostsResponse, error)
+	SetDeliveryHostStatus(context.Context, *SetDeliveryHostStatusRequest) (*SetDeliveryHostStatusResponse, error)
+	// GetChannelImageURL returns a URL to a channel's avatar or banner.
+	GetChannelImageURL(context.Context, *GetChannelImageURLRequest) (*GetChannelImageURLResponse, error)
 	mustEmbedUnimplementedStreamingServiceServer()
 }
 
@@ -65,6 +159,24 @@ type UnimplementedStreamingServiceServer struct{}
 func (UnimplementedStreamingServiceServer) GetStreamURL(context.Context, *GetStreamURLRequest) (*GetStreamURLResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetStreamURL not implemented")
 }
+func (UnimplementedStreamingServiceServer) InvalidateStreamURL(context.Context, *InvalidateStreamURLRequest) (*InvalidateStreamURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InvalidateStreamURL not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetDownloadURL(context.Context, *GetDownloadURLRequest) (*GetDownloadURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetDownloadURL not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetVideoMedia(context.Context, *GetVideoMediaRequest) (*GetVideoMediaResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVideoMedia not implemented")
+}
+func (UnimplementedStreamingServiceServer) ListDeliveryHosts(context.Context, *ListDeliveryHostsRequest) (*ListDeliveryHostsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeliveryHosts not implemented")
+}
+func (UnimplementedStreamingServiceServer) SetDeliveryHostStatus(context.Context, *SetDeliveryHostStatusRequest) (*SetDeliveryHostStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetDeliveryHostStatus not implemented")
+}
+func (UnimplementedStreamingServiceServer) GetChannelImageURL(context.Context, *GetChannelImageURLRequest) (*GetChannelImageURLResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetChannelImageURL not implemented")
+}
 func (UnimplementedStreamingServiceServer) mustEmbedUnimplementedStreamingServiceServer() {}
 func (UnimplementedStreamingServiceServer) testEmbeddedByValue()                          {}
 
@@ -104,6 +216,114 @@ func _StreamingService_GetStreamURL_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StreamingService_InvalidateStreamURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateStreamURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).InvalidateStreamURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_InvalidateStreamURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).InvalidateStreamURL(ctx, req.(*InvalidateStreamURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetDownloadURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDownloadURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetDownloadURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetDownloadURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetDownloadURL(ctx, req.(*GetDownloadURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetVideoMedia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVideoMediaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetVideoMedia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetVideoMedia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetVideoMedia(ctx, req.(*GetVideoMediaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_ListDeliveryHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveryHostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).ListDeliveryHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_ListDeliveryHosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).ListDeliveryHosts(ctx, req.(*ListDeliveryHostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_SetDeliveryHostStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDeliveryHostStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).SetDeliveryHostStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_SetDeliveryHostStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).SetDeliveryHostStatus(ctx, req.(*SetDeliveryHostStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StreamingService_GetChannelImageURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChannelImageURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StreamingServiceServer).GetChannelImageURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StreamingService_GetChannelImageURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StreamingServiceServer).GetChannelImageURL(ctx, req.(*GetChannelImageURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // StreamingService_ServiceDesc is the grpc.ServiceDesc for StreamingService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +335,30 @@ var StreamingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetStreamURL",
 			Handler:    _StreamingService_GetStreamURL_Handler,
 		},
+		{
+			MethodName: "InvalidateStreamURL",
+			Handler:    _StreamingService_InvalidateStreamURL_Handler,
+		},
+		{
+			MethodName: "GetDownloadURL",
+			Handler:    _StreamingService_GetDownloadURL_Handler,
+		},
+		{
+			MethodName: "GetVideoMedia",
+			Handler:    _StreamingService_GetVideoMedia_Handler,
+		},
+		{
+			MethodName: "ListDeliveryHosts",
+			Handler:    _StreamingService_ListDeliveryHosts_Handler,
+		},
+		{
+			MethodName: "SetDeliveryHostStatus",
+			Handler:    _StreamingService_SetDeliveryHostStatus_Handler,
+		},
+		{
+			MethodName: "GetChannelImageURL",
+			Handler:    _StreamingService_GetChannelImageURL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/streaming/streaming.proto",
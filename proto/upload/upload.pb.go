@@ -25,6 +25,10 @@ type InitUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AllowDownload bool                   `protobuf:"varint,3,opt,name=allow_download,json=allowDownload,proto3" json:"allow_download,omitempty"`
+	PublishAt     string                 `protobuf:"bytes,4,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"` // RFC 3339; the video stays unlisted until then
+	Premiere      bool                   `protobuf:"varint,5,opt,name=premiere,proto3" json:"premiere,omitempty"`                   // requires publish_at
+	ChannelId     string                 `protobuf:"bytes,6,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"` // optional; the uploader must own the channel or be one of its members
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -73,6 +77,34 @@ func (x *InitUploadRequest) GetTitle() string {
 	return ""
 }
 
+func (x *InitUploadRequest) GetAllowDownload() bool {
+	if x != nil {
+		return x.AllowDownload
+	}
+	return false
+}
+
+func (x *InitUploadRequest) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+func (x *InitUploadRequest) GetPremiere() bool {
+	if x != nil {
+		return x.Premiere
+	}
+	return false
+}
+
+func (x *InitUploadRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
 type InitUploadResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
@@ -127,7 +159,7 @@ func (x *InitUploadResponse) GetPresignedUrl() string {
 
 type CompleteUploadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	VideoId       string                 `protobuf:"bytes,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"` // the caller must own the video or be an admin
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -213,25 +245,143 @@ func (x *CompleteUploadResponse) GetStatus() string {
 	return ""
 }
 
+type InitChannelImageUploadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChannelId     string                 `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`         // avatar or banner
+	Filename      string                 `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"` // its extension gives the image type: .jpg, .jpeg, .png or .webp
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitChannelImageUploadRequest) Reset() {
+	*x = InitChannelImageUploadRequest{}
+	mi := &file_proto_upload_upload_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitChannelImageUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitChannelImageUploadRequest) ProtoMessage() {}
+
+func (x *InitChannelImageUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_upload_upload_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitChannelImageUploadRequest.ProtoReflect.Descriptor instead.
+func (*InitChannelImageUploadRequest) Descriptor() ([]byte, []int) {
+	return file_proto_upload_upload_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *InitChannelImageUploadRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *InitChannelImageUploadRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *InitChannelImageUploadRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+type InitChannelImageUploadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PresignedUrl  string                 `protobuf:"bytes,1,opt,name=presigned_url,json=presignedUrl,proto3" json:"presigned_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InitChannelImageUploadResponse) Reset() {
+	*x = InitChannelImageUploadResponse{}
+	mi := &file_proto_upload_upload_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InitChannelImageUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitChannelImageUploadResponse) ProtoMessage() {}
+
+func (x *InitChannelImageUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_upload_upload_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitChannelImageUploadResponse.ProtoReflect.Descriptor instead.
+func (*InitChannelImageUploadResponse) Descriptor() ([]byte, []int) {
+	return file_proto_upload_upload_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *InitChannelImageUploadResponse) GetPresignedUrl() string {
+	if x != nil {
+		return x.PresignedUrl
+	}
+	return ""
+}
+
 var File_proto_upload_upload_proto protoreflect.FileDescriptor
 
 const file_proto_upload_upload_proto_rawDesc = "" +
 	"\n" +
-	"\x19proto/upload/upload.proto\x12\x06upload\"E\n" +
+	"\x19proto/upload/upload.proto\x12\x06upload\"\xc6\x01\n" +
 	"\x11InitUploadRequest\x12\x1a\n" +
 	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\"T\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12%\n" +
+	"\x0eallow_download\x18\x03 \x01(\bR\rallowDownload\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18\x04 \x01(\tR\tpublishAt\x12\x1a\n" +
+	"\bpremiere\x18\x05 \x01(\bR\bpremiere\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x06 \x01(\tR\tchannelId\"T\n" +
 	"\x12InitUploadResponse\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\x12#\n" +
 	"\rpresigned_url\x18\x02 \x01(\tR\fpresignedUrl\"2\n" +
 	"\x15CompleteUploadRequest\x12\x19\n" +
 	"\bvideo_id\x18\x01 \x01(\tR\avideoId\"0\n" +
 	"\x16CompleteUploadResponse\x12\x16\n" +
-	"\x06status\x18\x01 \x01(\tR\x06status2\xa5\x01\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"n\n" +
+	"\x1dInitChannelImageUploadRequest\x12\x1d\n" +
+	"\n" +
+	"channel_id\x18\x01 \x01(\tR\tchannelId\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\"E\n" +
+	"\x1eInitChannelImageUploadResponse\x12#\n" +
+	"\rpresigned_url\x18\x01 \x01(\tR\fpresignedUrl2\x8e\x02\n" +
 	"\rUploadService\x12C\n" +
 	"\n" +
 	"InitUpload\x12\x19.upload.InitUploadRequest\x1a\x1a.upload.InitUploadResponse\x12O\n" +
-	"\x0eCompleteUpload\x12\x1d.upload.CompleteUploadRequest\x1a\x1e.upload.CompleteUploadResponseB+Z)github.com/athandoan/youtube/proto/uploadb\x06proto3"
+	"\x0eCompleteUpload\x12\x1d.upload.CompleteUploadRequest\x1a\x1e.upload.CompleteUploadResponse\x12g\n" +
+	"\x16InitChannelImageUpload\x12%.upload.InitChannelImageUploadRequest\x1a&.upload.InitChannelImageUploadResponseB+Z)github.com/athandoan/youtube/proto/uploadb\x06proto3"
 
 var (
 	file_proto_upload_upload_proto_rawDescOnce sync.Once
@@ -245,20 +395,24 @@ func file_proto_upload_upload_proto_rawDescGZIP() []byte {
 	return file_proto_upload_upload_proto_rawDescData
 }
 
-var file_proto_upload_upload_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_proto_upload_upload_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_proto_upload_upload_proto_goTypes = []any{
-	(*InitUploadRequest)(nil),      // 0: upload.InitUploadRequest
-	(*InitUploadResponse)(nil),     // 1: upload.InitUploadResponse
-	(*CompleteUploadRequest)(nil),  // 2: upload.CompleteUploadRequest
-	(*CompleteUploadResponse)(nil), // 3: upload.CompleteUploadResponse
+	(*InitUploadRequest)(nil),              // 0: upload.InitUploadRequest
+	(*InitUploadResponse)(nil),             // 1: upload.InitUploadResponse
+	(*CompleteUploadRequest)(nil),          // 2: upload.CompleteUploadRequest
+	(*CompleteUploadResponse)(nil),         // 3: upload.CompleteUploadResponse
+	(*InitChannelImageUploadRequest)(nil),  // 4: upload.InitChannelImageUploadRequest
+	(*InitChannelImageUploadResponse)(nil), // 5: upload.InitChannelImageUploadResponse
 }
 var file_proto_upload_upload_proto_depIdxs = []int32{
 	0, // 0: upload.UploadService.InitUpload:input_type -> upload.InitUploadRequest
 	2, // 1: upload.UploadService.CompleteUpload:input_type -> upload.CompleteUploadRequest
-	1, // 2: upload.UploadService.InitUpload:output_type -> upload.InitUploadResponse
-	3, // 3: upload.UploadService.CompleteUpload:output_type -> upload.CompleteUploadResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	4, // 2: upload.UploadService.InitChannelImageUpload:input_type -> upload.InitChannelImageUploadRequest
+	1, // 3: upload.UploadService.InitUpload:output_type -> upload.InitUploadResponse
+	3, // 4: upload.UploadService.CompleteUpload:output_type -> upload.CompleteUploadResponse
+	5, // 5: upload.UploadService.InitChannelImageUpload:output_type -> upload.InitChannelImageUploadResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -275,7 +429,7 @@ func file_proto_upload_upload_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_upload_upload_proto_rawDesc), len(file_proto_upload_upload_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,16 +19,23 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UploadService_InitUpload_FullMethodName     = "/upload.UploadService/InitUpload"
-	UploadService_CompleteUpload_FullMethodName = "/upload.UploadService/CompleteUpload"
+	UploadService_InitUpload_FullMethodName             = "/upload.UploadService/InitUpload"
+	UploadService_CompleteUpload_FullMethodName         = "/upload.UploadService/CompleteUpload"
+	UploadService_InitChannelImageUpload_FullMethodName = "/upload.UploadService/InitChannelImageUpload"
 )
 
 // UploadServiceClient is the client API for UploadService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Uploads are made by signed-in users, identified by the x-user-id and x-user-roles gRPC
+// metadata the gateway sends. The uploader owns the video.
 type UploadServiceClient interface {
 	InitUpload(ctx context.Context, in *InitUploadRequest, opts ...grpc.CallOption) (*InitUploadResponse, error)
 	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadResponse, error)
+	// InitChannelImageUpload returns a URL to PUT a channel's new avatar or banner to, which
+	// replaces the old one right away. Only the channel's owner and admins can change them.
+	InitChannelImageUpload(ctx context.Context, in *InitChannelImageUploadRequest, opts ...grpc.CallOption) (*InitChannelImageUploadResponse, error)
 }
 
 type uploadServiceClient struct {
@@ -59,12 +66,28 @@ func (c *uploadServiceClient) CompleteUpload(ctx context.Context, in *CompleteUp
 	return out, nil
 }
 
+func (c *uploadServiceClient) InitChannelImageUpload(ctx context.Context, in *InitChannelImageUploadRequest, opts ...grpc.CallOption) (*InitChannelImageUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InitChannelImageUploadResponse)
+	err := c.cc.Invoke(ctx, UploadService_InitChannelImageUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UploadServiceServer is the server API for UploadService service.
 // All implementations must embed UnimplementedUploadServiceServer
 // for forward compatibility.
+//
+// Uploads are made by signed-in users, identified by the x-user-id and x-user-roles gRPC
+// metadata the gateway sends. The uploader owns the video.
 type UploadServiceServer interface {
 	InitUpload(context.Context, *InitUploadRequest) (*InitUploadResponse, error)
 	CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error)
+	// InitChannelImageUpload returns a URL to PUT a channel's new avatar or banner to, which
+	// replaces the old one right away. Only the channel's owner and admins can change them.
+	InitChannelImageUpload(context.Context, *InitChannelImageUploadRequest) (*InitChannelImageUploadResponse, error)
 	mustEmbedUnimplementedUploadServiceServer()
 }
 
@@ -81,6 +104,9 @@ func (UnimplementedUploadServiceServer) InitUpload(context.Context, *InitUploadR
 func (UnimplementedUploadServiceServer) CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CompleteUpload not implemented")
 }
+func (UnimplementedUploadServiceServer) InitChannelImageUpload(context.Context, *InitChannelImageUploadRequest) (*InitChannelImageUploadResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method InitChannelImageUpload not implemented")
+}
 func (UnimplementedUploadServiceServer) mustEmbedUnimplementedUploadServiceServer() {}
 func (UnimplementedUploadServiceServer) testEmbeddedByValue()                       {}
 
@@ -138,6 +164,24 @@ func _UploadService_CompleteUpload_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UploadService_InitChannelImageUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitChannelImageUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UploadServiceServer).InitChannelImageUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UploadService_InitChannelImageUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UploadServiceServer).InitChannelImageUpload(ctx, req.(*InitChannelImageUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UploadService_ServiceDesc is the grpc.ServiceDesc for UploadService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +197,10 @@ var UploadService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CompleteUpload",
 			Handler:    _UploadService_CompleteUpload_Handler,
 		},
+		{
+			MethodName: "InitChannelImageUpload",
+			Handler:    _UploadService_InitChannelImageUpload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/upload/upload.proto",
@@ -1,12 +1,18 @@
 package main
 
 import (
+	_ "expvar"
 	"log"
 	"net"
+	"net/http"
 	"os"
 
 	pb "github.com/athandoan/youtube/proto/streaming"
 	handler "github.com/athandoan/youtube/streaming-service/internal/delivery/grpc"
+	httphandler "github.com/athandoan/youtube/streaming-service/internal/delivery/http"
+	"github.com/athandoan/youtube/streaming-service/internal/infrastructure/cache"
+	"github.com/athandoan/youtube/streaming-service/internal/infrastructure/delivery"
+	"github.com/athandoan/youtube/streaming-service/internal/infrastructure/limiter"
 	"github.com/athandoan/youtube/streaming-service/internal/infrastructure/rpc"
 	"github.com/athandoan/youtube/streaming-service/internal/infrastructure/storage"
 	"github.com/athandoan/youtube/streaming-service/internal/usecase"
@@ -27,7 +33,7 @@ func main() {
 		externalEndpoint = minioEndpoint
 	}
 
-	storageService, err := storage.NewMinioStorage(externalEndpoint, minioAccessKey, minioSecretKey, useSSL, "us-east-1")
+	storageService, err := storage.NewMinioStorage(minioEndpoint, externalEndpoint, minioAccessKey, minioSecretKey, useSSL, "us-east-1")
 	if err != nil {
 		log.Fatalf("failed to create storage service: %v", err)
 	}
@@ -42,13 +48,70 @@ func main() {
 		log.Fatalf("failed to create metadata client: %v", err)
 	}
 
-	// 3. Init Usecase
-	uc := usecase.NewStreamingUsecase(storageService, metadataService, bucketName)
+	// 3. Init Usecases
+	urlCache := cache.NewMemoryCache()
+
+	// Optional CDN/delivery hosts as a JSON array; without any, URLs point at S3_EXTERNAL_ENDPOINT
+	hosts, err := delivery.ParseHosts(os.Getenv("DELIVERY_HOSTS"))
+	if err != nil {
+		log.Fatalf("invalid DELIVERY_HOSTS: %v", err)
+	}
+
+	// Public base URL of the live service's HLS output
+	liveBaseURL := os.Getenv("LIVE_PLAYLIST_BASE_URL")
+	if liveBaseURL == "" {
+		liveBaseURL = "http://localhost:8080/api/live/videos"
+	}
+	// Premieres still playing are streamed through the gateway's /api/content proxy
+	contentBaseURL := os.Getenv("CONTENT_BASE_URL")
+	if contentBaseURL == "" {
+		contentBaseURL = "http://localhost:8080/api/content/videos"
+	}
+	uc := usecase.NewStreamingUsecase(storageService, metadataService, urlCache, delivery.NewHostPool(hosts), bucketName, liveBaseURL, contentBaseURL)
+
+	// Per-tier limits for proxied streams, "tier=bytesPerSecond:maxStreams,..."
+	tierLimits := os.Getenv("STREAM_TIER_LIMITS")
+	if tierLimits == "" {
+		tierLimits = "default=4194304:3"
+	}
+	tiers, err := limiter.ParseTierLimits(tierLimits)
+	if err != nil {
+		log.Fatalf("invalid STREAM_TIER_LIMITS: %v", err)
+	}
+	proxy := usecase.NewProxyUsecase(storageService, metadataService, limiter.NewMemoryTracker(), limiter.NewTokenBucketLimiter(), tiers, bucketName)
 
 	// 4. Init gRPC Handler
 	h := handler.NewStreamingHandler(uc)
 
-	// 5. Start gRPC Server
+	// 5. Start HTTP Server for proxied playback
+	httpPort := os.Getenv("HTTP_PORT")
+	if httpPort == "" {
+		httpPort = "8082"
+	}
+	hh := httphandler.NewHandler(uc, proxy)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/videos/", hh.HandleStreamVideo)
+	mux.HandleFunc("/content/videos/", hh.HandleStreamContent)
+	go func() {
+		log.Printf("Streaming Service (HTTP) running on :%s", httpPort)
+		if err := http.ListenAndServe(":"+httpPort, httphandler.CorsMiddleware(mux)); err != nil {
+			log.Fatalf("failed to serve http: %v", err)
+		}
+	}()
+
+	// 6. Expose metrics (cache hit/miss counters) on /debug/vars
+	metricsPort := os.Getenv("METRICS_PORT")
+	if metricsPort == "" {
+		metricsPort = "9090"
+	}
+	go func() {
+		log.Printf("Streaming Service metrics running on :%s", metricsPort)
+		if err := http.ListenAndServe(":"+metricsPort, nil); err != nil {
+			log.Printf("metrics server stopped: %v", err)
+		}
+	}()
+
+	// 7. Start gRPC Server
 	port := os.Getenv("GRPC_PORT")
 	if port == "" {
 		port = "50053"
@@ -58,7 +121,7 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(handler.AuthInterceptor))
 	pb.RegisterStreamingServiceServer(s, h)
 
 	log.Printf("Streaming Service (gRPC) running on :%s", port)
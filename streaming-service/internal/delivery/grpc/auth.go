@@ -0,0 +1,78 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	pb "github.com/athandoan/youtube/proto/streaming"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Metadata keys identifying the caller: the gateway sends the signed-in user, other
+// services their own name.
+const (
+	userIDKey    = "x-user-id"
+	userRolesKey = "x-user-roles"
+	serviceKey   = "x-service"
+)
+
+const roleAdmin = "admin"
+
+type access int
+
+const (
+	servicesOnly access = iota
+	anyone
+	signedIn
+	admins
+)
+
+// methodAccess lists who besides other services may call each RPC. Playback and channel
+// images are open to anyone, as the metadata service decides who may watch which video;
+// steering delivery hosts is for admins.
+var methodAccess = map[string]access{
+	pb.StreamingService_GetStreamURL_FullMethodName:          anyone,
+	pb.StreamingService_GetDownloadURL_FullMethodName:        anyone,
+	pb.StreamingService_GetVideoMedia_FullMethodName:         anyone,
+	pb.StreamingService_InvalidateStreamURL_FullMethodName:   signedIn,
+	pb.StreamingService_ListDeliveryHosts_FullMethodName:     admins,
+	pb.StreamingService_SetDeliveryHostStatus_FullMethodName: admins,
+	pb.StreamingService_GetChannelImageURL_FullMethodName:    anyone,
+}
+
+// AuthInterceptor rejects RPCs the caller named in the request metadata may not make, and
+// requests on behalf of anyone but the caller.
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get(serviceKey)) > 0 {
+		return handler(ctx, req)
+	}
+	var userID string
+	if ids := md.Get(userIDKey); len(ids) > 0 {
+		userID = ids[0]
+	}
+
+	switch methodAccess[info.FullMethod] {
+	case servicesOnly:
+		return nil, status.Error(codes.PermissionDenied, "only for internal services")
+	case signedIn:
+		if userID == "" {
+			return nil, status.Error(codes.Unauthenticated, "sign in first")
+		}
+	case admins:
+		if userID == "" {
+			return nil, status.Error(codes.Unauthenticated, "sign in first")
+		}
+		if !slices.Contains(md.Get(userRolesKey), roleAdmin) {
+			return nil, status.Error(codes.PermissionDenied, "admins only")
+		}
+	}
+	// Requests may name the caller or nobody, as shared links are played without one
+	if r, ok := req.(interface{ GetUserId() string }); ok && r.GetUserId() != "" && r.GetUserId() != userID {
+		return nil, status.Error(codes.PermissionDenied, "user_id must be the caller")
+	}
+	return handler(ctx, req)
+}
@@ -2,9 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/athandoan/youtube/proto/streaming"
 	"github.com/athandoan/youtube/streaming-service/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type StreamingHandler struct {
@@ -17,9 +20,86 @@ func NewStreamingHandler(u domain.StreamingUsecase) *StreamingHandler {
 }
 
 func (h *StreamingHandler) GetStreamURL(ctx context.Context, req *pb.GetStreamURLRequest) (*pb.GetStreamURLResponse, error) {
-	url, err := h.usecase.GetStreamURL(ctx, req.VideoId)
+	videoID := req.VideoId
+	playback := &domain.Playback{}
+	var err error
+	if req.ShareToken != "" {
+		playback.URL, videoID, err = h.usecase.GetSharedStreamURL(ctx, req.ShareToken, req.SharePassword, req.Rendition, req.Region)
+	} else {
+		playback, err = h.usecase.GetStreamURL(ctx, req.VideoId, req.Rendition, req.Region, req.UserId)
+	}
+	if err != nil {
+		if errors.Is(err, domain.ErrNotPublished) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		if errors.Is(err, domain.ErrUnknownRendition) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.GetStreamURLResponse{Url: playback.URL, VideoId: videoID, NextEpisodeId: playback.NextEpisodeID}, nil
+}
+
+func (h *StreamingHandler) InvalidateStreamURL(ctx context.Context, req *pb.InvalidateStreamURLRequest) (*pb.InvalidateStreamURLResponse, error) {
+	if err := h.usecase.InvalidateStreamURL(ctx, req.VideoId); err != nil {
+		return nil, err
+	}
+	return &pb.InvalidateStreamURLResponse{Status: "success"}, nil
+}
+
+func (h *StreamingHandler) GetDownloadURL(ctx context.Context, req *pb.GetDownloadURLRequest) (*pb.GetDownloadURLResponse, error) {
+	url, err := h.usecase.GetDownloadURL(ctx, req.VideoId, req.UserId)
+	if err != nil {
+		if errors.Is(err, domain.ErrDownloadNotAllowed) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		if errors.Is(err, domain.ErrNotPublished) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.GetDownloadURLResponse{Url: url}, nil
+}
+
+func (h *StreamingHandler) GetVideoMedia(ctx context.Context, req *pb.GetVideoMediaRequest) (*pb.GetVideoMediaResponse, error) {
+	media, err := h.usecase.GetVideoMedia(ctx, req.VideoId, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetVideoMediaResponse{ThumbnailUrl: media.ThumbnailURL, Renditions: media.Renditions}, nil
+}
+
+func (h *StreamingHandler) ListDeliveryHosts(ctx context.Context, req *pb.ListDeliveryHostsRequest) (*pb.ListDeliveryHostsResponse, error) {
+	var hosts []*pb.DeliveryHost
+	for _, d := range h.usecase.ListDeliveryHosts(ctx) {
+		hosts = append(hosts, &pb.DeliveryHost{
+			Name:    d.Name,
+			Host:    d.Host,
+			Region:  d.Region,
+			Weight:  int32(d.Weight),
+			Healthy: d.Healthy,
+		})
+	}
+	return &pb.ListDeliveryHostsResponse{Hosts: hosts}, nil
+}
+
+func (h *StreamingHandler) SetDeliveryHostStatus(ctx context.Context, req *pb.SetDeliveryHostStatusRequest) (*pb.SetDeliveryHostStatusResponse, error) {
+	if err := h.usecase.SetDeliveryHostStatus(ctx, req.Name, req.Healthy); err != nil {
+		if errors.Is(err, domain.ErrUnknownDeliveryHost) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.SetDeliveryHostStatusResponse{Status: "success"}, nil
+}
+
+func (h *StreamingHandler) GetChannelImageURL(ctx context.Context, req *pb.GetChannelImageURLRequest) (*pb.GetChannelImageURLResponse, error) {
+	url, err := h.usecase.GetChannelImageURL(ctx, req.ChannelId, req.Kind)
 	if err != nil {
+		if errors.Is(err, domain.ErrNoChannelImage) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
 		return nil, err
 	}
-	return &pb.GetStreamURLResponse{Url: url}, nil
+	return &pb.GetChannelImageURLResponse{Url: url}, nil
 }
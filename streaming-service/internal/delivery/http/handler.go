@@ -1,10 +1,14 @@
 package http
 
 import (
+	"errors"
+	"io"
 	"log"
+	"net"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/athandoan/youtube/streaming-service/internal/domain"
 	"github.com/google/jsonapi"
@@ -12,15 +16,17 @@ import (
 
 type Handler struct {
 	usecase domain.StreamingUsecase
+	proxy   domain.ProxyUsecase
 }
 
-func NewHandler(u domain.StreamingUsecase) *Handler {
-	return &Handler{usecase: u}
+func NewHandler(u domain.StreamingUsecase, p domain.ProxyUsecase) *Handler {
+	return &Handler{usecase: u, proxy: p}
 }
 
 type StreamResponse struct {
-	ID  string `jsonapi:"primary,video-stream"`
-	Url string `jsonapi:"attr,url"`
+	ID            string `jsonapi:"primary,video-stream"`
+	Url           string `jsonapi:"attr,url"`
+	NextEpisodeID string `jsonapi:"attr,next_episode_id,omitempty"`
 }
 
 func writeJsonApi(w http.ResponseWriter, data interface{}) {
@@ -61,7 +67,11 @@ func (h *Handler) HandleStreamVideo(w http.ResponseWriter, r *http.Request) {
 	}
 	videoID := pathParts[2]
 
-	url, err := h.usecase.GetStreamURL(r.Context(), videoID)
+	playback, err := h.usecase.GetStreamURL(r.Context(), videoID, r.URL.Query().Get("rendition"), r.URL.Query().Get("region"), r.Header.Get("X-User-ID"))
+	if errors.Is(err, domain.ErrUnknownRendition) {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", err.Error())
+		return
+	}
 	if err != nil {
 		log.Printf("Error getting stream URL: %v", err)
 		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Video not found")
@@ -69,17 +79,101 @@ func (h *Handler) HandleStreamVideo(w http.ResponseWriter, r *http.Request) {
 	}
 
 	data := &StreamResponse{
-		ID:  videoID,
-		Url: url,
+		ID:            videoID,
+		Url:           playback.URL,
+		NextEpisodeID: playback.NextEpisodeID,
 	}
 	writeJsonApi(w, data)
 }
 
+func (h *Handler) HandleStreamContent(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		writeJsonApiError(w, http.StatusMethodNotAllowed, "Method Not Allowed", "Only GET and HEAD are allowed")
+		return
+	}
+
+	// Extract video ID from path: /content/videos/{id}
+	pathParts := strings.Split(r.URL.Path, "/")
+	if len(pathParts) < 4 || pathParts[3] == "" {
+		writeJsonApiError(w, http.StatusBadRequest, "Invalid Request", "Invalid video ID")
+		return
+	}
+	videoID := pathParts[3]
+
+	stream, err := h.proxy.OpenStream(r.Context(), videoID, viewerFromRequest(r))
+	if err != nil {
+		if errors.Is(err, domain.ErrTooManyStreams) {
+			w.Header().Set("Retry-After", "30")
+			writeJsonApiError(w, http.StatusTooManyRequests, "Too Many Requests", "Concurrent stream limit reached")
+			return
+		}
+		log.Printf("Error opening stream: %v", err)
+		writeJsonApiError(w, http.StatusNotFound, "Not Found", "Video not found")
+		return
+	}
+	defer func() { _ = stream.Content.Close() }()
+
+	if stream.Ahead != nil {
+		if offset, ok := rangeStart(r.Header.Get("Range"), stream.Content); ok && stream.Ahead(offset) {
+			writeJsonApiError(w, http.StatusRequestedRangeNotSatisfiable, "Range Not Satisfiable", domain.ErrPremiereAhead.Error())
+			return
+		}
+	}
+
+	// ServeContent handles Range requests and partial responses for seeking players.
+	http.ServeContent(w, r, stream.Name, time.Time{}, stream.Content)
+}
+
+// rangeStart returns the offset the first range of a Range header starts at, if it has one.
+func rangeStart(header string, content io.Seeker) (int64, bool) {
+	spec, ok := strings.CutPrefix(header, "bytes=")
+	if !ok {
+		return 0, false
+	}
+	first, _, _ := strings.Cut(spec, ",")
+	from, to, ok := strings.Cut(strings.TrimSpace(first), "-")
+	if !ok {
+		return 0, false
+	}
+	if from != "" {
+		offset, err := strconv.ParseInt(from, 10, 64)
+		return offset, err == nil
+	}
+	// A suffix range, the last n bytes
+	n, err := strconv.ParseInt(to, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	size, err := content.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, false
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return 0, false
+	}
+	return max(size-n, 0), true
+}
+
+// viewerFromRequest identifies the viewer from headers set by the gateway, which proxies
+// every request here and replaces whatever the client sent with the session's user. It
+// falls back to the client IP for anonymous viewers.
+func viewerFromRequest(r *http.Request) domain.Viewer {
+	if userID := r.Header.Get("X-User-ID"); userID != "" {
+		return domain.Viewer{Key: "user:" + userID, UserID: userID, Roles: r.Header.Values("X-User-Roles")}
+	}
+
+	ip := r.Header.Get("X-Real-IP")
+	if ip == "" {
+		ip, _, _ = net.SplitHostPort(r.RemoteAddr)
+	}
+	return domain.Viewer{Key: "ip:" + ip}
+}
+
 func CorsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
-		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding")
+		w.Header().Set("Access-Control-Allow-Methods", "POST, GET, HEAD, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Accept-Encoding, Range")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -4,24 +4,188 @@ package domain
 
 import (
 	"context"
+	"errors"
+	"io"
 	"net/url"
 	"time"
 )
 
+var (
+	ErrTooManyStreams      = errors.New("concurrent stream limit reached")
+	ErrDownloadNotAllowed  = errors.New("download not allowed")
+	ErrUnknownDeliveryHost = errors.New("unknown delivery host")
+	ErrNotPublished        = errors.New("video is not published yet")
+	ErrNoChannelImage      = errors.New("channel has no such image")
+	ErrUnknownRendition    = errors.New("unknown rendition")
+	ErrPremiereAhead       = errors.New("the premiere has not reached this point yet")
+)
+
+// VisibilityPrivate marks videos only their owner and users granted access may watch.
+const VisibilityPrivate = "private"
+
+// StatusReady marks videos whose media is in place; only their URLs are cached.
+const StatusReady = "ready"
+
+// Renditions lists the renditions players may ask for, stored next to the source upload.
+// An empty rendition plays the source itself.
+var Renditions = []string{"240p", "360p", "480p", "720p", "1080p"}
+
+// ThumbnailName is the file a video's thumbnail is stored as next to its source, where live
+// recordings put theirs.
+const ThumbnailName = "thumbnail.jpg"
+
 type VideoMetadata struct {
-	ID         string
-	BucketName string
-	ObjectKey  string
+	ID            string
+	Title         string
+	BucketName    string
+	ObjectKey     string
+	OwnerID       string
+	AllowDownload bool
+	Status        string
+	LiveStatus    string // empty for uploads, "live" or "ended" for live streams
+	PublishAt     time.Time
+	Visibility    string
+	SeriesID      string // empty unless the video is an episode of a series
+	Premiere      bool
+	Duration      time.Duration // zero until the upload or recording has been probed
+}
+
+// Published reports whether viewers may watch the video at now; scheduled videos and
+// premieres can't be played before their publish time.
+func (v *VideoMetadata) Published(now time.Time) bool {
+	return v.PublishAt.IsZero() || !now.Before(v.PublishAt)
+}
+
+// PremiereLive reports whether v is a premiere that is still playing at now, which viewers
+// may not get ahead of. Premieres of unknown duration can't be held to their position.
+func (v *VideoMetadata) PremiereLive(now time.Time) bool {
+	return v.Premiere && v.Duration > 0 && v.Published(now) && now.Before(v.PublishAt.Add(v.Duration))
+}
+
+// ChannelImage is a channel's avatar or banner in object storage.
+type ChannelImage struct {
+	Bucket    string
+	ObjectKey string
 }
 
 type MetadataService interface {
-	GetVideo(ctx context.Context, id string) (*VideoMetadata, error)
+	// GetVideo returns a video on behalf of userID, empty for anonymous viewers. The metadata
+	// service refuses private videos to anyone but their owner and users granted access.
+	GetVideo(ctx context.Context, id, userID string) (*VideoMetadata, error)
+	// ResolveShareLink returns the video a share link plays, whatever its visibility, and
+	// counts a view against the link.
+	ResolveShareLink(ctx context.Context, token, password string) (*VideoMetadata, error)
+	// GetChannelImage returns a channel's avatar or banner, or ErrNoChannelImage when it has none.
+	GetChannelImage(ctx context.Context, channelID, kind string) (*ChannelImage, error)
+	// NextEpisode returns the ID of the episode of seriesID userID can play after videoID,
+	// or an empty ID after the last one.
+	NextEpisode(ctx context.Context, seriesID, videoID, userID string) (string, error)
+}
+
+// VideoMedia is what is stored next to a video's source besides the source itself.
+type VideoMedia struct {
+	ThumbnailURL string   // empty without a thumbnail
+	Renditions   []string // in the order of Renditions
+}
+
+// Playback is what a player needs to play a video.
+type Playback struct {
+	URL string
+	// NextEpisodeID is the episode to autoplay afterwards; empty for videos outside a series
+	// and for last episodes
+	NextEpisodeID string
+}
+
+// DeliveryHost is a CDN or origin hostname playback URLs can point at.
+type DeliveryHost struct {
+	Name   string `json:"name"`
+	Host   string `json:"host"` // host[:port] browsers fetch from
+	Region string `json:"region"`
+	Weight int    `json:"weight"`
+	Secure bool   `json:"secure"`
+	// Rewrite signs for the origin and only swaps the hostname, for CDNs that forward
+	// requests with the origin's Host header. Otherwise the URL is signed for Host.
+	Rewrite bool `json:"rewrite"`
+	Healthy bool `json:"-"`
+}
+
+// DeliveryHosts picks a healthy delivery host per request and tracks host health.
+type DeliveryHosts interface {
+	// Select prefers healthy hosts in region and fails over to healthy hosts elsewhere.
+	// It returns false when no host is available, in which case the origin is used.
+	Select(region string) (*DeliveryHost, bool)
+	SetHealthy(name string, healthy bool) error
+	List() []DeliveryHost
 }
 
 type StorageService interface {
-	PresignedGetObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error)
+	// PresignedGetObject signs a GET URL for host, or for the default external endpoint when
+	// host is nil. A non-empty contentDisposition is returned as the response's
+	// Content-Disposition header, e.g. to make browsers save the file.
+	PresignedGetObject(ctx context.Context, host *DeliveryHost, bucket, objectKey string, expiry time.Duration, contentDisposition string) (*url.URL, error)
+	GetObject(ctx context.Context, bucket, objectKey string) (io.ReadSeekCloser, error)
+	// ListObjects returns the keys of every object under prefix.
+	ListObjects(ctx context.Context, bucket, prefix string) ([]string, error)
+}
+
+// URLCache keeps playbacks per video and rendition until their presigned URLs expire.
+type URLCache interface {
+	Get(videoID, rendition string, minValidity time.Duration) (*Playback, bool)
+	Set(videoID, rendition string, playback *Playback, expiresAt time.Time)
+	Invalidate(videoID string)
+}
+
+// Viewer identifies who is pulling bytes through the proxy. Key is the account ID,
+// or the client IP for anonymous viewers.
+type Viewer struct {
+	Key    string
+	UserID string   // empty for anonymous viewers
+	Roles  []string // the user's roles, which pick their tier
+}
+
+// DefaultTier holds the limits for viewers with no role that has limits configured.
+const DefaultTier = "default"
+
+// TierLimits caps bandwidth and parallel streams for a user tier. Tiers are named after
+// user roles, such as premium. Zero means unlimited.
+type TierLimits struct {
+	BytesPerSecond       int64
+	MaxConcurrentStreams int
+}
+
+// StreamTracker counts open streams per viewer. Implementations may be shared across instances.
+type StreamTracker interface {
+	// Acquire reserves a stream slot and returns a func that frees it, or ErrTooManyStreams.
+	Acquire(ctx context.Context, key string, max int) (func(), error)
+}
+
+// BandwidthLimiter throttles bytes per viewer with a token bucket.
+type BandwidthLimiter interface {
+	WaitN(ctx context.Context, key string, n int, bytesPerSecond int64) error
+}
+
+// Stream is an open, throttled object body. Closing it releases the viewer's stream slot.
+type Stream struct {
+	Name    string
+	Content io.ReadSeekCloser
+	// Ahead reports whether reading from offset would get ahead of a premiere that is still
+	// playing; nil when viewers may seek anywhere.
+	Ahead func(offset int64) bool
+}
+
+type ProxyUsecase interface {
+	OpenStream(ctx context.Context, videoID string, viewer Viewer) (*Stream, error)
 }
 
 type StreamingUsecase interface {
-	GetStreamURL(ctx context.Context, videoID string) (string, error)
+	GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*Playback, error)
+	// GetSharedStreamURL returns a playback URL for the video of a share link, and its ID.
+	GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error)
+	InvalidateStreamURL(ctx context.Context, videoID string) error
+	GetDownloadURL(ctx context.Context, videoID, userID string) (string, error)
+	// GetVideoMedia returns the thumbnail and renditions of a video userID may watch.
+	GetVideoMedia(ctx context.Context, videoID, userID string) (*VideoMedia, error)
+	ListDeliveryHosts(ctx context.Context) []DeliveryHost
+	SetDeliveryHostStatus(ctx context.Context, name string, healthy bool) error
+	GetChannelImageURL(ctx context.Context, channelID, kind string) (string, error)
 }
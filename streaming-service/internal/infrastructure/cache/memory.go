@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/streaming-service/internal/domain"
+)
+
+// Hit/miss counters are published on /debug/vars.
+var (
+	hits          = expvar.NewInt("stream_url_cache_hits")
+	misses        = expvar.NewInt("stream_url_cache_misses")
+	invalidations = expvar.NewInt("stream_url_cache_invalidations")
+)
+
+type entry struct {
+	playback  *domain.Playback
+	expiresAt time.Time
+}
+
+// sweepInterval bounds how often Set walks the map to drop expired entries.
+const sweepInterval = time.Minute
+
+type memoryCache struct {
+	mu        sync.RWMutex
+	entries   map[string]map[string]entry // videoID -> rendition -> entry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func NewMemoryCache() domain.URLCache {
+	return &memoryCache{
+		entries: make(map[string]map[string]entry),
+		now:     time.Now,
+	}
+}
+
+func (c *memoryCache) Get(videoID, rendition string, minValidity time.Duration) (*domain.Playback, bool) {
+	c.mu.RLock()
+	e, ok := c.entries[videoID][rendition]
+	c.mu.RUnlock()
+
+	// Only hand out URLs that still have enough validity left for playback.
+	if !ok || c.now().Add(minValidity).After(e.expiresAt) {
+		misses.Add(1)
+		return nil, false
+	}
+	hits.Add(1)
+	return e.playback, true
+}
+
+func (c *memoryCache) Set(videoID, rendition string, playback *domain.Playback, expiresAt time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if now := c.now(); now.Sub(c.lastSweep) >= sweepInterval {
+		c.evictExpired(now)
+		c.lastSweep = now
+	}
+	if c.entries[videoID] == nil {
+		c.entries[videoID] = make(map[string]entry)
+	}
+	c.entries[videoID][rendition] = entry{playback: playback, expiresAt: expiresAt}
+}
+
+func (c *memoryCache) Invalidate(videoID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.entries[videoID]; ok {
+		delete(c.entries, videoID)
+		invalidations.Add(1)
+	}
+}
+
+// evictExpired drops entries that can no longer be served. Callers must hold the write lock.
+func (c *memoryCache) evictExpired(now time.Time) {
+	for videoID, renditions := range c.entries {
+		for rendition, e := range renditions {
+			if !now.Before(e.expiresAt) {
+				delete(renditions, rendition)
+			}
+		}
+		if len(renditions) == 0 {
+			delete(c.entries, videoID)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/streaming-service/internal/domain"
+)
+
+func newTestCache(now *time.Time) *memoryCache {
+	c := NewMemoryCache().(*memoryCache)
+	c.now = func() time.Time { return *now }
+	return c
+}
+
+func TestMemoryCache_Get(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	playback := &domain.Playback{URL: "https://s3.example.com/videos/uuid/video.mp4?signature=xxx"}
+	tests := []struct {
+		name        string
+		elapsed     time.Duration
+		minValidity time.Duration
+		wantHit     bool
+	}{
+		{name: "hit - fresh entry", elapsed: 0, minValidity: 15 * time.Minute, wantHit: true},
+		{name: "hit - exactly the minimum validity left", elapsed: 45 * time.Minute, minValidity: 15 * time.Minute, wantHit: true},
+		{name: "miss - less than the minimum validity left", elapsed: 46 * time.Minute, minValidity: 15 * time.Minute},
+		{name: "miss - expired", elapsed: 2 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := start
+			c := newTestCache(&now)
+			c.Set("video-123", "720p", playback, start.Add(time.Hour))
+
+			now = start.Add(tt.elapsed)
+			got, ok := c.Get("video-123", "720p", tt.minValidity)
+			if ok != tt.wantHit {
+				t.Fatalf("Get() hit = %v, want %v", ok, tt.wantHit)
+			}
+			if ok && got != playback {
+				t.Errorf("Get() = %+v, want %+v", got, playback)
+			}
+		})
+	}
+}
+
+func TestMemoryCache_Invalidate(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	c := newTestCache(&now)
+	c.Set("video-123", "", &domain.Playback{URL: "a"}, now.Add(time.Hour))
+	c.Set("video-123", "720p", &domain.Playback{URL: "b"}, now.Add(time.Hour))
+	c.Set("video-456", "", &domain.Playback{URL: "c"}, now.Add(time.Hour))
+
+	c.Invalidate("video-123")
+	for _, rendition := range []string{"", "720p"} {
+		if _, ok := c.Get("video-123", rendition, 0); ok {
+			t.Errorf("Get(video-123, %q) hit after Invalidate", rendition)
+		}
+	}
+	if _, ok := c.Get("video-456", "", 0); !ok {
+		t.Errorf("Get(video-456) missed; Invalidate dropped another video")
+	}
+}
+
+func TestMemoryCache_EvictsExpiredEntries(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	now := start
+	c := newTestCache(&now)
+	c.Set("short", "", &domain.Playback{URL: "a"}, start.Add(30*time.Second))
+	c.Set("long", "", &domain.Playback{URL: "b"}, start.Add(time.Hour))
+	c.Set("long", "720p", &domain.Playback{URL: "c"}, start.Add(30*time.Second))
+
+	// Within the sweep interval, Set leaves expired entries alone
+	now = start.Add(sweepInterval / 2)
+	c.Set("other", "", &domain.Playback{URL: "d"}, now.Add(time.Hour))
+	if _, ok := c.entries["short"]; !ok {
+		t.Fatalf("entry evicted before the sweep interval passed")
+	}
+
+	now = start.Add(sweepInterval)
+	c.Set("other", "", &domain.Playback{URL: "d"}, now.Add(time.Hour))
+	if _, ok := c.entries["short"]; ok {
+		t.Errorf("expired video still cached after a sweep")
+	}
+	if _, ok := c.entries["long"]["720p"]; ok {
+		t.Errorf("expired rendition still cached after a sweep")
+	}
+	if _, ok := c.entries["long"][""]; !ok {
+		t.Errorf("valid entry evicted by a sweep")
+	}
+}
@@ -0,0 +1,115 @@
+package delivery
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"sync"
+
+	"github.com/athandoan/youtube/streaming-service/internal/domain"
+)
+
+type hostPool struct {
+	mu     sync.RWMutex
+	hosts  []domain.DeliveryHost
+	intN   func(n int) int
+	byName map[string]int
+}
+
+// NewHostPool picks among hosts by weight. All hosts start healthy.
+func NewHostPool(hosts []domain.DeliveryHost) domain.DeliveryHosts {
+	p := &hostPool{
+		hosts:  make([]domain.DeliveryHost, len(hosts)),
+		intN:   rand.IntN,
+		byName: make(map[string]int, len(hosts)),
+	}
+	for i, h := range hosts {
+		h.Healthy = true
+		if h.Weight <= 0 {
+			h.Weight = 1
+		}
+		p.hosts[i] = h
+		p.byName[h.Name] = i
+	}
+	return p
+}
+
+// ParseHosts reads the DELIVERY_HOSTS JSON array, e.g.
+// [{"name":"eu-1","host":"cdn-eu.example.com","region":"eu","weight":3,"secure":true}].
+func ParseHosts(s string) ([]domain.DeliveryHost, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var hosts []domain.DeliveryHost
+	if err := json.Unmarshal([]byte(s), &hosts); err != nil {
+		return nil, fmt.Errorf("invalid delivery hosts: %w", err)
+	}
+	seen := make(map[string]bool, len(hosts))
+	for _, h := range hosts {
+		if h.Name == "" || h.Host == "" {
+			return nil, fmt.Errorf("invalid delivery hosts: name and host are required")
+		}
+		if seen[h.Name] {
+			return nil, fmt.Errorf("invalid delivery hosts: duplicate name %q", h.Name)
+		}
+		seen[h.Name] = true
+	}
+	return hosts, nil
+}
+
+func (p *hostPool) Select(region string) (*domain.DeliveryHost, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	var local, healthy []domain.DeliveryHost
+	for _, h := range p.hosts {
+		if !h.Healthy {
+			continue
+		}
+		healthy = append(healthy, h)
+		if region != "" && strings.EqualFold(h.Region, region) {
+			local = append(local, h)
+		}
+	}
+
+	candidates := local
+	if len(candidates) == 0 {
+		candidates = healthy
+	}
+	if len(candidates) == 0 {
+		return nil, false
+	}
+
+	total := 0
+	for _, h := range candidates {
+		total += h.Weight
+	}
+	n := p.intN(total)
+	for _, h := range candidates {
+		if n < h.Weight {
+			return &h, true
+		}
+		n -= h.Weight
+	}
+	return nil, false
+}
+
+func (p *hostPool) SetHealthy(name string, healthy bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	i, ok := p.byName[name]
+	if !ok {
+		return domain.ErrUnknownDeliveryHost
+	}
+	p.hosts[i].Healthy = healthy
+	return nil
+}
+
+func (p *hostPool) List() []domain.DeliveryHost {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return append([]domain.DeliveryHost(nil), p.hosts...)
+}
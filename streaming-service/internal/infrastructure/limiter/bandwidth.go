@@ -0,0 +1,79 @@
+package limiter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/athandoan/youtube/streaming-service/internal/domain"
+)
+
+// idleBucketTTL is how long an unused bucket is kept before it is dropped.
+const idleBucketTTL = time.Minute * 5
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+type tokenBucketLimiter struct {
+	mu        sync.Mutex
+	buckets   map[string]*bucket
+	lastSweep time.Time
+}
+
+// NewTokenBucketLimiter returns a per-key token bucket with a burst of one second of traffic.
+func NewTokenBucketLimiter() domain.BandwidthLimiter {
+	return &tokenBucketLimiter{buckets: make(map[string]*bucket)}
+}
+
+func (l *tokenBucketLimiter) WaitN(ctx context.Context, key string, n int, bytesPerSecond int64) error {
+	if bytesPerSecond <= 0 || n <= 0 {
+		return nil
+	}
+
+	wait := l.reserve(key, float64(n), float64(bytesPerSecond), time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes n tokens, letting the balance go negative, and returns how long the
+// caller has to wait until the debt is paid off. Concurrent streams of one viewer
+// therefore share the same bandwidth.
+func (l *tokenBucketLimiter) reserve(key string, n, rate float64, now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastSweep) >= idleBucketTTL {
+		for k, b := range l.buckets {
+			if now.Sub(b.last) >= idleBucketTTL {
+				delete(l.buckets, k)
+			}
+		}
+		l.lastSweep = now
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{tokens: rate, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = min(rate, b.tokens+now.Sub(b.last).Seconds()*rate)
+	b.last = now
+	b.tokens -= n
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / rate * float64(time.Second))
+}
@@ -0,0 +1,39 @@
+package limiter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/athandoan/youtube/streaming-service/internal/domain"
+)
+
+// ParseTierLimits parses "tier=bytesPerSecond:maxStreams" pairs separated by commas,
+// e.g. "default=2097152:2,premium=0:10". Zero disables a limit.
+func ParseTierLimits(s string) (map[string]domain.TierLimits, error) {
+	limits := make(map[string]domain.TierLimits)
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		tier, values, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid tier limit %q: missing '='", pair)
+		}
+		rateStr, streamsStr, ok := strings.Cut(values, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid tier limit %q: want bytesPerSecond:maxStreams", pair)
+		}
+		rate, err := strconv.ParseInt(rateStr, 10, 64)
+		if err != nil || rate < 0 {
+			return nil, fmt.Errorf("invalid bytes per second for tier %q: %s", tier, rateStr)
+		}
+		streams, err := strconv.Atoi(streamsStr)
+		if err != nil || streams < 0 {
+			return nil, fmt.Errorf("invalid max streams for tier %q: %s", tier, streamsStr)
+		}
+		limits[strings.TrimSpace(tier)] = domain.TierLimits{BytesPerSecond: rate, MaxConcurrentStreams: streams}
+	}
+	return limits, nil
+}
@@ -0,0 +1,39 @@
+package limiter
+
+import (
+	"context"
+	"sync"
+
+	"github.com/athandoan/youtube/streaming-service/internal/domain"
+)
+
+type memoryTracker struct {
+	mu   sync.Mutex
+	open map[string]int
+}
+
+// NewMemoryTracker counts open streams in process memory. Limits are per instance.
+func NewMemoryTracker() domain.StreamTracker {
+	return &memoryTracker{open: make(map[string]int)}
+}
+
+func (t *memoryTracker) Acquire(ctx context.Context, key string, max int) (func(), error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if max > 0 && t.open[key] >= max {
+		return nil, domain.ErrTooManyStreams
+	}
+	t.open[key]++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			if t.open[key]--; t.open[key] <= 0 {
+				delete(t.open, key)
+			}
+		})
+	}, nil
+}
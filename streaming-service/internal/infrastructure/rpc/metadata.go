@@ -2,11 +2,16 @@ package rpc
 
 import (
 	"context"
+	"time"
 
+	"github.com/athandoan/youtube/proto/common"
 	pb "github.com/athandoan/youtube/proto/metadata"
 	"github.com/athandoan/youtube/streaming-service/internal/domain"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 type metadataClient struct {
@@ -15,7 +20,7 @@ type metadataClient struct {
 }
 
 func NewMetadataClient(addr string) (domain.MetadataService, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(identify))
 	if err != nil {
 		return nil, err
 	}
@@ -23,14 +28,79 @@ func NewMetadataClient(addr string) (domain.MetadataService, error) {
 	return &metadataClient{client: client, conn: conn}, nil
 }
 
-func (m *metadataClient) GetVideo(ctx context.Context, id string) (*domain.VideoMetadata, error) {
-	resp, err := m.client.GetVideo(ctx, &pb.GetVideoRequest{Id: id})
+// identify calls the metadata service as this service, which may ask on behalf of any viewer.
+func identify(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(metadata.AppendToOutgoingContext(ctx, "x-service", "streaming-service"), method, req, reply, cc, opts...)
+}
+
+func (m *metadataClient) GetVideo(ctx context.Context, id, userID string) (*domain.VideoMetadata, error) {
+	resp, err := m.client.GetVideo(ctx, &pb.GetVideoRequest{Id: id, Viewer: &pb.Viewer{UserId: userID}})
+	if err != nil {
+		return nil, err
+	}
+	return toVideoMetadata(resp)
+}
+
+func (m *metadataClient) ResolveShareLink(ctx context.Context, token, password string) (*domain.VideoMetadata, error) {
+	resp, err := m.client.ResolveShareLink(ctx, &pb.ResolveShareLinkRequest{Token: token, Password: password})
+	if err != nil {
+		return nil, err
+	}
+	return toVideoMetadata(resp)
+}
+
+func (m *metadataClient) GetChannelImage(ctx context.Context, channelID, kind string) (*domain.ChannelImage, error) {
+	resp, err := m.client.GetChannel(ctx, &pb.GetChannelRequest{Id: channelID})
 	if err != nil {
 		return nil, err
 	}
+	var image *pb.ChannelImage
+	switch kind {
+	case "avatar":
+		image = resp.Avatar
+	case "banner":
+		image = resp.Banner
+	}
+	if image == nil {
+		return nil, domain.ErrNoChannelImage
+	}
+	return &domain.ChannelImage{Bucket: image.Bucket, ObjectKey: image.ObjectKey}, nil
+}
+
+// NextEpisode treats every NotFound as the end of the series: besides the last episode, the
+// video may have just left the series or the series may be gone.
+func (m *metadataClient) NextEpisode(ctx context.Context, seriesID, videoID, userID string) (string, error) {
+	resp, err := m.client.GetNextEpisode(ctx, &pb.GetNextEpisodeRequest{Id: seriesID, AfterVideoId: videoID, Viewer: &pb.Viewer{UserId: userID}})
+	if status.Code(err) == codes.NotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return resp.Id, nil
+}
+
+func toVideoMetadata(resp *common.Video) (*domain.VideoMetadata, error) {
+	var publishAt time.Time
+	var err error
+	if resp.PublishAt != "" {
+		if publishAt, err = time.Parse(time.RFC3339, resp.PublishAt); err != nil {
+			return nil, err
+		}
+	}
 	return &domain.VideoMetadata{
-		ID:         resp.Id,
-		BucketName: resp.BucketName,
-		ObjectKey:  resp.ObjectKey,
+		ID:            resp.Id,
+		Title:         resp.Title,
+		BucketName:    resp.BucketName,
+		ObjectKey:     resp.ObjectKey,
+		OwnerID:       resp.OwnerId,
+		AllowDownload: resp.AllowDownload,
+		Status:        resp.Status,
+		LiveStatus:    resp.LiveStatus,
+		PublishAt:     publishAt,
+		Visibility:    resp.Visibility,
+		SeriesID:      resp.SeriesId,
+		Premiere:      resp.Premiere,
+		Duration:      time.Duration(resp.DurationSeconds * float64(time.Second)),
 	}, nil
 }
@@ -2,7 +2,9 @@ package storage
 
 import (
 	"context"
+	"io"
 	"net/url"
+	"sync"
 	"time"
 
 	"github.com/athandoan/youtube/streaming-service/internal/domain"
@@ -11,10 +13,16 @@ import (
 )
 
 type minioStorage struct {
-	client *minio.Client
+	client        *minio.Client // internal endpoint, used to read objects
+	presignClient *minio.Client // external endpoint, used to sign browser URLs
+	creds         *credentials.Credentials
+	region        string
+
+	mu          sync.Mutex
+	hostClients map[string]*minio.Client // delivery host -> client signing for it
 }
 
-func NewMinioStorage(endpoint, accessKey, secretKey string, useSSL bool, region string) (domain.StorageService, error) {
+func NewMinioStorage(endpoint, externalEndpoint, accessKey, secretKey string, useSSL bool, region string) (domain.StorageService, error) {
 	opts := &minio.Options{
 		Creds:  credentials.NewStaticV4(accessKey, secretKey, ""),
 		Secure: useSSL,
@@ -24,10 +32,76 @@ func NewMinioStorage(endpoint, accessKey, secretKey string, useSSL bool, region
 	if err != nil {
 		return nil, err
 	}
-	return &minioStorage{client: client}, nil
+	presignClient, err := minio.New(externalEndpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &minioStorage{
+		client:        client,
+		presignClient: presignClient,
+		creds:         opts.Creds,
+		region:        region,
+		hostClients:   make(map[string]*minio.Client),
+	}, nil
 }
 
-func (s *minioStorage) PresignedGetObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error) {
+func (s *minioStorage) PresignedGetObject(ctx context.Context, host *domain.DeliveryHost, bucket, objectKey string, expiry time.Duration, contentDisposition string) (*url.URL, error) {
 	reqParams := make(url.Values)
-	return s.client.PresignedGetObject(ctx, bucket, objectKey, expiry, reqParams)
+	if contentDisposition != "" {
+		reqParams.Set("response-content-disposition", contentDisposition)
+	}
+
+	client := s.presignClient
+	if host != nil {
+		var err error
+		if client, err = s.clientFor(host); err != nil {
+			return nil, err
+		}
+	}
+	return client.PresignedGetObject(ctx, bucket, objectKey, expiry, reqParams)
+}
+
+// clientFor returns a client that signs URLs for the delivery host. Presigning is
+// local, so these clients never talk to the host.
+func (s *minioStorage) clientFor(host *domain.DeliveryHost) (*minio.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if c, ok := s.hostClients[host.Host]; ok {
+		return c, nil
+	}
+	c, err := minio.New(host.Host, &minio.Options{
+		Creds:  s.creds,
+		Secure: host.Secure,
+		Region: s.region,
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.hostClients[host.Host] = c
+	return c, nil
+}
+
+func (s *minioStorage) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	var keys []string
+	for obj := range s.client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		keys = append(keys, obj.Key)
+	}
+	return keys, nil
+}
+
+func (s *minioStorage) GetObject(ctx context.Context, bucket, objectKey string) (io.ReadSeekCloser, error) {
+	obj, err := s.client.GetObject(ctx, bucket, objectKey, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	// GetObject is lazy; Stat surfaces a missing object before we start writing a response.
+	if _, err := obj.Stat(); err != nil {
+		_ = obj.Close()
+		return nil, err
+	}
+	return obj, nil
 }
@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	url "net/url"
 	reflect "reflect"
 	time "time"
@@ -43,19 +44,131 @@ func (m *MockMetadataService) EXPECT() *MockMetadataServiceMockRecorder {
 	return m.recorder
 }
 
+// GetChannelImage mocks base method.
+func (m *MockMetadataService) GetChannelImage(ctx context.Context, channelID, kind string) (*domain.ChannelImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelImage", ctx, channelID, kind)
+	ret0, _ := ret[0].(*domain.ChannelImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelImage indicates an expected call of GetChannelImage.
+func (mr *MockMetadataServiceMockRecorder) GetChannelImage(ctx, channelID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelImage", reflect.TypeOf((*MockMetadataService)(nil).GetChannelImage), ctx, channelID, kind)
+}
+
 // GetVideo mocks base method.
-func (m *MockMetadataService) GetVideo(ctx context.Context, id string) (*domain.VideoMetadata, error) {
+func (m *MockMetadataService) GetVideo(ctx context.Context, id, userID string) (*domain.VideoMetadata, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetVideo", ctx, id)
+	ret := m.ctrl.Call(m, "GetVideo", ctx, id, userID)
 	ret0, _ := ret[0].(*domain.VideoMetadata)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetVideo indicates an expected call of GetVideo.
-func (mr *MockMetadataServiceMockRecorder) GetVideo(ctx, id any) *gomock.Call {
+func (mr *MockMetadataServiceMockRecorder) GetVideo(ctx, id, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockMetadataService)(nil).GetVideo), ctx, id, userID)
+}
+
+// NextEpisode mocks base method.
+func (m *MockMetadataService) NextEpisode(ctx context.Context, seriesID, videoID, userID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NextEpisode", ctx, seriesID, videoID, userID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NextEpisode indicates an expected call of NextEpisode.
+func (mr *MockMetadataServiceMockRecorder) NextEpisode(ctx, seriesID, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NextEpisode", reflect.TypeOf((*MockMetadataService)(nil).NextEpisode), ctx, seriesID, videoID, userID)
+}
+
+// ResolveShareLink mocks base method.
+func (m *MockMetadataService) ResolveShareLink(ctx context.Context, token, password string) (*domain.VideoMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveShareLink", ctx, token, password)
+	ret0, _ := ret[0].(*domain.VideoMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveShareLink indicates an expected call of ResolveShareLink.
+func (mr *MockMetadataServiceMockRecorder) ResolveShareLink(ctx, token, password any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveShareLink", reflect.TypeOf((*MockMetadataService)(nil).ResolveShareLink), ctx, token, password)
+}
+
+// MockDeliveryHosts is a mock of DeliveryHosts interface.
+type MockDeliveryHosts struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeliveryHostsMockRecorder
+	isgomock struct{}
+}
+
+// MockDeliveryHostsMockRecorder is the mock recorder for MockDeliveryHosts.
+type MockDeliveryHostsMockRecorder struct {
+	mock *MockDeliveryHosts
+}
+
+// NewMockDeliveryHosts creates a new mock instance.
+func NewMockDeliveryHosts(ctrl *gomock.Controller) *MockDeliveryHosts {
+	mock := &MockDeliveryHosts{ctrl: ctrl}
+	mock.recorder = &MockDeliveryHostsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeliveryHosts) EXPECT() *MockDeliveryHostsMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockDeliveryHosts) List() []domain.DeliveryHost {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List")
+	ret0, _ := ret[0].([]domain.DeliveryHost)
+	return ret0
+}
+
+// List indicates an expected call of List.
+func (mr *MockDeliveryHostsMockRecorder) List() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockDeliveryHosts)(nil).List))
+}
+
+// Select mocks base method.
+func (m *MockDeliveryHosts) Select(region string) (*domain.DeliveryHost, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Select", region)
+	ret0, _ := ret[0].(*domain.DeliveryHost)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Select indicates an expected call of Select.
+func (mr *MockDeliveryHostsMockRecorder) Select(region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Select", reflect.TypeOf((*MockDeliveryHosts)(nil).Select), region)
+}
+
+// SetHealthy mocks base method.
+func (m *MockDeliveryHosts) SetHealthy(name string, healthy bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHealthy", name, healthy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHealthy indicates an expected call of SetHealthy.
+func (mr *MockDeliveryHostsMockRecorder) SetHealthy(name, healthy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockMetadataService)(nil).GetVideo), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHealthy", reflect.TypeOf((*MockDeliveryHosts)(nil).SetHealthy), name, healthy)
 }
 
 // MockStorageService is a mock of StorageService interface.
@@ -82,19 +195,228 @@ func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
 	return m.recorder
 }
 
+// GetObject mocks base method.
+func (m *MockStorageService) GetObject(ctx context.Context, bucket, objectKey string) (io.ReadSeekCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetObject", ctx, bucket, objectKey)
+	ret0, _ := ret[0].(io.ReadSeekCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetObject indicates an expected call of GetObject.
+func (mr *MockStorageServiceMockRecorder) GetObject(ctx, bucket, objectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObject", reflect.TypeOf((*MockStorageService)(nil).GetObject), ctx, bucket, objectKey)
+}
+
+// ListObjects mocks base method.
+func (m *MockStorageService) ListObjects(ctx context.Context, bucket, prefix string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjects", ctx, bucket, prefix)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjects indicates an expected call of ListObjects.
+func (mr *MockStorageServiceMockRecorder) ListObjects(ctx, bucket, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjects", reflect.TypeOf((*MockStorageService)(nil).ListObjects), ctx, bucket, prefix)
+}
+
 // PresignedGetObject mocks base method.
-func (m *MockStorageService) PresignedGetObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error) {
+func (m *MockStorageService) PresignedGetObject(ctx context.Context, host *domain.DeliveryHost, bucket, objectKey string, expiry time.Duration, contentDisposition string) (*url.URL, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PresignedGetObject", ctx, bucket, objectKey, expiry)
+	ret := m.ctrl.Call(m, "PresignedGetObject", ctx, host, bucket, objectKey, expiry, contentDisposition)
 	ret0, _ := ret[0].(*url.URL)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PresignedGetObject indicates an expected call of PresignedGetObject.
-func (mr *MockStorageServiceMockRecorder) PresignedGetObject(ctx, bucket, objectKey, expiry any) *gomock.Call {
+func (mr *MockStorageServiceMockRecorder) PresignedGetObject(ctx, host, bucket, objectKey, expiry, contentDisposition any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedGetObject", reflect.TypeOf((*MockStorageService)(nil).PresignedGetObject), ctx, bucket, objectKey, expiry)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedGetObject", reflect.TypeOf((*MockStorageService)(nil).PresignedGetObject), ctx, host, bucket, objectKey, expiry, contentDisposition)
+}
+
+// MockURLCache is a mock of URLCache interface.
+type MockURLCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockURLCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockURLCacheMockRecorder is the mock recorder for MockURLCache.
+type MockURLCacheMockRecorder struct {
+	mock *MockURLCache
+}
+
+// NewMockURLCache creates a new mock instance.
+func NewMockURLCache(ctrl *gomock.Controller) *MockURLCache {
+	mock := &MockURLCache{ctrl: ctrl}
+	mock.recorder = &MockURLCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockURLCache) EXPECT() *MockURLCacheMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockURLCache) Get(videoID, rendition string, minValidity time.Duration) (*domain.Playback, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", videoID, rendition, minValidity)
+	ret0, _ := ret[0].(*domain.Playback)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockURLCacheMockRecorder) Get(videoID, rendition, minValidity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockURLCache)(nil).Get), videoID, rendition, minValidity)
+}
+
+// Invalidate mocks base method.
+func (m *MockURLCache) Invalidate(videoID string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Invalidate", videoID)
+}
+
+// Invalidate indicates an expected call of Invalidate.
+func (mr *MockURLCacheMockRecorder) Invalidate(videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invalidate", reflect.TypeOf((*MockURLCache)(nil).Invalidate), videoID)
+}
+
+// Set mocks base method.
+func (m *MockURLCache) Set(videoID, rendition string, playback *domain.Playback, expiresAt time.Time) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Set", videoID, rendition, playback, expiresAt)
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockURLCacheMockRecorder) Set(videoID, rendition, playback, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockURLCache)(nil).Set), videoID, rendition, playback, expiresAt)
+}
+
+// MockStreamTracker is a mock of StreamTracker interface.
+type MockStreamTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamTrackerMockRecorder
+	isgomock struct{}
+}
+
+// MockStreamTrackerMockRecorder is the mock recorder for MockStreamTracker.
+type MockStreamTrackerMockRecorder struct {
+	mock *MockStreamTracker
+}
+
+// NewMockStreamTracker creates a new mock instance.
+func NewMockStreamTracker(ctrl *gomock.Controller) *MockStreamTracker {
+	mock := &MockStreamTracker{ctrl: ctrl}
+	mock.recorder = &MockStreamTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamTracker) EXPECT() *MockStreamTrackerMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockStreamTracker) Acquire(ctx context.Context, key string, max int) (func(), error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acquire", ctx, key, max)
+	ret0, _ := ret[0].(func())
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Acquire indicates an expected call of Acquire.
+func (mr *MockStreamTrackerMockRecorder) Acquire(ctx, key, max any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockStreamTracker)(nil).Acquire), ctx, key, max)
+}
+
+// MockBandwidthLimiter is a mock of BandwidthLimiter interface.
+type MockBandwidthLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockBandwidthLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockBandwidthLimiterMockRecorder is the mock recorder for MockBandwidthLimiter.
+type MockBandwidthLimiterMockRecorder struct {
+	mock *MockBandwidthLimiter
+}
+
+// NewMockBandwidthLimiter creates a new mock instance.
+func NewMockBandwidthLimiter(ctrl *gomock.Controller) *MockBandwidthLimiter {
+	mock := &MockBandwidthLimiter{ctrl: ctrl}
+	mock.recorder = &MockBandwidthLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBandwidthLimiter) EXPECT() *MockBandwidthLimiterMockRecorder {
+	return m.recorder
+}
+
+// WaitN mocks base method.
+func (m *MockBandwidthLimiter) WaitN(ctx context.Context, key string, n int, bytesPerSecond int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitN", ctx, key, n, bytesPerSecond)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitN indicates an expected call of WaitN.
+func (mr *MockBandwidthLimiterMockRecorder) WaitN(ctx, key, n, bytesPerSecond any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitN", reflect.TypeOf((*MockBandwidthLimiter)(nil).WaitN), ctx, key, n, bytesPerSecond)
+}
+
+// MockProxyUsecase is a mock of ProxyUsecase interface.
+type MockProxyUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockProxyUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockProxyUsecaseMockRecorder is the mock recorder for MockProxyUsecase.
+type MockProxyUsecaseMockRecorder struct {
+	mock *MockProxyUsecase
+}
+
+// NewMockProxyUsecase creates a new mock instance.
+func NewMockProxyUsecase(ctrl *gomock.Controller) *MockProxyUsecase {
+	mock := &MockProxyUsecase{ctrl: ctrl}
+	mock.recorder = &MockProxyUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProxyUsecase) EXPECT() *MockProxyUsecaseMockRecorder {
+	return m.recorder
+}
+
+// OpenStream mocks base method.
+func (m *MockProxyUsecase) OpenStream(ctx context.Context, videoID string, viewer domain.Viewer) (*domain.Stream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenStream", ctx, videoID, viewer)
+	ret0, _ := ret[0].(*domain.Stream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenStream indicates an expected call of OpenStream.
+func (mr *MockProxyUsecaseMockRecorder) OpenStream(ctx, videoID, viewer any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenStream", reflect.TypeOf((*MockProxyUsecase)(nil).OpenStream), ctx, videoID, viewer)
 }
 
 // MockStreamingUsecase is a mock of StreamingUsecase interface.
@@ -121,17 +443,120 @@ func (m *MockStreamingUsecase) EXPECT() *MockStreamingUsecaseMockRecorder {
 	return m.recorder
 }
 
-// GetStreamURL mocks base method.
-func (m *MockStreamingUsecase) GetStreamURL(ctx context.Context, videoID string) (string, error) {
+// GetChannelImageURL mocks base method.
+func (m *MockStreamingUsecase) GetChannelImageURL(ctx context.Context, channelID, kind string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelImageURL", ctx, channelID, kind)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelImageURL indicates an expected call of GetChannelImageURL.
+func (mr *MockStreamingUsecaseMockRecorder) GetChannelImageURL(ctx, channelID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelImageURL", reflect.TypeOf((*MockStreamingUsecase)(nil).GetChannelImageURL), ctx, channelID, kind)
+}
+
+// GetDownloadURL mocks base method.
+func (m *MockStreamingUsecase) GetDownloadURL(ctx context.Context, videoID, userID string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStreamURL", ctx, videoID)
+	ret := m.ctrl.Call(m, "GetDownloadURL", ctx, videoID, userID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
+// GetDownloadURL indicates an expected call of GetDownloadURL.
+func (mr *MockStreamingUsecaseMockRecorder) GetDownloadURL(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDownloadURL", reflect.TypeOf((*MockStreamingUsecase)(nil).GetDownloadURL), ctx, videoID, userID)
+}
+
+// GetSharedStreamURL mocks base method.
+func (m *MockStreamingUsecase) GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSharedStreamURL", ctx, token, password, rendition, region)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSharedStreamURL indicates an expected call of GetSharedStreamURL.
+func (mr *MockStreamingUsecaseMockRecorder) GetSharedStreamURL(ctx, token, password, rendition, region any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedStreamURL", reflect.TypeOf((*MockStreamingUsecase)(nil).GetSharedStreamURL), ctx, token, password, rendition, region)
+}
+
+// GetStreamURL mocks base method.
+func (m *MockStreamingUsecase) GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*domain.Playback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamURL", ctx, videoID, rendition, region, userID)
+	ret0, _ := ret[0].(*domain.Playback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // GetStreamURL indicates an expected call of GetStreamURL.
-func (mr *MockStreamingUsecaseMockRecorder) GetStreamURL(ctx, videoID any) *gomock.Call {
+func (mr *MockStreamingUsecaseMockRecorder) GetStreamURL(ctx, videoID, rendition, region, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamURL", reflect.TypeOf((*MockStreamingUsecase)(nil).GetStreamURL), ctx, videoID, rendition, region, userID)
+}
+
+// GetVideoMedia mocks base method.
+func (m *MockStreamingUsecase) GetVideoMedia(ctx context.Context, videoID, userID string) (*domain.VideoMedia, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideoMedia", ctx, videoID, userID)
+	ret0, _ := ret[0].(*domain.VideoMedia)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideoMedia indicates an expected call of GetVideoMedia.
+func (mr *MockStreamingUsecaseMockRecorder) GetVideoMedia(ctx, videoID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideoMedia", reflect.TypeOf((*MockStreamingUsecase)(nil).GetVideoMedia), ctx, videoID, userID)
+}
+
+// InvalidateStreamURL mocks base method.
+func (m *MockStreamingUsecase) InvalidateStreamURL(ctx context.Context, videoID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateStreamURL", ctx, videoID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateStreamURL indicates an expected call of InvalidateStreamURL.
+func (mr *MockStreamingUsecaseMockRecorder) InvalidateStreamURL(ctx, videoID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateStreamURL", reflect.TypeOf((*MockStreamingUsecase)(nil).InvalidateStreamURL), ctx, videoID)
+}
+
+// ListDeliveryHosts mocks base method.
+func (m *MockStreamingUsecase) ListDeliveryHosts(ctx context.Context) []domain.DeliveryHost {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeliveryHosts", ctx)
+	ret0, _ := ret[0].([]domain.DeliveryHost)
+	return ret0
+}
+
+// ListDeliveryHosts indicates an expected call of ListDeliveryHosts.
+func (mr *MockStreamingUsecaseMockRecorder) ListDeliveryHosts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeliveryHosts", reflect.TypeOf((*MockStreamingUsecase)(nil).ListDeliveryHosts), ctx)
+}
+
+// SetDeliveryHostStatus mocks base method.
+func (m *MockStreamingUsecase) SetDeliveryHostStatus(ctx context.Context, name string, healthy bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDeliveryHostStatus", ctx, name, healthy)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDeliveryHostStatus indicates an expected call of SetDeliveryHostStatus.
+func (mr *MockStreamingUsecaseMockRecorder) SetDeliveryHostStatus(ctx, name, healthy any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamURL", reflect.TypeOf((*MockStreamingUsecase)(nil).GetStreamURL), ctx, videoID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeliveryHostStatus", reflect.TypeOf((*MockStreamingUsecase)(nil).SetDeliveryHostStatus), ctx, name, healthy)
 }
@@ -0,0 +1,249 @@
+package usecase
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"path"
+	"time"
+
+	"github.com/athandoan/youtube/streaming-service/internal/domain"
+)
+
+// maxChunk bounds a single read so throttled viewers get a steady flow of small writes.
+const maxChunk = 32 * 1024
+
+const (
+	// premiereLead is how far past a premiere's shared position viewers may buffer.
+	premiereLead = 10 * time.Second
+	// premiereTick is how often a read waiting for a premiere to catch up checks again.
+	premiereTick = 250 * time.Millisecond
+)
+
+type proxyUsecase struct {
+	storage       domain.StorageService
+	metadata      domain.MetadataService
+	tracker       domain.StreamTracker
+	limiter       domain.BandwidthLimiter
+	tiers         map[string]domain.TierLimits
+	defaultBucket string
+}
+
+func NewProxyUsecase(storage domain.StorageService, metadata domain.MetadataService, tracker domain.StreamTracker, limiter domain.BandwidthLimiter, tiers map[string]domain.TierLimits, bucket string) domain.ProxyUsecase {
+	return &proxyUsecase{
+		storage:       storage,
+		metadata:      metadata,
+		tracker:       tracker,
+		limiter:       limiter,
+		tiers:         tiers,
+		defaultBucket: bucket,
+	}
+}
+
+func (u *proxyUsecase) OpenStream(ctx context.Context, videoID string, viewer domain.Viewer) (*domain.Stream, error) {
+	limits := u.limitsFor(viewer)
+
+	// 1. Reserve a stream slot for the viewer
+	release, err := u.tracker.Acquire(ctx, viewer.Key, limits.MaxConcurrentStreams)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Get Metadata
+	v, err := u.metadata.GetVideo(ctx, videoID, viewer.UserID)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	if !v.Published(time.Now()) {
+		release()
+		return nil, domain.ErrNotPublished
+	}
+
+	bucket := v.BucketName
+	if bucket == "" {
+		bucket = u.defaultBucket
+	}
+
+	// 3. Open the object
+	obj, err := u.storage.GetObject(ctx, bucket, v.ObjectKey)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	stream := &domain.Stream{
+		Name: path.Base(v.ObjectKey),
+		Content: &throttledReader{
+			ctx:     ctx,
+			obj:     obj,
+			limiter: u.limiter,
+			key:     viewer.Key,
+			rate:    limits.BytesPerSecond,
+			release: release,
+		},
+	}
+
+	// 4. Hold viewers of a premiere that is still playing to its position
+	if v.PremiereLive(time.Now()) {
+		start, end, err := mediaData(obj)
+		if err != nil {
+			_ = stream.Content.Close()
+			return nil, err
+		}
+		gate := &premiereGate{start: start, end: end, publishAt: v.PublishAt, duration: v.Duration, now: time.Now}
+		stream.Content = &gatedReader{ReadSeekCloser: stream.Content, ctx: ctx, gate: gate}
+		stream.Ahead = gate.ahead
+	}
+	return stream, nil
+}
+
+// limitsFor returns the limits of the viewer's first role that has any, or the default tier's.
+func (u *proxyUsecase) limitsFor(viewer domain.Viewer) domain.TierLimits {
+	for _, role := range viewer.Roles {
+		if limits, ok := u.tiers[role]; ok {
+			return limits
+		}
+	}
+	return u.tiers[domain.DefaultTier]
+}
+
+// throttledReader charges every read against the viewer's bandwidth bucket.
+type throttledReader struct {
+	ctx     context.Context
+	obj     io.ReadSeekCloser
+	limiter domain.BandwidthLimiter
+	key     string
+	rate    int64
+	release func()
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if r.rate > 0 && len(p) > maxChunk {
+		p = p[:maxChunk]
+	}
+	n, err := r.obj.Read(p)
+	if n > 0 {
+		if werr := r.limiter.WaitN(r.ctx, r.key, n, r.rate); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+func (r *throttledReader) Seek(offset int64, whence int) (int64, error) {
+	return r.obj.Seek(offset, whence)
+}
+
+func (r *throttledReader) Close() error {
+	r.release()
+	return r.obj.Close()
+}
+
+// premiereGate opens up a premiere's media data at the rate it plays, counting from its
+// publish time. The container's headers around the media data stay readable, as players
+// fetch them in any order.
+type premiereGate struct {
+	start, end int64 // media data
+	publishAt  time.Time
+	duration   time.Duration
+	now        func() time.Time
+}
+
+// limit is the end of the media data viewers may read at now.
+func (g *premiereGate) limit(now time.Time) int64 {
+	played := now.Sub(g.publishAt) + premiereLead
+	switch {
+	case played >= g.duration:
+		return g.end
+	case played <= 0:
+		return g.start
+	}
+	return g.start + int64(float64(g.end-g.start)*float64(played)/float64(g.duration))
+}
+
+// ahead reports whether offset is media data the premiere hasn't reached yet.
+func (g *premiereGate) ahead(offset int64) bool {
+	return offset >= g.start && offset < g.end && offset > g.limit(g.now())
+}
+
+// gatedReader holds reads of media data back until the premiere reaches them, so viewers
+// get a premiere as it plays, like a live stream.
+type gatedReader struct {
+	io.ReadSeekCloser
+	ctx  context.Context
+	gate *premiereGate
+	pos  int64
+}
+
+func (r *gatedReader) Read(p []byte) (int, error) {
+	switch {
+	case r.pos < r.gate.start && r.pos+int64(len(p)) > r.gate.start:
+		// Stop at the media data, so the next read is held to the premiere
+		p = p[:r.gate.start-r.pos]
+	case r.pos >= r.gate.start && r.pos < r.gate.end:
+		for {
+			limit := r.gate.limit(r.gate.now())
+			if r.pos < limit {
+				p = p[:min(int64(len(p)), limit-r.pos)]
+				break
+			}
+			select {
+			case <-time.After(premiereTick):
+			case <-r.ctx.Done():
+				return 0, r.ctx.Err()
+			}
+		}
+	}
+	n, err := r.ReadSeekCloser.Read(p)
+	r.pos += int64(n)
+	return n, err
+}
+
+func (r *gatedReader) Seek(offset int64, whence int) (int64, error) {
+	pos, err := r.ReadSeekCloser.Seek(offset, whence)
+	if err == nil {
+		r.pos = pos
+	}
+	return pos, err
+}
+
+// mediaData locates the media samples of r: the mdat box of an MP4, which plays front to
+// back, or the whole file for containers it doesn't know. r is left at its start.
+func mediaData(r io.ReadSeeker) (int64, int64, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return 0, 0, err
+	}
+	start, end := int64(0), size
+	var hdr [16]byte
+	for off := int64(0); off+8 <= size; {
+		if _, err := r.Seek(off, io.SeekStart); err != nil {
+			return 0, 0, err
+		}
+		if _, err := io.ReadFull(r, hdr[:8]); err != nil {
+			return 0, 0, err
+		}
+		boxSize, headerSize := int64(binary.BigEndian.Uint32(hdr[:4])), int64(8)
+		switch boxSize {
+		case 0: // runs to the end of the file
+			boxSize = size - off
+		case 1: // 64-bit size follows the type
+			if _, err := io.ReadFull(r, hdr[8:16]); err != nil {
+				return 0, 0, err
+			}
+			boxSize, headerSize = int64(binary.BigEndian.Uint64(hdr[8:16])), 16
+		}
+		boxType := string(hdr[4:8])
+		if boxSize < headerSize || off == 0 && boxType != "ftyp" {
+			break // not an MP4
+		}
+		if boxType == "mdat" {
+			start, end = off+headerSize, min(off+boxSize, size)
+			break
+		}
+		off += boxSize
+	}
+	_, err = r.Seek(0, io.SeekStart)
+	return start, end, err
+}
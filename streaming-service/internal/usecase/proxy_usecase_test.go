@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/streaming-service/internal/domain"
+	"github.com/athandoan/youtube/streaming-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+)
+
+type nopSeekCloser struct {
+	*bytes.Reader
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
+func TestProxyUsecase_OpenStream(t *testing.T) {
+	tiers := map[string]domain.TierLimits{
+		domain.DefaultTier: {BytesPerSecond: 1024, MaxConcurrentStreams: 2},
+		"premium":          {BytesPerSecond: 0, MaxConcurrentStreams: 10},
+	}
+
+	tests := []struct {
+		name         string
+		viewer       domain.Viewer
+		setupMock    func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, tracker *mocks.MockStreamTracker, limiter *mocks.MockBandwidthLimiter, released *int)
+		wantErr      error
+		wantReleased int
+	}{
+		{
+			name:   "success - streams throttled bytes and releases slot on close",
+			viewer: domain.Viewer{Key: "ip:10.0.0.1"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, tracker *mocks.MockStreamTracker, limiter *mocks.MockBandwidthLimiter, released *int) {
+				tracker.EXPECT().
+					Acquire(gomock.Any(), "ip:10.0.0.1", 2).
+					Return(func() { *released++ }, nil)
+				metadata.EXPECT().
+					GetVideo(gomock.Any(), "video-123", "").
+					Return(&domain.VideoMetadata{ID: "video-123", BucketName: "videos", ObjectKey: "uuid/video.mp4"}, nil)
+				storage.EXPECT().
+					GetObject(gomock.Any(), "videos", "uuid/video.mp4").
+					Return(nopSeekCloser{bytes.NewReader([]byte("video-bytes"))}, nil)
+				limiter.EXPECT().
+					WaitN(gomock.Any(), "ip:10.0.0.1", 11, int64(1024)).
+					Return(nil)
+			},
+			wantReleased: 1,
+		},
+		{
+			name:   "success - uses the limits of the viewer's role",
+			viewer: domain.Viewer{Key: "user:42", UserID: "42", Roles: []string{"moderator", "premium"}},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, tracker *mocks.MockStreamTracker, limiter *mocks.MockBandwidthLimiter, released *int) {
+				tracker.EXPECT().
+					Acquire(gomock.Any(), "user:42", 10).
+					Return(func() { *released++ }, nil)
+				metadata.EXPECT().
+					GetVideo(gomock.Any(), "video-123", "42").
+					Return(&domain.VideoMetadata{ID: "video-123", ObjectKey: "uuid/video.mp4"}, nil)
+				storage.EXPECT().
+					GetObject(gomock.Any(), "default-bucket", "uuid/video.mp4").
+					Return(nopSeekCloser{bytes.NewReader([]byte("video-bytes"))}, nil)
+				limiter.EXPECT().
+					WaitN(gomock.Any(), "user:42", 11, int64(0)).
+					Return(nil)
+			},
+			wantReleased: 1,
+		},
+		{
+			name:   "error - concurrent stream limit reached",
+			viewer: domain.Viewer{Key: "ip:10.0.0.1"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, tracker *mocks.MockStreamTracker, limiter *mocks.MockBandwidthLimiter, released *int) {
+				tracker.EXPECT().
+					Acquire(gomock.Any(), "ip:10.0.0.1", 2).
+					Return(nil, domain.ErrTooManyStreams)
+			},
+			wantErr: domain.ErrTooManyStreams,
+		},
+		{
+			name:   "error - video not found releases slot",
+			viewer: domain.Viewer{Key: "ip:10.0.0.1"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, tracker *mocks.MockStreamTracker, limiter *mocks.MockBandwidthLimiter, released *int) {
+				tracker.EXPECT().
+					Acquire(gomock.Any(), "ip:10.0.0.1", 2).
+					Return(func() { *released++ }, nil)
+				metadata.EXPECT().
+					GetVideo(gomock.Any(), "video-123", "").
+					Return(nil, errors.New("video not found"))
+			},
+			wantErr:      errors.New("video not found"),
+			wantReleased: 1,
+		},
+		{
+			name:   "error - storage fails releases slot",
+			viewer: domain.Viewer{Key: "ip:10.0.0.1"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, tracker *mocks.MockStreamTracker, limiter *mocks.MockBandwidthLimiter, released *int) {
+				tracker.EXPECT().
+					Acquire(gomock.Any(), "ip:10.0.0.1", 2).
+					Return(func() { *released++ }, nil)
+				metadata.EXPECT().
+					GetVideo(gomock.Any(), "video-123", "").
+					Return(&domain.VideoMetadata{ID: "video-123", BucketName: "videos", ObjectKey: "uuid/video.mp4"}, nil)
+				storage.EXPECT().
+					GetObject(gomock.Any(), "videos", "uuid/video.mp4").
+					Return(nil, errors.New("storage unavailable"))
+			},
+			wantErr:      errors.New("storage unavailable"),
+			wantReleased: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockTracker := mocks.NewMockStreamTracker(ctrl)
+			mockLimiter := mocks.NewMockBandwidthLimiter(ctrl)
+			released := 0
+			tt.setupMock(mockStorage, mockMetadata, mockTracker, mockLimiter, &released)
+
+			uc := NewProxyUsecase(mockStorage, mockMetadata, mockTracker, mockLimiter, tiers, "default-bucket")
+			stream, err := uc.OpenStream(context.Background(), "video-123", tt.viewer)
+
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Fatalf("OpenStream() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				if err.Error() != tt.wantErr.Error() {
+					t.Errorf("OpenStream() error = %v, want %v", err, tt.wantErr)
+				}
+			} else {
+				body, err := io.ReadAll(stream.Content)
+				if err != nil {
+					t.Fatalf("reading stream: %v", err)
+				}
+				if string(body) != "video-bytes" {
+					t.Errorf("stream body = %q, want %q", body, "video-bytes")
+				}
+				if stream.Name != "video.mp4" {
+					t.Errorf("stream name = %q, want %q", stream.Name, "video.mp4")
+				}
+				_ = stream.Content.Close()
+			}
+
+			if released != tt.wantReleased {
+				t.Errorf("released %d stream slots, want %d", released, tt.wantReleased)
+			}
+		})
+	}
+}
+
+// box encodes an MP4 box with a 32-bit size.
+func box(boxType string, payload []byte) []byte {
+	b := binary.BigEndian.AppendUint32(nil, uint32(8+len(payload)))
+	return append(append(b, boxType...), payload...)
+}
+
+func TestMediaData(t *testing.T) {
+	ftyp := box("ftyp", []byte("isom"))
+	moov := box("moov", make([]byte, 10))
+	mdat := box("mdat", make([]byte, 100))
+	largeMdat := append(append(binary.BigEndian.AppendUint32(nil, 1), "mdat"...), binary.BigEndian.AppendUint64(nil, 16+100)...)
+	largeMdat = append(largeMdat, make([]byte, 100)...)
+
+	tests := []struct {
+		name      string
+		file      []byte
+		wantStart int64
+		wantEnd   int64
+	}{
+		{
+			name:      "success - mdat after moov",
+			file:      slices.Concat(ftyp, moov, mdat),
+			wantStart: 38,
+			wantEnd:   138,
+		},
+		{
+			name:      "success - mdat before moov",
+			file:      slices.Concat(ftyp, mdat, moov),
+			wantStart: 20,
+			wantEnd:   120,
+		},
+		{
+			name:      "success - mdat with a 64-bit size",
+			file:      slices.Concat(ftyp, moov, largeMdat),
+			wantStart: 46,
+			wantEnd:   146,
+		},
+		{
+			name:      "success - not an MP4 is all media data",
+			file:      []byte("#EXTM3U\nsegment0.ts\n"),
+			wantStart: 0,
+			wantEnd:   20,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := bytes.NewReader(tt.file)
+			start, end, err := mediaData(r)
+			if err != nil {
+				t.Fatalf("mediaData() error = %v", err)
+			}
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Errorf("mediaData() = %d, %d, want %d, %d", start, end, tt.wantStart, tt.wantEnd)
+			}
+			if pos, _ := r.Seek(0, io.SeekCurrent); pos != 0 {
+				t.Errorf("mediaData() left the reader at %d, want 0", pos)
+			}
+		})
+	}
+}
+
+func TestPremiereGate(t *testing.T) {
+	now := time.Now()
+	// Halfway through the media data, counting the lead: 40s played of 100s
+	gate := &premiereGate{start: 100, end: 1100, publishAt: now.Add(-40 * time.Second), duration: 100 * time.Second, now: func() time.Time { return now }}
+
+	if got := gate.limit(now.Add(-time.Hour)); got != 100 {
+		t.Errorf("limit() before the premiere = %d, want 100", got)
+	}
+	if got := gate.limit(now.Add(time.Hour)); got != 1100 {
+		t.Errorf("limit() after the premiere = %d, want 1100", got)
+	}
+	for offset, want := range map[int64]bool{0: false, 600: false, 601: true, 1099: true, 1100: false} {
+		if got := gate.ahead(offset); got != want {
+			t.Errorf("ahead(%d) = %v, want %v", offset, got, want)
+		}
+	}
+}
+
+func TestGatedReader(t *testing.T) {
+	now := time.Now()
+	gate := &premiereGate{start: 100, end: 1100, publishAt: now.Add(-40 * time.Second), duration: 100 * time.Second, now: func() time.Time { return now }}
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &gatedReader{ReadSeekCloser: nopSeekCloser{bytes.NewReader(make([]byte, 1200))}, ctx: ctx, gate: gate}
+
+	buf := make([]byte, 1200)
+	// Headers, stopping at the media data
+	if n, err := r.Read(buf); n != 100 || err != nil {
+		t.Fatalf("Read() = %d, %v, want 100, nil", n, err)
+	}
+	// Media data up to the premiere's position
+	if n, err := r.Read(buf); n != 500 || err != nil {
+		t.Fatalf("Read() = %d, %v, want 500, nil", n, err)
+	}
+	// Then held until the premiere gets further, or the viewer goes away
+	cancel()
+	if n, err := r.Read(buf); n != 0 || !errors.Is(err, context.Canceled) {
+		t.Fatalf("Read() = %d, %v, want 0, %v", n, err, context.Canceled)
+	}
+	// What follows the media data is not held back
+	if _, err := r.Seek(1100, io.SeekStart); err != nil {
+		t.Fatalf("Seek() error = %v", err)
+	}
+	if n, err := r.Read(buf); n != 100 || err != nil {
+		t.Fatalf("Read() = %d, %v, want 100, nil", n, err)
+	}
+}
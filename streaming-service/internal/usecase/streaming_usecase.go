@@ -2,42 +2,343 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
 	"time"
+	"unicode"
 
 	"github.com/athandoan/youtube/streaming-service/internal/domain"
 )
 
+const (
+	// urlExpiry is how long a presigned URL is valid for.
+	urlExpiry = time.Hour * 1
+	// minURLValidity is the validity a cached URL must still have to be handed out,
+	// so players don't receive a URL that expires mid-playback.
+	minURLValidity = time.Minute * 15
+)
+
 type streamingUsecase struct {
-	storage       domain.StorageService
-	metadata      domain.MetadataService
-	defaultBucket string
+	storage        domain.StorageService
+	metadata       domain.MetadataService
+	cache          domain.URLCache
+	hosts          domain.DeliveryHosts
+	defaultBucket  string
+	liveBaseURL    string
+	contentBaseURL string
 }
 
-func NewStreamingUsecase(storage domain.StorageService, metadata domain.MetadataService, bucket string) domain.StreamingUsecase {
+// NewStreamingUsecase creates the usecase. liveBaseURL is where the live service serves
+// HLS playlists, as /{liveBaseURL}/{videoID}/index.m3u8, and contentBaseURL where this
+// service's proxy streams videos, as /{contentBaseURL}/{videoID}.
+func NewStreamingUsecase(storage domain.StorageService, metadata domain.MetadataService, cache domain.URLCache, hosts domain.DeliveryHosts, bucket, liveBaseURL, contentBaseURL string) domain.StreamingUsecase {
 	return &streamingUsecase{
-		storage:       storage,
-		metadata:      metadata,
-		defaultBucket: bucket,
+		storage:        storage,
+		metadata:       metadata,
+		cache:          cache,
+		hosts:          hosts,
+		defaultBucket:  bucket,
+		liveBaseURL:    strings.TrimSuffix(liveBaseURL, "/"),
+		contentBaseURL: strings.TrimSuffix(contentBaseURL, "/"),
+	}
+}
+
+// GetStreamURL returns a playback URL for userID. The metadata service is asked first, so a
+// video that was made private, unpublished or deleted stops playing even while a URL for it
+// is cached; private videos are never served from the cache.
+func (u *streamingUsecase) GetStreamURL(ctx context.Context, videoID, rendition, region, userID string) (*domain.Playback, error) {
+	if err := checkRendition(rendition); err != nil {
+		return nil, err
+	}
+
+	// 1. Get Metadata, which also checks the viewer may watch a private video
+	v, err := u.metadata.GetVideo(ctx, videoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !v.Published(time.Now()) {
+		return nil, domain.ErrNotPublished
+	}
+
+	// 2. Pick a delivery host for the client's region; nil means the origin
+	host, variant := u.selectHost(region, rendition)
+
+	// 3. Serve from cache while the URL is still comfortably valid. URLs are host specific.
+	if v.Visibility != domain.VisibilityPrivate {
+		if playback, ok := u.cache.Get(v.ID, variant, minURLValidity); ok {
+			return playback, nil
+		}
+	}
+	return u.signStreamURL(ctx, v, rendition, host, variant, userID)
+}
+
+// GetSharedStreamURL resolves the share link before looking at the cache, so every play
+// counts against the link's views and expired or revoked links stop working right away.
+func (u *streamingUsecase) GetSharedStreamURL(ctx context.Context, token, password, rendition, region string) (string, string, error) {
+	if err := checkRendition(rendition); err != nil {
+		return "", "", err
+	}
+	v, err := u.metadata.ResolveShareLink(ctx, token, password)
+	if err != nil {
+		return "", "", err
+	}
+	// Premieres play through the proxy, which only knows signed-in viewers, not share links
+	if v.Visibility == domain.VisibilityPrivate && v.PremiereLive(time.Now()) {
+		return "", "", domain.ErrNotPublished
+	}
+	host, variant := u.selectHost(region, rendition)
+	if v.Visibility != domain.VisibilityPrivate {
+		if playback, ok := u.cache.Get(v.ID, variant, minURLValidity); ok {
+			return playback.URL, v.ID, nil
+		}
+	}
+	playback, err := u.signStreamURL(ctx, v, rendition, host, variant, "")
+	if err != nil {
+		return "", "", err
+	}
+	return playback.URL, v.ID, nil
+}
+
+// selectHost picks a delivery host for region, nil for the origin, and the cache variant of
+// rendition on it; URLs are host specific.
+func (u *streamingUsecase) selectHost(region, rendition string) (*domain.DeliveryHost, string) {
+	host, _ := u.hosts.Select(region)
+	if host == nil {
+		return nil, rendition
 	}
+	return host, rendition + "@" + host.Name
+}
+
+// signStreamURL presigns a playback URL of v on host and caches it unless v is private or
+// not ready yet. Uploads and recordings stay ready once they are, and live playlists are
+// never cached, so status changes can't leave a cached URL behind.
+func (u *streamingUsecase) signStreamURL(ctx context.Context, v *domain.VideoMetadata, rendition string, host *domain.DeliveryHost, variant, userID string) (*domain.Playback, error) {
+	// Unpublished videos never reach the cache, so the cache lookups can't hand one out
+	if !v.Published(time.Now()) {
+		return nil, domain.ErrNotPublished
+	}
+
+	// The next episode is cached along with the URL, so anyone hitting the cache gets it too.
+	// It is therefore looked up as an anonymous viewer unless v is private and never cached.
+	playback := &domain.Playback{}
+	if v.SeriesID != "" {
+		viewerID := ""
+		if v.Visibility == domain.VisibilityPrivate {
+			viewerID = userID
+		}
+		next, err := u.metadata.NextEpisode(ctx, v.SeriesID, v.ID, viewerID)
+		if err != nil {
+			return nil, err
+		}
+		playback.NextEpisodeID = next
+	}
+
+	// Premieres that are still playing go through the proxy, which holds viewers to the
+	// premiere's position; a presigned URL would hand out the whole file. They aren't cached,
+	// so the presigned URL takes over once the premiere is over.
+	if v.PremiereLive(time.Now()) {
+		playback.URL = u.contentBaseURL + "/" + v.ID
+		return playback, nil
+	}
+
+	// Live streams are served as HLS by the live service until their recording is in the bucket
+	if v.LiveStatus != "" && v.ObjectKey == "" {
+		playback.URL = u.liveBaseURL + "/" + v.ID + "/index.m3u8"
+		return playback, nil
+	}
+
+	bucket := v.BucketName
+	if bucket == "" {
+		bucket = u.defaultBucket
+	}
+
+	// 4. Presign, either for the host itself or for the origin with the host swapped in
+	signFor := host
+	if host != nil && host.Rewrite {
+		signFor = nil
+	}
+	expiresAt := time.Now().Add(urlExpiry)
+	url, err := u.storage.PresignedGetObject(ctx, signFor, bucket, renditionObjectKey(v.ObjectKey, rendition), urlExpiry, "")
+	if err != nil {
+		return nil, err
+	}
+	if host != nil && host.Rewrite {
+		url.Host = host.Host
+		url.Scheme = "http"
+		if host.Secure {
+			url.Scheme = "https"
+		}
+	}
+
+	playback.URL = url.String()
+	if v.Visibility != domain.VisibilityPrivate && v.Status == domain.StatusReady {
+		u.cache.Set(v.ID, variant, playback, expiresAt)
+	}
+	return playback, nil
+}
+
+func (u *streamingUsecase) InvalidateStreamURL(ctx context.Context, videoID string) error {
+	u.cache.Invalidate(videoID)
+	return nil
 }
 
-func (u *streamingUsecase) GetStreamURL(ctx context.Context, videoID string) (string, error) {
-	// 1. Get Metadata
-	v, err := u.metadata.GetVideo(ctx, videoID)
+func (u *streamingUsecase) GetDownloadURL(ctx context.Context, videoID, userID string) (string, error) {
+	v, err := u.metadata.GetVideo(ctx, videoID, userID)
 	if err != nil {
 		return "", err
 	}
 
+	// Live streams have no original file to hand out until their recording is in the bucket
+	if v.LiveStatus != "" && v.ObjectKey == "" {
+		return "", domain.ErrDownloadNotAllowed
+	}
+
+	// Owners can always fetch their original; everyone else needs the video to allow it.
+	isOwner := userID != "" && userID == v.OwnerID
+	if !isOwner && !v.Published(time.Now()) {
+		return "", domain.ErrNotPublished
+	}
+	if !v.AllowDownload && !isOwner {
+		return "", domain.ErrDownloadNotAllowed
+	}
+	// The original would let viewers skip ahead of a premiere that is still playing
+	if !isOwner && v.PremiereLive(time.Now()) {
+		return "", domain.ErrDownloadNotAllowed
+	}
+
 	bucket := v.BucketName
 	if bucket == "" {
 		bucket = u.defaultBucket
 	}
 
-	// 2. Presign
-	expiry := time.Hour * 1
-	url, err := u.storage.PresignedGetObject(ctx, bucket, v.ObjectKey, expiry)
+	disposition := contentDisposition(downloadFilename(v.Title, v.ObjectKey))
+	url, err := u.storage.PresignedGetObject(ctx, nil, bucket, v.ObjectKey, urlExpiry, disposition)
 	if err != nil {
 		return "", err
 	}
 	return url.String(), nil
 }
+
+// GetVideoMedia looks in the video's directory for its thumbnail and renditions. Sources
+// uploaded outside a directory and live streams still being recorded have neither.
+func (u *streamingUsecase) GetVideoMedia(ctx context.Context, videoID, userID string) (*domain.VideoMedia, error) {
+	v, err := u.metadata.GetVideo(ctx, videoID, userID)
+	if err != nil {
+		return nil, err
+	}
+	media := &domain.VideoMedia{}
+	dir := path.Dir(v.ObjectKey)
+	if v.ObjectKey == "" || dir == "." {
+		return media, nil
+	}
+
+	bucket := v.BucketName
+	if bucket == "" {
+		bucket = u.defaultBucket
+	}
+	keys, err := u.storage.ListObjects(ctx, bucket, dir+"/")
+	if err != nil {
+		return nil, err
+	}
+	thumbnail := path.Join(dir, domain.ThumbnailName)
+	if slices.Contains(keys, thumbnail) {
+		url, err := u.storage.PresignedGetObject(ctx, nil, bucket, thumbnail, urlExpiry, "")
+		if err != nil {
+			return nil, err
+		}
+		media.ThumbnailURL = url.String()
+	}
+	for _, rendition := range domain.Renditions {
+		if slices.Contains(keys, renditionObjectKey(v.ObjectKey, rendition)) {
+			media.Renditions = append(media.Renditions, rendition)
+		}
+	}
+	return media, nil
+}
+
+func (u *streamingUsecase) ListDeliveryHosts(ctx context.Context) []domain.DeliveryHost {
+	return u.hosts.List()
+}
+
+func (u *streamingUsecase) SetDeliveryHostStatus(ctx context.Context, name string, healthy bool) error {
+	return u.hosts.SetHealthy(name, healthy)
+}
+
+// GetChannelImageURL signs a URL to a channel's avatar or banner on the origin. Channel
+// images are public, so anyone may fetch them.
+func (u *streamingUsecase) GetChannelImageURL(ctx context.Context, channelID, kind string) (string, error) {
+	image, err := u.metadata.GetChannelImage(ctx, channelID, kind)
+	if err != nil {
+		return "", err
+	}
+	url, err := u.storage.PresignedGetObject(ctx, nil, image.Bucket, image.ObjectKey, urlExpiry, "")
+	if err != nil {
+		return "", err
+	}
+	return url.String(), nil
+}
+
+// downloadFilename builds "title.ext" from the video title and the uploaded file's
+// extension, dropping characters that are unsafe in a filename or header.
+func downloadFilename(title, objectKey string) string {
+	name := strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsControl(r), strings.ContainsRune(`"\/:*?<>|;`, r):
+			return -1
+		case unicode.IsSpace(r):
+			return ' '
+		}
+		return r
+	}, title)
+	name = strings.Trim(strings.Join(strings.Fields(name), " "), ". ")
+	if name == "" {
+		name = "video"
+	}
+	return name + path.Ext(objectKey)
+}
+
+// contentDisposition formats an attachment header with an ASCII fallback and the
+// UTF-8 filename per RFC 6266.
+func contentDisposition(filename string) string {
+	fallback := strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII {
+			return '_'
+		}
+		return r
+	}, filename)
+	return fmt.Sprintf(`attachment; filename="%s"; filename*=UTF-8''%s`, fallback, encodeExtValue(filename))
+}
+
+// encodeExtValue percent-encodes everything outside RFC 5987 attr-char.
+func encodeExtValue(s string) string {
+	var b strings.Builder
+	for _, c := range []byte(s) {
+		if c < unicode.MaxASCII && (unicode.IsLetter(rune(c)) || unicode.IsDigit(rune(c)) || strings.IndexByte("!#$&+-.^_`|~", c) >= 0) {
+			b.WriteByte(c)
+			continue
+		}
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+	return b.String()
+}
+
+// checkRendition only lets known rendition names through; they become part of object keys
+// and cache keys.
+func checkRendition(rendition string) error {
+	if rendition != "" && !slices.Contains(domain.Renditions, rendition) {
+		return fmt.Errorf("%w: %q", domain.ErrUnknownRendition, rendition)
+	}
+	return nil
+}
+
+// renditionObjectKey maps a rendition name to its object next to the source upload,
+// e.g. "uuid/video.mp4" + "720p" -> "uuid/renditions/720p/video.mp4". The rendition must
+// have passed checkRendition.
+func renditionObjectKey(objectKey, rendition string) string {
+	if rendition == "" {
+		return objectKey
+	}
+	return path.Join(path.Dir(objectKey), "renditions", rendition, path.Base(objectKey))
+}
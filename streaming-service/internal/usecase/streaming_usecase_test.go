@@ -4,19 +4,24 @@ import (
 	"context"
 	"errors"
 	"net/url"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/athandoan/youtube/streaming-service/internal/domain"
 	"github.com/athandoan/youtube/streaming-service/internal/mocks"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestStreamingUsecase_GetStreamURL(t *testing.T) {
 	tests := []struct {
 		name          string
 		videoID       string
+		rendition     string
 		defaultBucket string
-		setupMock     func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService)
+		setupMock     func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache)
 		wantURL       string
 		wantErr       bool
 	}{
@@ -24,19 +29,22 @@ func TestStreamingUsecase_GetStreamURL(t *testing.T) {
 			name:          "success - returns presigned URL with video's bucket",
 			videoID:       "video-123",
 			defaultBucket: "default-bucket",
-			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				cache.EXPECT().Get("video-123", "", gomock.Any()).Return(nil, false)
 				metadata.EXPECT().
-					GetVideo(gomock.Any(), "video-123").
+					GetVideo(gomock.Any(), "video-123", "").
 					Return(&domain.VideoMetadata{
 						ID:         "video-123",
 						BucketName: "custom-bucket",
 						ObjectKey:  "uuid/video.mp4",
+						Status:     "ready",
 					}, nil)
 
 				presignedURL, _ := url.Parse("https://s3.example.com/custom-bucket/uuid/video.mp4?signature=xxx")
 				storage.EXPECT().
-					PresignedGetObject(gomock.Any(), "custom-bucket", "uuid/video.mp4", gomock.Any()).
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "custom-bucket", "uuid/video.mp4", gomock.Any(), "").
 					Return(presignedURL, nil)
+				cache.EXPECT().Set("video-123", "", &domain.Playback{URL: presignedURL.String()}, gomock.Any())
 			},
 			wantURL: "https://s3.example.com/custom-bucket/uuid/video.mp4?signature=xxx",
 			wantErr: false,
@@ -45,19 +53,22 @@ func TestStreamingUsecase_GetStreamURL(t *testing.T) {
 			name:          "success - uses default bucket when video bucket is empty",
 			videoID:       "video-456",
 			defaultBucket: "default-bucket",
-			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				cache.EXPECT().Get("video-456", "", gomock.Any()).Return(nil, false)
 				metadata.EXPECT().
-					GetVideo(gomock.Any(), "video-456").
+					GetVideo(gomock.Any(), "video-456", "").
 					Return(&domain.VideoMetadata{
 						ID:         "video-456",
 						BucketName: "", // Empty bucket
 						ObjectKey:  "uuid/video.mp4",
+						Status:     "ready",
 					}, nil)
 
 				presignedURL, _ := url.Parse("https://s3.example.com/default-bucket/uuid/video.mp4?signature=xxx")
 				storage.EXPECT().
-					PresignedGetObject(gomock.Any(), "default-bucket", "uuid/video.mp4", gomock.Any()).
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "default-bucket", "uuid/video.mp4", gomock.Any(), "").
 					Return(presignedURL, nil)
+				cache.EXPECT().Set("video-456", "", &domain.Playback{URL: presignedURL.String()}, gomock.Any())
 			},
 			wantURL: "https://s3.example.com/default-bucket/uuid/video.mp4?signature=xxx",
 			wantErr: false,
@@ -66,9 +77,9 @@ func TestStreamingUsecase_GetStreamURL(t *testing.T) {
 			name:          "error - video not found",
 			videoID:       "nonexistent-id",
 			defaultBucket: "default-bucket",
-			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
 				metadata.EXPECT().
-					GetVideo(gomock.Any(), "nonexistent-id").
+					GetVideo(gomock.Any(), "nonexistent-id", "").
 					Return(nil, errors.New("video not found"))
 			},
 			wantErr: true,
@@ -77,9 +88,10 @@ func TestStreamingUsecase_GetStreamURL(t *testing.T) {
 			name:          "error - storage service fails to generate presigned URL",
 			videoID:       "video-789",
 			defaultBucket: "default-bucket",
-			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				cache.EXPECT().Get("video-789", "", gomock.Any()).Return(nil, false)
 				metadata.EXPECT().
-					GetVideo(gomock.Any(), "video-789").
+					GetVideo(gomock.Any(), "video-789", "").
 					Return(&domain.VideoMetadata{
 						ID:         "video-789",
 						BucketName: "videos",
@@ -87,7 +99,7 @@ func TestStreamingUsecase_GetStreamURL(t *testing.T) {
 					}, nil)
 
 				storage.EXPECT().
-					PresignedGetObject(gomock.Any(), "videos", "uuid/video.mp4", gomock.Any()).
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "uuid/video.mp4", gomock.Any(), "").
 					Return(nil, errors.New("storage unavailable"))
 			},
 			wantErr: true,
@@ -96,13 +108,85 @@ func TestStreamingUsecase_GetStreamURL(t *testing.T) {
 			name:          "error - metadata service unavailable",
 			videoID:       "video-123",
 			defaultBucket: "default-bucket",
-			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
 				metadata.EXPECT().
-					GetVideo(gomock.Any(), "video-123").
+					GetVideo(gomock.Any(), "video-123", "").
 					Return(nil, errors.New("connection refused"))
 			},
 			wantErr: true,
 		},
+		{
+			name:          "success - returns cached URL once metadata allows playing",
+			videoID:       "video-123",
+			defaultBucket: "default-bucket",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				metadata.EXPECT().
+					GetVideo(gomock.Any(), "video-123", "").
+					Return(&domain.VideoMetadata{ID: "video-123", BucketName: "videos", ObjectKey: "uuid/video.mp4", Status: "ready"}, nil)
+				cache.EXPECT().
+					Get("video-123", "", gomock.Any()).
+					Return(&domain.Playback{URL: "https://s3.example.com/videos/uuid/video.mp4?signature=cached"}, true)
+			},
+			wantURL: "https://s3.example.com/videos/uuid/video.mp4?signature=cached",
+			wantErr: false,
+		},
+		{
+			name:          "success - presigns rendition object next to the source",
+			videoID:       "video-123",
+			rendition:     "720p",
+			defaultBucket: "default-bucket",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				cache.EXPECT().Get("video-123", "720p", gomock.Any()).Return(nil, false)
+				metadata.EXPECT().
+					GetVideo(gomock.Any(), "video-123", "").
+					Return(&domain.VideoMetadata{
+						ID:         "video-123",
+						BucketName: "videos",
+						ObjectKey:  "uuid/video.mp4",
+						Status:     "ready",
+					}, nil)
+
+				presignedURL, _ := url.Parse("https://s3.example.com/videos/uuid/renditions/720p/video.mp4?signature=xxx")
+				storage.EXPECT().
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "uuid/renditions/720p/video.mp4", gomock.Any(), "").
+					Return(presignedURL, nil)
+				cache.EXPECT().Set("video-123", "720p", &domain.Playback{URL: presignedURL.String()}, gomock.Any())
+			},
+			wantURL: "https://s3.example.com/videos/uuid/renditions/720p/video.mp4?signature=xxx",
+			wantErr: false,
+		},
+		{
+			name:          "success - doesn't cache a video that isn't ready",
+			videoID:       "video-123",
+			defaultBucket: "default-bucket",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				cache.EXPECT().Get("video-123", "", gomock.Any()).Return(nil, false)
+				metadata.EXPECT().
+					GetVideo(gomock.Any(), "video-123", "").
+					Return(&domain.VideoMetadata{
+						ID:         "video-123",
+						BucketName: "videos",
+						ObjectKey:  "uuid/video.mp4",
+						Status:     "processing",
+					}, nil)
+
+				presignedURL, _ := url.Parse("https://s3.example.com/videos/uuid/video.mp4?signature=xxx")
+				storage.EXPECT().
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "uuid/video.mp4", gomock.Any(), "").
+					Return(presignedURL, nil)
+			},
+			wantURL: "https://s3.example.com/videos/uuid/video.mp4?signature=xxx",
+			wantErr: false,
+		},
+		{
+			name:          "error - unknown rendition never reaches the cache or storage",
+			videoID:       "video-123",
+			rendition:     "../../other-id",
+			defaultBucket: "default-bucket",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -112,52 +196,606 @@ func TestStreamingUsecase_GetStreamURL(t *testing.T) {
 
 			mockStorage := mocks.NewMockStorageService(ctrl)
 			mockMetadata := mocks.NewMockMetadataService(ctrl)
-			tt.setupMock(mockStorage, mockMetadata)
+			mockCache := mocks.NewMockURLCache(ctrl)
+			mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+			mockHosts.EXPECT().Select(gomock.Any()).Return(nil, false).AnyTimes()
+			tt.setupMock(mockStorage, mockMetadata, mockCache)
 
-			uc := NewStreamingUsecase(mockStorage, mockMetadata, tt.defaultBucket)
-			gotURL, err := uc.GetStreamURL(context.Background(), tt.videoID)
+			uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, tt.defaultBucket, "http://localhost:8080/live", "")
+			got, err := uc.GetStreamURL(context.Background(), tt.videoID, tt.rendition, "", "")
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetStreamURL() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
 
-			if !tt.wantErr && gotURL != tt.wantURL {
-				t.Errorf("GetStreamURL() = %v, want %v", gotURL, tt.wantURL)
+			if !tt.wantErr && got.URL != tt.wantURL {
+				t.Errorf("GetStreamURL() = %v, want %v", got.URL, tt.wantURL)
 			}
 		})
 	}
 }
 
+func TestStreamingUsecase_GetStreamURL_Live(t *testing.T) {
+	presignedURL, _ := url.Parse("https://s3.example.com/videos/video-123/recording.mp4?signature=xxx")
+	tests := []struct {
+		name    string
+		video   *domain.VideoMetadata
+		presign bool
+		wantURL string
+	}{
+		{
+			name:    "live - the live playlist",
+			video:   &domain.VideoMetadata{ID: "video-123", Status: "ready", LiveStatus: "live"},
+			wantURL: "http://localhost:8080/live/video-123/index.m3u8",
+		},
+		{
+			name:    "ended - the recording's playlist until it is in the bucket",
+			video:   &domain.VideoMetadata{ID: "video-123", Status: "processing", LiveStatus: "ended"},
+			wantURL: "http://localhost:8080/live/video-123/index.m3u8",
+		},
+		{
+			name:    "ended - the recording in the bucket like an upload",
+			video:   &domain.VideoMetadata{ID: "video-123", Status: "ready", LiveStatus: "ended", BucketName: "videos", ObjectKey: "video-123/recording.mp4"},
+			presign: true,
+			wantURL: presignedURL.String(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockCache := mocks.NewMockURLCache(ctrl)
+			mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+			mockHosts.EXPECT().Select("").Return(nil, false)
+			mockCache.EXPECT().Get("video-123", "", gomock.Any()).Return(nil, false)
+			mockMetadata.EXPECT().GetVideo(gomock.Any(), "video-123", "").Return(tt.video, nil)
+			if tt.presign {
+				mockStorage.EXPECT().
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "video-123/recording.mp4", gomock.Any(), "").
+					Return(presignedURL, nil)
+				mockCache.EXPECT().Set("video-123", "", &domain.Playback{URL: presignedURL.String()}, gomock.Any())
+			}
+
+			uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "http://localhost:8080/live/", "")
+			got, err := uc.GetStreamURL(context.Background(), "video-123", "", "", "")
+			if err != nil {
+				t.Fatalf("GetStreamURL() unexpected error: %v", err)
+			}
+			if got.URL != tt.wantURL {
+				t.Errorf("GetStreamURL() = %v, want %v", got.URL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestStreamingUsecase_GetStreamURL_Private(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorageService(ctrl)
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockCache := mocks.NewMockURLCache(ctrl)
+	mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+	mockHosts.EXPECT().Select("").Return(nil, false)
+	// No cache.Get either: a URL cached before the video went private must not be handed out
+	mockMetadata.EXPECT().
+		GetVideo(gomock.Any(), "video-123", "owner-1").
+		Return(&domain.VideoMetadata{ID: "video-123", BucketName: "videos", ObjectKey: "uuid/video.mp4", OwnerID: "owner-1", Visibility: domain.VisibilityPrivate}, nil)
+	presignedURL, _ := url.Parse("https://s3.example.com/videos/uuid/video.mp4?signature=xxx")
+	mockStorage.EXPECT().
+		PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "uuid/video.mp4", gomock.Any(), "").
+		Return(presignedURL, nil)
+	// No cache.Set: a cached URL would be handed out without checking the next viewer
+
+	uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "", "")
+	if _, err := uc.GetStreamURL(context.Background(), "video-123", "", "", "owner-1"); err != nil {
+		t.Errorf("GetStreamURL() unexpected error: %v", err)
+	}
+}
+
+func TestStreamingUsecase_GetStreamURL_NextEpisode(t *testing.T) {
+	tests := []struct {
+		name       string
+		visibility string
+		nextViewer string
+		cached     bool
+	}{
+		{name: "public episode - looked up anonymously and cached", visibility: "public", nextViewer: "", cached: true},
+		{name: "private episode - looked up for the viewer", visibility: domain.VisibilityPrivate, nextViewer: "user-1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockCache := mocks.NewMockURLCache(ctrl)
+			mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+			mockHosts.EXPECT().Select("").Return(nil, false)
+			if tt.cached {
+				mockCache.EXPECT().Get("ep-1", "", gomock.Any()).Return(nil, false)
+			}
+			mockMetadata.EXPECT().
+				GetVideo(gomock.Any(), "ep-1", "user-1").
+				Return(&domain.VideoMetadata{ID: "ep-1", BucketName: "videos", ObjectKey: "uuid/video.mp4", Status: "ready", Visibility: tt.visibility, SeriesID: "series-1"}, nil)
+			mockMetadata.EXPECT().NextEpisode(gomock.Any(), "series-1", "ep-1", tt.nextViewer).Return("ep-2", nil)
+			presignedURL, _ := url.Parse("https://s3.example.com/videos/uuid/video.mp4?signature=xxx")
+			mockStorage.EXPECT().
+				PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "uuid/video.mp4", gomock.Any(), "").
+				Return(presignedURL, nil)
+			want := &domain.Playback{URL: presignedURL.String(), NextEpisodeID: "ep-2"}
+			if tt.cached {
+				mockCache.EXPECT().Set("ep-1", "", want, gomock.Any())
+			}
+
+			uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "", "")
+			got, err := uc.GetStreamURL(context.Background(), "ep-1", "", "", "user-1")
+			if err != nil {
+				t.Fatalf("GetStreamURL() unexpected error: %v", err)
+			}
+			if *got != *want {
+				t.Errorf("GetStreamURL() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestStreamingUsecase_GetSharedStreamURL(t *testing.T) {
+	presignedURL, _ := url.Parse("https://s3.example.com/videos/uuid/video.mp4?signature=xxx")
+	tests := []struct {
+		name      string
+		setupMock func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache)
+		wantURL   string
+		wantCode  codes.Code
+	}{
+		{
+			name: "success - signs a private video without caching it",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				metadata.EXPECT().ResolveShareLink(gomock.Any(), "token", "hunter2").
+					Return(&domain.VideoMetadata{ID: "video-123", BucketName: "videos", ObjectKey: "uuid/video.mp4", Visibility: domain.VisibilityPrivate}, nil)
+				storage.EXPECT().
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "uuid/video.mp4", gomock.Any(), "").
+					Return(presignedURL, nil)
+			},
+			wantURL:  presignedURL.String(),
+			wantCode: codes.OK,
+		},
+		{
+			name: "success - serves a public video from the cache after resolving the link",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				metadata.EXPECT().ResolveShareLink(gomock.Any(), "token", "hunter2").
+					Return(&domain.VideoMetadata{ID: "video-123", ObjectKey: "uuid/video.mp4"}, nil)
+				cache.EXPECT().Get("video-123", "", gomock.Any()).Return(&domain.Playback{URL: "https://cached.example.com/video.mp4"}, true)
+			},
+			wantURL:  "https://cached.example.com/video.mp4",
+			wantCode: codes.OK,
+		},
+		{
+			name: "error - wrong password",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, cache *mocks.MockURLCache) {
+				metadata.EXPECT().ResolveShareLink(gomock.Any(), "token", "hunter2").
+					Return(nil, status.Error(codes.PermissionDenied, "wrong share link password"))
+			},
+			wantCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockCache := mocks.NewMockURLCache(ctrl)
+			mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+			mockHosts.EXPECT().Select("").Return(nil, false).AnyTimes()
+			tt.setupMock(mockStorage, mockMetadata, mockCache)
+
+			uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "", "")
+			got, videoID, err := uc.GetSharedStreamURL(context.Background(), "token", "hunter2", "", "")
+			if status.Code(err) != tt.wantCode {
+				t.Fatalf("GetSharedStreamURL() error = %v, want code %v", err, tt.wantCode)
+			}
+			if err == nil && (got != tt.wantURL || videoID != "video-123") {
+				t.Errorf("GetSharedStreamURL() = %v, %v, want %v, video-123", got, videoID, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestStreamingUsecase_GetStreamURL_Scheduled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorageService(ctrl)
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockCache := mocks.NewMockURLCache(ctrl)
+	mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+	// Checked before the cache, so a video scheduled again after it was cached stops playing
+	mockMetadata.EXPECT().
+		GetVideo(gomock.Any(), "video-123", "").
+		Return(&domain.VideoMetadata{ID: "video-123", ObjectKey: "uuid/video.mp4", PublishAt: time.Now().Add(time.Hour)}, nil)
+
+	uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "", "")
+	if _, err := uc.GetStreamURL(context.Background(), "video-123", "", "", ""); !errors.Is(err, domain.ErrNotPublished) {
+		t.Errorf("GetStreamURL() error = %v, want %v", err, domain.ErrNotPublished)
+	}
+}
+
+func TestStreamingUsecase_GetStreamURL_Premiere(t *testing.T) {
+	presignedURL, _ := url.Parse("https://s3.example.com/videos/uuid/video.mp4?signature=xxx")
+	tests := []struct {
+		name      string
+		publishAt time.Time
+		presign   bool
+		wantURL   string
+	}{
+		{
+			name:      "playing - the proxy, which holds viewers to the premiere",
+			publishAt: time.Now().Add(-time.Minute),
+			wantURL:   "http://localhost:8080/api/content/videos/video-123",
+		},
+		{
+			name:      "over - a presigned URL like any upload",
+			publishAt: time.Now().Add(-time.Hour),
+			presign:   true,
+			wantURL:   presignedURL.String(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockCache := mocks.NewMockURLCache(ctrl)
+			mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+			mockHosts.EXPECT().Select("").Return(nil, false)
+			mockCache.EXPECT().Get("video-123", "", gomock.Any()).Return(nil, false)
+			mockMetadata.EXPECT().
+				GetVideo(gomock.Any(), "video-123", "").
+				Return(&domain.VideoMetadata{ID: "video-123", BucketName: "videos", ObjectKey: "uuid/video.mp4", Status: "ready", Premiere: true, Duration: 10 * time.Minute, PublishAt: tt.publishAt}, nil)
+			// Only the presigned URL is cached; the proxy URL would outlive the premiere
+			if tt.presign {
+				mockStorage.EXPECT().
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "uuid/video.mp4", gomock.Any(), "").
+					Return(presignedURL, nil)
+				mockCache.EXPECT().Set("video-123", "", &domain.Playback{URL: presignedURL.String()}, gomock.Any())
+			}
+
+			uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "", "http://localhost:8080/api/content/videos/")
+			got, err := uc.GetStreamURL(context.Background(), "video-123", "", "", "")
+			if err != nil {
+				t.Fatalf("GetStreamURL() unexpected error: %v", err)
+			}
+			if got.URL != tt.wantURL {
+				t.Errorf("GetStreamURL() = %v, want %v", got.URL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestStreamingUsecase_GetSharedStreamURL_PrivatePremiere(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorageService(ctrl)
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockCache := mocks.NewMockURLCache(ctrl)
+	mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+	mockMetadata.EXPECT().ResolveShareLink(gomock.Any(), "token", "").
+		Return(&domain.VideoMetadata{ID: "video-123", ObjectKey: "uuid/video.mp4", Visibility: domain.VisibilityPrivate, Premiere: true, Duration: 10 * time.Minute, PublishAt: time.Now().Add(-time.Minute)}, nil)
+
+	uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "", "")
+	if _, _, err := uc.GetSharedStreamURL(context.Background(), "token", "", "", ""); !errors.Is(err, domain.ErrNotPublished) {
+		t.Errorf("GetSharedStreamURL() error = %v, want %v", err, domain.ErrNotPublished)
+	}
+}
+
 func TestStreamingUsecase_GetStreamURL_ContextPropagation(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockStorage := mocks.NewMockStorageService(ctrl)
 	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockCache := mocks.NewMockURLCache(ctrl)
+	mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+	mockHosts.EXPECT().Select("").Return(nil, false)
 
 	type ctxKey string
 	const testKey ctxKey = "test-key"
 	ctx := context.WithValue(context.Background(), testKey, "test-value")
 
+	mockCache.EXPECT().Get("video-123", "", gomock.Any()).Return(nil, false)
+	mockCache.EXPECT().Set("video-123", "", gomock.Any(), gomock.Any())
+
 	// Verify context is propagated correctly
 	mockMetadata.EXPECT().
-		GetVideo(ctx, "video-123").
+		GetVideo(ctx, "video-123", "").
 		Return(&domain.VideoMetadata{
 			ID:         "video-123",
 			BucketName: "videos",
 			ObjectKey:  "test.mp4",
+			Status:     "ready",
 		}, nil)
 
 	presignedURL, _ := url.Parse("https://example.com/presigned")
 	mockStorage.EXPECT().
-		PresignedGetObject(ctx, "videos", "test.mp4", gomock.Any()).
+		PresignedGetObject(ctx, gomock.Nil(), "videos", "test.mp4", gomock.Any(), "").
 		Return(presignedURL, nil)
 
-	uc := NewStreamingUsecase(mockStorage, mockMetadata, "default")
-	_, err := uc.GetStreamURL(ctx, "video-123")
+	uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "http://localhost:8080/live", "")
+	_, err := uc.GetStreamURL(ctx, "video-123", "", "", "")
 
 	if err != nil {
 		t.Errorf("GetStreamURL() unexpected error: %v", err)
 	}
 }
+
+func TestStreamingUsecase_GetStreamURL_DeliveryHost(t *testing.T) {
+	tests := []struct {
+		name    string
+		host    *domain.DeliveryHost
+		signFor gomock.Matcher
+		wantURL string
+	}{
+		{
+			name:    "signs for the selected host",
+			host:    &domain.DeliveryHost{Name: "eu-cdn", Host: "eu.cdn.example.com", Region: "eu", Secure: true},
+			signFor: gomock.Not(gomock.Nil()),
+			wantURL: "https://eu.cdn.example.com/videos/uuid/video.mp4?signature=xxx",
+		},
+		{
+			name:    "rewrite host signs for the origin and swaps the hostname",
+			host:    &domain.DeliveryHost{Name: "eu-cdn", Host: "eu.cdn.example.com", Region: "eu", Rewrite: true},
+			signFor: gomock.Nil(),
+			wantURL: "http://eu.cdn.example.com/videos/uuid/video.mp4?signature=xxx",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockCache := mocks.NewMockURLCache(ctrl)
+			mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+			mockHosts.EXPECT().Select("eu").Return(tt.host, true)
+			mockCache.EXPECT().Get("video-123", "@eu-cdn", gomock.Any()).Return(nil, false)
+			mockMetadata.EXPECT().GetVideo(gomock.Any(), "video-123", "").Return(&domain.VideoMetadata{
+				ID:         "video-123",
+				BucketName: "videos",
+				ObjectKey:  "uuid/video.mp4",
+				Status:     "ready",
+			}, nil)
+
+			signed := "https://eu.cdn.example.com/videos/uuid/video.mp4?signature=xxx"
+			if tt.host.Rewrite {
+				signed = "https://s3.example.com/videos/uuid/video.mp4?signature=xxx"
+			}
+			presignedURL, _ := url.Parse(signed)
+			mockStorage.EXPECT().
+				PresignedGetObject(gomock.Any(), tt.signFor, "videos", "uuid/video.mp4", gomock.Any(), "").
+				Return(presignedURL, nil)
+			mockCache.EXPECT().Set("video-123", "@eu-cdn", &domain.Playback{URL: tt.wantURL}, gomock.Any())
+
+			uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "http://localhost:8080/live", "")
+			got, err := uc.GetStreamURL(context.Background(), "video-123", "", "eu", "")
+			if err != nil {
+				t.Fatalf("GetStreamURL() unexpected error: %v", err)
+			}
+			if got.URL != tt.wantURL {
+				t.Errorf("GetStreamURL() = %v, want %v", got.URL, tt.wantURL)
+			}
+		})
+	}
+}
+
+func TestStreamingUsecase_InvalidateStreamURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorageService(ctrl)
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+	mockCache := mocks.NewMockURLCache(ctrl)
+	mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+	mockCache.EXPECT().Invalidate("video-123")
+
+	uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "http://localhost:8080/live", "")
+	if err := uc.InvalidateStreamURL(context.Background(), "video-123"); err != nil {
+		t.Errorf("InvalidateStreamURL() unexpected error: %v", err)
+	}
+}
+
+func TestStreamingUsecase_GetDownloadURL(t *testing.T) {
+	tests := []struct {
+		name            string
+		userID          string
+		video           *domain.VideoMetadata
+		wantDisposition string
+		wantErr         error
+	}{
+		{
+			name:            "success - downloadable video for any viewer",
+			video:           &domain.VideoMetadata{ID: "video-123", Title: "Team Demo", BucketName: "videos", ObjectKey: "uuid/demo.mp4", AllowDownload: true},
+			wantDisposition: `attachment; filename="Team Demo.mp4"; filename*=UTF-8''Team%20Demo.mp4`,
+		},
+		{
+			name:            "success - owner downloads own original",
+			userID:          "user-1",
+			video:           &domain.VideoMetadata{ID: "video-123", Title: "Café: Tour/1", BucketName: "videos", ObjectKey: "uuid/raw.MOV", OwnerID: "user-1"},
+			wantDisposition: `attachment; filename="Caf_ Tour1.MOV"; filename*=UTF-8''Caf%C3%A9%20Tour1.MOV`,
+		},
+		{
+			name:    "error - download not allowed for other viewers",
+			userID:  "user-2",
+			video:   &domain.VideoMetadata{ID: "video-123", Title: "Private", BucketName: "videos", ObjectKey: "uuid/raw.mp4", OwnerID: "user-1"},
+			wantErr: domain.ErrDownloadNotAllowed,
+		},
+		{
+			name:    "error - anonymous viewer cannot claim an unowned video",
+			video:   &domain.VideoMetadata{ID: "video-123", Title: "Unowned", BucketName: "videos", ObjectKey: "uuid/raw.mp4"},
+			wantErr: domain.ErrDownloadNotAllowed,
+		},
+		{
+			name:    "error - premiere that is still playing",
+			userID:  "user-2",
+			video:   &domain.VideoMetadata{ID: "video-123", Title: "Premiere", BucketName: "videos", ObjectKey: "uuid/raw.mp4", OwnerID: "user-1", AllowDownload: true, Premiere: true, Duration: time.Hour, PublishAt: time.Now().Add(-time.Minute)},
+			wantErr: domain.ErrDownloadNotAllowed,
+		},
+		{
+			name:    "error - live stream without a recording yet",
+			userID:  "user-1",
+			video:   &domain.VideoMetadata{ID: "video-123", Title: "Live", OwnerID: "user-1", LiveStatus: "ended"},
+			wantErr: domain.ErrDownloadNotAllowed,
+		},
+		{
+			name:            "success - recording of an ended stream",
+			userID:          "user-1",
+			video:           &domain.VideoMetadata{ID: "video-123", Title: "Live", BucketName: "videos", ObjectKey: "video-123/recording.mp4", OwnerID: "user-1", LiveStatus: "ended"},
+			wantDisposition: `attachment; filename="Live.mp4"; filename*=UTF-8''Live.mp4`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockCache := mocks.NewMockURLCache(ctrl)
+			mockHosts := mocks.NewMockDeliveryHosts(ctrl)
+
+			mockMetadata.EXPECT().GetVideo(gomock.Any(), "video-123", tt.userID).Return(tt.video, nil)
+			if tt.wantErr == nil {
+				presignedURL, _ := url.Parse("https://s3.example.com/videos/" + tt.video.ObjectKey + "?signature=xxx")
+				mockStorage.EXPECT().
+					PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", tt.video.ObjectKey, gomock.Any(), tt.wantDisposition).
+					Return(presignedURL, nil)
+			}
+
+			uc := NewStreamingUsecase(mockStorage, mockMetadata, mockCache, mockHosts, "default", "http://localhost:8080/live", "")
+			_, err := uc.GetDownloadURL(context.Background(), "video-123", tt.userID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("GetDownloadURL() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStreamingUsecase_GetChannelImageURL(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockStorage := mocks.NewMockStorageService(ctrl)
+	mockMetadata := mocks.NewMockMetadataService(ctrl)
+
+	mockMetadata.EXPECT().
+		GetChannelImage(gomock.Any(), "ch-1", "avatar").
+		Return(&domain.ChannelImage{Bucket: "videos", ObjectKey: "channels/ch-1/avatar-1.png"}, nil)
+	presignedURL, _ := url.Parse("https://s3.example.com/videos/channels/ch-1/avatar-1.png?signature=xxx")
+	mockStorage.EXPECT().
+		PresignedGetObject(gomock.Any(), gomock.Nil(), "videos", "channels/ch-1/avatar-1.png", urlExpiry, "").
+		Return(presignedURL, nil)
+	mockMetadata.EXPECT().
+		GetChannelImage(gomock.Any(), "ch-1", "banner").
+		Return(nil, domain.ErrNoChannelImage)
+
+	uc := NewStreamingUsecase(mockStorage, mockMetadata, nil, nil, "default", "", "")
+	got, err := uc.GetChannelImageURL(context.Background(), "ch-1", "avatar")
+	if err != nil || got != presignedURL.String() {
+		t.Errorf("GetChannelImageURL() = %q, %v, want %q", got, err, presignedURL.String())
+	}
+	if _, err := uc.GetChannelImageURL(context.Background(), "ch-1", "banner"); !errors.Is(err, domain.ErrNoChannelImage) {
+		t.Errorf("GetChannelImageURL() without a banner error = %v, want %v", err, domain.ErrNoChannelImage)
+	}
+}
+
+func TestStreamingUsecase_GetVideoMedia(t *testing.T) {
+	tests := []struct {
+		name           string
+		video          *domain.VideoMetadata
+		keys           []string
+		wantThumbnail  bool
+		wantRenditions []string
+	}{
+		{
+			name:          "live recording with a thumbnail",
+			video:         &domain.VideoMetadata{ID: "video-1", ObjectKey: "video-1/recording.mp4", LiveStatus: "ended"},
+			keys:          []string{"video-1/recording.mp4", "video-1/thumbnail.jpg"},
+			wantThumbnail: true,
+		},
+		{
+			name:  "upload with renditions",
+			video: &domain.VideoMetadata{ID: "video-1", BucketName: "uploads", ObjectKey: "video-1/video.mp4"},
+			keys: []string{"video-1/video.mp4", "video-1/renditions/720p/video.mp4", "video-1/renditions/240p/video.mp4",
+				"video-1/renditions/4k/video.mp4", "video-1/renditions/480p/other.mp4"},
+			wantRenditions: []string{"240p", "720p"},
+		},
+		{
+			name:  "live stream still being recorded",
+			video: &domain.VideoMetadata{ID: "video-1", LiveStatus: "live"},
+		},
+		{
+			name:  "upload outside a directory",
+			video: &domain.VideoMetadata{ID: "video-1", ObjectKey: "video.mp4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockMetadata.EXPECT().GetVideo(gomock.Any(), "video-1", "user-1").Return(tt.video, nil)
+
+			bucket := tt.video.BucketName
+			if bucket == "" {
+				bucket = "default"
+			}
+			if tt.keys != nil {
+				mockStorage.EXPECT().ListObjects(gomock.Any(), bucket, "video-1/").Return(tt.keys, nil)
+			}
+			presignedURL, _ := url.Parse("https://s3.example.com/default/video-1/thumbnail.jpg?signature=xxx")
+			if tt.wantThumbnail {
+				mockStorage.EXPECT().PresignedGetObject(gomock.Any(), gomock.Nil(), bucket, "video-1/thumbnail.jpg", urlExpiry, "").Return(presignedURL, nil)
+			}
+
+			uc := NewStreamingUsecase(mockStorage, mockMetadata, nil, nil, "default", "", "")
+			media, err := uc.GetVideoMedia(context.Background(), "video-1", "user-1")
+			if err != nil {
+				t.Fatalf("GetVideoMedia() error = %v", err)
+			}
+			if (media.ThumbnailURL != "") != tt.wantThumbnail || tt.wantThumbnail && media.ThumbnailURL != presignedURL.String() {
+				t.Errorf("ThumbnailURL = %q, want thumbnail %v", media.ThumbnailURL, tt.wantThumbnail)
+			}
+			if !slices.Equal(media.Renditions, tt.wantRenditions) {
+				t.Errorf("Renditions = %v, want %v", media.Renditions, tt.wantRenditions)
+			}
+		})
+	}
+}
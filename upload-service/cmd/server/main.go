@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
 	"os"
+	"time"
 
 	pb "github.com/athandoan/youtube/proto/upload"
 	handler "github.com/athandoan/youtube/upload-service/internal/delivery/grpc"
+	"github.com/athandoan/youtube/upload-service/internal/delivery/scheduler"
+	"github.com/athandoan/youtube/upload-service/internal/infrastructure/probe"
 	"github.com/athandoan/youtube/upload-service/internal/infrastructure/rpc"
 	"github.com/athandoan/youtube/upload-service/internal/infrastructure/storage"
 	"github.com/athandoan/youtube/upload-service/internal/usecase"
@@ -27,7 +31,7 @@ func main() {
 		externalEndpoint = minioEndpoint
 	}
 
-	storageService, err := storage.NewMinioStorage(externalEndpoint, minioAccessKey, minioSecretKey, useSSL, "us-east-1")
+	storageService, err := storage.NewMinioStorage(minioEndpoint, externalEndpoint, minioAccessKey, minioSecretKey, useSSL, "us-east-1")
 	if err != nil {
 		log.Fatalf("failed to create storage service: %v", err)
 	}
@@ -42,13 +46,22 @@ func main() {
 		log.Fatalf("failed to create metadata client: %v", err)
 	}
 
-	// 3. Init Usecase
-	uc := usecase.NewUploadUsecase(storageService, metadataService, bucketName)
+	// 3. Init Usecase, which probes completed uploads for their duration with ffprobe
+	uc := usecase.NewUploadUsecase(storageService, metadataService, probe.NewFFprobe(), bucketName)
 
-	// 4. Init Handler
+	// 4. Start the storage cleanup loop, removing the objects of deleted videos
+	cleanupInterval := time.Minute
+	if v := os.Getenv("STORAGE_CLEANUP_INTERVAL"); v != "" {
+		if cleanupInterval, err = time.ParseDuration(v); err != nil || cleanupInterval <= 0 {
+			log.Fatalf("invalid STORAGE_CLEANUP_INTERVAL: %q", v)
+		}
+	}
+	go scheduler.NewCleaner(uc, cleanupInterval).Run(context.Background())
+
+	// 5. Init Handler
 	h := handler.NewUploadHandler(uc)
 
-	// 5. Start gRPC Server
+	// 6. Start gRPC Server
 	port := os.Getenv("GRPC_PORT")
 	if port == "" {
 		port = "50052"
@@ -58,7 +71,8 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	s := grpc.NewServer()
+	// Only signed-in users, identified by the gateway, can upload
+	s := grpc.NewServer(grpc.UnaryInterceptor(handler.AuthInterceptor))
 	pb.RegisterUploadServiceServer(s, h)
 
 	log.Printf("Upload Service (gRPC) running on :%s", port)
@@ -0,0 +1,39 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/athandoan/youtube/upload-service/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// The gateway sends the signed-in user it calls for in these metadata keys.
+const (
+	userIDKey    = "x-user-id"
+	userRolesKey = "x-user-roles"
+)
+
+type callerKey struct{}
+
+// AuthInterceptor turns away anonymous callers; every upload RPC is made by a user, who
+// becomes the owner of what they upload.
+func AuthInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	ids := md.Get(userIDKey)
+	if len(ids) == 0 || ids[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "sign in to upload")
+	}
+	caller := &domain.Caller{UserID: ids[0], Roles: md.Get(userRolesKey)}
+	return handler(context.WithValue(ctx, callerKey{}, caller), req)
+}
+
+// callerFrom returns the user AuthInterceptor identified.
+func callerFrom(ctx context.Context) *domain.Caller {
+	if caller, ok := ctx.Value(callerKey{}).(*domain.Caller); ok {
+		return caller
+	}
+	return &domain.Caller{}
+}
@@ -2,9 +2,12 @@ package grpc
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/athandoan/youtube/proto/upload"
 	"github.com/athandoan/youtube/upload-service/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type UploadHandler struct {
@@ -17,7 +20,7 @@ func NewUploadHandler(u domain.UploadUsecase) *UploadHandler {
 }
 
 func (h *UploadHandler) InitUpload(ctx context.Context, req *pb.InitUploadRequest) (*pb.InitUploadResponse, error) {
-	videoID, url, err := h.Usecase.InitUpload(ctx, req.Title, req.Filename)
+	videoID, url, err := h.Usecase.InitUpload(ctx, callerFrom(ctx).UserID, req.ChannelId, req.Title, req.Filename, req.AllowDownload, req.PublishAt, req.Premiere)
 	if err != nil {
 		return nil, err
 	}
@@ -28,9 +31,29 @@ func (h *UploadHandler) InitUpload(ctx context.Context, req *pb.InitUploadReques
 }
 
 func (h *UploadHandler) CompleteUpload(ctx context.Context, req *pb.CompleteUploadRequest) (*pb.CompleteUploadResponse, error) {
-	err := h.Usecase.CompleteUpload(ctx, req.VideoId)
+	err := h.Usecase.CompleteUpload(ctx, req.VideoId, callerFrom(ctx))
 	if err != nil {
+		if errors.Is(err, domain.ErrForbidden) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, err
 	}
 	return &pb.CompleteUploadResponse{Status: "success"}, nil
 }
+
+func (h *UploadHandler) InitChannelImageUpload(ctx context.Context, req *pb.InitChannelImageUploadRequest) (*pb.InitChannelImageUploadResponse, error) {
+	if req.ChannelId == "" {
+		return nil, status.Error(codes.InvalidArgument, "channel_id is required")
+	}
+	url, err := h.Usecase.InitChannelImageUpload(ctx, callerFrom(ctx), req.ChannelId, req.Kind, req.Filename)
+	if err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidImage):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, domain.ErrNotChannelOwner):
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
+		return nil, err
+	}
+	return &pb.InitChannelImageUploadResponse{PresignedUrl: url}, nil
+}
@@ -0,0 +1,39 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/athandoan/youtube/upload-service/internal/domain"
+)
+
+// Cleaner removes the stored objects of deleted videos.
+type Cleaner struct {
+	usecase  domain.UploadUsecase
+	interval time.Duration
+}
+
+func NewCleaner(u domain.UploadUsecase, interval time.Duration) *Cleaner {
+	return &Cleaner{usecase: u, interval: interval}
+}
+
+// Run cleans up due videos every interval until ctx is done.
+func (c *Cleaner) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		n, err := c.usecase.CleanupStorage(ctx)
+		if err != nil {
+			log.Printf("failed to clean up storage: %v", err)
+		} else if n > 0 {
+			log.Printf("removed the objects of %d deleted videos", n)
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
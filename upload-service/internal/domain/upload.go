@@ -4,20 +4,95 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"net/url"
+	"slices"
 	"time"
 )
 
+var (
+	ErrForbidden       = errors.New("only the uploader or an admin can do that")
+	ErrNotChannelOwner = errors.New("only the channel's owner or an admin can change its images")
+	ErrInvalidImage    = errors.New("invalid channel image")
+)
+
+// Kinds of channel images.
+const (
+	ChannelImageAvatar = "avatar"
+	ChannelImageBanner = "banner"
+)
+
+// ImageExtensions are the file types channel images may be uploaded as.
+var ImageExtensions = []string{".jpg", ".jpeg", ".png", ".webp"}
+
+// RoleAdmin may complete anyone's upload.
+const RoleAdmin = "admin"
+
+// Caller is the signed-in user an upload RPC is made for.
+type Caller struct {
+	UserID string
+	Roles  []string
+}
+
+func (c *Caller) IsAdmin() bool {
+	return slices.Contains(c.Roles, RoleAdmin)
+}
+
+// Video is an upload as the metadata service knows it.
+type Video struct {
+	ID        string
+	OwnerID   string // empty if nobody owns it
+	Bucket    string
+	ObjectKey string
+}
+
+// StorageCleanup is a deleted video whose objects under Prefix are still to be removed. A
+// Prefix that doesn't end in a slash is the key of the video's only object.
+type StorageCleanup struct {
+	VideoID  string
+	Bucket   string
+	Prefix   string
+	Attempts int
+}
+
 type StorageService interface {
 	PresignedPutObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error)
+	// PresignedGetObject signs a download for this service's own use, on the internal endpoint.
+	PresignedGetObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error)
+	// RemovePrefix removes every object under prefix and returns how many it removed.
+	RemovePrefix(ctx context.Context, bucket, prefix string) (int, error)
+	// RemoveObject removes the object with exactly objectKey; a missing one is not an error.
+	RemoveObject(ctx context.Context, bucket, objectKey string) error
 }
 
 type MetadataService interface {
-	CreateVideo(ctx context.Context, title, bucket, objectKey string) (string, error)
-	UpdateVideoStatus(ctx context.Context, id, status string) error
+	CreateVideo(ctx context.Context, ownerID, channelID, title, bucket, objectKey string, allowDownload bool, publishAt string, premiere bool) (string, error)
+	GetVideo(ctx context.Context, id string) (*Video, error)
+	// UpdateVideoStatus sets a video's status along with its probed duration, 0 when unknown.
+	UpdateVideoStatus(ctx context.Context, id, status string, duration time.Duration) error
+	// GetChannelOwner returns the ID of the user who owns a channel.
+	GetChannelOwner(ctx context.Context, channelID string) (string, error)
+	SetChannelImage(ctx context.Context, channelID, kind, bucket, objectKey string) error
+	ListStorageCleanups(ctx context.Context, limit int) ([]*StorageCleanup, error)
+	CompleteStorageCleanup(ctx context.Context, videoID, errMsg string) error
+}
+
+// Prober reads the length of a media file.
+type Prober interface {
+	// Duration probes the file at url, fetching only the parts it needs.
+	Duration(ctx context.Context, url string) (time.Duration, error)
 }
 
 type UploadUsecase interface {
-	InitUpload(ctx context.Context, title, filename string) (string, string, error) // returns videoID, presignedURL
-	CompleteUpload(ctx context.Context, videoID string) error
+	// InitUpload returns videoID, presignedURL for a video owned by ownerID and posted to channelID, if set. publishAt (RFC 3339) schedules publication; empty publishes on completion.
+	InitUpload(ctx context.Context, ownerID, channelID, title, filename string, allowDownload bool, publishAt string, premiere bool) (string, string, error)
+	// CompleteUpload marks caller's upload ready with its probed duration; admins can
+	// complete anyone's.
+	CompleteUpload(ctx context.Context, videoID string, caller *Caller) error
+	// InitChannelImageUpload returns a presigned URL for a channel's new avatar or banner and
+	// makes the channel use it. Only its owner and admins can.
+	InitChannelImageUpload(ctx context.Context, caller *Caller, channelID, kind, filename string) (string, error)
+	// CleanupStorage removes the objects of deleted videos that are due and returns how many
+	// videos it cleaned up. Failures are reported back to be retried later.
+	CleanupStorage(ctx context.Context) (int, error)
 }
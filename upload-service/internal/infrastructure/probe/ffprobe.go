@@ -0,0 +1,37 @@
+package probe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/athandoan/youtube/upload-service/internal/domain"
+)
+
+type ffprobe struct{}
+
+// NewFFprobe returns a Prober running ffprobe, which reads a file's container headers over
+// HTTP with range requests rather than downloading it.
+func NewFFprobe() domain.Prober {
+	return ffprobe{}
+}
+
+func (ffprobe) Duration(ctx context.Context, url string) (time.Duration, error) {
+	out, err := exec.CommandContext(ctx, "ffprobe", "-v", "error", "-show_entries", "format=duration", "-of", "default=noprint_wrappers=1:nokey=1", url).Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return 0, fmt.Errorf("%w: %s", err, strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return 0, err
+	}
+	secs, err := strconv.ParseFloat(strings.TrimSpace(string(out)), 64)
+	if err != nil || secs <= 0 {
+		return 0, fmt.Errorf("no duration in %q", strings.TrimSpace(string(out)))
+	}
+	return time.Duration(secs * float64(time.Second)), nil
+}
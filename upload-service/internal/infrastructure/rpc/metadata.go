@@ -2,20 +2,26 @@ package rpc
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/athandoan/youtube/proto/metadata"
 	"github.com/athandoan/youtube/upload-service/internal/domain"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 )
 
+// serviceName identifies this service to the metadata service, which only lets services
+// create videos and report on their processing.
+const serviceName = "upload-service"
+
 type metadataClient struct {
 	client pb.MetadataServiceClient
 	conn   *grpc.ClientConn
 }
 
 func NewMetadataClient(addr string) (domain.MetadataService, error) {
-	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithUnaryInterceptor(identify))
 	if err != nil {
 		return nil, err
 	}
@@ -23,11 +29,21 @@ func NewMetadataClient(addr string) (domain.MetadataService, error) {
 	return &metadataClient{client: client, conn: conn}, nil
 }
 
-func (m *metadataClient) CreateVideo(ctx context.Context, title, bucket, objectKey string) (string, error) {
+// identify sends every call as this service rather than as the user it serves.
+func identify(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(metadata.AppendToOutgoingContext(ctx, "x-service", serviceName), method, req, reply, cc, opts...)
+}
+
+func (m *metadataClient) CreateVideo(ctx context.Context, ownerID, channelID, title, bucket, objectKey string, allowDownload bool, publishAt string, premiere bool) (string, error) {
 	resp, err := m.client.CreateVideo(ctx, &pb.CreateVideoRequest{
-		Title:     title,
-		Bucket:    bucket,
-		ObjectKey: objectKey,
+		OwnerId:       ownerID,
+		ChannelId:     channelID,
+		Title:         title,
+		Bucket:        bucket,
+		ObjectKey:     objectKey,
+		AllowDownload: allowDownload,
+		PublishAt:     publishAt,
+		Premiere:      premiere,
 	})
 	if err != nil {
 		return "", err
@@ -35,10 +51,66 @@ func (m *metadataClient) CreateVideo(ctx context.Context, title, bucket, objectK
 	return resp.Id, nil
 }
 
-func (m *metadataClient) UpdateVideoStatus(ctx context.Context, id, status string) error {
+func (m *metadataClient) GetVideo(ctx context.Context, id string) (*domain.Video, error) {
+	resp, err := m.client.GetVideo(ctx, &pb.GetVideoRequest{Id: id, Unrestricted: true})
+	if err != nil {
+		return nil, err
+	}
+	return &domain.Video{
+		ID:        resp.Id,
+		OwnerID:   resp.OwnerId,
+		Bucket:    resp.BucketName,
+		ObjectKey: resp.ObjectKey,
+	}, nil
+}
+
+func (m *metadataClient) UpdateVideoStatus(ctx context.Context, id, status string, duration time.Duration) error {
 	_, err := m.client.UpdateVideoStatus(ctx, &pb.UpdateVideoStatusRequest{
-		Id:     id,
-		Status: status,
+		Id:              id,
+		Status:          status,
+		DurationSeconds: duration.Seconds(),
+	})
+	return err
+}
+
+func (m *metadataClient) GetChannelOwner(ctx context.Context, channelID string) (string, error) {
+	resp, err := m.client.GetChannel(ctx, &pb.GetChannelRequest{Id: channelID})
+	if err != nil {
+		return "", err
+	}
+	return resp.OwnerId, nil
+}
+
+func (m *metadataClient) SetChannelImage(ctx context.Context, channelID, kind, bucket, objectKey string) error {
+	_, err := m.client.SetChannelImage(ctx, &pb.SetChannelImageRequest{
+		Id:    channelID,
+		Kind:  kind,
+		Image: &pb.ChannelImage{Bucket: bucket, ObjectKey: objectKey},
+	})
+	return err
+}
+
+func (m *metadataClient) ListStorageCleanups(ctx context.Context, limit int) ([]*domain.StorageCleanup, error) {
+	resp, err := m.client.ListStorageCleanups(ctx, &pb.ListStorageCleanupsRequest{Limit: int32(limit)})
+	if err != nil {
+		return nil, err
+	}
+	cleanups := make([]*domain.StorageCleanup, 0, len(resp.Cleanups))
+	for _, c := range resp.Cleanups {
+		cleanups = append(cleanups, &domain.StorageCleanup{
+			VideoID:  c.VideoId,
+			Bucket:   c.Bucket,
+			Prefix:   c.Prefix,
+			Attempts: int(c.Attempts),
+		})
+	}
+	return cleanups, nil
+}
+
+func (m *metadataClient) CompleteStorageCleanup(ctx context.Context, videoID, errMsg string) error {
+	_, err := m.client.CompleteStorageCleanup(ctx, &pb.CompleteStorageCleanupRequest{
+		VideoId: videoID,
+		Error:   errMsg,
 	})
 	return err
 }
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"fmt"
 	"net/url"
 	"time"
 
@@ -11,10 +12,11 @@ import (
 )
 
 type minioStorage struct {
-	client *minio.Client
+	client        *minio.Client // internal endpoint, used to manage objects
+	presignClient *minio.Client // external endpoint, used to sign browser URLs
 }
 
-func NewMinioStorage(endpoint, accessKey, secretKey string, useSSL bool, region string) (domain.StorageService, error) {
+func NewMinioStorage(endpoint, externalEndpoint, accessKey, secretKey string, useSSL bool, region string) (domain.StorageService, error) {
 	opts := &minio.Options{
 		Creds:        credentials.NewStaticV4(accessKey, secretKey, ""),
 		Secure:       useSSL,
@@ -26,9 +28,56 @@ func NewMinioStorage(endpoint, accessKey, secretKey string, useSSL bool, region
 	if err != nil {
 		return nil, err
 	}
-	return &minioStorage{client: client}, nil
+	presignClient, err := minio.New(externalEndpoint, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &minioStorage{client: client, presignClient: presignClient}, nil
 }
 
 func (s *minioStorage) PresignedPutObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error) {
-	return s.client.PresignedPutObject(ctx, bucket, objectKey, expiry)
+	return s.presignClient.PresignedPutObject(ctx, bucket, objectKey, expiry)
+}
+
+func (s *minioStorage) PresignedGetObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error) {
+	return s.client.PresignedGetObject(ctx, bucket, objectKey, expiry, nil)
+}
+
+func (s *minioStorage) RemovePrefix(ctx context.Context, bucket, prefix string) (int, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	listed := 0
+	var listErr error
+	objects := make(chan minio.ObjectInfo)
+	go func() {
+		defer close(objects)
+		for obj := range s.client.ListObjects(ctx, bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+			if obj.Err != nil {
+				listErr = obj.Err
+				return
+			}
+			listed++
+			objects <- obj
+		}
+	}()
+
+	failed := 0
+	var removeErr error
+	for rerr := range s.client.RemoveObjects(ctx, bucket, objects, minio.RemoveObjectsOptions{}) {
+		failed++
+		if removeErr == nil {
+			removeErr = fmt.Errorf("failed to remove %s: %w", rerr.ObjectName, rerr.Err)
+		}
+	}
+
+	// RemoveObjects has drained objects, so the lister is done
+	if listErr != nil {
+		return listed - failed, fmt.Errorf("failed to list %s: %w", prefix, listErr)
+	}
+	return listed - failed, removeErr
+}
+
+func (s *minioStorage) RemoveObject(ctx context.Context, bucket, objectKey string) error {
+	return s.client.RemoveObject(ctx, bucket, objectKey, minio.RemoveObjectOptions{})
 }
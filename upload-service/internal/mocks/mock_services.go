@@ -15,6 +15,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	domain "github.com/athandoan/youtube/upload-service/internal/domain"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -42,6 +43,21 @@ func (m *MockStorageService) EXPECT() *MockStorageServiceMockRecorder {
 	return m.recorder
 }
 
+// PresignedGetObject mocks base method.
+func (m *MockStorageService) PresignedGetObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignedGetObject", ctx, bucket, objectKey, expiry)
+	ret0, _ := ret[0].(*url.URL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignedGetObject indicates an expected call of PresignedGetObject.
+func (mr *MockStorageServiceMockRecorder) PresignedGetObject(ctx, bucket, objectKey, expiry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedGetObject", reflect.TypeOf((*MockStorageService)(nil).PresignedGetObject), ctx, bucket, objectKey, expiry)
+}
+
 // PresignedPutObject mocks base method.
 func (m *MockStorageService) PresignedPutObject(ctx context.Context, bucket, objectKey string, expiry time.Duration) (*url.URL, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +73,35 @@ func (mr *MockStorageServiceMockRecorder) PresignedPutObject(ctx, bucket, object
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignedPutObject", reflect.TypeOf((*MockStorageService)(nil).PresignedPutObject), ctx, bucket, objectKey, expiry)
 }
 
+// RemoveObject mocks base method.
+func (m *MockStorageService) RemoveObject(ctx context.Context, bucket, objectKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveObject", ctx, bucket, objectKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveObject indicates an expected call of RemoveObject.
+func (mr *MockStorageServiceMockRecorder) RemoveObject(ctx, bucket, objectKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveObject", reflect.TypeOf((*MockStorageService)(nil).RemoveObject), ctx, bucket, objectKey)
+}
+
+// RemovePrefix mocks base method.
+func (m *MockStorageService) RemovePrefix(ctx context.Context, bucket, prefix string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePrefix", ctx, bucket, prefix)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemovePrefix indicates an expected call of RemovePrefix.
+func (mr *MockStorageServiceMockRecorder) RemovePrefix(ctx, bucket, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePrefix", reflect.TypeOf((*MockStorageService)(nil).RemovePrefix), ctx, bucket, prefix)
+}
+
 // MockMetadataService is a mock of MetadataService interface.
 type MockMetadataService struct {
 	ctrl     *gomock.Controller
@@ -81,33 +126,145 @@ func (m *MockMetadataService) EXPECT() *MockMetadataServiceMockRecorder {
 	return m.recorder
 }
 
+// CompleteStorageCleanup mocks base method.
+func (m *MockMetadataService) CompleteStorageCleanup(ctx context.Context, videoID, errMsg string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteStorageCleanup", ctx, videoID, errMsg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteStorageCleanup indicates an expected call of CompleteStorageCleanup.
+func (mr *MockMetadataServiceMockRecorder) CompleteStorageCleanup(ctx, videoID, errMsg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteStorageCleanup", reflect.TypeOf((*MockMetadataService)(nil).CompleteStorageCleanup), ctx, videoID, errMsg)
+}
+
 // CreateVideo mocks base method.
-func (m *MockMetadataService) CreateVideo(ctx context.Context, title, bucket, objectKey string) (string, error) {
+func (m *MockMetadataService) CreateVideo(ctx context.Context, ownerID, channelID, title, bucket, objectKey string, allowDownload bool, publishAt string, premiere bool) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateVideo", ctx, title, bucket, objectKey)
+	ret := m.ctrl.Call(m, "CreateVideo", ctx, ownerID, channelID, title, bucket, objectKey, allowDownload, publishAt, premiere)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateVideo indicates an expected call of CreateVideo.
-func (mr *MockMetadataServiceMockRecorder) CreateVideo(ctx, title, bucket, objectKey any) *gomock.Call {
+func (mr *MockMetadataServiceMockRecorder) CreateVideo(ctx, ownerID, channelID, title, bucket, objectKey, allowDownload, publishAt, premiere any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVideo", reflect.TypeOf((*MockMetadataService)(nil).CreateVideo), ctx, ownerID, channelID, title, bucket, objectKey, allowDownload, publishAt, premiere)
+}
+
+// GetChannelOwner mocks base method.
+func (m *MockMetadataService) GetChannelOwner(ctx context.Context, channelID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelOwner", ctx, channelID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelOwner indicates an expected call of GetChannelOwner.
+func (mr *MockMetadataServiceMockRecorder) GetChannelOwner(ctx, channelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelOwner", reflect.TypeOf((*MockMetadataService)(nil).GetChannelOwner), ctx, channelID)
+}
+
+// GetVideo mocks base method.
+func (m *MockMetadataService) GetVideo(ctx context.Context, id string) (*domain.Video, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVideo", ctx, id)
+	ret0, _ := ret[0].(*domain.Video)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVideo indicates an expected call of GetVideo.
+func (mr *MockMetadataServiceMockRecorder) GetVideo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVideo", reflect.TypeOf((*MockMetadataService)(nil).GetVideo), ctx, id)
+}
+
+// ListStorageCleanups mocks base method.
+func (m *MockMetadataService) ListStorageCleanups(ctx context.Context, limit int) ([]*domain.StorageCleanup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageCleanups", ctx, limit)
+	ret0, _ := ret[0].([]*domain.StorageCleanup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageCleanups indicates an expected call of ListStorageCleanups.
+func (mr *MockMetadataServiceMockRecorder) ListStorageCleanups(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageCleanups", reflect.TypeOf((*MockMetadataService)(nil).ListStorageCleanups), ctx, limit)
+}
+
+// SetChannelImage mocks base method.
+func (m *MockMetadataService) SetChannelImage(ctx context.Context, channelID, kind, bucket, objectKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChannelImage", ctx, channelID, kind, bucket, objectKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChannelImage indicates an expected call of SetChannelImage.
+func (mr *MockMetadataServiceMockRecorder) SetChannelImage(ctx, channelID, kind, bucket, objectKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVideo", reflect.TypeOf((*MockMetadataService)(nil).CreateVideo), ctx, title, bucket, objectKey)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelImage", reflect.TypeOf((*MockMetadataService)(nil).SetChannelImage), ctx, channelID, kind, bucket, objectKey)
 }
 
 // UpdateVideoStatus mocks base method.
-func (m *MockMetadataService) UpdateVideoStatus(ctx context.Context, id, status string) error {
+func (m *MockMetadataService) UpdateVideoStatus(ctx context.Context, id, status string, duration time.Duration) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateVideoStatus", ctx, id, status)
+	ret := m.ctrl.Call(m, "UpdateVideoStatus", ctx, id, status, duration)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateVideoStatus indicates an expected call of UpdateVideoStatus.
-func (mr *MockMetadataServiceMockRecorder) UpdateVideoStatus(ctx, id, status any) *gomock.Call {
+func (mr *MockMetadataServiceMockRecorder) UpdateVideoStatus(ctx, id, status, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVideoStatus", reflect.TypeOf((*MockMetadataService)(nil).UpdateVideoStatus), ctx, id, status, duration)
+}
+
+// MockProber is a mock of Prober interface.
+type MockProber struct {
+	ctrl     *gomock.Controller
+	recorder *MockProberMockRecorder
+	isgomock struct{}
+}
+
+// MockProberMockRecorder is the mock recorder for MockProber.
+type MockProberMockRecorder struct {
+	mock *MockProber
+}
+
+// NewMockProber creates a new mock instance.
+func NewMockProber(ctrl *gomock.Controller) *MockProber {
+	mock := &MockProber{ctrl: ctrl}
+	mock.recorder = &MockProberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProber) EXPECT() *MockProberMockRecorder {
+	return m.recorder
+}
+
+// Duration mocks base method.
+func (m *MockProber) Duration(ctx context.Context, arg1 string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Duration", ctx, arg1)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Duration indicates an expected call of Duration.
+func (mr *MockProberMockRecorder) Duration(ctx, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVideoStatus", reflect.TypeOf((*MockMetadataService)(nil).UpdateVideoStatus), ctx, id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Duration", reflect.TypeOf((*MockProber)(nil).Duration), ctx, arg1)
 }
 
 // MockUploadUsecase is a mock of UploadUsecase interface.
@@ -134,24 +291,54 @@ func (m *MockUploadUsecase) EXPECT() *MockUploadUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CleanupStorage mocks base method.
+func (m *MockUploadUsecase) CleanupStorage(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanupStorage", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanupStorage indicates an expected call of CleanupStorage.
+func (mr *MockUploadUsecaseMockRecorder) CleanupStorage(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanupStorage", reflect.TypeOf((*MockUploadUsecase)(nil).CleanupStorage), ctx)
+}
+
 // CompleteUpload mocks base method.
-func (m *MockUploadUsecase) CompleteUpload(ctx context.Context, videoID string) error {
+func (m *MockUploadUsecase) CompleteUpload(ctx context.Context, videoID string, caller *domain.Caller) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CompleteUpload", ctx, videoID)
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, videoID, caller)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CompleteUpload indicates an expected call of CompleteUpload.
-func (mr *MockUploadUsecaseMockRecorder) CompleteUpload(ctx, videoID any) *gomock.Call {
+func (mr *MockUploadUsecaseMockRecorder) CompleteUpload(ctx, videoID, caller any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockUploadUsecase)(nil).CompleteUpload), ctx, videoID, caller)
+}
+
+// InitChannelImageUpload mocks base method.
+func (m *MockUploadUsecase) InitChannelImageUpload(ctx context.Context, caller *domain.Caller, channelID, kind, filename string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InitChannelImageUpload", ctx, caller, channelID, kind, filename)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InitChannelImageUpload indicates an expected call of InitChannelImageUpload.
+func (mr *MockUploadUsecaseMockRecorder) InitChannelImageUpload(ctx, caller, channelID, kind, filename any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockUploadUsecase)(nil).CompleteUpload), ctx, videoID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitChannelImageUpload", reflect.TypeOf((*MockUploadUsecase)(nil).InitChannelImageUpload), ctx, caller, channelID, kind, filename)
 }
 
 // InitUpload mocks base method.
-func (m *MockUploadUsecase) InitUpload(ctx context.Context, title, filename string) (string, string, error) {
+func (m *MockUploadUsecase) InitUpload(ctx context.Context, ownerID, channelID, title, filename string, allowDownload bool, publishAt string, premiere bool) (string, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "InitUpload", ctx, title, filename)
+	ret := m.ctrl.Call(m, "InitUpload", ctx, ownerID, channelID, title, filename, allowDownload, publishAt, premiere)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(string)
 	ret2, _ := ret[2].(error)
@@ -159,7 +346,7 @@ func (m *MockUploadUsecase) InitUpload(ctx context.Context, title, filename stri
 }
 
 // InitUpload indicates an expected call of InitUpload.
-func (mr *MockUploadUsecaseMockRecorder) InitUpload(ctx, title, filename any) *gomock.Call {
+func (mr *MockUploadUsecaseMockRecorder) InitUpload(ctx, ownerID, channelID, title, filename, allowDownload, publishAt, premiere any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitUpload", reflect.TypeOf((*MockUploadUsecase)(nil).InitUpload), ctx, title, filename)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InitUpload", reflect.TypeOf((*MockUploadUsecase)(nil).InitUpload), ctx, ownerID, channelID, title, filename, allowDownload, publishAt, premiere)
 }
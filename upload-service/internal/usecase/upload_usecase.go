@@ -3,33 +3,46 @@ package usecase
 import (
 	"context"
 	"fmt"
+	"log"
+	"path"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/athandoan/youtube/upload-service/internal/domain"
 	"github.com/google/uuid"
 )
 
+const (
+	// cleanupBatchSize is how many queued cleanups one CleanupStorage run handles.
+	cleanupBatchSize = 20
+	// probeTimeout bounds reading an upload's duration, which only needs its headers.
+	probeTimeout = 30 * time.Second
+)
+
 type uploadUsecase struct {
 	storage    domain.StorageService
 	metadata   domain.MetadataService
+	prober     domain.Prober
 	bucketName string
 }
 
-func NewUploadUsecase(storage domain.StorageService, metadata domain.MetadataService, bucketName string) domain.UploadUsecase {
+func NewUploadUsecase(storage domain.StorageService, metadata domain.MetadataService, prober domain.Prober, bucketName string) domain.UploadUsecase {
 	return &uploadUsecase{
 		storage:    storage,
 		metadata:   metadata,
+		prober:     prober,
 		bucketName: bucketName,
 	}
 }
 
-func (u *uploadUsecase) InitUpload(ctx context.Context, title, filename string) (string, string, error) {
+func (u *uploadUsecase) InitUpload(ctx context.Context, ownerID, channelID, title, filename string, allowDownload bool, publishAt string, premiere bool) (string, string, error) {
 	// Generate unique path for S3 to avoid filename collision
 	fileUUID := uuid.New().String()
 	objectKey := fmt.Sprintf("%s/%s", fileUUID, filename)
 
 	// 1. Create Video in Metadata Service and get the canonical VideoID
-	videoID, err := u.metadata.CreateVideo(ctx, title, u.bucketName, objectKey)
+	videoID, err := u.metadata.CreateVideo(ctx, ownerID, channelID, title, u.bucketName, objectKey, allowDownload, publishAt, premiere)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to create metadata: %w", err)
 	}
@@ -44,11 +57,112 @@ func (u *uploadUsecase) InitUpload(ctx context.Context, title, filename string)
 	return videoID, url.String(), nil
 }
 
-func (u *uploadUsecase) CompleteUpload(ctx context.Context, videoID string) error {
+func (u *uploadUsecase) CompleteUpload(ctx context.Context, videoID string, caller *domain.Caller) error {
+	v, err := u.metadata.GetVideo(ctx, videoID)
+	if err != nil {
+		return fmt.Errorf("failed to get metadata: %w", err)
+	}
+	if !caller.IsAdmin() && v.OwnerID != caller.UserID {
+		return domain.ErrForbidden
+	}
+
 	// Call Metadata Service to update status using the canonical VideoID
-	err := u.metadata.UpdateVideoStatus(ctx, videoID, "ready")
+	err = u.metadata.UpdateVideoStatus(ctx, videoID, "ready", u.probeDuration(ctx, v))
 	if err != nil {
 		return fmt.Errorf("failed to update metadata: %w", err)
 	}
 	return nil
 }
+
+// probeDuration reads the length of the uploaded file, which premieres are held to. Uploads
+// that can't be probed still complete, without a duration.
+func (u *uploadUsecase) probeDuration(ctx context.Context, v *domain.Video) time.Duration {
+	bucket := v.Bucket
+	if bucket == "" {
+		bucket = u.bucketName
+	}
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	url, err := u.storage.PresignedGetObject(ctx, bucket, v.ObjectKey, probeTimeout)
+	if err != nil {
+		log.Printf("video %s: failed to presign for probing: %v", v.ID, err)
+		return 0
+	}
+	duration, err := u.prober.Duration(ctx, url.String())
+	if err != nil {
+		log.Printf("video %s: failed to probe duration: %v", v.ID, err)
+		return 0
+	}
+	return duration
+}
+
+// InitChannelImageUpload points the channel at the new image right away, so it shows none
+// until the upload finishes. Each image gets a fresh key, which keeps browsers from showing a
+// cached old one; metadata-service queues the replaced image for removal.
+func (u *uploadUsecase) InitChannelImageUpload(ctx context.Context, caller *domain.Caller, channelID, kind, filename string) (string, error) {
+	if kind != domain.ChannelImageAvatar && kind != domain.ChannelImageBanner {
+		return "", fmt.Errorf("%w: kind must be avatar or banner", domain.ErrInvalidImage)
+	}
+	ext := strings.ToLower(path.Ext(filename))
+	if !slices.Contains(domain.ImageExtensions, ext) {
+		return "", fmt.Errorf("%w: images must be one of %s", domain.ErrInvalidImage, strings.Join(domain.ImageExtensions, ", "))
+	}
+	if !caller.IsAdmin() {
+		owner, err := u.metadata.GetChannelOwner(ctx, channelID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get channel: %w", err)
+		}
+		if owner != caller.UserID {
+			return "", domain.ErrNotChannelOwner
+		}
+	}
+
+	objectKey := fmt.Sprintf("channels/%s/%s-%s%s", channelID, kind, uuid.New().String(), ext)
+	url, err := u.storage.PresignedPutObject(ctx, u.bucketName, objectKey, time.Hour)
+	if err != nil {
+		return "", fmt.Errorf("failed to presign: %w", err)
+	}
+	if err := u.metadata.SetChannelImage(ctx, channelID, kind, u.bucketName, objectKey); err != nil {
+		return "", fmt.Errorf("failed to set channel image: %w", err)
+	}
+	return url.String(), nil
+}
+
+func (u *uploadUsecase) CleanupStorage(ctx context.Context) (int, error) {
+	cleanups, err := u.metadata.ListStorageCleanups(ctx, cleanupBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list storage cleanups: %w", err)
+	}
+
+	cleaned := 0
+	for _, c := range cleanups {
+		// Removal is idempotent, so a cleanup that fails halfway is simply run again
+		removed, err := u.removeObjects(ctx, c)
+		var errMsg string
+		if err != nil {
+			errMsg = err.Error()
+			log.Printf("storage cleanup %s: removed %d objects under %s/%s, then failed: %v", c.VideoID, removed, c.Bucket, c.Prefix, err)
+		}
+		if err := u.metadata.CompleteStorageCleanup(ctx, c.VideoID, errMsg); err != nil {
+			// Left as due; the next run repeats it
+			return cleaned, fmt.Errorf("failed to report storage cleanup %s: %w", c.VideoID, err)
+		}
+		if errMsg == "" {
+			cleaned++
+		}
+	}
+	return cleaned, nil
+}
+
+// removeObjects removes what c covers: everything under its prefix, or only the object keyed
+// Prefix when it isn't a directory, which would otherwise match siblings like "video.mp4.bak".
+func (u *uploadUsecase) removeObjects(ctx context.Context, c *domain.StorageCleanup) (int, error) {
+	if !strings.HasSuffix(c.Prefix, "/") {
+		if err := u.storage.RemoveObject(ctx, c.Bucket, c.Prefix); err != nil {
+			return 0, err
+		}
+		return 1, nil
+	}
+	return u.storage.RemovePrefix(ctx, c.Bucket, c.Prefix)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/athandoan/youtube/upload-service/internal/domain"
 	"github.com/athandoan/youtube/upload-service/internal/mocks"
 	"go.uber.org/mock/gomock"
 )
@@ -26,7 +28,7 @@ func TestUploadUsecase_InitUpload(t *testing.T) {
 			filename: "video.mp4",
 			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
 				metadata.EXPECT().
-					CreateVideo(gomock.Any(), "My Video", "videos", gomock.Any()).
+					CreateVideo(gomock.Any(), "user-1", "", "My Video", "videos", gomock.Any(), false, "", false).
 					Return("video-123", nil)
 
 				presignedURL, _ := url.Parse("https://s3.example.com/videos/uuid/video.mp4?signature=xxx")
@@ -43,7 +45,7 @@ func TestUploadUsecase_InitUpload(t *testing.T) {
 			filename: "video.mp4",
 			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
 				metadata.EXPECT().
-					CreateVideo(gomock.Any(), "My Video", "videos", gomock.Any()).
+					CreateVideo(gomock.Any(), "user-1", "", "My Video", "videos", gomock.Any(), false, "", false).
 					Return("", errors.New("metadata service unavailable"))
 			},
 			wantErr: true,
@@ -54,7 +56,7 @@ func TestUploadUsecase_InitUpload(t *testing.T) {
 			filename: "video.mp4",
 			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
 				metadata.EXPECT().
-					CreateVideo(gomock.Any(), "My Video", "videos", gomock.Any()).
+					CreateVideo(gomock.Any(), "user-1", "", "My Video", "videos", gomock.Any(), false, "", false).
 					Return("video-123", nil)
 
 				storage.EXPECT().
@@ -74,8 +76,8 @@ func TestUploadUsecase_InitUpload(t *testing.T) {
 			mockMetadata := mocks.NewMockMetadataService(ctrl)
 			tt.setupMock(mockStorage, mockMetadata)
 
-			uc := NewUploadUsecase(mockStorage, mockMetadata, "videos")
-			videoID, presignedURL, err := uc.InitUpload(context.Background(), tt.title, tt.filename)
+			uc := NewUploadUsecase(mockStorage, mockMetadata, nil, "videos")
+			videoID, presignedURL, err := uc.InitUpload(context.Background(), "user-1", "", tt.title, tt.filename, false, "", false)
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("InitUpload() error = %v, wantErr %v", err, tt.wantErr)
@@ -105,8 +107,8 @@ func TestUploadUsecase_InitUpload_ObjectKeyFormat(t *testing.T) {
 
 	// Capture the object key to verify format
 	mockMetadata.EXPECT().
-		CreateVideo(gomock.Any(), "Test Video", "test-bucket", gomock.Any()).
-		DoAndReturn(func(ctx context.Context, title, bucket, objectKey string) (string, error) {
+		CreateVideo(gomock.Any(), "user-1", "", "Test Video", "test-bucket", gomock.Any(), false, "", false).
+		DoAndReturn(func(ctx context.Context, ownerID, channelID, title, bucket, objectKey string, allowDownload bool, publishAt string, premiere bool) (string, error) {
 			capturedObjectKey = objectKey
 			return "video-123", nil
 		})
@@ -116,8 +118,8 @@ func TestUploadUsecase_InitUpload_ObjectKeyFormat(t *testing.T) {
 		PresignedPutObject(gomock.Any(), "test-bucket", gomock.Any(), gomock.Any()).
 		Return(presignedURL, nil)
 
-	uc := NewUploadUsecase(mockStorage, mockMetadata, "test-bucket")
-	_, _, err := uc.InitUpload(context.Background(), "Test Video", "original-filename.mp4")
+	uc := NewUploadUsecase(mockStorage, mockMetadata, nil, "test-bucket")
+	_, _, err := uc.InitUpload(context.Background(), "user-1", "", "Test Video", "original-filename.mp4", false, "", false)
 
 	if err != nil {
 		t.Fatalf("InitUpload() unexpected error: %v", err)
@@ -136,39 +138,236 @@ func TestUploadUsecase_InitUpload_ObjectKeyFormat(t *testing.T) {
 }
 
 func TestUploadUsecase_CompleteUpload(t *testing.T) {
+	errVideoNotFound := errors.New("video not found")
+	errUnavailable := errors.New("connection refused")
+	video := &domain.Video{ID: "video-123", OwnerID: "user-1", Bucket: "videos", ObjectKey: "uuid/video.mp4"}
+	presigned, _ := url.Parse("http://storage:9000/videos/uuid/video.mp4?sig")
+
+	// probes expects the upload to be probed, returning duration or err
+	probes := func(storage *mocks.MockStorageService, prober *mocks.MockProber, duration time.Duration, err error) {
+		storage.EXPECT().PresignedGetObject(gomock.Any(), "videos", "uuid/video.mp4", probeTimeout).Return(presigned, nil)
+		prober.EXPECT().Duration(gomock.Any(), presigned.String()).Return(duration, err)
+	}
+
 	tests := []struct {
 		name      string
 		videoID   string
-		setupMock func(metadata *mocks.MockMetadataService)
-		wantErr   bool
+		caller    *domain.Caller
+		setupMock func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, prober *mocks.MockProber)
+		wantErr   error
 	}{
 		{
-			name:    "success - marks video as ready",
+			name:    "success - uploader marks video as ready with its duration",
 			videoID: "video-123",
-			setupMock: func(metadata *mocks.MockMetadataService) {
+			caller:  &domain.Caller{UserID: "user-1"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, prober *mocks.MockProber) {
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123").Return(video, nil)
+				probes(storage, prober, 90*time.Second, nil)
 				metadata.EXPECT().
-					UpdateVideoStatus(gomock.Any(), "video-123", "ready").
+					UpdateVideoStatus(gomock.Any(), "video-123", "ready", 90*time.Second).
 					Return(nil)
 			},
-			wantErr: false,
+		},
+		{
+			name:    "success - admin completes someone else's upload",
+			videoID: "video-123",
+			caller:  &domain.Caller{UserID: "admin-1", Roles: []string{domain.RoleAdmin}},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, prober *mocks.MockProber) {
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123").Return(video, nil)
+				probes(storage, prober, 90*time.Second, nil)
+				metadata.EXPECT().
+					UpdateVideoStatus(gomock.Any(), "video-123", "ready", 90*time.Second).
+					Return(nil)
+			},
+		},
+		{
+			name:    "success - a file that can't be probed completes without a duration",
+			videoID: "video-123",
+			caller:  &domain.Caller{UserID: "user-1"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, prober *mocks.MockProber) {
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123").Return(video, nil)
+				probes(storage, prober, 0, errors.New("invalid data found when processing input"))
+				metadata.EXPECT().
+					UpdateVideoStatus(gomock.Any(), "video-123", "ready", time.Duration(0)).
+					Return(nil)
+			},
+		},
+		{
+			name:    "error - not the uploader",
+			videoID: "video-123",
+			caller:  &domain.Caller{UserID: "user-2"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, prober *mocks.MockProber) {
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123").Return(video, nil)
+			},
+			wantErr: domain.ErrForbidden,
 		},
 		{
 			name:    "error - video not found",
 			videoID: "nonexistent-id",
-			setupMock: func(metadata *mocks.MockMetadataService) {
-				metadata.EXPECT().
-					UpdateVideoStatus(gomock.Any(), "nonexistent-id", "ready").
-					Return(errors.New("video not found"))
+			caller:  &domain.Caller{UserID: "user-1"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, prober *mocks.MockProber) {
+				metadata.EXPECT().GetVideo(gomock.Any(), "nonexistent-id").Return(nil, errVideoNotFound)
 			},
-			wantErr: true,
+			wantErr: errVideoNotFound,
 		},
 		{
 			name:    "error - metadata service unavailable",
 			videoID: "video-123",
-			setupMock: func(metadata *mocks.MockMetadataService) {
+			caller:  &domain.Caller{UserID: "user-1"},
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService, prober *mocks.MockProber) {
+				metadata.EXPECT().GetVideo(gomock.Any(), "video-123").Return(video, nil)
+				probes(storage, prober, 90*time.Second, nil)
 				metadata.EXPECT().
-					UpdateVideoStatus(gomock.Any(), "video-123", "ready").
-					Return(errors.New("connection refused"))
+					UpdateVideoStatus(gomock.Any(), "video-123", "ready", 90*time.Second).
+					Return(errUnavailable)
+			},
+			wantErr: errUnavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			mockProber := mocks.NewMockProber(ctrl)
+			tt.setupMock(mockStorage, mockMetadata, mockProber)
+
+			uc := NewUploadUsecase(mockStorage, mockMetadata, mockProber, "videos")
+			err := uc.CompleteUpload(context.Background(), tt.videoID, tt.caller)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CompleteUpload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUploadUsecase_InitChannelImageUpload(t *testing.T) {
+	presigned, _ := url.Parse("http://storage/videos/channels/ch-1/avatar.png?sig")
+
+	tests := []struct {
+		name      string
+		caller    *domain.Caller
+		kind      string
+		filename  string
+		setupMock func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService)
+		wantErr   error
+	}{
+		{
+			name:     "success - owner uploads an avatar",
+			caller:   &domain.Caller{UserID: "user-1"},
+			kind:     domain.ChannelImageAvatar,
+			filename: "me.PNG",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetChannelOwner(gomock.Any(), "ch-1").Return("user-1", nil)
+				storage.EXPECT().PresignedPutObject(gomock.Any(), "videos", gomock.Any(), time.Hour).Return(presigned, nil)
+				metadata.EXPECT().SetChannelImage(gomock.Any(), "ch-1", domain.ChannelImageAvatar, "videos", gomock.Any()).
+					DoAndReturn(func(ctx context.Context, channelID, kind, bucket, objectKey string) error {
+						if !strings.HasPrefix(objectKey, "channels/ch-1/avatar-") || !strings.HasSuffix(objectKey, ".png") {
+							t.Errorf("unexpected object key %q", objectKey)
+						}
+						return nil
+					})
+			},
+		},
+		{
+			name:     "success - admin uploads a banner for anyone",
+			caller:   &domain.Caller{UserID: "admin-1", Roles: []string{domain.RoleAdmin}},
+			kind:     domain.ChannelImageBanner,
+			filename: "banner.webp",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+				storage.EXPECT().PresignedPutObject(gomock.Any(), "videos", gomock.Any(), time.Hour).Return(presigned, nil)
+				metadata.EXPECT().SetChannelImage(gomock.Any(), "ch-1", domain.ChannelImageBanner, "videos", gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "error - not the channel's owner",
+			caller:   &domain.Caller{UserID: "user-2"},
+			kind:     domain.ChannelImageAvatar,
+			filename: "me.png",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().GetChannelOwner(gomock.Any(), "ch-1").Return("user-1", nil)
+			},
+			wantErr: domain.ErrNotChannelOwner,
+		},
+		{
+			name:      "error - not an image",
+			caller:    &domain.Caller{UserID: "user-1"},
+			kind:      domain.ChannelImageAvatar,
+			filename:  "me.exe",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {},
+			wantErr:   domain.ErrInvalidImage,
+		},
+		{
+			name:      "error - unknown kind",
+			caller:    &domain.Caller{UserID: "user-1"},
+			kind:      "thumbnail",
+			filename:  "me.png",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {},
+			wantErr:   domain.ErrInvalidImage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockStorage := mocks.NewMockStorageService(ctrl)
+			mockMetadata := mocks.NewMockMetadataService(ctrl)
+			tt.setupMock(mockStorage, mockMetadata)
+
+			uc := NewUploadUsecase(mockStorage, mockMetadata, nil, "videos")
+			got, err := uc.InitChannelImageUpload(context.Background(), tt.caller, "ch-1", tt.kind, tt.filename)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("InitChannelImageUpload() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got != presigned.String() {
+				t.Errorf("InitChannelImageUpload() = %q, want %q", got, presigned.String())
+			}
+		})
+	}
+}
+
+func TestUploadUsecase_CleanupStorage(t *testing.T) {
+	tests := []struct {
+		name        string
+		setupMock   func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService)
+		wantCleaned int
+		wantErr     bool
+	}{
+		{
+			name: "success - removes each prefix and reports success or failure",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().
+					ListStorageCleanups(gomock.Any(), cleanupBatchSize).
+					Return([]*domain.StorageCleanup{
+						{VideoID: "video-1", Bucket: "videos", Prefix: "uuid-1/"},
+						{VideoID: "video-2", Bucket: "videos", Prefix: "uuid-2/", Attempts: 1},
+						{VideoID: "video-3", Bucket: "videos", Prefix: "video.mp4"},
+					}, nil)
+
+				storage.EXPECT().RemovePrefix(gomock.Any(), "videos", "uuid-1/").Return(3, nil)
+				metadata.EXPECT().CompleteStorageCleanup(gomock.Any(), "video-1", "").Return(nil)
+
+				storage.EXPECT().RemovePrefix(gomock.Any(), "videos", "uuid-2/").Return(1, errors.New("connection reset"))
+				metadata.EXPECT().CompleteStorageCleanup(gomock.Any(), "video-2", "connection reset").Return(nil)
+
+				// Not a directory: only the object itself, never its siblings
+				storage.EXPECT().RemoveObject(gomock.Any(), "videos", "video.mp4").Return(nil)
+				metadata.EXPECT().CompleteStorageCleanup(gomock.Any(), "video-3", "").Return(nil)
+			},
+			wantCleaned: 2,
+		},
+		{
+			name: "error - metadata service unavailable",
+			setupMock: func(storage *mocks.MockStorageService, metadata *mocks.MockMetadataService) {
+				metadata.EXPECT().
+					ListStorageCleanups(gomock.Any(), cleanupBatchSize).
+					Return(nil, errors.New("connection refused"))
 			},
 			wantErr: true,
 		},
@@ -181,13 +380,17 @@ func TestUploadUsecase_CompleteUpload(t *testing.T) {
 
 			mockStorage := mocks.NewMockStorageService(ctrl)
 			mockMetadata := mocks.NewMockMetadataService(ctrl)
-			tt.setupMock(mockMetadata)
+			tt.setupMock(mockStorage, mockMetadata)
 
-			uc := NewUploadUsecase(mockStorage, mockMetadata, "videos")
-			err := uc.CompleteUpload(context.Background(), tt.videoID)
+			uc := NewUploadUsecase(mockStorage, mockMetadata, nil, "videos")
+			cleaned, err := uc.CleanupStorage(context.Background())
 
 			if (err != nil) != tt.wantErr {
-				t.Errorf("CompleteUpload() error = %v, wantErr %v", err, tt.wantErr)
+				t.Errorf("CleanupStorage() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if cleaned != tt.wantCleaned {
+				t.Errorf("CleanupStorage() cleaned = %d, want %d", cleaned, tt.wantCleaned)
 			}
 		})
 	}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"log"
+	"net"
+	"os"
+	"time"
+
+	pb "github.com/athandoan/youtube/proto/user"
+	handler "github.com/athandoan/youtube/user-service/internal/delivery/grpc"
+	"github.com/athandoan/youtube/user-service/internal/domain"
+	"github.com/athandoan/youtube/user-service/internal/infrastructure/mailer"
+	"github.com/athandoan/youtube/user-service/internal/repository"
+	"github.com/athandoan/youtube/user-service/internal/usecase"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	// 1. Init SQLite DB
+	dbPath := os.Getenv("SQLITE_DB_PATH")
+	if dbPath == "" {
+		dbPath = "users.db"
+	}
+
+	repo, err := repository.NewSQLiteRepository(dbPath)
+	if err != nil {
+		log.Fatalf("failed to init repository: %v", err)
+	}
+
+	// 2. Init Mailer: SMTP when configured, else mail goes to the log
+	var m domain.Mailer
+	if addr := os.Getenv("SMTP_ADDR"); addr != "" {
+		from := os.Getenv("MAIL_FROM")
+		if from == "" {
+			from = "no-reply@localhost"
+		}
+		m, err = mailer.NewSMTPMailer(addr, from, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"))
+		if err != nil {
+			log.Fatalf("failed to init mailer: %v", err)
+		}
+	} else {
+		m = mailer.NewLogMailer()
+	}
+
+	// 3. Init Usecase
+	sessionTTL := 30 * 24 * time.Hour
+	if v := os.Getenv("SESSION_TTL"); v != "" {
+		if sessionTTL, err = time.ParseDuration(v); err != nil || sessionTTL <= 0 {
+			log.Fatalf("invalid SESSION_TTL: %q", v)
+		}
+	}
+	resetURL := os.Getenv("PASSWORD_RESET_URL")
+	if resetURL == "" {
+		resetURL = "http://localhost/?reset_token="
+	}
+	uc := usecase.NewUserUsecase(repo, m, sessionTTL, resetURL)
+
+	// 4. Init Handler
+	h := handler.NewUserHandler(uc)
+
+	// 5. Start gRPC Server
+	port := os.Getenv("GRPC_PORT")
+	if port == "" {
+		port = "50056"
+	}
+	lis, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatalf("failed to listen: %v", err)
+	}
+
+	s := grpc.NewServer()
+	pb.RegisterUserServiceServer(s, h)
+
+	log.Printf("User Service (gRPC) running on :%s", port)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,191 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/athandoan/youtube/proto/user"
+	"github.com/athandoan/youtube/user-service/internal/domain"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UserHandler struct {
+	pb.UnimplementedUserServiceServer
+	Usecase domain.UserUsecase
+}
+
+func NewUserHandler(u domain.UserUsecase) *UserHandler {
+	return &UserHandler{Usecase: u}
+}
+
+func (h *UserHandler) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.Session, error) {
+	s, err := h.Usecase.Signup(ctx, req.Email, req.Password, req.DisplayName)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoSession(s), nil
+}
+
+func (h *UserHandler) Login(ctx context.Context, req *pb.LoginRequest) (*pb.Session, error) {
+	s, err := h.Usecase.Login(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoSession(s), nil
+}
+
+func (h *UserHandler) Logout(ctx context.Context, req *pb.LogoutRequest) (*pb.LogoutResponse, error) {
+	if err := h.Usecase.Logout(ctx, req.Token); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.LogoutResponse{Status: "success"}, nil
+}
+
+func (h *UserHandler) Authenticate(ctx context.Context, req *pb.AuthenticateRequest) (*pb.User, error) {
+	u, err := h.Usecase.Authenticate(ctx, req.Token)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoUser(u), nil
+}
+
+// GetUser only returns the public part of the profile; anyone may look a user up.
+func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.User, error) {
+	u, err := h.Usecase.GetUser(ctx, req.Id)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.User{Id: u.ID, DisplayName: u.DisplayName, CreatedAt: u.CreatedAt.UTC().Format(time.RFC3339)}, nil
+}
+
+func (h *UserHandler) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	if err := h.Usecase.RequestPasswordReset(ctx, req.Email); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RequestPasswordResetResponse{Status: "success"}, nil
+}
+
+func (h *UserHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	if err := h.Usecase.ResetPassword(ctx, req.Token, req.Password); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ResetPasswordResponse{Status: "success"}, nil
+}
+
+func (h *UserHandler) LoginExternal(ctx context.Context, req *pb.LoginExternalRequest) (*pb.Session, error) {
+	s, err := h.Usecase.LoginExternal(ctx, &domain.ExternalIdentity{
+		Issuer:        req.Issuer,
+		Subject:       req.Subject,
+		Email:         req.Email,
+		EmailVerified: req.EmailVerified,
+		DisplayName:   req.DisplayName,
+		Roles:         req.Roles,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toProtoSession(s), nil
+}
+
+func (h *UserHandler) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	secret, key, err := h.Usecase.CreateAPIKey(ctx, req.UserId, req.Name, req.Scopes)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.CreateAPIKeyResponse{ApiKey: toProtoAPIKey(key), Key: secret}, nil
+}
+
+func (h *UserHandler) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	userID := req.UserId
+	if req.All {
+		userID = ""
+	} else if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	keys, err := h.Usecase.ListAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &pb.ListAPIKeysResponse{ApiKeys: make([]*pb.APIKey, 0, len(keys))}
+	for _, k := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, toProtoAPIKey(k))
+	}
+	return resp, nil
+}
+
+func (h *UserHandler) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	userID := req.UserId
+	if req.Any {
+		userID = ""
+	} else if userID == "" {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if err := h.Usecase.RevokeAPIKey(ctx, req.Id, userID); err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.RevokeAPIKeyResponse{Status: "success"}, nil
+}
+
+func (h *UserHandler) AuthenticateAPIKey(ctx context.Context, req *pb.AuthenticateAPIKeyRequest) (*pb.AuthenticateAPIKeyResponse, error) {
+	u, key, err := h.Usecase.AuthenticateAPIKey(ctx, req.Key)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.AuthenticateAPIKeyResponse{User: toProtoUser(u), ApiKey: toProtoAPIKey(key)}, nil
+}
+
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrInvalidEmail), errors.Is(err, domain.ErrWeakPassword),
+		errors.Is(err, domain.ErrDisplayNameTooLong), errors.Is(err, domain.ErrInvalidResetToken),
+		errors.Is(err, domain.ErrInvalidIdentity), errors.Is(err, domain.ErrUnknownRole),
+		errors.Is(err, domain.ErrInvalidScope), errors.Is(err, domain.ErrInvalidAPIKeyName):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrEmailTaken):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrInvalidCredentials), errors.Is(err, domain.ErrSessionNotFound),
+		errors.Is(err, domain.ErrInvalidAPIKey):
+		return status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrAPIKeyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
+
+func toProtoSession(s *domain.NewSession) *pb.Session {
+	return &pb.Session{
+		Token:     s.Token,
+		ExpiresAt: s.Session.ExpiresAt.UTC().Format(time.RFC3339),
+		User:      toProtoUser(s.User),
+	}
+}
+
+func toProtoUser(u *domain.User) *pb.User {
+	return &pb.User{
+		Id:          u.ID,
+		Email:       u.Email,
+		DisplayName: u.DisplayName,
+		CreatedAt:   u.CreatedAt.UTC().Format(time.RFC3339),
+		Roles:       u.Roles,
+	}
+}
+
+func toProtoAPIKey(k *domain.APIKey) *pb.APIKey {
+	key := &pb.APIKey{
+		Id:        k.ID,
+		UserId:    k.UserID,
+		Name:      k.Name,
+		Prefix:    k.Prefix,
+		Scopes:    k.Scopes,
+		CreatedAt: k.CreatedAt.UTC().Format(time.RFC3339),
+	}
+	if !k.LastUsedAt.IsZero() {
+		key.LastUsedAt = k.LastUsedAt.UTC().Format(time.RFC3339)
+	}
+	if !k.RevokedAt.IsZero() {
+		key.RevokedAt = k.RevokedAt.UTC().Format(time.RFC3339)
+	}
+	return key
+}
@@ -0,0 +1,190 @@
+package domain
+
+//go:generate mockgen -source=user.go -destination=../mocks/mock_services.go -package=mocks
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrInvalidEmail       = errors.New("enter a valid email address")
+	ErrWeakPassword       = errors.New("password must be 8 to 72 bytes long")
+	ErrDisplayNameTooLong = errors.New("display name is too long")
+	ErrEmailTaken         = errors.New("an account with this email already exists")
+	ErrInvalidCredentials = errors.New("wrong email or password")
+	ErrSessionNotFound    = errors.New("session not found or expired")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrInvalidResetToken  = errors.New("password reset link is invalid or expired")
+	ErrInvalidIdentity    = errors.New("identity provider login lacks an issuer or subject")
+	ErrUnknownRole        = errors.New("unknown role")
+	ErrInvalidScope       = errors.New("API keys need one or more of the scopes upload:write, videos:read and videos:admin")
+	ErrInvalidAPIKeyName  = errors.New("API key name must be 1 to 100 characters")
+	ErrAPIKeyNotFound     = errors.New("API key not found")
+	ErrInvalidAPIKey      = errors.New("API key is invalid or revoked")
+)
+
+// Roles grant users more than their own content.
+const (
+	RoleAdmin     = "admin"
+	RoleModerator = "moderator"
+	RolePremium   = "premium" // streams with the premium tier's limits
+)
+
+// Roles lists every role users can hold.
+var Roles = []string{RoleAdmin, RoleModerator, RolePremium}
+
+// Scopes limit what an API key may do as its user.
+const (
+	ScopeUploadWrite = "upload:write" // upload videos
+	ScopeVideosRead  = "videos:read"  // look up and play videos
+	ScopeVideosAdmin = "videos:admin" // edit, share and delete videos; implies videos:read
+)
+
+// Scopes lists every scope API keys can have.
+var Scopes = []string{ScopeUploadWrite, ScopeVideosRead, ScopeVideosAdmin}
+
+const (
+	// APIKeyPrefix starts every API key, so they are easy to spot in logs and secret scanners.
+	APIKeyPrefix = "gtk_"
+	// MaxAPIKeyNameLength is in characters.
+	MaxAPIKeyNameLength = 100
+)
+
+// Limits on what users sign up with. bcrypt only looks at the first 72 bytes of a password,
+// so longer ones are refused rather than silently truncated.
+const (
+	MinPasswordLength    = 8
+	MaxPasswordLength    = 72
+	MaxDisplayNameLength = 50
+)
+
+type User struct {
+	ID           string
+	Email        string // stored lowercase
+	DisplayName  string
+	PasswordHash string // bcrypt; empty for users who only sign in through an identity provider
+	CreatedAt    time.Time
+	Roles        []string
+}
+
+// APIKey lets automation act as its user within its scopes, without signing in. Only the
+// SHA-256 of the key is stored.
+type APIKey struct {
+	ID         string
+	UserID     string
+	Name       string
+	Prefix     string // first characters of the key, shown to tell keys apart
+	KeyHash    string
+	Scopes     []string
+	CreatedAt  time.Time
+	LastUsedAt time.Time // zero if never used
+	RevokedAt  time.Time // zero while active
+}
+
+// ExternalIdentity is a user as an identity provider vouched for them. Issuer and Subject
+// identify them for good; the rest may change between logins.
+type ExternalIdentity struct {
+	Issuer        string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	DisplayName   string
+	Roles         []string
+}
+
+// Session keeps a user signed in until it expires or they log out. Only the SHA-256 of its
+// token is stored, so a leaked database can't be used to sign in.
+type Session struct {
+	TokenHash string
+	UserID    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+// PasswordReset lets whoever holds its token, mailed to the user, set a new password once.
+type PasswordReset struct {
+	TokenHash string
+	UserID    string
+	ExpiresAt time.Time
+}
+
+// Mail is a plain text message to one recipient.
+type Mail struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers mail, e.g. over SMTP or to the log during local development.
+type Mailer interface {
+	Send(ctx context.Context, mail *Mail) error
+}
+
+type UserRepository interface {
+	// CreateUser returns ErrEmailTaken when the email is already registered.
+	CreateUser(ctx context.Context, user *User) error
+	GetUser(ctx context.Context, id string) (*User, error)
+	GetUserByEmail(ctx context.Context, email string) (*User, error)
+	// GetUserByIdentity returns the user an identity provider's subject is linked to.
+	GetUserByIdentity(ctx context.Context, issuer, subject string) (*User, error)
+	LinkIdentity(ctx context.Context, issuer, subject, userID string) error
+	// SetRoles replaces the user's roles.
+	SetRoles(ctx context.Context, userID string, roles []string) error
+	UpdatePassword(ctx context.Context, userID, passwordHash string) error
+	CreateSession(ctx context.Context, session *Session) error
+	// GetSession returns a session that has not expired at now.
+	GetSession(ctx context.Context, tokenHash string, now time.Time) (*Session, error)
+	DeleteSession(ctx context.Context, tokenHash string) error
+	DeleteUserSessions(ctx context.Context, userID string) error
+	DeleteExpiredSessions(ctx context.Context, now time.Time) error
+	CreatePasswordReset(ctx context.Context, reset *PasswordReset) error
+	// ConsumePasswordReset uses a reset token up and returns its user, or ErrInvalidResetToken
+	// when it is unknown, used or expired at now.
+	ConsumePasswordReset(ctx context.Context, tokenHash string, now time.Time) (string, error)
+	CreateAPIKey(ctx context.Context, key *APIKey) error
+	// ListAPIKeys returns the user's keys, or everyone's for an empty userID, newest first.
+	ListAPIKeys(ctx context.Context, userID string) ([]*APIKey, error)
+	// GetAPIKey returns the active key with the hash.
+	GetAPIKey(ctx context.Context, keyHash string) (*APIKey, error)
+	// RevokeAPIKey revokes an active key of the user, or anyone's for an empty userID. It
+	// returns ErrAPIKeyNotFound when there is no such key.
+	RevokeAPIKey(ctx context.Context, id, userID string, now time.Time) error
+	// TouchAPIKey records that the key was used at now.
+	TouchAPIKey(ctx context.Context, id string, now time.Time) error
+}
+
+// NewSession is a session along with the token handed to the user, which is not stored.
+type NewSession struct {
+	Token   string
+	Session *Session
+	User    *User
+}
+
+type UserUsecase interface {
+	// Signup creates an account and signs it in.
+	Signup(ctx context.Context, email, password, displayName string) (*NewSession, error)
+	Login(ctx context.Context, email, password string) (*NewSession, error)
+	Logout(ctx context.Context, token string) error
+	// Authenticate returns the user signed in with token.
+	Authenticate(ctx context.Context, token string) (*User, error)
+	// GetUser returns a user by ID, or ErrUserNotFound.
+	GetUser(ctx context.Context, id string) (*User, error)
+	// RequestPasswordReset mails a reset link when email has an account and does nothing otherwise.
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResetPassword sets a new password with a mailed reset token and ends every session of the user.
+	ResetPassword(ctx context.Context, token, password string) error
+	// LoginExternal signs in a user an identity provider verified. Their first login links an
+	// account, creating one unless the verified email already has it; each login replaces the
+	// user's roles with the identity's.
+	LoginExternal(ctx context.Context, identity *ExternalIdentity) (*NewSession, error)
+	// CreateAPIKey returns the new key, which can't be looked up again, along with its record.
+	CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (string, *APIKey, error)
+	// ListAPIKeys returns the user's keys, including revoked ones; an empty userID lists every user's.
+	ListAPIKeys(ctx context.Context, userID string) ([]*APIKey, error)
+	// RevokeAPIKey revokes a key of the user; an empty userID revokes anyone's.
+	RevokeAPIKey(ctx context.Context, id, userID string) error
+	// AuthenticateAPIKey returns the user an active key acts as, and the key.
+	AuthenticateAPIKey(ctx context.Context, key string) (*User, *APIKey, error)
+}
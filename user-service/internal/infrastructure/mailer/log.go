@@ -0,0 +1,21 @@
+package mailer
+
+import (
+	"context"
+	"log"
+
+	"github.com/athandoan/youtube/user-service/internal/domain"
+)
+
+type logMailer struct{}
+
+// NewLogMailer returns a stand-in mailer for local development that writes mail to the log
+// instead of delivering it.
+func NewLogMailer() domain.Mailer {
+	return logMailer{}
+}
+
+func (logMailer) Send(ctx context.Context, mail *domain.Mail) error {
+	log.Printf("mail to %s: %s\n%s", mail.To, mail.Subject, mail.Body)
+	return nil
+}
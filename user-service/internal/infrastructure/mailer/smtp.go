@@ -0,0 +1,39 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+
+	"github.com/athandoan/youtube/user-service/internal/domain"
+)
+
+type smtpMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer delivers mail through the SMTP server at addr (host:port), authenticating
+// with PLAIN auth when username is set.
+func NewSMTPMailer(addr, from, username, password string) (domain.Mailer, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SMTP address %q: %w", addr, err)
+	}
+	m := &smtpMailer{addr: addr, from: from}
+	if username != "" {
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m, nil
+}
+
+func (m *smtpMailer) Send(ctx context.Context, mail *domain.Mail) error {
+	// Header values come from us and the user's validated address; keep newlines out anyway
+	clean := strings.NewReplacer("\r", "", "\n", "").Replace
+	msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: %s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s",
+		clean(m.from), clean(mail.To), clean(mail.Subject), strings.ReplaceAll(mail.Body, "\n", "\r\n"))
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{mail.To}, []byte(msg))
+}
@@ -0,0 +1,324 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/athandoan/youtube/user-service/internal/domain"
+	"github.com/mattn/go-sqlite3"
+)
+
+type sqliteRepo struct {
+	DB *sql.DB
+}
+
+func NewSQLiteRepository(dbPath string) (domain.UserRepository, error) {
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// Init Schema
+	schema := `
+	CREATE TABLE IF NOT EXISTS users (
+		id TEXT PRIMARY KEY,
+		email TEXT NOT NULL UNIQUE,
+		display_name TEXT NOT NULL DEFAULT '',
+		password_hash TEXT NOT NULL,
+		created_at INTEGER NOT NULL -- unix seconds
+	);
+
+	CREATE TABLE IF NOT EXISTS sessions (
+		token_hash TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		expires_at INTEGER NOT NULL, -- unix seconds
+		created_at INTEGER NOT NULL
+	);
+
+	CREATE INDEX IF NOT EXISTS sessions_user ON sessions(user_id);
+	CREATE INDEX IF NOT EXISTS sessions_expires ON sessions(expires_at);
+
+	CREATE TABLE IF NOT EXISTS password_resets (
+		token_hash TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		expires_at INTEGER NOT NULL, -- unix seconds
+		used INTEGER NOT NULL DEFAULT 0
+	);
+
+	CREATE TABLE IF NOT EXISTS identities (
+		issuer TEXT NOT NULL,
+		subject TEXT NOT NULL,
+		user_id TEXT NOT NULL,
+		PRIMARY KEY (issuer, subject)
+	);
+
+	CREATE TABLE IF NOT EXISTS user_roles (
+		user_id TEXT NOT NULL,
+		role TEXT NOT NULL,
+		PRIMARY KEY (user_id, role)
+	);
+
+	CREATE TABLE IF NOT EXISTS api_keys (
+		id TEXT PRIMARY KEY,
+		user_id TEXT NOT NULL,
+		name TEXT NOT NULL,
+		prefix TEXT NOT NULL,
+		key_hash TEXT NOT NULL UNIQUE,
+		scopes TEXT NOT NULL, -- space separated
+		created_at INTEGER NOT NULL, -- unix seconds
+		last_used_at INTEGER NOT NULL DEFAULT 0, -- 0 if never used
+		revoked_at INTEGER NOT NULL DEFAULT 0 -- 0 while active
+	);
+
+	CREATE INDEX IF NOT EXISTS api_keys_user ON api_keys(user_id);
+	`
+	if _, err := db.Exec(schema); err != nil {
+		return nil, fmt.Errorf("failed to create schema: %w", err)
+	}
+
+	return &sqliteRepo{DB: db}, nil
+}
+
+func (r *sqliteRepo) CreateUser(ctx context.Context, u *domain.User) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO users (id, email, display_name, password_hash, created_at) VALUES (?, ?, ?, ?, ?)",
+		u.ID, u.Email, u.DisplayName, u.PasswordHash, u.CreatedAt.Unix())
+	if sqliteErr, ok := err.(sqlite3.Error); ok && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+		return domain.ErrEmailTaken
+	}
+	return err
+}
+
+const userColumns = "id, email, display_name, password_hash, created_at"
+
+// getUser returns the user the query selects, with their roles.
+func (r *sqliteRepo) getUser(ctx context.Context, query string, args ...any) (*domain.User, error) {
+	var u domain.User
+	var createdAt int64
+	if err := r.DB.QueryRowContext(ctx, query, args...).Scan(&u.ID, &u.Email, &u.DisplayName, &u.PasswordHash, &createdAt); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, err
+	}
+	u.CreatedAt = time.Unix(createdAt, 0).UTC()
+
+	rows, err := r.DB.QueryContext(ctx, "SELECT role FROM user_roles WHERE user_id = ? ORDER BY role", u.ID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		u.Roles = append(u.Roles, role)
+	}
+	return &u, rows.Err()
+}
+
+func (r *sqliteRepo) GetUser(ctx context.Context, id string) (*domain.User, error) {
+	return r.getUser(ctx, "SELECT "+userColumns+" FROM users WHERE id = ?", id)
+}
+
+func (r *sqliteRepo) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	return r.getUser(ctx, "SELECT "+userColumns+" FROM users WHERE email = ?", email)
+}
+
+func (r *sqliteRepo) GetUserByIdentity(ctx context.Context, issuer, subject string) (*domain.User, error) {
+	return r.getUser(ctx, "SELECT "+userColumns+" FROM users WHERE id = (SELECT user_id FROM identities WHERE issuer = ? AND subject = ?)", issuer, subject)
+}
+
+func (r *sqliteRepo) LinkIdentity(ctx context.Context, issuer, subject, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO identities (issuer, subject, user_id) VALUES (?, ?, ?)", issuer, subject, userID)
+	return err
+}
+
+func (r *sqliteRepo) SetRoles(ctx context.Context, userID string, roles []string) error {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_roles WHERE user_id = ?", userID); err != nil {
+		return err
+	}
+	for _, role := range roles {
+		if _, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO user_roles (user_id, role) VALUES (?, ?)", userID, role); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *sqliteRepo) UpdatePassword(ctx context.Context, userID, passwordHash string) error {
+	res, err := r.DB.ExecContext(ctx, "UPDATE users SET password_hash = ? WHERE id = ?", passwordHash, userID)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) CreateSession(ctx context.Context, s *domain.Session) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO sessions (token_hash, user_id, expires_at, created_at) VALUES (?, ?, ?, ?)",
+		s.TokenHash, s.UserID, s.ExpiresAt.Unix(), s.CreatedAt.Unix())
+	return err
+}
+
+func (r *sqliteRepo) GetSession(ctx context.Context, tokenHash string, now time.Time) (*domain.Session, error) {
+	var s domain.Session
+	var expiresAt, createdAt int64
+	err := r.DB.QueryRowContext(ctx, "SELECT token_hash, user_id, expires_at, created_at FROM sessions WHERE token_hash = ? AND expires_at > ?", tokenHash, now.Unix()).
+		Scan(&s.TokenHash, &s.UserID, &expiresAt, &createdAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, domain.ErrSessionNotFound
+		}
+		return nil, err
+	}
+	s.ExpiresAt = time.Unix(expiresAt, 0).UTC()
+	s.CreatedAt = time.Unix(createdAt, 0).UTC()
+	return &s, nil
+}
+
+func (r *sqliteRepo) DeleteSession(ctx context.Context, tokenHash string) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM sessions WHERE token_hash = ?", tokenHash)
+	return err
+}
+
+func (r *sqliteRepo) DeleteUserSessions(ctx context.Context, userID string) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM sessions WHERE user_id = ?", userID)
+	return err
+}
+
+func (r *sqliteRepo) DeleteExpiredSessions(ctx context.Context, now time.Time) error {
+	_, err := r.DB.ExecContext(ctx, "DELETE FROM sessions WHERE expires_at <= ?", now.Unix())
+	return err
+}
+
+func (r *sqliteRepo) CreatePasswordReset(ctx context.Context, reset *domain.PasswordReset) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO password_resets (token_hash, user_id, expires_at) VALUES (?, ?, ?)",
+		reset.TokenHash, reset.UserID, reset.ExpiresAt.Unix())
+	return err
+}
+
+func (r *sqliteRepo) ConsumePasswordReset(ctx context.Context, tokenHash string, now time.Time) (string, error) {
+	tx, err := r.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	var userID string
+	err = tx.QueryRowContext(ctx, "SELECT user_id FROM password_resets WHERE token_hash = ? AND used = 0 AND expires_at > ?", tokenHash, now.Unix()).Scan(&userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", domain.ErrInvalidResetToken
+		}
+		return "", err
+	}
+	// Older links of the user stop working too
+	if _, err := tx.ExecContext(ctx, "UPDATE password_resets SET used = 1 WHERE user_id = ?", userID); err != nil {
+		return "", err
+	}
+	return userID, tx.Commit()
+}
+
+func (r *sqliteRepo) CreateAPIKey(ctx context.Context, k *domain.APIKey) error {
+	_, err := r.DB.ExecContext(ctx, "INSERT INTO api_keys (id, user_id, name, prefix, key_hash, scopes, created_at) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		k.ID, k.UserID, k.Name, k.Prefix, k.KeyHash, strings.Join(k.Scopes, " "), k.CreatedAt.Unix())
+	return err
+}
+
+const apiKeyColumns = "id, user_id, name, prefix, key_hash, scopes, created_at, last_used_at, revoked_at"
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(row rowScanner) (*domain.APIKey, error) {
+	var k domain.APIKey
+	var scopes string
+	var createdAt, lastUsedAt, revokedAt int64
+	if err := row.Scan(&k.ID, &k.UserID, &k.Name, &k.Prefix, &k.KeyHash, &scopes, &createdAt, &lastUsedAt, &revokedAt); err != nil {
+		return nil, err
+	}
+	k.Scopes = strings.Fields(scopes)
+	k.CreatedAt = time.Unix(createdAt, 0).UTC()
+	if lastUsedAt != 0 {
+		k.LastUsedAt = time.Unix(lastUsedAt, 0).UTC()
+	}
+	if revokedAt != 0 {
+		k.RevokedAt = time.Unix(revokedAt, 0).UTC()
+	}
+	return &k, nil
+}
+
+func (r *sqliteRepo) ListAPIKeys(ctx context.Context, userID string) ([]*domain.APIKey, error) {
+	query := "SELECT " + apiKeyColumns + " FROM api_keys"
+	var args []any
+	if userID != "" {
+		query += " WHERE user_id = ?"
+		args = append(args, userID)
+	}
+	rows, err := r.DB.QueryContext(ctx, query+" ORDER BY created_at DESC, id", args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []*domain.APIKey{}
+	for rows.Next() {
+		k, err := scanAPIKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+func (r *sqliteRepo) GetAPIKey(ctx context.Context, keyHash string) (*domain.APIKey, error) {
+	k, err := scanAPIKey(r.DB.QueryRowContext(ctx, "SELECT "+apiKeyColumns+" FROM api_keys WHERE key_hash = ? AND revoked_at = 0", keyHash))
+	if err == sql.ErrNoRows {
+		return nil, domain.ErrInvalidAPIKey
+	}
+	return k, err
+}
+
+func (r *sqliteRepo) RevokeAPIKey(ctx context.Context, id, userID string, now time.Time) error {
+	query := "UPDATE api_keys SET revoked_at = ? WHERE id = ? AND revoked_at = 0"
+	args := []any{now.Unix(), id}
+	if userID != "" {
+		query += " AND user_id = ?"
+		args = append(args, userID)
+	}
+	res, err := r.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return domain.ErrAPIKeyNotFound
+	}
+	return nil
+}
+
+func (r *sqliteRepo) TouchAPIKey(ctx context.Context, id string, now time.Time) error {
+	_, err := r.DB.ExecContext(ctx, "UPDATE api_keys SET last_used_at = ? WHERE id = ?", now.Unix(), id)
+	return err
+}
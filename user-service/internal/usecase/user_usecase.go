@@ -0,0 +1,363 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/athandoan/youtube/user-service/internal/domain"
+	"github.com/google/uuid"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// resetTTL is how long a mailed password reset link works.
+const resetTTL = time.Hour
+
+// lastUsedPrecision is how stale an API key's last use may be recorded, so busy keys don't
+// cost a write per request.
+const lastUsedPrecision = time.Minute
+
+// dummyHash is compared against when a login names an unknown email, so that it takes as
+// long as a wrong password and response times don't reveal who has an account.
+var dummyHash, _ = bcrypt.GenerateFromPassword([]byte("not a password"), bcrypt.DefaultCost)
+
+type userUsecase struct {
+	repo       domain.UserRepository
+	mailer     domain.Mailer
+	sessionTTL time.Duration
+	resetURL   string
+	now        func() time.Time
+}
+
+// NewUserUsecase creates the usecase. Sessions last sessionTTL; reset links are resetURL
+// followed by the reset token.
+func NewUserUsecase(repo domain.UserRepository, mailer domain.Mailer, sessionTTL time.Duration, resetURL string) domain.UserUsecase {
+	return &userUsecase{repo: repo, mailer: mailer, sessionTTL: sessionTTL, resetURL: resetURL, now: time.Now}
+}
+
+func (u *userUsecase) Signup(ctx context.Context, email, password, displayName string) (*domain.NewSession, error) {
+	email, err := normalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkPassword(password); err != nil {
+		return nil, err
+	}
+	displayName = strings.Join(strings.Fields(displayName), " ")
+	if utf8.RuneCountInString(displayName) > domain.MaxDisplayNameLength {
+		return nil, domain.ErrDisplayNameTooLong
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	user := &domain.User{
+		ID:           uuid.New().String(),
+		Email:        email,
+		DisplayName:  displayName,
+		PasswordHash: string(hash),
+		CreatedAt:    u.now().UTC(),
+	}
+	if err := u.repo.CreateUser(ctx, user); err != nil {
+		return nil, err
+	}
+	return u.startSession(ctx, user)
+}
+
+func (u *userUsecase) Login(ctx context.Context, email, password string) (*domain.NewSession, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	user, err := u.repo.GetUserByEmail(ctx, email)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		_ = bcrypt.CompareHashAndPassword(dummyHash, []byte(password))
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+	if bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(password)) != nil {
+		return nil, domain.ErrInvalidCredentials
+	}
+	return u.startSession(ctx, user)
+}
+
+// startSession signs user in, dropping expired sessions while at it so they don't pile up.
+func (u *userUsecase) startSession(ctx context.Context, user *domain.User) (*domain.NewSession, error) {
+	now := u.now().UTC()
+	if err := u.repo.DeleteExpiredSessions(ctx, now); err != nil {
+		log.Printf("failed to delete expired sessions: %v", err)
+	}
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	session := &domain.Session{
+		TokenHash: hashToken(token),
+		UserID:    user.ID,
+		ExpiresAt: now.Add(u.sessionTTL),
+		CreatedAt: now,
+	}
+	if err := u.repo.CreateSession(ctx, session); err != nil {
+		return nil, err
+	}
+	return &domain.NewSession{Token: token, Session: session, User: user}, nil
+}
+
+func (u *userUsecase) Logout(ctx context.Context, token string) error {
+	return u.repo.DeleteSession(ctx, hashToken(token))
+}
+
+func (u *userUsecase) Authenticate(ctx context.Context, token string) (*domain.User, error) {
+	if token == "" {
+		return nil, domain.ErrSessionNotFound
+	}
+	session, err := u.repo.GetSession(ctx, hashToken(token), u.now())
+	if err != nil {
+		return nil, err
+	}
+	user, err := u.repo.GetUser(ctx, session.UserID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, domain.ErrSessionNotFound
+	}
+	return user, err
+}
+
+func (u *userUsecase) GetUser(ctx context.Context, id string) (*domain.User, error) {
+	return u.repo.GetUser(ctx, id)
+}
+
+// RequestPasswordReset fails the same way whether or not email has an account; mail
+// delivery problems are only logged for the same reason.
+func (u *userUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
+	user, err := u.repo.GetUserByEmail(ctx, email)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return err
+	}
+	reset := &domain.PasswordReset{
+		TokenHash: hashToken(token),
+		UserID:    user.ID,
+		ExpiresAt: u.now().UTC().Add(resetTTL),
+	}
+	if err := u.repo.CreatePasswordReset(ctx, reset); err != nil {
+		return err
+	}
+
+	msg := &domain.Mail{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf("Someone asked to reset the password of your account. Open this link within %s to choose a new one:\n\n%s%s\n\nIf it wasn't you, ignore this message; your password stays the same.\n",
+			resetTTL, u.resetURL, token),
+	}
+	if err := u.mailer.Send(ctx, msg); err != nil {
+		log.Printf("failed to mail password reset to user %s: %v", user.ID, err)
+	}
+	return nil
+}
+
+func (u *userUsecase) ResetPassword(ctx context.Context, token, password string) error {
+	if err := checkPassword(password); err != nil {
+		return err
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	userID, err := u.repo.ConsumePasswordReset(ctx, hashToken(token), u.now())
+	if err != nil {
+		return err
+	}
+	if err := u.repo.UpdatePassword(ctx, userID, string(hash)); err != nil {
+		return err
+	}
+	// Whoever knew the old password must not stay signed in
+	return u.repo.DeleteUserSessions(ctx, userID)
+}
+
+func (u *userUsecase) LoginExternal(ctx context.Context, identity *domain.ExternalIdentity) (*domain.NewSession, error) {
+	if identity.Issuer == "" || identity.Subject == "" {
+		return nil, domain.ErrInvalidIdentity
+	}
+	for _, role := range identity.Roles {
+		if !slices.Contains(domain.Roles, role) {
+			return nil, fmt.Errorf("%w: %q", domain.ErrUnknownRole, role)
+		}
+	}
+
+	user, err := u.repo.GetUserByIdentity(ctx, identity.Issuer, identity.Subject)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		user, err = u.linkExternalUser(ctx, identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.repo.SetRoles(ctx, user.ID, identity.Roles); err != nil {
+		return nil, err
+	}
+	user.Roles = slices.Clone(identity.Roles)
+	slices.Sort(user.Roles)
+	user.Roles = slices.Compact(user.Roles)
+	return u.startSession(ctx, user)
+}
+
+// linkExternalUser links an identity seen for the first time to an account: the one with its
+// email if the provider verified it, else a new one without a password.
+func (u *userUsecase) linkExternalUser(ctx context.Context, identity *domain.ExternalIdentity) (*domain.User, error) {
+	email, err := normalizeEmail(identity.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := u.repo.GetUserByEmail(ctx, email)
+	switch {
+	case err == nil:
+		// Anyone can claim an address they don't own at some providers
+		if !identity.EmailVerified {
+			return nil, domain.ErrEmailTaken
+		}
+	case errors.Is(err, domain.ErrUserNotFound):
+		displayName := strings.Join(strings.Fields(identity.DisplayName), " ")
+		if r := []rune(displayName); len(r) > domain.MaxDisplayNameLength {
+			displayName = string(r[:domain.MaxDisplayNameLength])
+		}
+		user = &domain.User{
+			ID:          uuid.New().String(),
+			Email:       email,
+			DisplayName: displayName,
+			CreatedAt:   u.now().UTC(),
+		}
+		if err := u.repo.CreateUser(ctx, user); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	if err := u.repo.LinkIdentity(ctx, identity.Issuer, identity.Subject, user.ID); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+func (u *userUsecase) CreateAPIKey(ctx context.Context, userID, name string, scopes []string) (string, *domain.APIKey, error) {
+	name = strings.Join(strings.Fields(name), " ")
+	if name == "" || utf8.RuneCountInString(name) > domain.MaxAPIKeyNameLength {
+		return "", nil, domain.ErrInvalidAPIKeyName
+	}
+	if len(scopes) == 0 {
+		return "", nil, domain.ErrInvalidScope
+	}
+	for _, scope := range scopes {
+		if !slices.Contains(domain.Scopes, scope) {
+			return "", nil, fmt.Errorf("%w: %q", domain.ErrInvalidScope, scope)
+		}
+	}
+	scopes = slices.Compact(slices.Sorted(slices.Values(scopes)))
+
+	if _, err := u.repo.GetUser(ctx, userID); err != nil {
+		return "", nil, err
+	}
+	token, err := newToken()
+	if err != nil {
+		return "", nil, err
+	}
+	secret := domain.APIKeyPrefix + token
+	key := &domain.APIKey{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		Name:      name,
+		Prefix:    secret[:len(domain.APIKeyPrefix)+6],
+		KeyHash:   hashToken(secret),
+		Scopes:    scopes,
+		CreatedAt: u.now().UTC(),
+	}
+	if err := u.repo.CreateAPIKey(ctx, key); err != nil {
+		return "", nil, err
+	}
+	return secret, key, nil
+}
+
+func (u *userUsecase) ListAPIKeys(ctx context.Context, userID string) ([]*domain.APIKey, error) {
+	return u.repo.ListAPIKeys(ctx, userID)
+}
+
+func (u *userUsecase) RevokeAPIKey(ctx context.Context, id, userID string) error {
+	return u.repo.RevokeAPIKey(ctx, id, userID, u.now().UTC())
+}
+
+func (u *userUsecase) AuthenticateAPIKey(ctx context.Context, secret string) (*domain.User, *domain.APIKey, error) {
+	if !strings.HasPrefix(secret, domain.APIKeyPrefix) {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+	key, err := u.repo.GetAPIKey(ctx, hashToken(secret))
+	if err != nil {
+		return nil, nil, err
+	}
+	user, err := u.repo.GetUser(ctx, key.UserID)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, nil, domain.ErrInvalidAPIKey
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := u.now().UTC()
+	if now.Sub(key.LastUsedAt) >= lastUsedPrecision {
+		// Not worth failing the request over
+		if err := u.repo.TouchAPIKey(ctx, key.ID, now); err != nil {
+			log.Printf("failed to record use of API key %s: %v", key.ID, err)
+		}
+		key.LastUsedAt = now
+	}
+	return user, key, nil
+}
+
+// normalizeEmail accepts a bare address and lowercases it, so sign-in is case insensitive.
+func normalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", domain.ErrInvalidEmail
+	}
+	return strings.ToLower(email), nil
+}
+
+func checkPassword(password string) error {
+	if len(password) < domain.MinPasswordLength || len(password) > domain.MaxPasswordLength {
+		return domain.ErrWeakPassword
+	}
+	return nil
+}
+
+// newToken returns 256 random bits, URL safe.
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
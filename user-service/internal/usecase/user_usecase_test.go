@@ -0,0 +1,563 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/athandoan/youtube/user-service/internal/domain"
+	"github.com/athandoan/youtube/user-service/internal/mocks"
+	"go.uber.org/mock/gomock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testNow = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+type userMocks struct {
+	repo   *mocks.MockUserRepository
+	mailer *mocks.MockMailer
+}
+
+func newTestUsecase(ctrl *gomock.Controller) (*userUsecase, userMocks) {
+	m := userMocks{
+		repo:   mocks.NewMockUserRepository(ctrl),
+		mailer: mocks.NewMockMailer(ctrl),
+	}
+	uc := NewUserUsecase(m.repo, m.mailer, 24*time.Hour, "http://localhost/?reset_token=").(*userUsecase)
+	uc.now = func() time.Time { return testNow }
+	return uc, m
+}
+
+func testUser(t *testing.T, password string) *domain.User {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &domain.User{ID: "user-1", Email: "ada@example.com", PasswordHash: string(hash)}
+}
+
+func TestUserUsecase_Signup(t *testing.T) {
+	tests := []struct {
+		name        string
+		email       string
+		password    string
+		displayName string
+		setupMock   func(m userMocks)
+		wantErr     error
+	}{
+		{
+			name:        "success - stores a lowercase email and hashed password, then signs in",
+			email:       " Ada@Example.com ",
+			password:    "correct horse",
+			displayName: "  Ada   Lovelace ",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, u *domain.User) error {
+					if u.Email != "ada@example.com" || u.DisplayName != "Ada Lovelace" {
+						t.Errorf("unexpected user %+v", u)
+					}
+					if bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte("correct horse")) != nil {
+						t.Error("password was not hashed with bcrypt")
+					}
+					return nil
+				})
+				m.repo.EXPECT().DeleteExpiredSessions(gomock.Any(), testNow).Return(nil)
+				m.repo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, s *domain.Session) error {
+					if !s.ExpiresAt.Equal(testNow.Add(24 * time.Hour)) {
+						t.Errorf("ExpiresAt = %v, want a day from now", s.ExpiresAt)
+					}
+					return nil
+				})
+			},
+		},
+		{
+			name:      "error - invalid email",
+			email:     "Ada <ada@example.com>",
+			password:  "correct horse",
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrInvalidEmail,
+		},
+		{
+			name:      "error - short password",
+			email:     "ada@example.com",
+			password:  "short",
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrWeakPassword,
+		},
+		{
+			name:      "error - password longer than bcrypt reads",
+			email:     "ada@example.com",
+			password:  strings.Repeat("x", 73),
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrWeakPassword,
+		},
+		{
+			name:     "error - email taken",
+			email:    "ada@example.com",
+			password: "correct horse",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).Return(domain.ErrEmailTaken)
+			},
+			wantErr: domain.ErrEmailTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			s, err := uc.Signup(context.Background(), tt.email, tt.password, tt.displayName)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Signup() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && (s.Token == "" || s.Session.TokenHash != hashToken(s.Token)) {
+				t.Errorf("Signup() should store only the hash of the handed out token")
+			}
+		})
+	}
+}
+
+func TestUserUsecase_Login(t *testing.T) {
+	tests := []struct {
+		name      string
+		email     string
+		password  string
+		setupMock func(m userMocks)
+		wantErr   error
+	}{
+		{
+			name:     "success - email is case insensitive",
+			email:    "ADA@example.com",
+			password: "correct horse",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUserByEmail(gomock.Any(), "ada@example.com").Return(testUser(t, "correct horse"), nil)
+				m.repo.EXPECT().DeleteExpiredSessions(gomock.Any(), testNow).Return(nil)
+				m.repo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:     "error - wrong password",
+			email:    "ada@example.com",
+			password: "wrong horse",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUserByEmail(gomock.Any(), "ada@example.com").Return(testUser(t, "correct horse"), nil)
+			},
+			wantErr: domain.ErrInvalidCredentials,
+		},
+		{
+			name:     "error - unknown email looks like a wrong password",
+			email:    "nobody@example.com",
+			password: "correct horse",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUserByEmail(gomock.Any(), "nobody@example.com").Return(nil, domain.ErrUserNotFound)
+			},
+			wantErr: domain.ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			_, err := uc.Login(context.Background(), tt.email, tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Login() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_Authenticate(t *testing.T) {
+	tests := []struct {
+		name      string
+		token     string
+		setupMock func(m userMocks)
+		wantErr   error
+	}{
+		{
+			name:  "success - returns the session's user",
+			token: "token",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetSession(gomock.Any(), hashToken("token"), testNow).Return(&domain.Session{UserID: "user-1"}, nil)
+				m.repo.EXPECT().GetUser(gomock.Any(), "user-1").Return(&domain.User{ID: "user-1"}, nil)
+			},
+		},
+		{
+			name:  "error - expired or logged out",
+			token: "token",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetSession(gomock.Any(), hashToken("token"), testNow).Return(nil, domain.ErrSessionNotFound)
+			},
+			wantErr: domain.ErrSessionNotFound,
+		},
+		{
+			name:      "error - no token",
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			_, err := uc.Authenticate(context.Background(), tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Authenticate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_RequestPasswordReset(t *testing.T) {
+	tests := []struct {
+		name      string
+		setupMock func(m userMocks)
+		wantErr   error
+	}{
+		{
+			name: "success - mails a link with a token of which only the hash is stored",
+			setupMock: func(m userMocks) {
+				var stored string
+				m.repo.EXPECT().GetUserByEmail(gomock.Any(), "ada@example.com").Return(testUser(t, "correct horse"), nil)
+				m.repo.EXPECT().CreatePasswordReset(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, r *domain.PasswordReset) error {
+					if r.UserID != "user-1" || !r.ExpiresAt.Equal(testNow.Add(resetTTL)) {
+						t.Errorf("unexpected reset %+v", r)
+					}
+					stored = r.TokenHash
+					return nil
+				})
+				m.mailer.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, mail *domain.Mail) error {
+					_, token, ok := strings.Cut(mail.Body, "?reset_token=")
+					token, _, _ = strings.Cut(token, "\n")
+					if mail.To != "ada@example.com" || !ok || hashToken(token) != stored {
+						t.Errorf("unexpected mail %+v", mail)
+					}
+					return errors.New("mail server down")
+				})
+			},
+		},
+		{
+			name: "success - unknown emails are not revealed",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUserByEmail(gomock.Any(), "ada@example.com").Return(nil, domain.ErrUserNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			err := uc.RequestPasswordReset(context.Background(), "Ada@example.com")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RequestPasswordReset() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_ResetPassword(t *testing.T) {
+	tests := []struct {
+		name      string
+		password  string
+		setupMock func(m userMocks)
+		wantErr   error
+	}{
+		{
+			name:     "success - sets the password and signs every session out",
+			password: "new horse battery",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().ConsumePasswordReset(gomock.Any(), hashToken("token"), testNow).Return("user-1", nil)
+				m.repo.EXPECT().UpdatePassword(gomock.Any(), "user-1", gomock.Any()).Return(nil)
+				m.repo.EXPECT().DeleteUserSessions(gomock.Any(), "user-1").Return(nil)
+			},
+		},
+		{
+			name:     "error - used or expired token",
+			password: "new horse battery",
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().ConsumePasswordReset(gomock.Any(), hashToken("token"), testNow).Return("", domain.ErrInvalidResetToken)
+			},
+			wantErr: domain.ErrInvalidResetToken,
+		},
+		{
+			name:      "error - weak password keeps the token usable",
+			password:  "short",
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrWeakPassword,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			err := uc.ResetPassword(context.Background(), "token", tt.password)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ResetPassword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_LoginExternal(t *testing.T) {
+	identity := func(verified bool, roles ...string) *domain.ExternalIdentity {
+		return &domain.ExternalIdentity{
+			Issuer:        "https://idp.example.com",
+			Subject:       "sub-1",
+			Email:         "Ada@Example.com",
+			EmailVerified: verified,
+			DisplayName:   "Ada Lovelace",
+			Roles:         roles,
+		}
+	}
+	startsSession := func(m userMocks) {
+		m.repo.EXPECT().DeleteExpiredSessions(gomock.Any(), testNow).Return(nil)
+		m.repo.EXPECT().CreateSession(gomock.Any(), gomock.Any()).Return(nil)
+	}
+
+	tests := []struct {
+		name      string
+		identity  *domain.ExternalIdentity
+		setupMock func(m userMocks)
+		wantRoles []string
+		wantErr   error
+	}{
+		{
+			name:     "success - linked identity gets the provider's roles",
+			identity: identity(true, "moderator", "admin"),
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUserByIdentity(gomock.Any(), "https://idp.example.com", "sub-1").
+					Return(&domain.User{ID: "user-1", Roles: []string{"admin"}}, nil)
+				m.repo.EXPECT().SetRoles(gomock.Any(), "user-1", []string{"moderator", "admin"}).Return(nil)
+				startsSession(m)
+			},
+			wantRoles: []string{"admin", "moderator"},
+		},
+		{
+			name:     "success - first login creates an account without a password",
+			identity: identity(false),
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUserByIdentity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.ErrUserNotFound)
+				m.repo.EXPECT().GetUserByEmail(gomock.Any(), "ada@example.com").Return(nil, domain.ErrUserNotFound)
+				m.repo.EXPECT().CreateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, u *domain.User) error {
+					if u.Email != "ada@example.com" || u.DisplayName != "Ada Lovelace" || u.PasswordHash != "" {
+						t.Errorf("unexpected user %+v", u)
+					}
+					u.ID = "user-2"
+					return nil
+				})
+				m.repo.EXPECT().LinkIdentity(gomock.Any(), "https://idp.example.com", "sub-1", "user-2").Return(nil)
+				m.repo.EXPECT().SetRoles(gomock.Any(), "user-2", nil).Return(nil)
+				startsSession(m)
+			},
+		},
+		{
+			name:     "success - verified email links the existing account",
+			identity: identity(true),
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUserByIdentity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.ErrUserNotFound)
+				m.repo.EXPECT().GetUserByEmail(gomock.Any(), "ada@example.com").Return(testUser(t, "correct horse"), nil)
+				m.repo.EXPECT().LinkIdentity(gomock.Any(), "https://idp.example.com", "sub-1", "user-1").Return(nil)
+				m.repo.EXPECT().SetRoles(gomock.Any(), "user-1", nil).Return(nil)
+				startsSession(m)
+			},
+		},
+		{
+			name:     "error - unverified email can't take over an account",
+			identity: identity(false),
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUserByIdentity(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, domain.ErrUserNotFound)
+				m.repo.EXPECT().GetUserByEmail(gomock.Any(), "ada@example.com").Return(testUser(t, "correct horse"), nil)
+			},
+			wantErr: domain.ErrEmailTaken,
+		},
+		{
+			name:      "error - unknown role",
+			identity:  identity(true, "root"),
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrUnknownRole,
+		},
+		{
+			name:      "error - no subject",
+			identity:  &domain.ExternalIdentity{Issuer: "https://idp.example.com"},
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrInvalidIdentity,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			s, err := uc.LoginExternal(context.Background(), tt.identity)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("LoginExternal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(s.User.Roles, tt.wantRoles) {
+				t.Errorf("LoginExternal() roles = %v, want %v", s.User.Roles, tt.wantRoles)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_CreateAPIKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		keyName    string
+		scopes     []string
+		setupMock  func(m userMocks)
+		wantScopes []string
+		wantErr    error
+	}{
+		{
+			name:    "success - stores only the key's hash",
+			keyName: "  CI   uploads ",
+			scopes:  []string{"videos:read", "upload:write", "videos:read"},
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUser(gomock.Any(), "user-1").Return(&domain.User{ID: "user-1"}, nil)
+				m.repo.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantScopes: []string{"upload:write", "videos:read"},
+		},
+		{
+			name:      "error - unknown scope",
+			keyName:   "CI",
+			scopes:    []string{"videos:delete"},
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrInvalidScope,
+		},
+		{
+			name:      "error - no scopes",
+			keyName:   "CI",
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrInvalidScope,
+		},
+		{
+			name:      "error - no name",
+			keyName:   "   ",
+			scopes:    []string{"videos:read"},
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrInvalidAPIKeyName,
+		},
+		{
+			name:    "error - unknown user",
+			keyName: "CI",
+			scopes:  []string{"videos:read"},
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetUser(gomock.Any(), "user-1").Return(nil, domain.ErrUserNotFound)
+			},
+			wantErr: domain.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			secret, key, err := uc.CreateAPIKey(context.Background(), "user-1", tt.keyName, tt.scopes)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CreateAPIKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if !strings.HasPrefix(secret, domain.APIKeyPrefix) || key.KeyHash != hashToken(secret) || !strings.HasPrefix(secret, key.Prefix) {
+				t.Errorf("CreateAPIKey() = %q, %+v", secret, key)
+			}
+			if key.Name != "CI uploads" || !slices.Equal(key.Scopes, tt.wantScopes) {
+				t.Errorf("CreateAPIKey() name %q scopes %v, want %v", key.Name, key.Scopes, tt.wantScopes)
+			}
+		})
+	}
+}
+
+func TestUserUsecase_AuthenticateAPIKey(t *testing.T) {
+	const secret = domain.APIKeyPrefix + "secret"
+
+	tests := []struct {
+		name      string
+		key       string
+		setupMock func(m userMocks)
+		wantErr   error
+	}{
+		{
+			name: "success - records the first use",
+			key:  secret,
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetAPIKey(gomock.Any(), hashToken(secret)).Return(&domain.APIKey{ID: "key-1", UserID: "user-1"}, nil)
+				m.repo.EXPECT().GetUser(gomock.Any(), "user-1").Return(&domain.User{ID: "user-1"}, nil)
+				m.repo.EXPECT().TouchAPIKey(gomock.Any(), "key-1", testNow).Return(nil)
+			},
+		},
+		{
+			name: "success - recent use is not written again",
+			key:  secret,
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetAPIKey(gomock.Any(), hashToken(secret)).
+					Return(&domain.APIKey{ID: "key-1", UserID: "user-1", LastUsedAt: testNow.Add(-10 * time.Second)}, nil)
+				m.repo.EXPECT().GetUser(gomock.Any(), "user-1").Return(&domain.User{ID: "user-1"}, nil)
+			},
+		},
+		{
+			name:      "error - not an API key",
+			key:       "session-token",
+			setupMock: func(m userMocks) {},
+			wantErr:   domain.ErrInvalidAPIKey,
+		},
+		{
+			name: "error - revoked or unknown key",
+			key:  secret,
+			setupMock: func(m userMocks) {
+				m.repo.EXPECT().GetAPIKey(gomock.Any(), hashToken(secret)).Return(nil, domain.ErrInvalidAPIKey)
+			},
+			wantErr: domain.ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			uc, m := newTestUsecase(ctrl)
+			tt.setupMock(m)
+
+			_, _, err := uc.AuthenticateAPIKey(context.Background(), tt.key)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("AuthenticateAPIKey() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}